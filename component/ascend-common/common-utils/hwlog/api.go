@@ -93,9 +93,20 @@ type logger struct {
 	lgCtrl     *LogLimiter
 	lgLevel    int
 	lgMaxLine  int
+	lgFormat   string
+	component  string
 }
 
 func (lg *logger) initLogWriter(w io.Writer) {
+	if lg.lgFormat == FormatJSON {
+		// the json entry carries its own timestamp and level, so neither prefix nor custom writer is used
+		lg.lgDebug = log.New(w, "", 0)
+		lg.lgInfo = log.New(w, "", 0)
+		lg.lgWarn = log.New(w, "", 0)
+		lg.lgError = log.New(w, "", 0)
+		lg.lgCritical = log.New(w, "", 0)
+		return
+	}
 	// Use custom logger writer, note that we don't use log.Ldate|log.Lmicroseconds flag to avoid duplicate timestamps
 	// Custom writer will handle timestamp formatting
 	customWriter := NewCustomLoggerWriter(w)
@@ -123,6 +134,17 @@ func (lg *logger) setLoggerMaxLine(lml int) {
 	lg.lgMaxLine = lml
 }
 
+func (lg *logger) setLoggerFormat(config *LogConfig) {
+	lg.lgFormat = config.Format
+	if lg.lgFormat == "" {
+		lg.lgFormat = FormatText
+	}
+	lg.component = config.Component
+	if lg.component == "" {
+		lg.component = path.Base(os.Args[0])
+	}
+}
+
 func (lg *logger) setLoggerWriter(config *LogConfig) {
 	rollLogger := &Logs{
 		FileName:   config.LogFileName,
@@ -152,6 +174,7 @@ func (lg *logger) setLogger(config *LogConfig) error {
 	if err := validateLogConfigFiled(config); err != nil {
 		return err
 	}
+	lg.setLoggerFormat(config)
 	lg.setLoggerWriter(config)
 	lg.setLoggerLevel(config.LogLevel)
 	lg.setLoggerMaxLine(config.MaxLineLength)
@@ -169,6 +192,15 @@ func (lg *logger) setLogger(config *LogConfig) error {
 	return nil
 }
 
+// print write the log in the configured format
+func (lg *logger) print(l *log.Logger, level int, msg string, ctx context.Context) {
+	if lg.lgFormat == FormatJSON {
+		printJSONHelper(l, level, lg.component, msg, lg.lgMaxLine, ctx)
+		return
+	}
+	printHelper(l, msg, lg.lgMaxLine, ctx)
+}
+
 func (lg *logger) isInit() bool {
 	return lg.lgDebug != nil && lg.lgInfo != nil && lg.lgWarn != nil && lg.lgError != nil && lg.lgCritical != nil
 }
@@ -189,7 +221,7 @@ func (lg *logger) DebugWithCtx(ctx context.Context, args ...interface{}) {
 		return
 	}
 	if lg.validate() {
-		printHelper(lg.lgDebug, fmt.Sprint(args...), lg.lgMaxLine, ctx)
+		lg.print(lg.lgDebug, logDebugLv, fmt.Sprint(args...), ctx)
 	}
 }
 
@@ -199,7 +231,7 @@ func (lg *logger) DebugfWithCtx(ctx context.Context, format string, args ...inte
 		return
 	}
 	if lg.validate() {
-		printHelper(lg.lgDebug, fmt.Sprintf(format, args...), lg.lgMaxLine, ctx)
+		lg.print(lg.lgDebug, logDebugLv, fmt.Sprintf(format, args...), ctx)
 	}
 }
 
@@ -219,7 +251,7 @@ func (lg *logger) InfoWithCtx(ctx context.Context, args ...interface{}) {
 		return
 	}
 	if lg.validate() {
-		printHelper(lg.lgInfo, fmt.Sprint(args...), lg.lgMaxLine, ctx)
+		lg.print(lg.lgInfo, logInfoLv, fmt.Sprint(args...), ctx)
 	}
 }
 
@@ -229,7 +261,7 @@ func (lg *logger) InfofWithCtx(ctx context.Context, format string, args ...inter
 		return
 	}
 	if lg.validate() {
-		printHelper(lg.lgInfo, fmt.Sprintf(format, args...), lg.lgMaxLine, ctx)
+		lg.print(lg.lgInfo, logInfoLv, fmt.Sprintf(format, args...), ctx)
 	}
 }
 
@@ -249,7 +281,7 @@ func (lg *logger) WarnWithCtx(ctx context.Context, args ...interface{}) {
 		return
 	}
 	if lg.validate() {
-		printHelper(lg.lgWarn, fmt.Sprint(args...), lg.lgMaxLine, ctx)
+		lg.print(lg.lgWarn, logWarnLv, fmt.Sprint(args...), ctx)
 	}
 }
 
@@ -259,7 +291,7 @@ func (lg *logger) WarnfWithCtx(ctx context.Context, format string, args ...inter
 		return
 	}
 	if lg.validate() {
-		printHelper(lg.lgWarn, fmt.Sprintf(format, args...), lg.lgMaxLine, ctx)
+		lg.print(lg.lgWarn, logWarnLv, fmt.Sprintf(format, args...), ctx)
 	}
 }
 
@@ -308,7 +340,7 @@ func (lg *logger) ErrorWithCtx(ctx context.Context, args ...interface{}) {
 		return
 	}
 	if lg.validate() {
-		printHelper(lg.lgError, fmt.Sprint(args...), lg.lgMaxLine, ctx)
+		lg.print(lg.lgError, logErrorLv, fmt.Sprint(args...), ctx)
 	}
 }
 
@@ -318,7 +350,7 @@ func (lg *logger) ErrorfWithCtx(ctx context.Context, format string, args ...inte
 		return
 	}
 	if lg.validate() {
-		printHelper(lg.lgError, fmt.Sprintf(format, args...), lg.lgMaxLine, ctx)
+		lg.print(lg.lgError, logErrorLv, fmt.Sprintf(format, args...), ctx)
 	}
 }
 
@@ -338,7 +370,7 @@ func (lg *logger) CriticalWithCtx(ctx context.Context, args ...interface{}) {
 		return
 	}
 	if lg.validate() {
-		printHelper(lg.lgCritical, fmt.Sprint(args...), lg.lgMaxLine, ctx)
+		lg.print(lg.lgCritical, logCriticalLv, fmt.Sprint(args...), ctx)
 	}
 }
 
@@ -348,7 +380,7 @@ func (lg *logger) CriticalfWithCtx(ctx context.Context, format string, args ...i
 		return
 	}
 	if lg.validate() {
-		printHelper(lg.lgCritical, fmt.Sprintf(format, args...), lg.lgMaxLine, ctx)
+		lg.print(lg.lgCritical, logCriticalLv, fmt.Sprintf(format, args...), ctx)
 	}
 }
 
@@ -0,0 +1,173 @@
+/* Copyright(C) 2026. Huawei Technologies Co.,Ltd. All rights reserved.
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+// Package hwlog provides the capability of processing Huawei log rules.
+package hwlog
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	// FormatText the default format, each line is [time][level] goroutine caller message
+	FormatText = "text"
+	// FormatJSON each line is a json object, for shipping logs to Loki, Elasticsearch and so on
+	FormatJSON = "json"
+
+	// FieldJobID field key of the job id
+	FieldJobID = "jobId"
+	// FieldRank field key of the rank of the job
+	FieldRank = "rank"
+	// FieldNodeName field key of the node name
+	FieldNodeName = "nodeName"
+	// FieldLogicID field key of the logic device id
+	FieldLogicID = "logicId"
+
+	// fieldsKey used for context value key of the log fields
+	fieldsKey ContextKey = "logFields"
+	// jsonTimeLayout fixed width, so the log limiter cuts the timestamp off from the line
+	jsonTimeLayout = "2006-01-02T15:04:05.000000-07:00"
+	keyValuePair   = 2
+)
+
+// reservedKeys keys of the json entry, the fields with the same keys are ignored
+var reservedKeys = map[string]struct{}{
+	"timestamp": {}, "level": {}, "component": {}, "caller": {}, "goroutine": {}, "msg": {},
+	"userId": {}, "requestId": {},
+}
+
+var levelNames = map[int]string{
+	logDebugLv:    "DEBUG",
+	logInfoLv:     "INFO",
+	logWarnLv:     "WARN",
+	logErrorLv:    "ERROR",
+	logCriticalLv: "CRITICAL",
+}
+
+// Fields key/value pairs printed with the log
+type Fields map[string]interface{}
+
+// WithFields return a context carrying the fields, the fields of the parent context are inherited and
+// overridden by the same keys. pass the context to the *WithCtx methods to print the fields
+func WithFields(ctx context.Context, fields Fields) context.Context {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	parent := FieldsFromCtx(ctx)
+	merged := make(Fields, len(parent)+len(fields))
+	for k, v := range parent {
+		merged[k] = v
+	}
+	for k, v := range fields {
+		merged[k] = v
+	}
+	return context.WithValue(ctx, fieldsKey, merged)
+}
+
+// WithCtx return a context carrying the key/value pairs, e.g. WithCtx(ctx, FieldJobID, jobID, FieldRank, rank),
+// the key must be a string, the last key without value is ignored
+func WithCtx(ctx context.Context, keysAndValues ...interface{}) context.Context {
+	fields := make(Fields, len(keysAndValues)/keyValuePair)
+	for i := 0; i+1 < len(keysAndValues); i += keyValuePair {
+		key, ok := keysAndValues[i].(string)
+		if !ok {
+			key = fmt.Sprint(keysAndValues[i])
+		}
+		fields[key] = keysAndValues[i+1]
+	}
+	return WithFields(ctx, fields)
+}
+
+// FieldsFromCtx return the fields carried by the context
+func FieldsFromCtx(ctx context.Context) Fields {
+	if ctx == nil {
+		return nil
+	}
+	fields, ok := ctx.Value(fieldsKey).(Fields)
+	if !ok {
+		return nil
+	}
+	return fields
+}
+
+func sortedKeys(fields Fields) []string {
+	keys := make([]string, 0, len(fields))
+	for k := range fields {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// textFields format the fields as [k1=v1 k2=v2] for the text format
+func textFields(fields Fields) string {
+	if len(fields) == 0 {
+		return ""
+	}
+	pairs := make([]string, 0, len(fields))
+	for _, k := range sortedKeys(fields) {
+		pairs = append(pairs, fmt.Sprintf("%s=%v", k, fields[k]))
+	}
+	return "[" + strings.Join(pairs, " ") + "] "
+}
+
+// jsonEntry build a json line, the timestamp is always the first key to keep the log limiter working
+func jsonEntry(level int, component string, caller callerInfo, msg string) string {
+	var b strings.Builder
+	b.WriteString(`{"timestamp":`)
+	writeJSONValue(&b, time.Now().Format(jsonTimeLayout))
+	writeJSONField(&b, "level", levelNames[level])
+	writeJSONField(&b, "component", component)
+	writeJSONField(&b, "caller", caller.path)
+	writeJSONField(&b, "goroutine", caller.goroutineID)
+	writeJSONField(&b, "msg", msg)
+	if caller.userID != nil {
+		writeJSONField(&b, "userId", caller.userID)
+	}
+	if caller.traceID != nil {
+		writeJSONField(&b, "requestId", caller.traceID)
+	}
+	for _, k := range sortedKeys(caller.fields) {
+		if _, reserved := reservedKeys[k]; reserved {
+			continue
+		}
+		writeJSONField(&b, k, caller.fields[k])
+	}
+	b.WriteByte('}')
+	return b.String()
+}
+
+func writeJSONField(b *strings.Builder, key string, value interface{}) {
+	b.WriteByte(',')
+	writeJSONValue(b, key)
+	b.WriteByte(':')
+	writeJSONValue(b, value)
+}
+
+func writeJSONValue(b *strings.Builder, value interface{}) {
+	if err, ok := value.(error); ok {
+		value = err.Error()
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		// the value can not be encoded, such as channels and functions, print it as a string
+		data, _ = json.Marshal(fmt.Sprint(value))
+	}
+	b.Write(data)
+}
@@ -0,0 +1,125 @@
+/* Copyright(C) 2026. Huawei Technologies Co.,Ltd. All rights reserved.
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+// Package hwlog test file
+package hwlog
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/smartystreets/goconvey/convey"
+)
+
+const (
+	testJobID     = "job-1"
+	testNodeName  = "node-1"
+	testComponent = "clusterd"
+	testRank      = 3
+	testLogicID   = 5
+)
+
+func newBufferLogger(format string) (*logger, *bytes.Buffer) {
+	lg := new(logger)
+	lg.setLoggerFormat(&LogConfig{Format: format, Component: testComponent})
+	lg.setLoggerLevel(logDebugLv)
+	lg.setLoggerMaxLine(defaultMaxEachLineLen)
+	buf := &bytes.Buffer{}
+	lg.initLogWriter(buf)
+	return lg, buf
+}
+
+func decodeEntry(buf *bytes.Buffer) map[string]interface{} {
+	entry := make(map[string]interface{})
+	if err := json.Unmarshal(bytes.TrimSpace(buf.Bytes()), &entry); err != nil {
+		return nil
+	}
+	buf.Reset()
+	return entry
+}
+
+func TestJSONFormat(t *testing.T) {
+	convey.Convey("test json format", t, func() {
+		lg, buf := newBufferLogger(FormatJSON)
+		convey.Convey("entry should contain timestamp, level, component, caller and message", func() {
+			lg.Warnf("npu %d is %s", 0, "unhealthy")
+			entry := decodeEntry(buf)
+			convey.So(entry, convey.ShouldNotBeNil)
+			convey.So(entry["level"], convey.ShouldEqual, "WARN")
+			convey.So(entry["component"], convey.ShouldEqual, testComponent)
+			convey.So(entry["msg"], convey.ShouldEqual, "npu 0 is unhealthy")
+			convey.So(entry["caller"], convey.ShouldStartWith, "hwlog/fields_test.go:")
+			convey.So(entry["timestamp"], convey.ShouldNotBeEmpty)
+		})
+		convey.Convey("fields carried by the context should be printed", func() {
+			ctx := WithCtx(context.Background(), FieldJobID, testJobID, FieldRank, testRank)
+			ctx = WithFields(ctx, Fields{FieldNodeName: testNodeName, FieldLogicID: testLogicID,
+				"level": "ignored", "err": errors.New("mock error")})
+			lg.ErrorWithCtx(ctx, "fault \"occurred\"\n")
+			entry := decodeEntry(buf)
+			convey.So(entry, convey.ShouldNotBeNil)
+			convey.So(entry["level"], convey.ShouldEqual, "ERROR")
+			convey.So(entry["msg"], convey.ShouldEqual, "fault \"occurred\" ")
+			convey.So(entry[FieldJobID], convey.ShouldEqual, testJobID)
+			convey.So(entry[FieldRank], convey.ShouldEqual, testRank)
+			convey.So(entry[FieldNodeName], convey.ShouldEqual, testNodeName)
+			convey.So(entry[FieldLogicID], convey.ShouldEqual, testLogicID)
+			convey.So(entry["err"], convey.ShouldEqual, "mock error")
+			convey.So(entry["caller"], convey.ShouldStartWith, "hwlog/fields_test.go:")
+		})
+		convey.Convey("timestamp should be cut off by the log limiter", func() {
+			lg.Info("limiter")
+			line := buf.String()
+			convey.So(strings.HasPrefix(line[cutPreLen:], `","level":"INFO"`), convey.ShouldBeTrue)
+		})
+	})
+}
+
+func TestTextFormatWithFields(t *testing.T) {
+	convey.Convey("test text format with fields", t, func() {
+		lg, buf := newBufferLogger("")
+		ctx := WithCtx(context.Background(), FieldJobID, testJobID, FieldRank, testRank)
+		lg.InfoWithCtx(ctx, "job started")
+		line := buf.String()
+		convey.So(line, convey.ShouldStartWith, "[")
+		convey.So(line, convey.ShouldContainSubstring, "[INFO]")
+		convey.So(line, convey.ShouldContainSubstring, "hwlog/fields_test.go:")
+		convey.So(line, convey.ShouldContainSubstring, "[jobId=job-1 rank=3] job started")
+	})
+}
+
+func TestWithFields(t *testing.T) {
+	convey.Convey("test with fields", t, func() {
+		parent := WithFields(nil, Fields{FieldJobID: testJobID, FieldRank: 1})
+		child := WithCtx(parent, FieldRank, testRank, "dangling")
+		convey.So(FieldsFromCtx(child), convey.ShouldResemble, Fields{FieldJobID: testJobID, FieldRank: testRank})
+		convey.So(FieldsFromCtx(parent)[FieldRank], convey.ShouldEqual, 1)
+		convey.So(FieldsFromCtx(nil), convey.ShouldBeNil)
+	})
+}
+
+func TestValidateLogConfigFormat(t *testing.T) {
+	convey.Convey("test validate log format", t, func() {
+		lg := new(logger)
+		err := lg.setLogger(&LogConfig{OnlyToStdout: true, Format: "xml"})
+		convey.So(err, convey.ShouldNotBeNil)
+		err = lg.setLogger(&LogConfig{OnlyToStdout: true, Format: FormatJSON})
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(lg.component, convey.ShouldNotBeEmpty)
+	})
+}
@@ -45,7 +45,7 @@ const (
 	LogDirMode            = 0750
 	backUpLogRegex        = `^.+-[0-9]{4}-[0-9]{2}-[0-9T]{5}-[0-9]{2}-[0-9]{2}\.[0-9]{2,4}`
 	bitsize               = 64
-	stackDeep             = 3
+	stackDeep             = 4
 	pathLen               = 2
 	minLogLevel           = -1
 	maxLogLevel           = 3
@@ -77,6 +77,10 @@ type LogConfig struct {
 	ExpiredTime int
 	// Size of log cache space, default: 10240
 	CacheSize int
+	// Format log format, text or json, default value: text
+	Format string
+	// Component component name printed in the json format, default value: the name of the executable
+	Component string
 }
 
 var reg = regexp.MustCompile(backUpLogRegex)
@@ -178,7 +182,17 @@ func getValidateFuncList() []validateFunc {
 	return funcList
 }
 
+func validateLogConfigFormat(config *LogConfig) error {
+	if config.Format != "" && config.Format != FormatText && config.Format != FormatJSON {
+		return fmt.Errorf("the log format should be %s or %s", FormatText, FormatJSON)
+	}
+	return nil
+}
+
 func validateLogConfigFiled(config *LogConfig) error {
+	if err := validateLogConfigFormat(config); err != nil {
+		return err
+	}
 	if config.OnlyToStdout {
 		return nil
 	}
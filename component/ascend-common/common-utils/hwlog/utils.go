@@ -26,28 +26,57 @@ import (
 
 // printHelper helper function for log printing
 func printHelper(lg *log.Logger, msg string, maxLogLength int, ctx ...context.Context) {
-	str := getCallerInfo(ctx...)
+	caller := getCaller(ctx...)
+	lg.Println(caller.text() + trimLogMsg(msg, maxLogLength))
+}
+
+// printJSONHelper helper function for log printing in json format
+func printJSONHelper(lg *log.Logger, level int, component string, msg string, maxLogLength int,
+	ctx ...context.Context) {
+	caller := getCaller(ctx...)
+	lg.Println(jsonEntry(level, component, caller, trimLogMsg(msg, maxLogLength)))
+}
+
+func trimLogMsg(msg string, maxLogLength int) string {
 	trimMsg := strings.Replace(msg, "\r", " ", -1)
 	trimMsg = strings.Replace(trimMsg, "\n", " ", -1)
 	runeArr := []rune(trimMsg)
 	if length := len(runeArr); length > maxLogLength {
 		trimMsg = string(runeArr[:maxLogLength])
 	}
-	lg.Println(str + trimMsg)
+	return trimMsg
+}
+
+// callerInfo the caller's information and the values carried by the context
+type callerInfo struct {
+	goroutineID string
+	path        string
+	userID      interface{}
+	traceID     interface{}
+	fields      Fields
+}
+
+// text format the caller's information for the text format
+func (c callerInfo) text() string {
+	str := fmt.Sprintf("%-8s%s    ", c.goroutineID, c.path)
+	if c.userID != nil || c.traceID != nil {
+		str = fmt.Sprintf("%s{%#v}-{%#v} ", str, c.userID, c.traceID)
+	}
+	return str + textFields(c.fields)
 }
 
-// getCallerInfo gets the caller's information
-func getCallerInfo(ctx ...context.Context) string {
+// getCaller gets the caller's information
+func getCaller(ctx ...context.Context) callerInfo {
 	var deep = stackDeep
-	var userID interface{}
-	var traceID interface{}
+	var info callerInfo
 	for _, c := range ctx {
 		if c == nil {
 			deep++
 			continue
 		}
-		userID = c.Value(UserID)
-		traceID = c.Value(ReqID)
+		info.userID = c.Value(UserID)
+		info.traceID = c.Value(ReqID)
+		info.fields = FieldsFromCtx(c)
 		if val := c.Value(extraDeepKey); val != nil {
 			currentVal, _ := val.(int) // security type assertions, invalid values are automatically zeroed
 			deep += currentVal
@@ -65,13 +94,9 @@ func getCallerInfo(ctx ...context.Context) string {
 	} else if l > pathLen {
 		funcName = fmt.Sprintf("%s/%s", p[l-pathLen], p[l-1])
 	}
-	callerPath := fmt.Sprintf("%s:%d", funcName, codeLine)
-	goroutineID := getGoroutineID()
-	str := fmt.Sprintf("%-8s%s    ", goroutineID, callerPath)
-	if userID != nil || traceID != nil {
-		str = fmt.Sprintf("%s{%#v}-{%#v} ", str, userID, traceID)
-	}
-	return str
+	info.path = fmt.Sprintf("%s:%d", funcName, codeLine)
+	info.goroutineID = getGoroutineID()
+	return info
 }
 
 // getCallerGoroutineID gets the goroutineID
@@ -0,0 +1,133 @@
+/* Copyright(C) 2026. Huawei Technologies Co.,Ltd. All rights reserved.
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+// Package tlsutils offer the credentials of the clients connecting to clusterd
+package tlsutils
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+
+	"ascend-common/common-utils/utils"
+)
+
+const (
+	// ClusterdTLSDirEnv is the directory of ca.crt verifying clusterd, and tls.crt and tls.key of the client
+	// certificate for mTLS. the connection is insecure when it is empty
+	ClusterdTLSDirEnv = "CLUSTERD_TLS_DIR"
+	// ClusterdServerNameEnv is the name verified in the certificate of clusterd
+	ClusterdServerNameEnv = "CLUSTERD_TLS_SERVER_NAME"
+	// ClusterdTokenFileEnv is the token file sent to clusterd for authentication, usually the projected
+	// service account token
+	ClusterdTokenFileEnv = "CLUSTERD_TOKEN_FILE"
+	// DefaultClusterdServerName is the dns name of the clusterd grpc service
+	DefaultClusterdServerName = "clusterd-grpc-svc.mindx-dl.svc.cluster.local"
+
+	// AuthorizationKey is the metadata key of the token
+	AuthorizationKey = "authorization"
+	// BearerPrefix is the prefix of the token in the metadata
+	BearerPrefix = "Bearer "
+
+	maxTokenFileSize = 64 * 1024
+)
+
+// TokenCredentials send the token in the file on every call, the file is read every time since the projected
+// service account token is rotated by kubelet. it implements the PerRPCCredentials of grpc
+type TokenCredentials struct {
+	tokenFile string
+}
+
+// NewTokenCredentials return the credentials of the token file
+func NewTokenCredentials(tokenFile string) *TokenCredentials {
+	return &TokenCredentials{tokenFile: tokenFile}
+}
+
+// GetRequestMetadata return the authorization metadata
+func (c *TokenCredentials) GetRequestMetadata(ctx context.Context, uri ...string) (map[string]string, error) {
+	data, err := utils.ReadLimitBytesWithSymlink(c.tokenFile, maxTokenFileSize, func(string) bool { return true })
+	if err != nil {
+		return nil, fmt.Errorf("read token file failed: %v", err)
+	}
+	token := strings.TrimSpace(string(data))
+	if token == "" {
+		return nil, errors.New("token is empty")
+	}
+	return map[string]string{AuthorizationKey: BearerPrefix + token}, nil
+}
+
+// RequireTransportSecurity the token should not be sent on the insecure connection
+func (c *TokenCredentials) RequireTransportSecurity() bool {
+	return true
+}
+
+// ClientCredentials is the credentials of the client connecting to clusterd
+type ClientCredentials struct {
+	// TLS is nil when the connection is insecure
+	TLS *tls.Config
+	// Token is nil when no token is sent
+	Token *TokenCredentials
+}
+
+var (
+	clusterdCredentials   *ClientCredentials
+	clusterdCredentialsMu sync.Mutex
+)
+
+// GetClusterdClientCredentials return the credentials of connecting to clusterd configured by the environments, the
+// certificates loaded are shared by all connections and reloaded when the mounted secret is updated. the failure is
+// not cached, so the credentials are loaded again by the next call when the secret is mounted late
+func GetClusterdClientCredentials() (*ClientCredentials, error) {
+	clusterdCredentialsMu.Lock()
+	defer clusterdCredentialsMu.Unlock()
+	if clusterdCredentials != nil {
+		return clusterdCredentials, nil
+	}
+	creds, err := newClusterdClientCredentials()
+	if err != nil {
+		return nil, err
+	}
+	clusterdCredentials = creds
+	return creds, nil
+}
+
+func newClusterdClientCredentials() (*ClientCredentials, error) {
+	creds := &ClientCredentials{}
+	if tokenFile := os.Getenv(ClusterdTokenFileEnv); tokenFile != "" {
+		creds.Token = NewTokenCredentials(tokenFile)
+	}
+	dir := os.Getenv(ClusterdTLSDirEnv)
+	if dir == "" {
+		if creds.Token != nil {
+			return nil, fmt.Errorf("%s requires %s to be set", ClusterdTokenFileEnv, ClusterdTLSDirEnv)
+		}
+		return creds, nil
+	}
+	reloader, err := NewCertReloader(dir)
+	if err != nil {
+		return nil, fmt.Errorf("load clusterd client certificates failed: %v", err)
+	}
+	serverName := os.Getenv(ClusterdServerNameEnv)
+	if serverName == "" {
+		serverName = DefaultClusterdServerName
+	}
+	if creds.TLS, err = reloader.ClientConfig(serverName); err != nil {
+		return nil, err
+	}
+	return creds, nil
+}
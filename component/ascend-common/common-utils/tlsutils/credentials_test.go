@@ -0,0 +1,103 @@
+/* Copyright(C) 2026. Huawei Technologies Co.,Ltd. All rights reserved.
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+// Package tlsutils test for the credentials of the clients
+package tlsutils
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/smartystreets/goconvey/convey"
+)
+
+func TestTokenCredentials(t *testing.T) {
+	convey.Convey("Test TokenCredentials", t, func() {
+		dir := t.TempDir()
+		tokenFile := filepath.Join(dir, "token")
+		creds := NewTokenCredentials(tokenFile)
+		convey.So(creds.RequireTransportSecurity(), convey.ShouldBeTrue)
+		convey.Convey("01-token file exists, should return the rotated token", func() {
+			writeCertDir(t, dir, map[string][]byte{"token": []byte("token1\n")})
+			md, err := creds.GetRequestMetadata(context.Background())
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(md[AuthorizationKey], convey.ShouldEqual, BearerPrefix+"token1")
+			writeCertDir(t, dir, map[string][]byte{"token": []byte("token2")})
+			md, err = creds.GetRequestMetadata(context.Background())
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(md[AuthorizationKey], convey.ShouldEqual, BearerPrefix+"token2")
+		})
+		convey.Convey("02-token file not exists, should return error", func() {
+			_, err := creds.GetRequestMetadata(context.Background())
+			convey.So(err, convey.ShouldNotBeNil)
+		})
+	})
+}
+
+func TestNewClusterdClientCredentials(t *testing.T) {
+	convey.Convey("Test newClusterdClientCredentials", t, func() {
+		convey.Convey("01-no environment, should be insecure", func() {
+			t.Setenv(ClusterdTLSDirEnv, "")
+			t.Setenv(ClusterdTokenFileEnv, "")
+			creds, err := newClusterdClientCredentials()
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(creds.TLS, convey.ShouldBeNil)
+			convey.So(creds.Token, convey.ShouldBeNil)
+		})
+		convey.Convey("02-token without tls, should return error", func() {
+			t.Setenv(ClusterdTLSDirEnv, "")
+			t.Setenv(ClusterdTokenFileEnv, "/var/run/secrets/token")
+			_, err := newClusterdClientCredentials()
+			convey.So(err, convey.ShouldNotBeNil)
+		})
+		convey.Convey("03-tls and token, should use the default server name", func() {
+			dir := t.TempDir()
+			writeCertDir(t, dir, map[string][]byte{CAFileName: newTestCA(t).pem})
+			t.Setenv(ClusterdTLSDirEnv, dir)
+			t.Setenv(ClusterdServerNameEnv, "")
+			t.Setenv(ClusterdTokenFileEnv, filepath.Join(dir, "token"))
+			creds, err := newClusterdClientCredentials()
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(creds.TLS.ServerName, convey.ShouldEqual, DefaultClusterdServerName)
+			convey.So(creds.Token, convey.ShouldNotBeNil)
+		})
+	})
+}
+
+func TestGetClusterdClientCredentials(t *testing.T) {
+	convey.Convey("Test GetClusterdClientCredentials", t, func() {
+		clusterdCredentials = nil
+		defer func() { clusterdCredentials = nil }()
+		convey.Convey("01-load failed, should load again by the next call", func() {
+			t.Setenv(ClusterdTLSDirEnv, "")
+			t.Setenv(ClusterdTokenFileEnv, "/var/run/secrets/token")
+			_, err := GetClusterdClientCredentials()
+			convey.So(err, convey.ShouldNotBeNil)
+			t.Setenv(ClusterdTokenFileEnv, "")
+			creds, err := GetClusterdClientCredentials()
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(creds, convey.ShouldNotBeNil)
+		})
+		convey.Convey("02-load succeeded, should return the cached credentials", func() {
+			t.Setenv(ClusterdTLSDirEnv, "")
+			t.Setenv(ClusterdTokenFileEnv, "")
+			creds, err := GetClusterdClientCredentials()
+			convey.So(err, convey.ShouldBeNil)
+			cached, err := GetClusterdClientCredentials()
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(cached, convey.ShouldEqual, creds)
+		})
+	})
+}
@@ -0,0 +1,44 @@
+/* Copyright(C) 2026. Huawei Technologies Co.,Ltd. All rights reserved.
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+// Package tlsutils offer the credentials of the clients connecting to clusterd
+package tlsutils
+
+import (
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// ClusterdDialOptions return the grpc dial options of connecting to clusterd, the connection is secured by tls
+// and token when the environments are set, otherwise it is insecure
+func ClusterdDialOptions() ([]grpc.DialOption, error) {
+	creds, err := GetClusterdClientCredentials()
+	if err != nil {
+		return nil, err
+	}
+	return creds.DialOptions(), nil
+}
+
+// DialOptions return the grpc dial options of the credentials
+func (c *ClientCredentials) DialOptions() []grpc.DialOption {
+	if c.TLS == nil {
+		return []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}
+	}
+	opts := []grpc.DialOption{grpc.WithTransportCredentials(credentials.NewTLS(c.TLS))}
+	if c.Token != nil {
+		opts = append(opts, grpc.WithPerRPCCredentials(c.Token))
+	}
+	return opts
+}
@@ -0,0 +1,40 @@
+/* Copyright(C) 2026. Huawei Technologies Co.,Ltd. All rights reserved.
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+// Package tlsutils test for the grpc dial options of connecting to clusterd
+package tlsutils
+
+import (
+	"crypto/tls"
+	"testing"
+
+	"github.com/smartystreets/goconvey/convey"
+)
+
+func TestDialOptions(t *testing.T) {
+	convey.Convey("Test DialOptions", t, func() {
+		convey.Convey("01-tls is not set, should return insecure option", func() {
+			opts := (&ClientCredentials{}).DialOptions()
+			convey.So(len(opts), convey.ShouldEqual, 1)
+		})
+		convey.Convey("02-tls is set without token, should return tls option", func() {
+			opts := (&ClientCredentials{TLS: &tls.Config{}}).DialOptions()
+			convey.So(len(opts), convey.ShouldEqual, 1)
+		})
+		convey.Convey("03-tls and token are set, should return tls and token options", func() {
+			opts := (&ClientCredentials{TLS: &tls.Config{}, Token: NewTokenCredentials("token")}).DialOptions()
+			convey.So(len(opts), convey.ShouldEqual, len([]string{"tls", "token"}))
+		})
+	})
+}
@@ -0,0 +1,234 @@
+/* Copyright(C) 2026. Huawei Technologies Co.,Ltd. All rights reserved.
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+// Package tlsutils offer the tls certificates reloaded from the mounted kubernetes secret
+package tlsutils
+
+import (
+	"bytes"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"ascend-common/common-utils/hwlog"
+	"ascend-common/common-utils/utils"
+)
+
+const (
+	// CertFileName the certificate file name of kubernetes tls secret
+	CertFileName = "tls.crt"
+	// KeyFileName the private key file name of kubernetes tls secret
+	KeyFileName = "tls.key"
+	// CAFileName the ca certificate file name
+	CAFileName = "ca.crt"
+
+	maxCertFileSize = 1024 * 1024
+	// DefaultReloadInterval is the min interval of checking the certificates changed
+	DefaultReloadInterval = 10 * time.Second
+)
+
+// certBundle is the certificates loaded from the directory
+type certBundle struct {
+	cert   *tls.Certificate
+	caPool *x509.CertPool
+	raw    [][]byte
+}
+
+// CertReloader load the certificates in a directory mounted from the kubernetes secret. The files are checked on
+// handshake at most once per interval, and the certificates are replaced when the files changed, so the rotated
+// secret takes effect without restarting. the previous certificates are kept when the new files are invalid
+type CertReloader struct {
+	dir       string
+	interval  time.Duration
+	lock      sync.Mutex
+	bundle    *certBundle
+	lastCheck time.Time
+	now       func() time.Time
+}
+
+// NewCertReloader load the certificates in dir, tls.crt and tls.key should be both present or both absent, ca.crt
+// is optional
+func NewCertReloader(dir string) (*CertReloader, error) {
+	r := &CertReloader{dir: dir, interval: DefaultReloadInterval, now: time.Now}
+	bundle, err := r.load()
+	if err != nil {
+		return nil, err
+	}
+	r.bundle = bundle
+	r.lastCheck = r.now()
+	return r, nil
+}
+
+func (r *CertReloader) readFile(name string) ([]byte, error) {
+	dir, err := filepath.EvalSymlinks(r.dir)
+	if err != nil {
+		return nil, fmt.Errorf("resolve cert dir <%s> failed: %v", r.dir, err)
+	}
+	path := filepath.Join(r.dir, name)
+	if !utils.IsExist(path) {
+		return nil, nil
+	}
+	// the files of the mounted secret are symlinks to the data directory in it
+	return utils.ReadLimitBytesWithSymlink(path, maxCertFileSize, func(realPath string) bool {
+		return strings.HasPrefix(realPath, dir+string(filepath.Separator))
+	})
+}
+
+func (r *CertReloader) load() (*certBundle, error) {
+	bundle := &certBundle{}
+	for _, name := range []string{CertFileName, KeyFileName, CAFileName} {
+		data, err := r.readFile(name)
+		if err != nil {
+			return nil, fmt.Errorf("read %s failed: %v", name, err)
+		}
+		bundle.raw = append(bundle.raw, data)
+	}
+	certPEM, keyPEM, caPEM := bundle.raw[0], bundle.raw[1], bundle.raw[2]
+	if (len(certPEM) == 0) != (len(keyPEM) == 0) {
+		return nil, fmt.Errorf("%s and %s should be both present in <%s>", CertFileName, KeyFileName, r.dir)
+	}
+	if len(certPEM) != 0 {
+		cert, err := tls.X509KeyPair(certPEM, keyPEM)
+		if err != nil {
+			return nil, fmt.Errorf("load key pair failed: %v", err)
+		}
+		bundle.cert = &cert
+	}
+	if len(caPEM) != 0 {
+		bundle.caPool = x509.NewCertPool()
+		if !bundle.caPool.AppendCertsFromPEM(caPEM) {
+			return nil, fmt.Errorf("no valid certificate in %s", CAFileName)
+		}
+	}
+	if bundle.cert == nil && bundle.caPool == nil {
+		return nil, fmt.Errorf("no certificate found in <%s>", r.dir)
+	}
+	return bundle, nil
+}
+
+func (b *certBundle) equal(other *certBundle) bool {
+	for i := range b.raw {
+		if !bytes.Equal(b.raw[i], other.raw[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Reload reload the certificates when the files changed, return whether the certificates are replaced
+func (r *CertReloader) Reload() (bool, error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.lastCheck = r.now()
+	bundle, err := r.load()
+	if err != nil {
+		return false, err
+	}
+	if r.bundle.equal(bundle) {
+		return false, nil
+	}
+	r.bundle = bundle
+	return true, nil
+}
+
+func (r *CertReloader) current() *certBundle {
+	r.lock.Lock()
+	due := r.now().Sub(r.lastCheck) >= r.interval
+	r.lock.Unlock()
+	if due {
+		if changed, err := r.Reload(); err != nil {
+			hwlog.RunLog.Errorf("reload certificates in <%s> failed, the previous ones are used, err: %v",
+				r.dir, err)
+		} else if changed {
+			hwlog.RunLog.Infof("certificates in <%s> are reloaded", r.dir)
+		}
+	}
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	return r.bundle
+}
+
+// ServerConfig return the tls config of server, the client certificate is verified by ca.crt when given, and is
+// required when requireClientCert is true
+func (r *CertReloader) ServerConfig(requireClientCert bool) (*tls.Config, error) {
+	bundle := r.current()
+	if bundle.cert == nil {
+		return nil, fmt.Errorf("server certificate %s is not found in <%s>", CertFileName, r.dir)
+	}
+	if bundle.caPool == nil && requireClientCert {
+		return nil, fmt.Errorf("%s is required to verify the client certificate", CAFileName)
+	}
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			bundle := r.current()
+			if bundle.cert == nil {
+				return nil, errors.New("server certificate is not loaded")
+			}
+			cfg := &tls.Config{MinVersion: tls.VersionTLS12, Certificates: []tls.Certificate{*bundle.cert}}
+			if bundle.caPool != nil {
+				cfg.ClientCAs = bundle.caPool
+				cfg.ClientAuth = tls.VerifyClientCertIfGiven
+			}
+			if requireClientCert {
+				cfg.ClientAuth = tls.RequireAndVerifyClientCert
+			}
+			return cfg, nil
+		},
+	}, nil
+}
+
+// ClientConfig return the tls config of client, the server certificate is verified by ca.crt with serverName, and
+// tls.crt is sent when the server requests the client certificate
+func (r *CertReloader) ClientConfig(serverName string) (*tls.Config, error) {
+	if r.current().caPool == nil {
+		return nil, fmt.Errorf("%s is required to verify the server certificate", CAFileName)
+	}
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		ServerName: serverName,
+		// the default verification is replaced by VerifyConnection, which uses the reloaded ca.crt
+		InsecureSkipVerify: true,
+		VerifyConnection: func(state tls.ConnectionState) error {
+			return r.verifyServer(state)
+		},
+		GetClientCertificate: func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+			if cert := r.current().cert; cert != nil {
+				return cert, nil
+			}
+			return &tls.Certificate{}, nil
+		},
+	}, nil
+}
+
+func (r *CertReloader) verifyServer(state tls.ConnectionState) error {
+	if len(state.PeerCertificates) == 0 {
+		return errors.New("no certificate is provided by the server")
+	}
+	opts := x509.VerifyOptions{
+		DNSName:       state.ServerName,
+		Roots:         r.current().caPool,
+		Intermediates: x509.NewCertPool(),
+	}
+	for _, cert := range state.PeerCertificates[1:] {
+		opts.Intermediates.AddCert(cert)
+	}
+	_, err := state.PeerCertificates[0].Verify(opts)
+	return err
+}
@@ -0,0 +1,258 @@
+/* Copyright(C) 2026. Huawei Technologies Co.,Ltd. All rights reserved.
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+// Package tlsutils test for the certificates reloader
+package tlsutils
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/goconvey/convey"
+
+	"ascend-common/common-utils/hwlog"
+)
+
+const (
+	testServerName = "clusterd.test"
+	testFileMode   = 0600
+)
+
+func init() {
+	hwlog.InitRunLogger(&hwlog.LogConfig{OnlyToStdout: true}, context.Background())
+}
+
+// testCA is a self-signed ca issuing the certificates in test
+type testCA struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+	pem  []byte
+}
+
+func newTestCA(t *testing.T) *testCA {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test-ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &testCA{cert: cert, key: key, pem: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})}
+}
+
+// issue return the pem of the certificate and the private key of the common name
+func (ca *testCA) issue(t *testing.T, commonName string, dnsNames ...string) ([]byte, []byte) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	serial, err := rand.Int(rand.Reader, big.NewInt(1<<62))
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber: serial,
+		Subject:      pkix.Name{CommonName: commonName},
+		DNSNames:     dnsNames,
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, ca.cert, &key.PublicKey, ca.key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer})
+}
+
+func writeCertDir(t *testing.T, dir string, files map[string][]byte) {
+	for name, data := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), data, testFileMode); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+// handshake dial the server with the configs, return the common name of the client verified by server
+func handshake(serverCfg, clientCfg *tls.Config) (string, error) {
+	listener, err := tls.Listen("tcp", "127.0.0.1:0", serverCfg)
+	if err != nil {
+		return "", err
+	}
+	defer listener.Close()
+	type result struct {
+		cn  string
+		err error
+	}
+	ch := make(chan result, 1)
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			ch <- result{err: err}
+			return
+		}
+		defer conn.Close()
+		tlsConn, ok := conn.(*tls.Conn)
+		if !ok {
+			ch <- result{err: errors.New("not a tls connection")}
+			return
+		}
+		if err = tlsConn.Handshake(); err != nil {
+			ch <- result{err: err}
+			return
+		}
+		cn := ""
+		if certs := tlsConn.ConnectionState().PeerCertificates; len(certs) > 0 {
+			cn = certs[0].Subject.CommonName
+		}
+		ch <- result{cn: cn}
+	}()
+	conn, err := tls.Dial("tcp", listener.Addr().String(), clientCfg)
+	if err != nil {
+		<-ch
+		return "", err
+	}
+	defer conn.Close()
+	res := <-ch
+	return res.cn, res.err
+}
+
+func TestCertReloader(t *testing.T) {
+	convey.Convey("Test CertReloader", t, func() {
+		ca := newTestCA(t)
+		serverDir, clientDir := t.TempDir(), t.TempDir()
+		serverCert, serverKey := ca.issue(t, "clusterd", testServerName)
+		clientCert, clientKey := ca.issue(t, "taskd")
+		writeCertDir(t, serverDir, map[string][]byte{CertFileName: serverCert, KeyFileName: serverKey,
+			CAFileName: ca.pem})
+		writeCertDir(t, clientDir, map[string][]byte{CertFileName: clientCert, KeyFileName: clientKey,
+			CAFileName: ca.pem})
+		serverReloader, err := NewCertReloader(serverDir)
+		convey.So(err, convey.ShouldBeNil)
+		clientReloader, err := NewCertReloader(clientDir)
+		convey.So(err, convey.ShouldBeNil)
+		serverCfg, err := serverReloader.ServerConfig(true)
+		convey.So(err, convey.ShouldBeNil)
+		clientCfg, err := clientReloader.ClientConfig(testServerName)
+		convey.So(err, convey.ShouldBeNil)
+
+		convey.Convey("01-mutual tls handshake, should verify both sides", func() {
+			cn, err := handshake(serverCfg, clientCfg)
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(cn, convey.ShouldEqual, "taskd")
+		})
+		convey.Convey("02-wrong server name, should fail", func() {
+			cfg, err := clientReloader.ClientConfig("other.test")
+			convey.So(err, convey.ShouldBeNil)
+			_, err = handshake(serverCfg, cfg)
+			convey.So(err, convey.ShouldNotBeNil)
+		})
+		convey.Convey("03-client without certificate, should be rejected when required", func() {
+			noCertDir := t.TempDir()
+			writeCertDir(t, noCertDir, map[string][]byte{CAFileName: ca.pem})
+			reloader, err := NewCertReloader(noCertDir)
+			convey.So(err, convey.ShouldBeNil)
+			cfg, err := reloader.ClientConfig(testServerName)
+			convey.So(err, convey.ShouldBeNil)
+			_, err = handshake(serverCfg, cfg)
+			convey.So(err, convey.ShouldNotBeNil)
+			optionalCfg, err := serverReloader.ServerConfig(false)
+			convey.So(err, convey.ShouldBeNil)
+			cn, err := handshake(optionalCfg, cfg)
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(cn, convey.ShouldBeEmpty)
+		})
+		convey.Convey("04-rotated certificates, should be reloaded after the interval", func() {
+			newCA := newTestCA(t)
+			newCert, newKey := newCA.issue(t, "taskd-new")
+			writeCertDir(t, clientDir, map[string][]byte{CertFileName: newCert, KeyFileName: newKey})
+			cn, err := handshake(serverCfg, clientCfg)
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(cn, convey.ShouldEqual, "taskd")
+			clientReloader.lastCheck = time.Now().Add(-DefaultReloadInterval)
+			_, err = handshake(serverCfg, clientCfg)
+			convey.So(err, convey.ShouldNotBeNil)
+			writeCertDir(t, serverDir, map[string][]byte{CAFileName: append(ca.pem, newCA.pem...)})
+			serverReloader.lastCheck = time.Now().Add(-DefaultReloadInterval)
+			cn, err = handshake(serverCfg, clientCfg)
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(cn, convey.ShouldEqual, "taskd-new")
+		})
+		convey.Convey("05-invalid rotated certificates, should keep the previous ones", func() {
+			writeCertDir(t, clientDir, map[string][]byte{KeyFileName: []byte("invalid")})
+			changed, err := clientReloader.Reload()
+			convey.So(err, convey.ShouldNotBeNil)
+			convey.So(changed, convey.ShouldBeFalse)
+			_, err = handshake(serverCfg, clientCfg)
+			convey.So(err, convey.ShouldBeNil)
+		})
+	})
+}
+
+func TestNewCertReloaderFailed(t *testing.T) {
+	convey.Convey("Test NewCertReloader with invalid directory", t, func() {
+		ca := newTestCA(t)
+		cert, _ := ca.issue(t, "clusterd")
+		convey.Convey("01-empty directory, should return error", func() {
+			_, err := NewCertReloader(t.TempDir())
+			convey.So(err, convey.ShouldNotBeNil)
+		})
+		convey.Convey("02-certificate without key, should return error", func() {
+			dir := t.TempDir()
+			writeCertDir(t, dir, map[string][]byte{CertFileName: cert})
+			_, err := NewCertReloader(dir)
+			convey.So(err, convey.ShouldNotBeNil)
+		})
+		convey.Convey("03-server without certificate, should return error", func() {
+			dir := t.TempDir()
+			writeCertDir(t, dir, map[string][]byte{CAFileName: ca.pem})
+			reloader, err := NewCertReloader(dir)
+			convey.So(err, convey.ShouldBeNil)
+			_, err = reloader.ServerConfig(false)
+			convey.So(err, convey.ShouldNotBeNil)
+		})
+	})
+}
@@ -21,6 +21,7 @@ import (
 	"ascend-common/devmanager/dcmi"
 	"errors"
 	"fmt"
+	"os"
 	"time"
 )
 
@@ -97,6 +98,9 @@ func getDeviceInfoForInit(commonDevMgr DeviceInterface) (common.ChipInfo, common
 
 // AutoInit auto detect npu chip type and return the corresponding processing object
 func AutoInit(dType string, resetTimeout int) (DeviceInterface, error) {
+	if scenarioPath := os.Getenv(SimulatedScenarioEnv); scenarioPath != "" {
+		return autoInitSimulated(dType, scenarioPath)
+	}
 	var devMgr DeviceInterface
 	devCommonSetMgr, err := DetectDcmiApiVersion(resetTimeout)
 	if err != nil {
@@ -151,3 +155,18 @@ func AutoInit(dType string, resetTimeout int) (DeviceInterface, error) {
 	}
 	return devMgr, nil
 }
+
+// autoInitSimulated replace the driver with a simulated device manager, only for test environments without npu
+func autoInitSimulated(dType string, scenarioPath string) (DeviceInterface, error) {
+	hwlog.RunLog.Warnf("env %s is set, use simulated devices of scenario %s instead of the driver",
+		SimulatedScenarioEnv, scenarioPath)
+	devMgr, err := NewSimulatedDeviceManager(scenarioPath)
+	if err != nil {
+		return nil, fmt.Errorf("init simulated device manager failed, err: %s", err)
+	}
+	if dType != "" && devMgr.GetDevType() != dType {
+		return nil, fmt.Errorf("the value of dType(%s) is inconsistent with the simulated chip type(%s)",
+			dType, devMgr.GetDevType())
+	}
+	return devMgr, nil
+}
@@ -0,0 +1,610 @@
+/* Copyright(C) 2026. Huawei Technologies Co.,Ltd. All rights reserved.
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+// Package devmanager this for simulated device manager driven by a scenario file
+package devmanager
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"ascend-common/common-utils/hwlog"
+	"ascend-common/devmanager/common"
+	"ascend-common/devmanager/dcmi"
+)
+
+const (
+	faultEventCheckInterval = 100 * time.Millisecond
+	percent                 = 100
+)
+
+var _ DeviceInterface = &SimulatedDeviceManager{}
+
+// SimulatedDeviceManager device manager which replays a scenario instead of calling the driver,
+// methods not related to the scenario fall back to DeviceManagerMock
+type SimulatedDeviceManager struct {
+	DeviceManagerMock
+	scenario *SimulationScenario
+	start    time.Time
+	now      func() time.Time
+
+	lock          sync.Mutex
+	faultCallback func(common.DevFaultInfo)
+	subscribed    map[int32]bool
+	loopStarted   bool
+	lastCheck     time.Duration
+	pingMeshTasks map[int32]bool
+	stopCh        chan struct{}
+	stopOnce      sync.Once
+}
+
+// NewSimulatedDeviceManager create a simulated device manager from the scenario file
+func NewSimulatedDeviceManager(path string) (*SimulatedDeviceManager, error) {
+	scenario, err := LoadSimulationScenario(path)
+	if err != nil {
+		return nil, err
+	}
+	return NewSimulatedDeviceManagerWithScenario(scenario), nil
+}
+
+// NewSimulatedDeviceManagerWithScenario create a simulated device manager from a parsed scenario
+func NewSimulatedDeviceManagerWithScenario(scenario *SimulationScenario) *SimulatedDeviceManager {
+	return &SimulatedDeviceManager{
+		DeviceManagerMock: DeviceManagerMock{DevType: scenario.DevType},
+		scenario:          scenario,
+		start:             time.Now(),
+		now:               time.Now,
+		subscribed:        make(map[int32]bool),
+		lastCheck:         -1,
+		pingMeshTasks:     make(map[int32]bool),
+		stopCh:            make(chan struct{}),
+	}
+}
+
+func (d *SimulatedDeviceManager) elapsed() time.Duration {
+	return d.now().Sub(d.start)
+}
+
+func (d *SimulatedDeviceManager) checkLogicID(logicID int32) error {
+	if !d.scenario.validChip(logicID) {
+		return fmt.Errorf("logicID(%d) is not in the simulated chips", logicID)
+	}
+	return nil
+}
+
+func (d *SimulatedDeviceManager) seriesValue(logicID int32, metric string, defaultValue float64) float64 {
+	elapsed := d.elapsed()
+	for _, series := range d.scenario.Series {
+		if series.Metric == metric && series.matchChip(logicID) {
+			return series.valueAt(elapsed)
+		}
+	}
+	return defaultValue
+}
+
+// activeFaults fault codes of the chip at elapsed time
+func (d *SimulatedDeviceManager) activeFaults(logicID int32, elapsed time.Duration) []SimulatedFault {
+	faults := make([]SimulatedFault, 0)
+	for _, fault := range d.scenario.Faults {
+		if fault.Chip == logicID && activeBetween(elapsed, fault.Occur, fault.Recover) {
+			faults = append(faults, fault)
+		}
+	}
+	return faults
+}
+
+// ShutDown stop replaying fault events
+func (d *SimulatedDeviceManager) ShutDown() error {
+	d.stopOnce.Do(func() {
+		close(d.stopCh)
+	})
+	return nil
+}
+
+// WaitDeviceOnline simulated chips are always online
+func (d *SimulatedDeviceManager) WaitDeviceOnline(resetTimeout int) {
+}
+
+// GetDevType return the device type of the scenario
+func (d *SimulatedDeviceManager) GetDevType() string {
+	return d.scenario.DevType
+}
+
+// GetDcmiVersion get dcmi version
+func (d *SimulatedDeviceManager) GetDcmiVersion() string {
+	return "simulated"
+}
+
+// GetAllDeviceCount get npu device count
+func (d *SimulatedDeviceManager) GetAllDeviceCount() (int32, error) {
+	return d.scenario.ChipCount, nil
+}
+
+// GetCardList get all card list
+func (d *SimulatedDeviceManager) GetCardList() (int32, []int32, error) {
+	cardNum := d.scenario.ChipCount / d.scenario.ChipsPerCard
+	cards := make([]int32, 0, cardNum)
+	for cardID := int32(0); cardID < cardNum; cardID++ {
+		cards = append(cards, cardID)
+	}
+	return cardNum, cards, nil
+}
+
+// GetDeviceNumInCard get all device list in one card
+func (d *SimulatedDeviceManager) GetDeviceNumInCard(cardID int32) (int32, error) {
+	if cardID < 0 || cardID >= d.scenario.ChipCount/d.scenario.ChipsPerCard {
+		return common.RetError, fmt.Errorf("cardID(%d) is not in the simulated cards", cardID)
+	}
+	return d.scenario.ChipsPerCard, nil
+}
+
+// GetDeviceList get all device logicID list
+func (d *SimulatedDeviceManager) GetDeviceList() (int32, []int32, error) {
+	devices := make([]int32, 0, d.scenario.ChipCount)
+	for logicID := int32(0); logicID < d.scenario.ChipCount; logicID++ {
+		devices = append(devices, logicID)
+	}
+	return d.scenario.ChipCount, devices, nil
+}
+
+// GetChipBaseInfos get chip base info
+func (d *SimulatedDeviceManager) GetChipBaseInfos() ([]*common.ChipBaseInfo, error) {
+	infos := make([]*common.ChipBaseInfo, 0, d.scenario.ChipCount)
+	for logicID := int32(0); logicID < d.scenario.ChipCount; logicID++ {
+		cardID, deviceID, _ := d.GetCardIDDeviceID(logicID)
+		infos = append(infos, &common.ChipBaseInfo{PhysicID: logicID, LogicID: logicID, CardID: cardID,
+			DeviceID: deviceID})
+	}
+	return infos, nil
+}
+
+// GetCardIDDeviceID get cardID and deviceID by logicID
+func (d *SimulatedDeviceManager) GetCardIDDeviceID(logicID int32) (int32, int32, error) {
+	if err := d.checkLogicID(logicID); err != nil {
+		return common.RetError, common.RetError, err
+	}
+	return logicID / d.scenario.ChipsPerCard, logicID % d.scenario.ChipsPerCard, nil
+}
+
+// GetPhysicIDFromLogicID simulated physic id equals logic id
+func (d *SimulatedDeviceManager) GetPhysicIDFromLogicID(logicID int32) (int32, error) {
+	if err := d.checkLogicID(logicID); err != nil {
+		return common.RetError, err
+	}
+	return logicID, nil
+}
+
+// GetLogicIDFromPhysicID simulated physic id equals logic id
+func (d *SimulatedDeviceManager) GetLogicIDFromPhysicID(physicID int32) (int32, error) {
+	if err := d.checkLogicID(physicID); err != nil {
+		return common.RetError, err
+	}
+	return physicID, nil
+}
+
+// GetDeviceLogicID get device logic id from card id and device id
+func (d *SimulatedDeviceManager) GetDeviceLogicID(cardID, deviceID int32) (int32, error) {
+	logicID := cardID*d.scenario.ChipsPerCard + deviceID
+	if deviceID < 0 || deviceID >= d.scenario.ChipsPerCard {
+		return common.RetError, fmt.Errorf("deviceID(%d) is not in the simulated card", deviceID)
+	}
+	if err := d.checkLogicID(logicID); err != nil {
+		return common.RetError, err
+	}
+	return logicID, nil
+}
+
+// GetChipInfo get chip info of the scenario
+func (d *SimulatedDeviceManager) GetChipInfo(logicID int32) (*common.ChipInfo, error) {
+	if err := d.checkLogicID(logicID); err != nil {
+		return nil, err
+	}
+	return &common.ChipInfo{Type: "Ascend", Name: d.scenario.ChipName, Version: "V1"}, nil
+}
+
+// GetValidChipInfo get valid chip info from all npu
+func (d *SimulatedDeviceManager) GetValidChipInfo() (common.ChipInfo, error) {
+	chipInfo, err := d.GetChipInfo(0)
+	if err != nil {
+		return common.ChipInfo{}, err
+	}
+	return *chipInfo, nil
+}
+
+// GetBoardInfo get board info of the scenario
+func (d *SimulatedDeviceManager) GetBoardInfo(logicID int32) (common.BoardInfo, error) {
+	if err := d.checkLogicID(logicID); err != nil {
+		return common.BoardInfo{}, err
+	}
+	return d.scenario.BoardInfo, nil
+}
+
+// GetValidBoardInfo find a valid board info from all devices
+func (d *SimulatedDeviceManager) GetValidBoardInfo() (common.BoardInfo, error) {
+	return d.scenario.BoardInfo, nil
+}
+
+// GetMainBoardId get main board id
+func (d *SimulatedDeviceManager) GetMainBoardId() uint32 {
+	return d.scenario.MainBoardID
+}
+
+// GetValidMainBoardInfo find a valid main board info from all devices
+func (d *SimulatedDeviceManager) GetValidMainBoardInfo() (uint32, error) {
+	return d.scenario.MainBoardID, nil
+}
+
+// GetCardElabelV2 get card elabel, the serial number is suffixed with the card id
+func (d *SimulatedDeviceManager) GetCardElabelV2(cardID int32) (common.ElabelInfo, error) {
+	if _, err := d.GetDeviceNumInCard(cardID); err != nil {
+		return common.ElabelInfo{}, err
+	}
+	elabel := d.scenario.Elabel
+	elabel.SerialNumber = fmt.Sprintf("%s%02d", elabel.SerialNumber, cardID)
+	return elabel, nil
+}
+
+// GetProductType get product type of the scenario
+func (d *SimulatedDeviceManager) GetProductType(logicID int32) (string, error) {
+	if err := d.checkLogicID(logicID); err != nil {
+		return "", err
+	}
+	return d.scenario.ProductType, nil
+}
+
+// GetAllProductType get all product type
+func (d *SimulatedDeviceManager) GetAllProductType() ([]string, error) {
+	return d.GetProductTypeArray(), nil
+}
+
+// GetProductTypeArray get product type array
+func (d *SimulatedDeviceManager) GetProductTypeArray() []string {
+	if d.scenario.ProductType == "" {
+		return []string{}
+	}
+	return []string{d.scenario.ProductType}
+}
+
+// GetDieID get die id, unique for each chip
+func (d *SimulatedDeviceManager) GetDieID(logicID int32, dcmiDieType dcmi.DieType) (string, error) {
+	if err := d.checkLogicID(logicID); err != nil {
+		return "", err
+	}
+	const dieIDLen = 40
+	prefix := fmt.Sprintf("SIM%d%02d", dcmiDieType, logicID)
+	return prefix + strings.Repeat("0", dieIDLen-len(prefix)), nil
+}
+
+// GetPCIeBusInfo get pcie bus info, unique for each chip
+func (d *SimulatedDeviceManager) GetPCIeBusInfo(logicID int32) (string, error) {
+	if err := d.checkLogicID(logicID); err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("0000:%02x:00.0", logicID+1), nil
+}
+
+// GetDeviceIPAddress get device ip address
+func (d *SimulatedDeviceManager) GetDeviceIPAddress(logicID, ipType int32) (string, error) {
+	if err := d.checkLogicID(logicID); err != nil {
+		return "", err
+	}
+	if ipType == 0 {
+		return fmt.Sprintf("192.168.100.%d", logicID+1), nil
+	}
+	return fmt.Sprintf("fd00::%x", logicID+1), nil
+}
+
+// GetDeviceHealth the worst health level of the active faults, 0 when no fault
+func (d *SimulatedDeviceManager) GetDeviceHealth(logicID int32) (uint32, error) {
+	if err := d.checkLogicID(logicID); err != nil {
+		return common.UnRetError, err
+	}
+	var health uint32
+	for _, fault := range d.activeFaults(logicID, d.elapsed()) {
+		if fault.Health > health {
+			health = fault.Health
+		}
+	}
+	return health, nil
+}
+
+// GetDeviceNetWorkHealth network is unhealthy while the link is down
+func (d *SimulatedDeviceManager) GetDeviceNetWorkHealth(logicID int32) (uint32, error) {
+	if err := d.checkLogicID(logicID); err != nil {
+		return common.UnRetError, err
+	}
+	elapsed := d.elapsed()
+	for _, link := range d.scenario.Links {
+		if link.Chip == logicID && activeBetween(elapsed, link.Down, link.Up) {
+			return linkDownHealthCode, nil
+		}
+	}
+	return common.NetworkSuccess, nil
+}
+
+// GetDeviceErrorCode get the first active fault code and the fault count
+func (d *SimulatedDeviceManager) GetDeviceErrorCode(logicID int32) (int32, int64, error) {
+	errCount, errCodes, err := d.GetDeviceAllErrorCode(logicID)
+	if err != nil || errCount == 0 {
+		return errCount, 0, err
+	}
+	return errCount, errCodes[0], nil
+}
+
+// GetDeviceAllErrorCode get all active fault codes
+func (d *SimulatedDeviceManager) GetDeviceAllErrorCode(logicID int32) (int32, []int64, error) {
+	if err := d.checkLogicID(logicID); err != nil {
+		return common.RetError, nil, err
+	}
+	faults := d.activeFaults(logicID, d.elapsed())
+	codes := make([]int64, 0, len(faults))
+	for _, fault := range faults {
+		codes = append(codes, int64(fault.Code))
+	}
+	return int32(len(codes)), codes, nil
+}
+
+// GetDeviceAllErrorCodeWithTimeOut get all active fault codes
+func (d *SimulatedDeviceManager) GetDeviceAllErrorCodeWithTimeOut(logicID int32,
+	timeout time.Duration) (int32, []int64, error) {
+	return d.GetDeviceAllErrorCode(logicID)
+}
+
+// SubscribeDeviceFaultEvent subscribe fault events of the chip, -1 means all chips
+func (d *SimulatedDeviceManager) SubscribeDeviceFaultEvent(logicID int32) error {
+	if logicID != -1 {
+		if err := d.checkLogicID(logicID); err != nil {
+			return err
+		}
+	}
+	d.lock.Lock()
+	d.subscribed[logicID] = true
+	d.lock.Unlock()
+	return d.startFaultEventLoop()
+}
+
+// SetFaultEventCallFunc set fault event call func
+func (d *SimulatedDeviceManager) SetFaultEventCallFunc(businessFunc func(common.DevFaultInfo)) error {
+	if businessFunc == nil {
+		return errors.New("fault event call func is nil")
+	}
+	d.lock.Lock()
+	d.faultCallback = businessFunc
+	d.lock.Unlock()
+	return d.startFaultEventLoop()
+}
+
+func (d *SimulatedDeviceManager) startFaultEventLoop() error {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	if d.loopStarted || d.faultCallback == nil || len(d.subscribed) == 0 {
+		return nil
+	}
+	// events happened before subscribing are reported at the first check
+	d.loopStarted = true
+	go func() {
+		ticker := time.NewTicker(faultEventCheckInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-d.stopCh:
+				return
+			case <-ticker.C:
+				d.replayFaultEvents()
+			}
+		}
+	}()
+	return nil
+}
+
+// replayFaultEvents report the fault occur and recover events between the last check and now, the events are
+// collected under the lock and reported after it is released, so the callback may call the device manager
+func (d *SimulatedDeviceManager) replayFaultEvents() {
+	d.lock.Lock()
+	now := d.elapsed()
+	last := d.lastCheck
+	d.lastCheck = now
+	callback := d.faultCallback
+	var events []common.DevFaultInfo
+	for _, fault := range d.scenario.Faults {
+		if !d.subscribed[-1] && !d.subscribed[fault.Chip] {
+			continue
+		}
+		occur, recover := time.Duration(fault.Occur), time.Duration(fault.Recover)
+		if occur > last && occur <= now {
+			events = append(events, d.faultEvent(fault, common.FaultOccur, occur))
+		}
+		if fault.Recover != 0 && recover > last && recover <= now {
+			events = append(events, d.faultEvent(fault, common.FaultRecover, recover))
+		}
+	}
+	d.lock.Unlock()
+	for _, event := range events {
+		hwlog.RunLog.Infof("simulated fault %#x of logicID(%d) assertion %d", event.EventID, event.LogicID,
+			event.Assertion)
+		callback(event)
+	}
+}
+
+func (d *SimulatedDeviceManager) faultEvent(fault SimulatedFault, assertion int8,
+	offset time.Duration) common.DevFaultInfo {
+	return common.DevFaultInfo{
+		EventID:         int64(fault.Code),
+		LogicID:         fault.Chip,
+		Severity:        int8(fault.Health),
+		Assertion:       assertion,
+		AlarmRaisedTime: d.start.Add(offset).UnixMilli(),
+	}
+}
+
+// GetDeviceUtilizationRate get npu device utilization
+func (d *SimulatedDeviceManager) GetDeviceUtilizationRate(logicID int32, deviceType common.DeviceType) (uint32,
+	error) {
+	if err := d.checkLogicID(logicID); err != nil {
+		return common.UnRetError, err
+	}
+	if deviceType == common.HbmUtilization && d.scenario.HbmSize > 0 {
+		usage := d.seriesValue(logicID, SeriesHbmUsage, 0)
+		return uint32(usage * percent / float64(d.scenario.HbmSize)), nil
+	}
+	return uint32(d.seriesValue(logicID, SeriesAICoreUtilization, 0)), nil
+}
+
+// GetDeviceUtilizationRateV2 get npu device utilization by v2 api
+func (d *SimulatedDeviceManager) GetDeviceUtilizationRateV2(logicID int32) (common.DcmiMultiUtilizationInfo,
+	error) {
+	rate, err := d.GetDeviceUtilizationRate(logicID, common.AICore)
+	if err != nil {
+		return common.DcmiMultiUtilizationInfo{}, err
+	}
+	return common.DcmiMultiUtilizationInfo{AicUtil: rate, AivUtil: rate, AicoreUtil: rate, NpuUtil: rate}, nil
+}
+
+// GetDeviceTemperature get npu device temperature
+func (d *SimulatedDeviceManager) GetDeviceTemperature(logicID int32) (int32, error) {
+	if err := d.checkLogicID(logicID); err != nil {
+		return common.RetError, err
+	}
+	const defaultTemperature = 40
+	return int32(d.seriesValue(logicID, SeriesTemperature, defaultTemperature)), nil
+}
+
+// GetDeviceVoltage get npu device voltage
+func (d *SimulatedDeviceManager) GetDeviceVoltage(logicID int32) (float32, error) {
+	if err := d.checkLogicID(logicID); err != nil {
+		return common.RetError, err
+	}
+	const defaultVoltage = 0.8
+	return float32(d.seriesValue(logicID, SeriesVoltage, defaultVoltage)), nil
+}
+
+// GetDevicePowerInfo get npu device power
+func (d *SimulatedDeviceManager) GetDevicePowerInfo(logicID int32) (float32, error) {
+	if err := d.checkLogicID(logicID); err != nil {
+		return common.RetError, err
+	}
+	const defaultPower = 100
+	return float32(d.seriesValue(logicID, SeriesPower, defaultPower)), nil
+}
+
+// GetDeviceHbmInfo get npu HBM info from the hbm series
+func (d *SimulatedDeviceManager) GetDeviceHbmInfo(logicID int32) (*common.HbmInfo, error) {
+	if err := d.checkLogicID(logicID); err != nil {
+		return nil, err
+	}
+	const defaultHbmTemperature = 40
+	return &common.HbmInfo{
+		MemorySize:        d.scenario.HbmSize,
+		Usage:             uint64(d.seriesValue(logicID, SeriesHbmUsage, 0)),
+		Temp:              int32(d.seriesValue(logicID, SeriesHbmTemperature, defaultHbmTemperature)),
+		BandWidthUtilRate: uint32(d.seriesValue(logicID, SeriesHbmBandwidthUtil, 0)),
+	}, nil
+}
+
+// GetDeviceMemoryInfo get npu memory information
+func (d *SimulatedDeviceManager) GetDeviceMemoryInfo(logicID int32) (*common.MemoryInfo, error) {
+	hbmInfo, err := d.GetDeviceHbmInfo(logicID)
+	if err != nil {
+		return nil, err
+	}
+	var utilization uint32
+	if hbmInfo.MemorySize > 0 {
+		utilization = uint32(hbmInfo.Usage * percent / hbmInfo.MemorySize)
+	}
+	return &common.MemoryInfo{MemorySize: hbmInfo.MemorySize, MemoryAvailable: hbmInfo.MemorySize - hbmInfo.Usage,
+		Utilization: utilization}, nil
+}
+
+// GetDeviceEccInfo get device ECC info from the ecc series
+func (d *SimulatedDeviceManager) GetDeviceEccInfo(logicID int32,
+	dcmiDeviceType common.DcmiDeviceType) (*common.ECCInfo, error) {
+	if err := d.checkLogicID(logicID); err != nil {
+		return nil, err
+	}
+	singleBit := int64(d.seriesValue(logicID, SeriesHbmEccSingleBit, 0))
+	doubleBit := int64(d.seriesValue(logicID, SeriesHbmEccDoubleBit, 0))
+	return &common.ECCInfo{
+		EnableFlag:             1,
+		SingleBitErrorCnt:      singleBit,
+		DoubleBitErrorCnt:      doubleBit,
+		TotalSingleBitErrorCnt: singleBit,
+		TotalDoubleBitErrorCnt: doubleBit,
+	}, nil
+}
+
+// StartHccsPingMesh start hccs ping mesh
+func (d *SimulatedDeviceManager) StartHccsPingMesh(logicID int32, portID int,
+	operate common.HccspingMeshOperate) error {
+	if err := d.checkLogicID(logicID); err != nil {
+		return err
+	}
+	d.lock.Lock()
+	d.pingMeshTasks[logicID] = true
+	d.lock.Unlock()
+	return nil
+}
+
+// StopHccsPingMesh stop hccs ping mesh
+func (d *SimulatedDeviceManager) StopHccsPingMesh(logicID int32, portID int, taskID uint) error {
+	if err := d.checkLogicID(logicID); err != nil {
+		return err
+	}
+	d.lock.Lock()
+	delete(d.pingMeshTasks, logicID)
+	d.lock.Unlock()
+	return nil
+}
+
+// GetHccsPingMeshState 1 when the ping mesh task of the chip is running, otherwise 0
+func (d *SimulatedDeviceManager) GetHccsPingMeshState(logicID int32, portID int, taskID uint) (int, error) {
+	if err := d.checkLogicID(logicID); err != nil {
+		return 0, err
+	}
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	if d.pingMeshTasks[logicID] {
+		return 1, nil
+	}
+	return 0, nil
+}
+
+// GetHccsPingMeshInfo get the ping mesh result of the scenario
+func (d *SimulatedDeviceManager) GetHccsPingMeshInfo(logicID int32, portID int,
+	taskID uint) (*common.HccspingMeshInfo, error) {
+	if err := d.checkLogicID(logicID); err != nil {
+		return nil, err
+	}
+	info := &common.HccspingMeshInfo{}
+	for _, pingMesh := range d.scenario.PingMesh {
+		if pingMesh.Chip != logicID {
+			continue
+		}
+		for _, result := range pingMesh.Results {
+			info.DstAddr = append(info.DstAddr, result.DstAddr)
+			info.SucPktNum = append(info.SucPktNum, result.SucPktNum)
+			info.FailPktNum = append(info.FailPktNum, result.FailPktNum)
+			info.MaxTime = append(info.MaxTime, result.MaxTime)
+			info.MinTime = append(info.MinTime, result.MinTime)
+			info.AvgTime = append(info.AvgTime, result.AvgTime)
+			info.TP95Time = append(info.TP95Time, result.TP95Time)
+			info.ReplyStatNum = append(info.ReplyStatNum, int(result.SucPktNum))
+			info.PingTotalNum = append(info.PingTotalNum, int(result.SucPktNum+result.FailPktNum))
+		}
+	}
+	info.DestNum = len(info.DstAddr)
+	return info, nil
+}
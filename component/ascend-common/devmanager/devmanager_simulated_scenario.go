@@ -0,0 +1,323 @@
+/* Copyright(C) 2026. Huawei Technologies Co.,Ltd. All rights reserved.
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+// Package devmanager this for simulated device scenario definition
+package devmanager
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"sigs.k8s.io/yaml"
+
+	"ascend-common/api"
+	"ascend-common/common-utils/utils"
+	"ascend-common/devmanager/common"
+)
+
+const (
+	// SimulatedScenarioEnv env of the scenario file path, AutoInit returns a SimulatedDeviceManager when it is set
+	SimulatedScenarioEnv = "ASCEND_DEVICE_SIMULATION_FILE"
+
+	maxSimulatedChipNum = 64
+	defaultHealthLevel  = 2
+	linkDownHealthCode  = 1
+)
+
+// simulated series metric names
+const (
+	// SeriesTemperature chip temperature, unit celsius
+	SeriesTemperature = "temperature"
+	// SeriesPower chip power, unit watt
+	SeriesPower = "power"
+	// SeriesVoltage chip voltage, unit volt
+	SeriesVoltage = "voltage"
+	// SeriesAICoreUtilization ai core utilization, unit percent
+	SeriesAICoreUtilization = "aicoreUtilization"
+	// SeriesHbmUsage hbm usage, unit MB
+	SeriesHbmUsage = "hbmUsage"
+	// SeriesHbmTemperature hbm temperature, unit celsius
+	SeriesHbmTemperature = "hbmTemperature"
+	// SeriesHbmBandwidthUtil hbm bandwidth utilization, unit percent
+	SeriesHbmBandwidthUtil = "hbmBandwidthUtil"
+	// SeriesHbmEccSingleBit hbm ecc single bit error count
+	SeriesHbmEccSingleBit = "hbmEccSingleBitErrorCnt"
+	// SeriesHbmEccDoubleBit hbm ecc double bit error count
+	SeriesHbmEccDoubleBit = "hbmEccDoubleBitErrorCnt"
+)
+
+var supportedSeries = map[string]bool{
+	SeriesTemperature: true, SeriesPower: true, SeriesVoltage: true, SeriesAICoreUtilization: true,
+	SeriesHbmUsage: true, SeriesHbmTemperature: true, SeriesHbmBandwidthUtil: true,
+	SeriesHbmEccSingleBit: true, SeriesHbmEccDoubleBit: true,
+}
+
+var supportedSimulatedDevTypes = map[string]bool{
+	api.Ascend310: true, api.Ascend310B: true, api.Ascend310P: true, api.Ascend910A: true,
+	api.Ascend910B: true, api.Ascend910A3: true, api.Ascend910A5: true,
+}
+
+// SimulationScenario the whole simulated server, all offsets are relative to the time the scenario is loaded
+type SimulationScenario struct {
+	DevType      string              `json:"devType"`
+	ChipName     string              `json:"chipName"`
+	ProductType  string              `json:"productType"`
+	ChipCount    int32               `json:"chipCount"`
+	ChipsPerCard int32               `json:"chipsPerCard"`
+	MainBoardID  uint32              `json:"mainBoardId"`
+	HbmSize      uint64              `json:"hbmSize"`
+	BoardInfo    common.BoardInfo    `json:"boardInfo"`
+	Elabel       common.ElabelInfo   `json:"elabel"`
+	Series       []SimulatedSeries   `json:"series"`
+	Faults       []SimulatedFault    `json:"faults"`
+	Links        []SimulatedLink     `json:"links"`
+	PingMesh     []SimulatedPingMesh `json:"pingMesh"`
+}
+
+// SimulatedSeries a time series of one metric, linear interpolated between points
+type SimulatedSeries struct {
+	Metric string `json:"metric"`
+	// Chips logic ids of the chips, empty means all chips
+	Chips  []int32          `json:"chips"`
+	Points []SimulatedPoint `json:"points"`
+	// Loop repeat the series with the period of the last point
+	Loop bool `json:"loop"`
+}
+
+// SimulatedPoint value of a series at offset
+type SimulatedPoint struct {
+	Offset ScenarioDuration `json:"offset"`
+	Value  float64          `json:"value"`
+}
+
+// SimulatedFault a fault code injected into a chip between occur and recover
+type SimulatedFault struct {
+	Chip int32     `json:"chip"`
+	Code FaultCode `json:"code"`
+	// Health the health level reported when the fault is active, 1 minor, 2 major, 3 critical
+	Health  uint32           `json:"health"`
+	Occur   ScenarioDuration `json:"occur"`
+	Recover ScenarioDuration `json:"recover"`
+}
+
+// SimulatedLink a link down event of a chip network between down and up
+type SimulatedLink struct {
+	Chip int32            `json:"chip"`
+	Down ScenarioDuration `json:"down"`
+	Up   ScenarioDuration `json:"up"`
+}
+
+// SimulatedPingMesh hccs ping mesh result of a chip
+type SimulatedPingMesh struct {
+	Chip    int32                  `json:"chip"`
+	Results []SimulatedPingMeshDst `json:"results"`
+}
+
+// SimulatedPingMeshDst ping mesh result of one destination
+type SimulatedPingMeshDst struct {
+	DstAddr    string `json:"dstAddr"`
+	SucPktNum  uint   `json:"sucPktNum"`
+	FailPktNum uint   `json:"failPktNum"`
+	MaxTime    int    `json:"maxTime"`
+	MinTime    int    `json:"minTime"`
+	AvgTime    int    `json:"avgTime"`
+	TP95Time   int    `json:"tp95Time"`
+}
+
+// ScenarioDuration duration accepts "30s" like strings or numbers in seconds, zero means never for recover and up
+type ScenarioDuration time.Duration
+
+// UnmarshalJSON parse duration from string or seconds
+func (d *ScenarioDuration) UnmarshalJSON(data []byte) error {
+	var raw interface{}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	switch value := raw.(type) {
+	case float64:
+		*d = ScenarioDuration(time.Duration(value * float64(time.Second)))
+	case string:
+		parsed, err := time.ParseDuration(value)
+		if err != nil {
+			return fmt.Errorf("invalid duration %s: %v", value, err)
+		}
+		*d = ScenarioDuration(parsed)
+	default:
+		return fmt.Errorf("invalid duration %v", raw)
+	}
+	if *d < 0 {
+		return errors.New("duration must not be negative")
+	}
+	return nil
+}
+
+// FaultCode fault code accepts hex strings like "0x80E01801" or numbers
+type FaultCode int64
+
+// UnmarshalJSON parse fault code from hex string or number
+func (c *FaultCode) UnmarshalJSON(data []byte) error {
+	var raw interface{}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	switch value := raw.(type) {
+	case float64:
+		*c = FaultCode(value)
+	case string:
+		base := 10
+		if strings.HasPrefix(strings.ToLower(value), "0x") {
+			value, base = value[len("0x"):], 16
+		}
+		parsed, err := strconv.ParseInt(value, base, 64)
+		if err != nil {
+			return fmt.Errorf("invalid fault code %s: %v", value, err)
+		}
+		*c = FaultCode(parsed)
+	default:
+		return fmt.Errorf("invalid fault code %v", raw)
+	}
+	return nil
+}
+
+// LoadSimulationScenario load and validate the scenario from a yaml or json file
+func LoadSimulationScenario(path string) (*SimulationScenario, error) {
+	data, err := utils.ReadLimitBytes(path, utils.Size10M)
+	if err != nil {
+		return nil, fmt.Errorf("read simulation scenario failed: %v", err)
+	}
+	return ParseSimulationScenario(data)
+}
+
+// ParseSimulationScenario parse and validate the scenario from yaml or json content
+func ParseSimulationScenario(data []byte) (*SimulationScenario, error) {
+	scenario := &SimulationScenario{}
+	if err := yaml.Unmarshal(data, scenario); err != nil {
+		return nil, fmt.Errorf("unmarshal simulation scenario failed: %v", err)
+	}
+	if err := scenario.validate(); err != nil {
+		return nil, err
+	}
+	return scenario, nil
+}
+
+func (s *SimulationScenario) validate() error {
+	if !supportedSimulatedDevTypes[s.DevType] {
+		return fmt.Errorf("devType %s is not supported", s.DevType)
+	}
+	if s.ChipCount <= 0 || s.ChipCount > maxSimulatedChipNum {
+		return fmt.Errorf("chipCount should be in range [1, %d]", maxSimulatedChipNum)
+	}
+	if s.ChipsPerCard == 0 {
+		s.ChipsPerCard = 1
+	}
+	if s.ChipsPerCard < 0 || s.ChipCount%s.ChipsPerCard != 0 {
+		return errors.New("chipCount should be a multiple of chipsPerCard")
+	}
+	for _, series := range s.Series {
+		if err := s.validateSeries(series); err != nil {
+			return err
+		}
+	}
+	for i, fault := range s.Faults {
+		if !s.validChip(fault.Chip) {
+			return fmt.Errorf("fault chip %d is out of range", fault.Chip)
+		}
+		if fault.Recover != 0 && fault.Recover <= fault.Occur {
+			return fmt.Errorf("fault %#x of chip %d recovers before it occurs", int64(fault.Code), fault.Chip)
+		}
+		if fault.Health == 0 {
+			s.Faults[i].Health = defaultHealthLevel
+		}
+	}
+	for _, link := range s.Links {
+		if !s.validChip(link.Chip) {
+			return fmt.Errorf("link chip %d is out of range", link.Chip)
+		}
+		if link.Up != 0 && link.Up <= link.Down {
+			return fmt.Errorf("link of chip %d is up before it is down", link.Chip)
+		}
+	}
+	for _, pingMesh := range s.PingMesh {
+		if !s.validChip(pingMesh.Chip) {
+			return fmt.Errorf("ping mesh chip %d is out of range", pingMesh.Chip)
+		}
+	}
+	return nil
+}
+
+func (s *SimulationScenario) validateSeries(series SimulatedSeries) error {
+	if !supportedSeries[series.Metric] {
+		return fmt.Errorf("series metric %s is not supported", series.Metric)
+	}
+	if len(series.Points) == 0 {
+		return fmt.Errorf("series %s has no point", series.Metric)
+	}
+	for i := 1; i < len(series.Points); i++ {
+		if series.Points[i].Offset <= series.Points[i-1].Offset {
+			return fmt.Errorf("offsets of series %s should be increasing", series.Metric)
+		}
+	}
+	for _, chip := range series.Chips {
+		if !s.validChip(chip) {
+			return fmt.Errorf("series %s chip %d is out of range", series.Metric, chip)
+		}
+	}
+	return nil
+}
+
+func (s *SimulationScenario) validChip(logicID int32) bool {
+	return logicID >= 0 && logicID < s.ChipCount
+}
+
+// valueAt the value of the series at elapsed time
+func (series SimulatedSeries) valueAt(elapsed time.Duration) float64 {
+	points := series.Points
+	last := time.Duration(points[len(points)-1].Offset)
+	if series.Loop && last > 0 {
+		elapsed %= last
+	}
+	if elapsed <= time.Duration(points[0].Offset) {
+		return points[0].Value
+	}
+	for i := 1; i < len(points); i++ {
+		end := time.Duration(points[i].Offset)
+		if elapsed > end {
+			continue
+		}
+		begin := time.Duration(points[i-1].Offset)
+		ratio := float64(elapsed-begin) / float64(end-begin)
+		return points[i-1].Value + ratio*(points[i].Value-points[i-1].Value)
+	}
+	return points[len(points)-1].Value
+}
+
+func (series SimulatedSeries) matchChip(logicID int32) bool {
+	if len(series.Chips) == 0 {
+		return true
+	}
+	for _, chip := range series.Chips {
+		if chip == logicID {
+			return true
+		}
+	}
+	return false
+}
+
+func activeBetween(elapsed time.Duration, begin, end ScenarioDuration) bool {
+	return elapsed >= time.Duration(begin) && (end == 0 || elapsed < time.Duration(end))
+}
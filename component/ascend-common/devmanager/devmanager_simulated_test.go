@@ -0,0 +1,276 @@
+/* Copyright(C) 2026. Huawei Technologies Co.,Ltd. All rights reserved.
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+// Package devmanager for simulated device manager
+package devmanager
+
+import (
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/goconvey/convey"
+
+	"ascend-common/api"
+	"ascend-common/devmanager/common"
+)
+
+const (
+	simFaultCode     = int64(0x80E01801)
+	simChipCount     = 8
+	simHbmSize       = 65536
+	simTemperatureAt = 45
+	simHalfMinute    = 30 * time.Second
+	simFaultRecover  = 2 * time.Minute
+	simEventTimeout  = 3 * time.Second
+	simFileMode      = 0600
+)
+
+const simScenario = `
+devType: Ascend910B
+chipName: 910B3
+productType: Atlas 800T A2
+chipCount: 8
+hbmSize: 65536
+boardInfo:
+  boardId: 0x28
+elabel:
+  serialNumber: SIM
+series:
+  - metric: temperature
+    points:
+      - {offset: 0s, value: 40}
+      - {offset: 60s, value: 50}
+  - metric: hbmUsage
+    chips: [1]
+    loop: true
+    points:
+      - {offset: 0, value: 0}
+      - {offset: 10, value: 32768}
+faults:
+  - chip: 0
+    code: "0x80E01801"
+    health: 3
+    occur: 30s
+    recover: 2m
+links:
+  - chip: 2
+    down: 10s
+pingMesh:
+  - chip: 3
+    results:
+      - {dstAddr: 192.168.100.5, sucPktNum: 9, failPktNum: 1, avgTime: 20}
+`
+
+func newTestSimulatedManager(elapsed *time.Duration) *SimulatedDeviceManager {
+	scenario, err := ParseSimulationScenario([]byte(simScenario))
+	if err != nil {
+		panic(err)
+	}
+	d := NewSimulatedDeviceManagerWithScenario(scenario)
+	d.now = func() time.Time {
+		return d.start.Add(*elapsed)
+	}
+	return d
+}
+
+func TestParseSimulationScenario(t *testing.T) {
+	convey.Convey("test parse simulation scenario", t, func() {
+		convey.Convey("valid scenario should be parsed with defaults", func() {
+			scenario, err := ParseSimulationScenario([]byte(simScenario))
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(scenario.ChipsPerCard, convey.ShouldEqual, 1)
+			convey.So(int64(scenario.Faults[0].Code), convey.ShouldEqual, simFaultCode)
+			convey.So(time.Duration(scenario.Faults[0].Recover), convey.ShouldEqual, simFaultRecover)
+			convey.So(scenario.BoardInfo.BoardId, convey.ShouldEqual, common.A300IA2BoardId)
+		})
+		convey.Convey("json scenario should be parsed", func() {
+			_, err := ParseSimulationScenario([]byte(`{"devType":"Ascend310P","chipCount":2}`))
+			convey.So(err, convey.ShouldBeNil)
+		})
+		convey.Convey("invalid scenarios should be rejected", func() {
+			invalids := []string{
+				`{"devType":"Ascend000","chipCount":2}`,
+				`{"devType":"Ascend910B","chipCount":0}`,
+				`{"devType":"Ascend910B","chipCount":3,"chipsPerCard":2}`,
+				`{"devType":"Ascend910B","chipCount":2,"faults":[{"chip":2,"code":1}]}`,
+				`{"devType":"Ascend910B","chipCount":2,"faults":[{"chip":0,"code":1,"occur":"2m","recover":"1m"}]}`,
+				`{"devType":"Ascend910B","chipCount":2,"series":[{"metric":"unknown","points":[{"value":1}]}]}`,
+				`{"devType":"Ascend910B","chipCount":2,"series":[{"metric":"power","points":[{"offset":2},{"offset":1}]}]}`,
+				`{"devType":"Ascend910B","chipCount":2,"links":[{"chip":0,"down":"-1s"}]}`,
+			}
+			for _, invalid := range invalids {
+				_, err := ParseSimulationScenario([]byte(invalid))
+				convey.So(err, convey.ShouldNotBeNil)
+			}
+		})
+	})
+}
+
+func TestSimulatedDeviceInfo(t *testing.T) {
+	convey.Convey("test simulated device info", t, func() {
+		elapsed := time.Duration(0)
+		d := newTestSimulatedManager(&elapsed)
+
+		devNum, devList, err := d.GetDeviceList()
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(devNum, convey.ShouldEqual, simChipCount)
+		convey.So(len(devList), convey.ShouldEqual, simChipCount)
+		convey.So(d.GetDevType(), convey.ShouldEqual, api.Ascend910B)
+		productType, err := d.GetProductType(0)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(productType, convey.ShouldEqual, "Atlas 800T A2")
+		elabel, err := d.GetCardElabelV2(1)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(elabel.SerialNumber, convey.ShouldEqual, "SIM01")
+		_, err = d.GetChipInfo(simChipCount)
+		convey.So(err, convey.ShouldNotBeNil)
+		logicID, err := d.GetDeviceLogicID(3, 0)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(logicID, convey.ShouldEqual, 3)
+	})
+}
+
+func TestSimulatedSeries(t *testing.T) {
+	convey.Convey("test simulated series", t, func() {
+		elapsed := simHalfMinute
+		d := newTestSimulatedManager(&elapsed)
+
+		convey.Convey("value between points should be interpolated", func() {
+			temperature, err := d.GetDeviceTemperature(0)
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(temperature, convey.ShouldEqual, simTemperatureAt)
+		})
+		convey.Convey("value after the last point should be kept", func() {
+			elapsed = simFaultRecover
+			temperature, err := d.GetDeviceTemperature(0)
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(temperature, convey.ShouldEqual, 50)
+		})
+		convey.Convey("looped series should repeat and only apply to selected chips", func() {
+			elapsed = 25 * time.Second
+			hbmInfo, err := d.GetDeviceHbmInfo(1)
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(hbmInfo.Usage, convey.ShouldEqual, simHbmSize/4)
+			convey.So(hbmInfo.MemorySize, convey.ShouldEqual, simHbmSize)
+			hbmInfo, err = d.GetDeviceHbmInfo(0)
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(hbmInfo.Usage, convey.ShouldEqual, 0)
+		})
+	})
+}
+
+func TestSimulatedFaultsAndLinks(t *testing.T) {
+	convey.Convey("test simulated faults and links", t, func() {
+		elapsed := time.Duration(0)
+		d := newTestSimulatedManager(&elapsed)
+
+		convey.Convey("fault should only be active between occur and recover", func() {
+			health, err := d.GetDeviceHealth(0)
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(health, convey.ShouldEqual, 0)
+			elapsed = time.Minute
+			health, err = d.GetDeviceHealth(0)
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(health, convey.ShouldEqual, 3)
+			errCount, errCodes, err := d.GetDeviceAllErrorCode(0)
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(errCount, convey.ShouldEqual, 1)
+			convey.So(errCodes, convey.ShouldResemble, []int64{simFaultCode})
+			elapsed = simFaultRecover
+			errCount, _, err = d.GetDeviceErrorCode(0)
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(errCount, convey.ShouldEqual, 0)
+		})
+		convey.Convey("link without up offset should stay down", func() {
+			health, err := d.GetDeviceNetWorkHealth(2)
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(health, convey.ShouldEqual, common.NetworkSuccess)
+			elapsed = time.Hour
+			health, err = d.GetDeviceNetWorkHealth(2)
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(health, convey.ShouldEqual, linkDownHealthCode)
+		})
+		convey.Convey("ping mesh result should come from scenario", func() {
+			convey.So(d.StartHccsPingMesh(3, 0, common.HccspingMeshOperate{}), convey.ShouldBeNil)
+			state, err := d.GetHccsPingMeshState(3, 0, 0)
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(state, convey.ShouldEqual, 1)
+			info, err := d.GetHccsPingMeshInfo(3, 0, 0)
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(info.DestNum, convey.ShouldEqual, 1)
+			convey.So(info.PingTotalNum[0], convey.ShouldEqual, 10)
+		})
+	})
+}
+
+func TestSimulatedFaultEvent(t *testing.T) {
+	convey.Convey("test simulated fault event callback", t, func() {
+		var lock sync.Mutex
+		elapsed := time.Duration(0)
+		scenario, err := ParseSimulationScenario([]byte(simScenario))
+		convey.So(err, convey.ShouldBeNil)
+		d := NewSimulatedDeviceManagerWithScenario(scenario)
+		d.now = func() time.Time {
+			lock.Lock()
+			defer lock.Unlock()
+			return d.start.Add(elapsed)
+		}
+		defer d.ShutDown()
+		events := make(chan common.DevFaultInfo, simChipCount)
+		// the callback calls back into the device manager as the real consumers do, which should not deadlock
+		convey.So(d.SetFaultEventCallFunc(func(info common.DevFaultInfo) {
+			if err := d.SubscribeDeviceFaultEvent(info.LogicID); err == nil {
+				events <- info
+			}
+		}), convey.ShouldBeNil)
+		convey.So(d.SubscribeDeviceFaultEvent(-1), convey.ShouldBeNil)
+
+		lock.Lock()
+		elapsed = time.Minute
+		lock.Unlock()
+		select {
+		case event := <-events:
+			convey.So(event.EventID, convey.ShouldEqual, simFaultCode)
+			convey.So(event.Assertion, convey.ShouldEqual, common.FaultOccur)
+		case <-time.After(simEventTimeout):
+			t.Error("no fault occur event")
+		}
+		lock.Lock()
+		elapsed = time.Hour
+		lock.Unlock()
+		select {
+		case event := <-events:
+			convey.So(event.Assertion, convey.ShouldEqual, common.FaultRecover)
+		case <-time.After(simEventTimeout):
+			t.Error("no fault recover event")
+		}
+	})
+}
+
+func TestAutoInitSimulated(t *testing.T) {
+	convey.Convey("test auto init with simulation scenario env", t, func() {
+		path := filepath.Join(t.TempDir(), "scenario.yaml")
+		convey.So(os.WriteFile(path, []byte(simScenario), simFileMode), convey.ShouldBeNil)
+		t.Setenv(SimulatedScenarioEnv, path)
+
+		devMgr, err := AutoInit("", 0)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(devMgr.GetDevType(), convey.ShouldEqual, api.Ascend910B)
+		_, err = AutoInit(api.Ascend310P, 0)
+		convey.So(err, convey.ShouldNotBeNil)
+	})
+}
@@ -0,0 +1,272 @@
+/* Copyright(C) 2026. Huawei Technologies Co.,Ltd. All rights reserved.
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+// Package hccn this for the backend of npu hccn info
+package hccn
+
+import (
+	"errors"
+	"strconv"
+	"sync"
+
+	"ascend-common/common-utils/hwlog"
+	"ascend-common/devmanager/common"
+)
+
+var (
+	backend   HccnBackend = &execBackend{run: getInfoFromHccnTool, parser: &parserV1{}}
+	backendMu sync.RWMutex
+)
+
+// HccnBackend source of the npu hccn info. The default backend execs hccn_tool and parses its output,
+// an in-process backend can replace it by SetBackend
+type HccnBackend interface {
+	// Name name of the backend
+	Name() string
+	// GetNPULinkStatus link status of the npu, LinkUp or LinkDown
+	GetNPULinkStatus(phyID int32) (string, error)
+	// GetNPULinkSpeed link speed of the npu, unit Mb/s
+	GetNPULinkSpeed(phyID int32) (int, error)
+	// GetNPULinkUpNum link up count of the npu
+	GetNPULinkUpNum(phyID int32) (int, error)
+	// GetNPUStatInfo roce stat counters of the npu
+	GetNPUStatInfo(phyID int32) (map[string]int, error)
+	// GetNPUOpticalInfo optical info of the npu
+	GetNPUOpticalInfo(phyID int32) (map[string]string, error)
+	// GetNPUInterfaceTraffic tx and rx bandwidth of the npu, unit MB/sec
+	GetNPUInterfaceTraffic(phyID int32) (float64, float64, error)
+	// GetNPULinkStatusNpu link status of a port
+	GetNPULinkStatusNpu(logicID, udieID, portID int32) (string, error)
+	// GetNPUInterfaceTrafficNpu tx and rx bandwidth of a port in the duration seconds
+	GetNPUInterfaceTrafficNpu(logicID, udieID, portID, durationTime int32) (float64, float64, error)
+	// GetNPULinkSpeedNpu link speed of a port
+	GetNPULinkSpeedNpu(logicID, udieID, portID int32) (int, error)
+	// GetNpuOpticalInfoNpu optical info of a port
+	GetNpuOpticalInfoNpu(logicID, udieID, portID int32) (map[string]string, error)
+	// GetNPUUbStatInfo ub stat counters of a port
+	GetNPUUbStatInfo(logicID, udieID, portID int32) (map[string]string, error)
+	// GetNpuDevNetPortInfo udie id -> port ids of the npu
+	GetNpuDevNetPortInfo(logicID int32) (map[int][]int, error)
+}
+
+// SetBackend replace the backend of the hccn info
+func SetBackend(b HccnBackend) error {
+	if b == nil {
+		return errors.New("hccn backend is nil")
+	}
+	backendMu.Lock()
+	defer backendMu.Unlock()
+	hwlog.RunLog.Infof("hccn backend is set to %s", b.Name())
+	backend = b
+	return nil
+}
+
+// GetBackend get the backend of the hccn info
+func GetBackend() HccnBackend {
+	backendMu.RLock()
+	defer backendMu.RUnlock()
+	return backend
+}
+
+// NewExecBackend create a backend which execs hccn_tool and parses its output with the parser of the output
+// format of the product, such as Ascend910B
+func NewExecBackend(product string) (HccnBackend, error) {
+	parser, err := GetProductParser(product)
+	if err != nil {
+		return nil, err
+	}
+	return &execBackend{run: getInfoFromHccnTool, parser: parser}, nil
+}
+
+type execBackend struct {
+	run    func(args ...string) (string, error)
+	parser OutputParser
+}
+
+// Name name of the backend
+func (b *execBackend) Name() string {
+	return "hccn_tool-" + b.parser.Version()
+}
+
+// GetNPULinkStatus exec "hccn_tool -i * -link -g" to get link status
+func (b *execBackend) GetNPULinkStatus(phyID int32) (string, error) {
+	// command example: hccn_tool -i 0 -link -g
+	// success result example is: link status: DOWN
+	outStr, err := b.run("-i", strconv.Itoa(int(phyID)), "-link", "-g")
+	hwlog.RunLog.Debugf("hccn_tool command exec result: %v", outStr)
+	if err != nil {
+		return common.Abnormal, buildHccnErr(phyID, "link status", err)
+	}
+	status, err := b.parser.ParseLinkStatus(outStr)
+	if err != nil {
+		return common.Abnormal, buildHccnErr(phyID, "link status", err)
+	}
+	hwlog.RunLog.Debugf("hccn_tool get npu link status: %s", status)
+	return status, nil
+}
+
+// GetNPULinkSpeed exec "hccn_tool -i * -speed -g" to get link speed
+func (b *execBackend) GetNPULinkSpeed(phyID int32) (int, error) {
+	// command example: hccn_tool -i 0 -speed -g
+	// success result example is: Speed: 100000 Mb/s
+	outStr, err := b.run("-i", strconv.Itoa(int(phyID)), "-speed", "-g")
+	if err != nil {
+		return common.RetError, buildHccnErr(phyID, "link speed", err)
+	}
+	speed, err := b.parser.ParseLinkSpeed(outStr)
+	if err != nil {
+		return common.RetError, buildHccnErr(phyID, "link speed", err)
+	}
+	return speed, nil
+}
+
+// GetNPULinkUpNum exec "hccn_tool -i * -link_stat -g" to get link up count
+func (b *execBackend) GetNPULinkUpNum(phyID int32) (int, error) {
+	// command example: hccn_tool -i 0 -link_stat -g
+	// success result include: [device x]link up count : y
+	outStr, err := b.run("-i", strconv.Itoa(int(phyID)), "-link_stat", "-g")
+	if err != nil {
+		return common.RetError, buildHccnErr(phyID, "link stat", err)
+	}
+	linkUpNum, err := b.parser.ParseLinkUpNum(outStr)
+	if err != nil {
+		return common.RetError, buildHccnErr(phyID, "link up num", err)
+	}
+	return linkUpNum, nil
+}
+
+// GetNPUStatInfo exec "hccn_tool -i * -stat -g" to get stat info
+func (b *execBackend) GetNPUStatInfo(phyID int32) (map[string]int, error) {
+	// command example: hccn_tool -i 0 -stat -g
+	outStr, err := b.run("-i", strconv.Itoa(int(phyID)), "-stat", "-g")
+	if err != nil {
+		return nil, buildHccnErr(phyID, "stat", err)
+	}
+	return b.parser.ParseStatInfo(outStr), nil
+}
+
+// GetNPUOpticalInfo exec "hccn_tool -i * -optical -g" to get optical info
+func (b *execBackend) GetNPUOpticalInfo(phyID int32) (map[string]string, error) {
+	// command example: hccn_tool -i 0 -optical -g
+	outStr, err := b.run("-i", strconv.Itoa(int(phyID)), "-optical", "-g")
+	if err != nil {
+		return nil, buildHccnErr(phyID, "optical", err)
+	}
+	return b.parser.ParseOpticalInfo(outStr), nil
+}
+
+// GetNPUInterfaceTraffic exec "hccn_tool -i * -bandwidth -g" to get bandwidth info
+func (b *execBackend) GetNPUInterfaceTraffic(phyID int32) (float64, float64, error) {
+	// command example: hccn_tool -i 0 -bandwidth -g
+	// success result has two lines:
+	// Bandwidth TX: 0.00 MB/sec
+	// Bandwidth RX: 0.00 MB/sec
+	outStr, err := b.run("-i", strconv.Itoa(int(phyID)), "-bandwidth", "-g")
+	hwlog.RunLog.Debugf("hccn_tool command exec result: %v", outStr)
+	if err != nil {
+		return common.RetError, common.RetError, buildHccnErr(phyID, "interface traffic", err)
+	}
+	tx, rx := b.parser.ParseInterfaceTraffic(outStr)
+	return tx, rx, nil
+}
+
+// GetNPULinkStatusNpu exec "hccn_tool -g -link -i device_id -u udie_id -p port_id" to get link status
+func (b *execBackend) GetNPULinkStatusNpu(logicID, udieID, portID int32) (string, error) {
+	args := []string{"-g", "-link", "-i", strconv.Itoa(int(logicID)), "-u", strconv.Itoa(int(udieID)),
+		"-p", strconv.Itoa(int(portID))}
+	// command example: hccn_tool -g -link -i 58 -u 0 -p 4
+	// success result example is: link status: DOWN
+	outStr, err := b.run(args...)
+	hwlog.RunLog.Debugf("hccn_tool command: %v exec result: %v", args, outStr)
+	if err != nil {
+		return common.Abnormal, buildHccnErrA5("link status", err)
+	}
+	status, err := b.parser.ParseLinkStatus(outStr)
+	if err != nil {
+		return common.Abnormal, buildHccnErrA5("link status", err)
+	}
+	hwlog.RunLog.Debugf("hccn_tool get npu link status: %s", status)
+	return status, nil
+}
+
+// GetNPUInterfaceTrafficNpu exec "hccn_tool -g -bandwidth -i device_id -u udie_id -p port_id -time [1-226]"
+// to get bandwidth info
+func (b *execBackend) GetNPUInterfaceTrafficNpu(logicID, udieID, portID, durationTime int32) (float64, float64,
+	error) {
+	outStr, err := b.run("-g", "-bandwidth", "-i", strconv.Itoa(int(logicID)), "-u", strconv.Itoa(int(udieID)),
+		"-p", strconv.Itoa(int(portID)), "-time", strconv.Itoa(int(durationTime)))
+	hwlog.RunLog.Debugf("hccn_tool command exec result: %v", outStr)
+	if err != nil {
+		return common.RetError, common.RetError, buildHccnErrA5("interface traffic", err)
+	}
+	tx, rx := b.parser.ParseInterfaceTraffic(outStr)
+	return tx, rx, nil
+}
+
+// GetNPULinkSpeedNpu exec "hccn_tool -g -speed -i phy_id -u udie_id -p port_id" to get link speed
+func (b *execBackend) GetNPULinkSpeedNpu(logicID, udieID, portID int32) (int, error) {
+	// command example: hccn_tool -g -speed -i 56 -u 0 -p 4
+	outStr, err := b.run("-g", "-speed", "-i", strconv.Itoa(int(logicID)), "-u", strconv.Itoa(int(udieID)),
+		"-p", strconv.Itoa(int(portID)))
+	if err != nil {
+		return common.RetError, buildHccnErrA5("link speed", err)
+	}
+	speed, err := b.parser.ParsePortLinkSpeed(outStr)
+	if err != nil {
+		return common.RetError, buildHccnErrA5("link speed", err)
+	}
+	return speed, nil
+}
+
+// GetNpuOpticalInfoNpu exec "hccn_tool -g -optical -i device_id -u udie_id -p port_id" to get optical info
+func (b *execBackend) GetNpuOpticalInfoNpu(logicID, udieID, portID int32) (map[string]string, error) {
+	// command example: hccn_tool -g -optical -i 56 -u 0 -p 4
+	outStr, err := b.run("-g", "-optical", "-i", strconv.Itoa(int(logicID)), "-u", strconv.Itoa(int(udieID)),
+		"-p", strconv.Itoa(int(portID)))
+	if err != nil {
+		return nil, buildHccnErrA5("optical", err)
+	}
+	result, err := b.parser.ParsePortOpticalInfo(outStr)
+	if err != nil {
+		return result, buildHccnErrA5("optical", err)
+	}
+	hwlog.RunLog.Debugf("logicID:%v, udiePort:%v, portID:%v optical info: %v", logicID, udieID, portID, result)
+	return result, nil
+}
+
+// GetNPUUbStatInfo exec "hccn_tool -g -stat -i device_id -u udie_id -p port_id" to get ub stat info
+func (b *execBackend) GetNPUUbStatInfo(logicID, udieID, portID int32) (map[string]string, error) {
+	// command example: hccn_tool -g -stat -i 0 -u 0 -p 4
+	outStr, err := b.run("-g", "-stat", "-i", strconv.Itoa(int(logicID)), "-u", strconv.Itoa(int(udieID)),
+		"-p", strconv.Itoa(int(portID)))
+	if err != nil {
+		return nil, buildHccnErrA5("ub stat info", err)
+	}
+	return b.parser.ParsePortStatInfo(outStr), nil
+}
+
+// GetNpuDevNetPortInfo exec "hccn_tool -g -dev_info -i device_id" to get udie id -> port ids
+func (b *execBackend) GetNpuDevNetPortInfo(logicID int32) (map[int][]int, error) {
+	// command example: hccn_tool -g -dev_info -i 0
+	outStr, err := b.run("-g", "-dev_info", "-i", strconv.Itoa(int(logicID)))
+	if err != nil {
+		return nil, buildHccnErrA5("npu dev info", err)
+	}
+	hwlog.RunLog.Debugf("Full hccn_tool output: %s", outStr)
+	result, err := b.parser.ParseDevNetPortInfo(outStr)
+	if err != nil {
+		return nil, buildHccnErrA5("npu dev info", err)
+	}
+	return result, nil
+}
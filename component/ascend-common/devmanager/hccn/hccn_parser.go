@@ -0,0 +1,414 @@
+/* Copyright(C) 2026. Huawei Technologies Co.,Ltd. All rights reserved.
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+// Package hccn this for parsing the hccn_tool output
+package hccn
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+
+	"ascend-common/api"
+	"ascend-common/common-utils/hwlog"
+	"ascend-common/devmanager/common"
+)
+
+const (
+	// FormatV1 output format of hccn_tool shipped with the 910B, A3 and A5 drivers, the 910B and A3 commands
+	// print "key: value" lines and the A5 per port commands print tables
+	FormatV1 = "v1"
+)
+
+var (
+	parsers = map[string]OutputParser{FormatV1: &parserV1{}}
+	// productFormats output format version of hccn_tool shipped with the driver of each product
+	productFormats = map[string]string{api.Ascend910B: FormatV1, api.Ascend910A3: FormatV1, api.Ascend910A5: FormatV1}
+	parsersMu      sync.RWMutex
+)
+
+// OutputParser parse the text output of hccn_tool, each output format version has its own parser
+type OutputParser interface {
+	// Version output format version of the parser
+	Version() string
+	// ParseLinkStatus parse "-link -g" output, e.g. "link status: UP"
+	ParseLinkStatus(out string) (string, error)
+	// ParseLinkSpeed parse "-speed -g" output, e.g. "Speed: 100000 Mb/s"
+	ParseLinkSpeed(out string) (int, error)
+	// ParseLinkUpNum parse "-link_stat -g" output, e.g. "[device 0]link up count : 2"
+	ParseLinkUpNum(out string) (int, error)
+	// ParseStatInfo parse "-stat -g" output of "key:value" lines
+	ParseStatInfo(out string) map[string]int
+	// ParseOpticalInfo parse "-optical -g" output of "key : value" lines
+	ParseOpticalInfo(out string) map[string]string
+	// ParseInterfaceTraffic parse "-bandwidth -g" output, return tx and rx in MB/sec
+	ParseInterfaceTraffic(out string) (float64, float64)
+	// ParsePortLinkSpeed parse the speed table of a port
+	ParsePortLinkSpeed(out string) (int, error)
+	// ParsePortOpticalInfo parse the optical tables of a port
+	ParsePortOpticalInfo(out string) (map[string]string, error)
+	// ParsePortStatInfo parse the ub stat output of a port
+	ParsePortStatInfo(out string) map[string]string
+	// ParseDevNetPortInfo parse the device table, return udie id -> port ids
+	ParseDevNetPortInfo(out string) (map[int][]int, error)
+}
+
+// RegisterParser register the parser of a new output format version
+func RegisterParser(parser OutputParser) error {
+	if parser == nil {
+		return errors.New("parser is nil")
+	}
+	parsersMu.Lock()
+	defer parsersMu.Unlock()
+	if _, exist := parsers[parser.Version()]; exist {
+		return fmt.Errorf("parser of format %s already exists", parser.Version())
+	}
+	parsers[parser.Version()] = parser
+	return nil
+}
+
+// GetParser get the parser of the output format version
+func GetParser(version string) (OutputParser, error) {
+	parsersMu.RLock()
+	defer parsersMu.RUnlock()
+	parser, exist := parsers[version]
+	if !exist {
+		return nil, fmt.Errorf("parser of format %s does not exist", version)
+	}
+	return parser, nil
+}
+
+// SetProductFormat set the output format version of the product, e.g. when the driver of the product ships a
+// hccn_tool printing a new format, whose parser is registered by RegisterParser
+func SetProductFormat(product, version string) error {
+	parsersMu.Lock()
+	defer parsersMu.Unlock()
+	if _, exist := parsers[version]; !exist {
+		return fmt.Errorf("parser of format %s does not exist", version)
+	}
+	productFormats[product] = version
+	return nil
+}
+
+// GetProductParser get the parser of the output format of the product
+func GetProductParser(product string) (OutputParser, error) {
+	parsersMu.RLock()
+	version, exist := productFormats[product]
+	parsersMu.RUnlock()
+	if !exist {
+		return nil, fmt.Errorf("output format of product %s is unknown", product)
+	}
+	return GetParser(version)
+}
+
+type parserV1 struct{}
+
+// Version output format version of the parser
+func (p *parserV1) Version() string {
+	return FormatV1
+}
+
+// ParseLinkStatus parse link status
+func (p *parserV1) ParseLinkStatus(out string) (string, error) {
+	replacedStr := strings.ReplaceAll(out, newLine, "")
+	outArr := strings.Split(replacedStr, space)
+	if len(outArr) != linkStatusPart {
+		return common.Abnormal, fmt.Errorf("length of output %v is not equal to %v", outArr, linkStatusPart)
+	}
+	return outArr[secondIndex], nil
+}
+
+// ParseLinkSpeed parse link speed
+func (p *parserV1) ParseLinkSpeed(out string) (int, error) {
+	if strings.Contains(out, unknownStr) {
+		return common.RetError, errors.New("npu link speed is unknown")
+	}
+	replacedStr := strings.ReplaceAll(out, newLine, "")
+	outArr := strings.Split(replacedStr, space)
+	if len(outArr) != linkStatusPart {
+		return common.RetError, fmt.Errorf("length of output %v is not equal to %v", outArr, linkStatusPart)
+	}
+	const midIndex = 1
+	speed, err := strconv.Atoi(outArr[midIndex])
+	if err != nil {
+		return common.RetError, fmt.Errorf("covert speed from string failed: %s", err)
+	}
+	return speed, nil
+}
+
+// ParseLinkUpNum parse link up count
+func (p *parserV1) ParseLinkUpNum(out string) (int, error) {
+	const (
+		linkUpArrLen = 6
+		linkUpStr    = "link up count"
+	)
+	for _, line := range strings.Split(out, newLine) {
+		if line == "" || !strings.Contains(line, linkUpStr) {
+			continue
+		}
+		linkUpArr := strings.Fields(line)
+		if len(linkUpArr) != linkUpArrLen {
+			return common.RetError, fmt.Errorf("length of output %v is not equal to %v", linkUpArr, linkUpArrLen)
+		}
+		linkUPCount, err := strconv.Atoi(linkUpArr[linkUpArrLen-1])
+		if err != nil {
+			return common.RetError, fmt.Errorf("covert link up num from string failed: %s", err)
+		}
+		return linkUPCount, nil
+	}
+	return common.RetError, errors.New("did not find link up count")
+}
+
+// ParseStatInfo parse stat info, lines that are not numbers are skipped
+func (p *parserV1) ParseStatInfo(out string) map[string]int {
+	const statPartLen = 2
+	statInfoMap := make(map[string]int)
+	for _, line := range strings.Split(out, newLine) {
+		statParts := strings.Split(line, colon)
+		if len(statParts) != statPartLen || statParts[1] == "" {
+			continue
+		}
+		statNum, err := strconv.Atoi(statParts[1])
+		if err != nil {
+			hwlog.RunLog.Errorf("covert stat num of [%s] from string failed: %s", statParts[1], err)
+			continue
+		}
+		statInfoMap[statParts[0]] = statNum
+	}
+	return statInfoMap
+}
+
+// ParseOpticalInfo parse optical info, spaces in keys are replaced with "_"
+func (p *parserV1) ParseOpticalInfo(out string) map[string]string {
+	opticalInfoMap := make(map[string]string)
+	for _, line := range strings.Split(out, newLine) {
+		opticalParts := strings.Split(line, colon)
+		if len(opticalParts) != opticalPartLen {
+			continue
+		}
+		opticalKey := strings.ReplaceAll(strings.TrimSpace(opticalParts[0]), space, "_")
+		opticalInfoMap[opticalKey] = strings.TrimSpace(opticalParts[1])
+	}
+	return opticalInfoMap
+}
+
+// ParseInterfaceTraffic parse bandwidth, the missing direction is common.RetError
+func (p *parserV1) ParseInterfaceTraffic(out string) (float64, float64) {
+	const (
+		trafficPartLen = 4
+		txStr          = "TX:"
+		rxStr          = "RX:"
+	)
+	tx, rx := float64(common.RetError), float64(common.RetError)
+	for _, line := range strings.Split(out, newLine) {
+		if line == "" {
+			continue
+		}
+		trafficArr := strings.Fields(line)
+		hwlog.RunLog.Debugf("npu bandwidth split as: %v", trafficArr)
+		if len(trafficArr) != trafficPartLen {
+			continue
+		}
+		if strings.Contains(line, txStr) {
+			tmpTx, err := strconv.ParseFloat(trafficArr[secondIndex], base64)
+			if err != nil {
+				hwlog.RunLog.Errorf("get float data from Bandwidth TX err: %v", err)
+				continue
+			}
+			tx = tmpTx
+		}
+		if strings.Contains(line, rxStr) {
+			tmpRx, err := strconv.ParseFloat(trafficArr[secondIndex], base64)
+			if err != nil {
+				hwlog.RunLog.Errorf("get float data from Bandwidth RX err: %v", err)
+				continue
+			}
+			rx = tmpRx
+		}
+	}
+	return tx, rx
+}
+
+// ParsePortLinkSpeed parse the speed column of the first data row in the speed table
+func (p *parserV1) ParsePortLinkSpeed(out string) (int, error) {
+	if strings.Contains(out, naValue) {
+		return common.RetError, errors.New("npu link speed is unknown, port is down")
+	}
+	var dataRows []string
+	for _, line := range strings.Split(strings.TrimSpace(out), newLine) {
+		if line != "" && !strings.Contains(line, "+") && strings.Contains(line, "|") {
+			dataRows = append(dataRows, line)
+		}
+	}
+	if len(dataRows) <= firstIndex {
+		return common.RetError, errors.New("speed table has no data row")
+	}
+	parts := strings.Split(dataRows[firstIndex], "|")
+	if len(parts) <= fourthIndex {
+		return common.RetError, fmt.Errorf("speed row %v has no speed column", parts)
+	}
+	speedInfo := strings.Split(strings.TrimSpace(parts[fourthIndex]), space)
+	if len(speedInfo) != netSpeedPartLen {
+		return common.RetError, fmt.Errorf("length of output %v is not equal to %v", speedInfo, netSpeedPartLen)
+	}
+	speed, err := strconv.Atoi(speedInfo[0])
+	if err != nil {
+		return common.RetError, fmt.Errorf("convert speed from string failed: %v", err)
+	}
+	return speed, nil
+}
+
+// ParsePortOpticalInfo parse the power rows of the second optical table, and count the optical modules
+func (p *parserV1) ParsePortOpticalInfo(out string) (map[string]string, error) {
+	if !strings.Contains(out, "Power") {
+		return map[string]string{}, errors.New("optical info is not valid")
+	}
+	return parseSecondTableColumns(strings.Split(strings.TrimSpace(out), newLine)), nil
+}
+
+func parseSecondTableColumns(lines []string) map[string]string {
+	opticalInfoMap := make(map[string]string)
+	var opticalIndex []string
+	tableSepCount := 0
+	inDataRow := false
+	for _, line := range lines {
+		line = strings.TrimSpace(line)
+		if strings.HasPrefix(line, "+-") {
+			tableSepCount++
+			if tableSepCount == fifthIndex {
+				inDataRow = true
+			} else if tableSepCount == sixthIndex {
+				break
+			}
+			continue
+		}
+		if !inDataRow {
+			continue
+		}
+		parts := strings.Split(line, "|")
+		if strings.Contains(line, "optical_") && len(parts) >= secondLast {
+			opticalIndex = append(opticalIndex, strings.TrimSpace(parts[len(parts)-secondLast]))
+		}
+		if len(parts) < eleventhIndex {
+			continue
+		}
+		col4 := strings.TrimSpace(parts[fourthIndex])
+		col5 := strings.TrimSpace(parts[fifthIndex])
+		if strings.Contains(col5, "-") {
+			continue
+		}
+		if col4 != "" && strings.Contains(line, "xPower") {
+			opticalInfoMap[col4] = col5
+		}
+	}
+	opticalInfoMap["optical_index"] = strconv.Itoa(len(opticalIndex))
+	return opticalInfoMap
+}
+
+// ParsePortStatInfo parse ub stat info of "key : value" lines
+func (p *parserV1) ParsePortStatInfo(out string) map[string]string {
+	ubStatInfoMap := make(map[string]string)
+	for _, line := range strings.Split(out, newLine) {
+		ubParts := strings.Split(line, colon)
+		if len(ubParts) != opticalPartLen {
+			continue
+		}
+		ubStatInfoMap[strings.TrimSpace(ubParts[0])] = strings.TrimSpace(ubParts[1])
+	}
+	return ubStatInfoMap
+}
+
+// ParseDevNetPortInfo parse the first table of the device info, return udie id -> port ids
+func (p *parserV1) ParseDevNetPortInfo(out string) (map[int][]int, error) {
+	return parseDeviceTable(strings.Split(out, newLine))
+}
+
+// parseDeviceTable processes device information table and returns UdieID-PortID mapping
+func parseDeviceTable(lines []string) (map[int][]int, error) {
+	result := make(map[int][]int)
+	isInTable := false
+	separatorCount := 0
+
+	for _, line := range lines {
+		trimmedLine := strings.TrimSpace(line)
+		if trimmedLine == "" {
+			continue // Skip empty lines
+		}
+
+		// Handle table separator lines
+		if strings.HasPrefix(trimmedLine, "+-") {
+			separatorCount++
+			if separatorCount == firstIndex {
+				isInTable = true
+			} else if separatorCount == threePart {
+				hwlog.RunLog.Debugf("Found end of first table, stopping parsing")
+				break
+			}
+			continue
+		}
+
+		// Process table data lines
+		if !isInTable || !strings.HasPrefix(trimmedLine, "|") {
+			continue
+		}
+		// Skip header row
+		lineLower := strings.ToLower(trimmedLine)
+		if !strings.Contains(lineLower, "ub") {
+			continue
+		}
+
+		// Parse device row data
+		uDieID, portID, err := parseDeviceRow(trimmedLine)
+		if err != nil {
+			hwlog.RunLog.Warnf("Skipping invalid row: %s", trimmedLine)
+			continue
+		}
+		result[uDieID] = append(result[uDieID], portID)
+	}
+
+	if len(result) == 0 {
+		return nil, fmt.Errorf("failed to parse any valid data rows")
+	}
+	return result, nil
+}
+
+// parseDeviceRow extracts and validates UdieID and PortID from a single table row
+func parseDeviceRow(trimmedLine string) (int, int, error) {
+	// Extract columns from table row
+	rowData := strings.TrimPrefix(trimmedLine, "|")
+	rowData = strings.TrimSuffix(rowData, "|")
+	columns := strings.Split(rowData, "|")
+	for i, col := range columns {
+		columns[i] = strings.TrimSpace(col)
+	}
+
+	// Validate columns
+	if len(columns) < secondIndex || columns[0] == "" || columns[1] == "" {
+		return 0, 0, fmt.Errorf("insufficient or empty columns")
+	}
+
+	// Convert to integers
+	uDieID, err := strconv.Atoi(columns[0])
+	if err != nil {
+		return 0, 0, err
+	}
+	portID, err := strconv.Atoi(columns[1])
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return uDieID, portID, nil
+}
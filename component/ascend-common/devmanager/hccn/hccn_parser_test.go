@@ -0,0 +1,259 @@
+/* Copyright(C) 2026. Huawei Technologies Co.,Ltd. All rights reserved.
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+// Package hccn this for parsing the hccn_tool output
+package hccn
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"ascend-common/api"
+	"ascend-common/common-utils/hwlog"
+)
+
+const goldenSuffix = ".golden.json"
+
+type parseFunc func(p OutputParser, out string) (interface{}, error)
+
+type trafficResult struct {
+	Tx float64 `json:"tx"`
+	Rx float64 `json:"rx"`
+}
+
+type goldenResult struct {
+	Value interface{} `json:"value"`
+	Error string      `json:"error,omitempty"`
+}
+
+// fixtureProducts the product of the fixtures in each directory of testdata
+var fixtureProducts = map[string]string{"910b": api.Ascend910B, "a3": api.Ascend910A3, "a5": api.Ascend910A5}
+
+var goldenCases = []struct {
+	fixture string
+	parse   parseFunc
+}{
+	{fixture: "910b/link", parse: parseLinkStatus},
+	{fixture: "910b/speed", parse: parseLinkSpeed},
+	{fixture: "910b/link_stat", parse: parseLinkUpNum},
+	{fixture: "910b/stat", parse: parseStatInfo},
+	{fixture: "910b/optical", parse: parseOpticalInfo},
+	{fixture: "910b/bandwidth", parse: parseInterfaceTraffic},
+	{fixture: "a3/link", parse: parseLinkStatus},
+	{fixture: "a3/speed", parse: parseLinkSpeed},
+	{fixture: "a3/speed_unknown", parse: parseLinkSpeed},
+	{fixture: "a3/link_stat", parse: parseLinkUpNum},
+	{fixture: "a3/stat", parse: parseStatInfo},
+	{fixture: "a3/optical", parse: parseOpticalInfo},
+	{fixture: "a3/bandwidth", parse: parseInterfaceTraffic},
+	{fixture: "a5/link", parse: parseLinkStatus},
+	{fixture: "a5/bandwidth", parse: parseInterfaceTraffic},
+	{fixture: "a5/port_speed", parse: parsePortLinkSpeed},
+	{fixture: "a5/port_speed_na", parse: parsePortLinkSpeed},
+	{fixture: "a5/port_optical", parse: parsePortOpticalInfo},
+	{fixture: "a5/port_stat", parse: parsePortStatInfo},
+	{fixture: "a5/dev_info", parse: parseDevNetPortInfo},
+}
+
+func init() {
+	hwlog.InitRunLogger(&hwlog.LogConfig{OnlyToStdout: true}, context.Background())
+}
+
+func parseLinkStatus(p OutputParser, out string) (interface{}, error) {
+	return p.ParseLinkStatus(out)
+}
+
+func parseLinkSpeed(p OutputParser, out string) (interface{}, error) {
+	return p.ParseLinkSpeed(out)
+}
+
+func parseLinkUpNum(p OutputParser, out string) (interface{}, error) {
+	return p.ParseLinkUpNum(out)
+}
+
+func parseStatInfo(p OutputParser, out string) (interface{}, error) {
+	return p.ParseStatInfo(out), nil
+}
+
+func parseOpticalInfo(p OutputParser, out string) (interface{}, error) {
+	return p.ParseOpticalInfo(out), nil
+}
+
+func parseInterfaceTraffic(p OutputParser, out string) (interface{}, error) {
+	tx, rx := p.ParseInterfaceTraffic(out)
+	return trafficResult{Tx: tx, Rx: rx}, nil
+}
+
+func parsePortLinkSpeed(p OutputParser, out string) (interface{}, error) {
+	return p.ParsePortLinkSpeed(out)
+}
+
+func parsePortOpticalInfo(p OutputParser, out string) (interface{}, error) {
+	return p.ParsePortOpticalInfo(out)
+}
+
+func parsePortStatInfo(p OutputParser, out string) (interface{}, error) {
+	return p.ParsePortStatInfo(out), nil
+}
+
+func parseDevNetPortInfo(p OutputParser, out string) (interface{}, error) {
+	return p.ParseDevNetPortInfo(out)
+}
+
+// normalize marshal and unmarshal the value, so the result can be compared with the golden file
+func normalize(t *testing.T, value interface{}) interface{} {
+	data, err := json.Marshal(value)
+	if err != nil {
+		t.Fatalf("marshal failed: %v", err)
+	}
+	var res interface{}
+	if err = json.Unmarshal(data, &res); err != nil {
+		t.Fatalf("unmarshal failed: %v", err)
+	}
+	return res
+}
+
+// TestParserGolden parse the hccn_tool outputs in testdata by the parser of the product and compare with the golden
+// files
+func TestParserGolden(t *testing.T) {
+	for _, tc := range goldenCases {
+		t.Run(tc.fixture, func(t *testing.T) {
+			parser, err := GetProductParser(fixtureProducts[filepath.Dir(tc.fixture)])
+			if err != nil {
+				t.Fatalf("get parser failed: %v", err)
+			}
+			base := filepath.Join("testdata", parser.Version(), tc.fixture)
+			out, err := os.ReadFile(base + ".txt")
+			if err != nil {
+				t.Fatalf("read fixture failed: %v", err)
+			}
+			value, err := tc.parse(parser, string(out))
+			got := goldenResult{Value: value}
+			if err != nil {
+				got.Error = err.Error()
+			}
+			goldenData, err := os.ReadFile(base + goldenSuffix)
+			if err != nil {
+				t.Fatalf("read golden file failed: %v", err)
+			}
+			var want interface{}
+			if err = json.Unmarshal(goldenData, &want); err != nil {
+				t.Fatalf("unmarshal golden file failed: %v", err)
+			}
+			if !reflect.DeepEqual(normalize(t, got), want) {
+				t.Errorf("parse result %s does not match the golden file %s", normalize(t, got), goldenData)
+			}
+		})
+	}
+}
+
+func TestRegisterParser(t *testing.T) {
+	t.Run("duplicate version", func(t *testing.T) {
+		if err := RegisterParser(&parserV1{}); err == nil {
+			t.Error("duplicate version should be rejected")
+		}
+	})
+	t.Run("nil parser", func(t *testing.T) {
+		if err := RegisterParser(nil); err == nil {
+			t.Error("nil parser should be rejected")
+		}
+	})
+	t.Run("unknown version", func(t *testing.T) {
+		if _, err := GetParser("v0"); err == nil {
+			t.Error("unknown version should be rejected")
+		}
+	})
+}
+
+func TestProductParser(t *testing.T) {
+	t.Run("unknown product", func(t *testing.T) {
+		if _, err := GetProductParser(api.Ascend310P); err == nil {
+			t.Error("unknown product should be rejected")
+		}
+		if _, err := NewExecBackend(api.Ascend310P); err == nil {
+			t.Error("backend of unknown product should not be created")
+		}
+	})
+	t.Run("format without parser", func(t *testing.T) {
+		if err := SetProductFormat(api.Ascend910A5, "v0"); err == nil {
+			t.Error("format without parser should be rejected")
+		}
+	})
+	t.Run("backend of product", func(t *testing.T) {
+		b, err := NewExecBackend(api.Ascend910A5)
+		if err != nil || b.Name() != "hccn_tool-"+FormatV1 {
+			t.Errorf("backend of %s should parse the %s format, got %v %v", api.Ascend910A5, FormatV1, b, err)
+		}
+	})
+}
+
+func TestParserMalformedOutput(t *testing.T) {
+	p := &parserV1{}
+	t.Run("speed table without data row", func(t *testing.T) {
+		if _, err := p.ParsePortLinkSpeed("| udie | port | status | speed |\n"); err == nil {
+			t.Error("speed table without data row should fail")
+		}
+	})
+	t.Run("optical output without power", func(t *testing.T) {
+		if _, err := p.ParsePortOpticalInfo("optical is not present"); err == nil {
+			t.Error("optical output without power should fail")
+		}
+	})
+	t.Run("short optical rows", func(t *testing.T) {
+		out := strings.Repeat("+-\n", fifthIndex) + "| 0 | xPower | optical_0 |\n"
+		res, err := p.ParsePortOpticalInfo(out)
+		if err != nil || res["optical_index"] != "1" {
+			t.Errorf("short optical rows should be skipped, got %v %v", res, err)
+		}
+	})
+}
+
+func TestExecBackend(t *testing.T) {
+	runErr := errors.New("exec failed")
+	var gotArgs []string
+	b := &execBackend{parser: &parserV1{}, run: func(args ...string) (string, error) {
+		gotArgs = args
+		if args[len(args)-1] == "-g" {
+			return "link status: UP\n", nil
+		}
+		return "", runErr
+	}}
+	old := GetBackend()
+	if err := SetBackend(b); err != nil {
+		t.Fatalf("set backend failed: %v", err)
+	}
+	defer func() {
+		backend = old
+	}()
+
+	status, err := GetNPULinkStatus(1)
+	if err != nil || status != LinkUp {
+		t.Errorf("link status should be %s, got %s %v", LinkUp, status, err)
+	}
+	if strings.Join(gotArgs, space) != "-i 1 -link -g" {
+		t.Errorf("unexpected hccn_tool args %v", gotArgs)
+	}
+	if _, err = GetNpuDevNetPortInfo(0); err == nil || !strings.Contains(err.Error(), runErr.Error()) {
+		t.Errorf("exec error should be returned, got %v", err)
+	}
+	if err = SetBackend(nil); err == nil {
+		t.Error("nil backend should be rejected")
+	}
+}
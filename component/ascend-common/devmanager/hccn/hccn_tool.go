@@ -84,201 +84,64 @@ func getInfoFromHccnTool(args ...string) (string, error) {
 	return string(limitStdout.GetBufferBytes()), nil
 }
 
-// GetNPULinkStatus exec "hccn_tool -i * -link -g" to get link status
+// GetNPULinkStatus get link status of the npu from the hccn backend
 func GetNPULinkStatus(phyID int32) (string, error) {
-	args := []string{"-i", strconv.Itoa(int(phyID)), "-link", "-g"}
-	// command example: hccn_tool -i 0 -link -g
-	// success result example is: link status: DOWN
-	outStr, err := getInfoFromHccnTool(args...)
-	hwlog.RunLog.Debugf("hccn_tool command exec result: %v", outStr)
-	if err != nil {
-		return common.Abnormal, buildHccnErr(phyID, "link status", err)
-	}
-	replacedStr := strings.ReplaceAll(outStr, newLine, "")
-	outArr := strings.Split(replacedStr, space)
-	if len(outArr) != linkStatusPart {
-		return common.Abnormal, buildHccnErr(phyID, "link status",
-			fmt.Errorf("length of output %v is not equal to %v", outArr, linkStatusPart))
-	}
-
-	status := outArr[secondIndex]
-	hwlog.RunLog.Debugf("hccn_tool get npu link status: %s", status)
-	return status, nil
+	return GetBackend().GetNPULinkStatus(phyID)
 }
 
-// GetNPULinkSpeed exec "hccn_tool -i * -speed -g" to get link speed
+// GetNPULinkSpeed get link speed of the npu from the hccn backend
 func GetNPULinkSpeed(phyID int32) (int, error) {
-	args := []string{"-i", strconv.Itoa(int(phyID)), "-speed", "-g"}
-	// command example: hccn_tool -i 0 -speed -g
-	// success result example is: Speed: 100000 Mb/s
-	outStr, err := getInfoFromHccnTool(args...)
-	if err != nil {
-		return common.RetError, buildHccnErr(phyID, "link speed", err)
-	}
-	return getSpeedFromOutStr(outStr, phyID)
+	return GetBackend().GetNPULinkSpeed(phyID)
 }
 
-func getSpeedFromOutStr(outStr string, phyID int32) (int, error) {
-	if strings.Contains(outStr, unknownStr) {
-		return common.RetError, buildHccnErr(phyID, "link speed", fmt.Errorf("npu link speed is unknown"))
-	}
-	replacedStr := strings.ReplaceAll(outStr, newLine, "")
-	outArr := strings.Split(replacedStr, space)
-	if len(outArr) != linkStatusPart {
-		return common.RetError, buildHccnErr(phyID, "link speed", fmt.Errorf("length of output %v is not equal to %v",
-			outArr, linkStatusPart))
-	}
-	const midIndex = 1
-	speed, err := strconv.Atoi(outArr[midIndex])
-	if err != nil {
-		return common.RetError, buildHccnErr(phyID, "link speed", fmt.Errorf("covert speed from string failed: %s", err))
-	}
-
-	return speed, nil
+// GetNPULinkUpNum get link up count of the npu from the hccn backend
+func GetNPULinkUpNum(phyID int32) (int, error) {
+	return GetBackend().GetNPULinkUpNum(phyID)
 }
 
-// GetNPULinkUpNum exec "hccn_tool -i * -link_stat -g" to get link up count
-func GetNPULinkUpNum(phyID int32) (int, error) {
-	args := []string{"-i", strconv.Itoa(int(phyID)), "-link_stat", "-g"}
-	// command example: hccn_tool -i 0 -link_stat -g
-	// success result include: [device x]link up count : y
-	outStr, err := getInfoFromHccnTool(args...)
-	if err != nil {
-		return common.RetError, buildHccnErr(phyID, "link stat", err)
-	}
+// GetNPUStatInfo get stat info of the npu from the hccn backend
+func GetNPUStatInfo(phyID int32) (map[string]int, error) {
+	return GetBackend().GetNPUStatInfo(phyID)
+}
 
-	const (
-		linkUpArrLen = 6
-		linkUpStr    = "link up count"
-	)
-	linkUPCount := 0
-	lines := strings.Split(outStr, newLine)
-	for _, line := range lines {
-		if line == "" || !strings.Contains(line, linkUpStr) {
-			continue
-		}
-
-		linkUpArr := strings.Fields(line)
-		if len(linkUpArr) != linkUpArrLen {
-			return common.RetError, buildHccnErr(phyID, "link up num", fmt.Errorf("length of output %v is not "+
-				"equal to %v", linkUpArr, linkUpArrLen))
-		}
-		if linkUPCount, err = strconv.Atoi(linkUpArr[linkUpArrLen-1]); err != nil {
-			return common.RetError, buildHccnErr(phyID, "link up num",
-				fmt.Errorf("covert link up num from string failed: %s", err))
-		}
-		return linkUPCount, nil
-	}
+// GetNPUOpticalInfo get optical info of the npu from the hccn backend
+func GetNPUOpticalInfo(phyID int32) (map[string]string, error) {
+	return GetBackend().GetNPUOpticalInfo(phyID)
+}
 
-	return common.RetError, buildHccnErr(phyID, "link up num", fmt.Errorf("did not find link up count"))
+// GetNPUInterfaceTraffic get bandwidth info of the npu from the hccn backend
+func GetNPUInterfaceTraffic(phyID int32) (float64, float64, error) {
+	return GetBackend().GetNPUInterfaceTraffic(phyID)
 }
 
-// GetNPUStatInfo exec "hccn_tool -i * -stat -g" to get stat info
-func GetNPUStatInfo(phyID int32) (map[string]int, error) {
-	args := []string{"-i", strconv.Itoa(int(phyID)), "-stat", "-g"}
-	// command example: hccn_tool -i 0 -stat -g
-	// success result include: [device x]link up count : y
-	outStr, err := getInfoFromHccnTool(args...)
-	if err != nil {
-		return nil, buildHccnErr(phyID, "stat", err)
-	}
-	lines := strings.Split(outStr, newLine)
-	statInfoMap := make(map[string]int)
-	const statPartLen = 2
-	for _, line := range lines {
-		statParts := strings.Split(line, colon)
-		if len(statParts) != statPartLen || statParts[1] == "" {
-			continue
-		}
-		statNum, err := strconv.Atoi(statParts[1])
-		if err != nil {
-			hwlog.RunLog.Errorf("covert stat num of [%s] from string failed: %s", statParts[1], err)
-			continue
-		}
-		statInfoMap[statParts[0]] = statNum
-	}
+// GetNPULinkStatusNpu get link status of the port from the hccn backend
+func GetNPULinkStatusNpu(logicID, udieID, portID int32) (string, error) {
+	return GetBackend().GetNPULinkStatusNpu(logicID, udieID, portID)
+}
 
-	return statInfoMap, nil
+// GetNPUInterfaceTrafficNpu get bandwidth info of the port from the hccn backend
+func GetNPUInterfaceTrafficNpu(logicID, udieID, portID, durationTime int32) (float64, float64, error) {
+	return GetBackend().GetNPUInterfaceTrafficNpu(logicID, udieID, portID, durationTime)
 }
 
-// GetNPUOpticalInfo exec "hccn_tool -i * -optical -g" to get optical info
-func GetNPUOpticalInfo(phyID int32) (map[string]string, error) {
-	args := []string{"-i", strconv.Itoa(int(phyID)), "-optical", "-g"}
-	// command example: hccn_tool -i 0 -optical -g
-	// success result include: [device x]link up count : y
-	outStr, err := getInfoFromHccnTool(args...)
-	if err != nil {
-		return nil, buildHccnErr(phyID, "optical", err)
-	}
-	lines := strings.Split(outStr, newLine)
-	opticalInfoMap := make(map[string]string)
-	for _, line := range lines {
-		opticalParts := strings.Split(line, colon)
-		if len(opticalParts) != opticalPartLen {
-			continue
-		}
-		opticalKey := strings.ReplaceAll(strings.TrimSpace(opticalParts[0]), space, "_")
-		opticalValue := strings.TrimSpace(opticalParts[1])
-		opticalInfoMap[opticalKey] = opticalValue
-	}
+// GetNPULinkSpeedNpu get link speed of the port from the hccn backend
+func GetNPULinkSpeedNpu(logicID, udieID, portID int32) (int, error) {
+	return GetBackend().GetNPULinkSpeedNpu(logicID, udieID, portID)
+}
 
-	return opticalInfoMap, nil
+// GetNpuOpticalInfoNpu get npu optical info of the port from the hccn backend
+func GetNpuOpticalInfoNpu(logicID, udieID, portID int32) (map[string]string, error) {
+	return GetBackend().GetNpuOpticalInfoNpu(logicID, udieID, portID)
 }
 
-// GetNPUInterfaceTraffic exec "hccn_tool -i * -bandwidth -g" to get bandwidth info
-func GetNPUInterfaceTraffic(phyID int32) (float64, float64, error) {
-	const (
-		noTraffic      = common.RetError
-		trafficPartLen = 4
-		txStr          = "TX:"
-		rxStr          = "RX:"
-	)
-
-	args := []string{"-i", strconv.Itoa(int(phyID)), "-bandwidth", "-g"}
-	// command example: hccn_tool -i 0 -bandwidth -g
-	// success result has two lines:
-	// Bandwidth TX: 0.00 MB/sec
-	// Bandwidth RX: 0.00 MB/sec
-	outStr, err := getInfoFromHccnTool(args...)
-	hwlog.RunLog.Debugf("hccn_tool command exec result: %v", outStr)
-	if err != nil {
-		return noTraffic, noTraffic, buildHccnErr(phyID, "interface traffic", err)
-	}
+// GetNPUUbStatInfo get npu ub stat information of the port from the hccn backend
+func GetNPUUbStatInfo(logicID, udieID, portID int32) (map[string]string, error) {
+	return GetBackend().GetNPUUbStatInfo(logicID, udieID, portID)
+}
 
-	var (
-		tx = float64(noTraffic)
-		rx = float64(noTraffic)
-	)
-
-	lines := strings.Split(outStr, newLine)
-	for _, line := range lines {
-		if line == "" {
-			continue
-		}
-
-		trafficArr := strings.Fields(line)
-		hwlog.RunLog.Debugf("npu bandwidth split as: %v", trafficArr)
-		if len(trafficArr) != trafficPartLen {
-			continue
-		}
-		if strings.Contains(line, txStr) {
-			tmpTx, err := strconv.ParseFloat(trafficArr[secondIndex], base64)
-			if err != nil {
-				hwlog.RunLog.Errorf("get float data from Bandwidth TX err: %s", err)
-				continue
-			}
-			tx = tmpTx
-		}
-		if strings.Contains(line, rxStr) {
-			tmpRx, err := strconv.ParseFloat(trafficArr[secondIndex], base64)
-			if err != nil {
-				hwlog.RunLog.Errorf("get float data from Bandwidth RX err: %s", err)
-				continue
-			}
-			rx = tmpRx
-		}
-	}
-	return tx, rx, nil
+// GetNpuDevNetPortInfo retrieves NPU device information and returns UdieID-PortID mapping
+func GetNpuDevNetPortInfo(logicID int32) (map[int][]int, error) {
+	return GetBackend().GetNpuDevNetPortInfo(logicID)
 }
 
 // GetFloatDataFromStr get float data from string with space
@@ -342,181 +205,10 @@ func buildHccnErr(phyID int32, msg string, err error) error {
 	return fmt.Errorf("phyID(%d),get npu %s info failed,error is :%v", phyID, msg, err)
 }
 
-// GetNPULinkStatusNpu exec "hccn_tool -g -link -i device_id -u udie_id -p port_id" to get link status
-func GetNPULinkStatusNpu(logicID, udieID, portID int32) (string, error) {
-	args := []string{"-g", "-link", "-i", strconv.Itoa(int(logicID)), "-u", strconv.Itoa(int(udieID)), "-p", strconv.Itoa(int(portID))}
-	// command example: hccn_tool -g -link -i 58 -u 0 -p 4
-	// success result example is: link status: DOWN
-	outStr, err := getInfoFromHccnTool(args...)
-	hwlog.RunLog.Debugf("hccn_tool command: %v exec result: %v", args, outStr)
-	if err != nil {
-		return common.Abnormal, buildHccnErrA5("link status", err)
-	}
-	replacedStr := strings.ReplaceAll(outStr, newLine, "")
-	outArr := strings.Split(replacedStr, space)
-	if len(outArr) != linkStatusPart {
-		return common.Abnormal, buildHccnErrA5("link status",
-			fmt.Errorf("length of output %v is not equal to %v", outArr, linkStatusPart))
-	}
-
-	status := outArr[secondIndex]
-	hwlog.RunLog.Debugf("hccn_tool get npu link status: %s", status)
-	return status, nil
-}
-
-// GetNPUInterfaceTrafficNpu exec "hccn_tool -g -bandwidth -i device_id -u udie_id -p port_id -time [1-226]" to get bandwidth info
-func GetNPUInterfaceTrafficNpu(logicID, udieID, portID, durationTime int32) (float64, float64, error) {
-	const (
-		noTraffic      = common.RetError
-		trafficPartLen = 4
-		txStr          = "TX:"
-		rxStr          = "RX:"
-	)
-	args := []string{"-g", "-bandwidth", "-i", strconv.Itoa(int(logicID)), "-u", strconv.Itoa(int(udieID)), "-p",
-		strconv.Itoa(int(portID)), "-time", strconv.Itoa(int(durationTime))}
-	// success result has two lines:
-	// Bandwidth TX: 0.00 MB/sec
-	// Bandwidth RX: 0.00 MB/sec
-	outStr, err := getInfoFromHccnTool(args...)
-	hwlog.RunLog.Debugf("hccn_tool command exec result: %v", outStr)
-	if err != nil {
-		return noTraffic, noTraffic, buildHccnErrA5("interface traffic", err)
-	}
-	var (
-		tx = float64(noTraffic)
-		rx = float64(noTraffic)
-	)
-	lines := strings.Split(outStr, newLine)
-	for _, line := range lines {
-		if line == "" {
-			continue
-		}
-		trafficArr := strings.Fields(line)
-		hwlog.RunLog.Debugf("npu bandwidth split as: %v", trafficArr)
-		if len(trafficArr) != trafficPartLen {
-			continue
-		}
-		if strings.Contains(line, txStr) {
-			tmpTx, err := strconv.ParseFloat(trafficArr[secondIndex], base64)
-			if err != nil {
-				hwlog.RunLog.Errorf("get float data from Bandwidth TX err: %v", err)
-				continue
-			}
-			tx = tmpTx
-		}
-		if strings.Contains(line, rxStr) {
-			tmpRx, err := strconv.ParseFloat(trafficArr[secondIndex], base64)
-			if err != nil {
-				hwlog.RunLog.Errorf("get float data from Bandwidth RX err: %v", err)
-				continue
-			}
-			rx = tmpRx
-		}
-	}
-	return tx, rx, nil
-}
-
-// GetNPULinkSpeedNpu exec "hccn_tool -g -speed -i phy_id -u udie_id -p port_id" to get link speed
-func GetNPULinkSpeedNpu(logicID, udieID, portID int32) (int, error) {
-	args := []string{"-g", "-speed", "-i", strconv.Itoa(int(logicID)), "-u", strconv.Itoa(int(udieID)),
-		"-p", strconv.Itoa(int(portID))}
-	// command example: hccn_tool -g -speed -i 56 -u 0 -p 4
-	outStr, err := getInfoFromHccnTool(args...)
-	if err != nil {
-		return common.RetError, buildHccnErrA5("link speed", err)
-	}
-	return getSpeedFromStrA5(outStr)
-}
-
-func getSpeedFromStrA5(str string) (int, error) {
-	if strings.Contains(str, naValue) {
-		return common.RetError, buildHccnErrA5("link speed", fmt.Errorf("npu link speed is unknown, port is down"))
-	}
-
-	lines := strings.Split(strings.TrimSpace(str), "\n")
-	var dataRows []string
-	for _, line := range lines {
-		if line != "" && !strings.Contains(line, "+") && strings.Contains(line, "|") {
-			dataRows = append(dataRows, line)
-		}
-	}
-	parts := strings.Split(dataRows[firstIndex], "|")
-
-	speedInfo := strings.Split(strings.TrimSpace(parts[fourthIndex]), space)
-	if len(speedInfo) != netSpeedPartLen {
-		return common.RetError, buildHccnErrA5("port speed ",
-			fmt.Errorf("length of output %v is not equal to %v", speedInfo, netSpeedPartLen))
-	}
-	speed, err := strconv.Atoi(speedInfo[0])
-	if err != nil {
-		return common.RetError, buildHccnErrA5("convert speed from string failed", err)
-	}
-
-	return speed, nil
-}
-
 func buildHccnErrA5(msg string, err error) error {
 	return fmt.Errorf("get npu %s info failed,error is :%v", msg, err)
 }
 
-// GetNpuOpticalInfoNpu get npu optical info from hccn_tool
-func GetNpuOpticalInfoNpu(logicID, udieID, portID int32) (map[string]string, error) {
-	args := []string{"-g", "-optical", "-i", strconv.Itoa(int(logicID)), "-u",
-		strconv.Itoa(int(udieID)), "-p", strconv.Itoa(int(portID))}
-	// command example: hccn_tool -g -optical -i 56 -u 0 -p 4
-	outStr, err := getInfoFromHccnTool(args...)
-	if err != nil {
-		return nil, buildHccnErrA5("optical", err)
-	}
-	if !strings.Contains(outStr, "Power") {
-		return map[string]string{}, buildHccnErrA5("optical", fmt.Errorf("optical info is not valid"))
-	}
-	lines := strings.Split(strings.TrimSpace(outStr), "\n")
-	result, err := parseSecondTableColumns(lines)
-	hwlog.RunLog.Debugf("logicID:%v, udiePort:%v, portID:%v optical info: %v", logicID, udieID, portID, result)
-	return result, err
-}
-
-func parseSecondTableColumns(lines []string) (map[string]string, error) {
-	opticalInfoMap := make(map[string]string)
-	var opticalIndex []string
-
-	tableSepCount := 0
-	inDataRow := false
-
-	for _, line := range lines {
-		line = strings.TrimSpace(line)
-
-		if strings.HasPrefix(line, "+-") {
-			tableSepCount++
-			if tableSepCount == fifthIndex {
-				inDataRow = true
-			} else if tableSepCount == sixthIndex {
-				break
-			}
-			continue
-		}
-
-		if !inDataRow {
-			continue
-		}
-		parts := strings.Split(line, "|")
-		if strings.Contains(line, "optical_") {
-			opticalIndex = append(opticalIndex, strings.TrimSpace(parts[len(parts)-secondLast]))
-		}
-		col4 := strings.TrimSpace(parts[fourthIndex])
-		col5 := strings.TrimSpace(parts[fifthIndex])
-		if len(parts) < eleventhIndex || strings.Contains(col5, "-") {
-			continue
-		}
-		if col4 != "" && strings.Contains(line, "xPower") {
-			opticalInfoMap[col4] = col5
-		}
-	}
-	opticalInfoMap["optical_index"] = strconv.Itoa(len(opticalIndex))
-	return opticalInfoMap, nil
-}
-
 // GetFloatDataFromStrNpu get float data from string with space
 func GetFloatDataFromStrNpu(str string) (float64, error) {
 	if str == "" {
@@ -549,29 +241,6 @@ func GetIntDataFromStrNpu(str string) (int, error) {
 	return intData, nil
 }
 
-// GetNPUUbStatInfo  get npu ub stat information
-func GetNPUUbStatInfo(logicID, udieID, portID int32) (map[string]string, error) {
-	args := []string{"-g", "-stat", "-i", strconv.Itoa(int(logicID)), "-u", strconv.Itoa(int(udieID)),
-		"-p", strconv.Itoa(int(portID))}
-	// command example: hccn_tool -g -stat -i 0 -u 0 -p 4
-	outStr, err := getInfoFromHccnTool(args...)
-	if err != nil {
-		return nil, buildHccnErrA5("ub stat info", err)
-	}
-	lines := strings.Split(outStr, newLine)
-	ubStatInfoMap := make(map[string]string)
-	for _, line := range lines {
-		ubParts := strings.Split(line, colon)
-		if len(ubParts) != opticalPartLen {
-			continue
-		}
-		ubKey := strings.TrimSpace(ubParts[0])
-		ubValue := strings.TrimSpace(ubParts[1])
-		ubStatInfoMap[ubKey] = ubValue
-	}
-	return ubStatInfoMap, nil
-}
-
 // GetIntDataFromStr get int data from string  without err info
 func GetIntDataFromStr(str, dataType string) int {
 	if str == "" || strings.Contains(str, naValue) || strings.Contains(str, notSupport) {
@@ -584,102 +253,3 @@ func GetIntDataFromStr(str, dataType string) int {
 	}
 	return intData
 }
-
-// GetNpuDevNetPortInfo retrieves NPU device information and returns UdieID-PortID mapping
-func GetNpuDevNetPortInfo(logicID int32) (map[int][]int, error) {
-	args := []string{"-g", "-dev_info", "-i", strconv.Itoa(int(logicID))}
-	// command example: hccn_tool -g -dev_info -i 0
-	outStr, err := getInfoFromHccnTool(args...)
-	if err != nil {
-		return nil, buildHccnErrA5("npu dev info", err)
-	}
-
-	// First, log the full output for debugging
-	hwlog.RunLog.Debugf("Full hccn_tool output: %s", outStr)
-
-	// Split output into lines and parse table data
-	lines := strings.Split(outStr, "\n")
-	result, err := parseDeviceTable(lines)
-	if err != nil {
-		return nil, buildHccnErrA5("npu dev info", err)
-	}
-
-	return result, nil
-}
-
-// parseDeviceTable processes device information table and returns UdieID-PortID mapping
-func parseDeviceTable(lines []string) (map[int][]int, error) {
-	result := make(map[int][]int)
-	isInTable := false
-	separatorCount := 0
-
-	for _, line := range lines {
-		trimmedLine := strings.TrimSpace(line)
-		if trimmedLine == "" {
-			continue // Skip empty lines
-		}
-
-		// Handle table separator lines
-		if strings.HasPrefix(trimmedLine, "+-") {
-			separatorCount++
-			if separatorCount == firstIndex {
-				isInTable = true
-			} else if separatorCount == threePart {
-				hwlog.RunLog.Debugf("Found end of first table, stopping parsing")
-				break
-			}
-			continue
-		}
-
-		// Process table data lines
-		if !isInTable || !strings.HasPrefix(trimmedLine, "|") {
-			continue
-		}
-		// Skip header row
-		lineLower := strings.ToLower(trimmedLine)
-		if !strings.Contains(lineLower, "ub") {
-			continue
-		}
-
-		// Parse device row data
-		uDieID, portID, err := parseDeviceRow(trimmedLine)
-		if err != nil {
-			hwlog.RunLog.Warnf("Skipping invalid row: %s", trimmedLine)
-			continue
-		}
-		result[uDieID] = append(result[uDieID], portID)
-	}
-
-	if len(result) == 0 {
-		return nil, fmt.Errorf("failed to parse any valid data rows")
-	}
-	return result, nil
-}
-
-// parseDeviceRow extracts and validates UdieID and PortID from a single table row
-func parseDeviceRow(trimmedLine string) (int, int, error) {
-	// Extract columns from table row
-	rowData := strings.TrimPrefix(trimmedLine, "|")
-	rowData = strings.TrimSuffix(rowData, "|")
-	columns := strings.Split(rowData, "|")
-	for i, col := range columns {
-		columns[i] = strings.TrimSpace(col)
-	}
-
-	// Validate columns
-	if len(columns) < secondIndex || columns[0] == "" || columns[1] == "" {
-		return 0, 0, fmt.Errorf("insufficient or empty columns")
-	}
-
-	// Convert to integers
-	uDieID, err := strconv.Atoi(columns[0])
-	if err != nil {
-		return 0, 0, err
-	}
-	portID, err := strconv.Atoi(columns[1])
-	if err != nil {
-		return 0, 0, err
-	}
-
-	return uDieID, portID, nil
-}
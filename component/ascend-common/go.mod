@@ -4,13 +4,16 @@ go 1.18
 
 require (
 	github.com/agiledragon/gomonkey/v2 v2.8.0
+	github.com/containerd/containerd v1.7.20
 	github.com/fsnotify/fsnotify v1.6.0
 	github.com/kubeflow/common v0.4.3
-	github.com/containerd/containerd v1.7.20
 	github.com/smartystreets/goconvey v1.6.4
+	google.golang.org/grpc v1.57.2
 	k8s.io/api v0.25.3
 	k8s.io/apimachinery v0.25.3
 	k8s.io/client-go v0.25.3
+	k8s.io/utils v0.0.0-20220728103510-ee6ede2d64ed
+	sigs.k8s.io/yaml v1.3.0
 )
 
 require (
@@ -49,8 +52,6 @@ require (
 	gopkg.in/yaml.v3 v3.0.1 // indirect
 	k8s.io/klog/v2 v2.70.1 // indirect
 	k8s.io/kube-openapi v0.0.0-20220803162953-67bda5d908f1 // indirect
-	k8s.io/utils v0.0.0-20220728103510-ee6ede2d64ed // indirect
 	sigs.k8s.io/json v0.0.0-20220713155537-f223a00ba0e2 // indirect
 	sigs.k8s.io/structured-merge-diff/v4 v4.2.3 // indirect
-	sigs.k8s.io/yaml v1.3.0 // indirect
 )
@@ -93,6 +93,7 @@ function modify_version() {
     sed -i "s/ascend-k8sdeviceplugin:.*/ascend-k8sdeviceplugin:${build_version}/" "$CUR_DIR"/ascendplugin-310P-1usoc.yaml
     sed -i "s/ascend-k8sdeviceplugin:.*/ascend-k8sdeviceplugin:${build_version}/" "$CUR_DIR"/ascendplugin-npu-volcano.yaml
     sed -i "s/ascend-k8sdeviceplugin:.*/ascend-k8sdeviceplugin:${build_version}/" "$CUR_DIR"/ascendplugin-npu.yaml
+    sed -i "s/ascend-k8sdeviceplugin:.*/ascend-k8sdeviceplugin:${build_version}/" "$CUR_DIR"/ascendplugin-dra.yaml
     cp "$CUR_DIR"/Dockerfile "$TOP_DIR"/output/
     cp "$CUR_DIR"/Dockerfile-310P-1usoc "$TOP_DIR"/output/Dockerfile-310P-1usoc
     cp "$CUR_DIR"/run_for_310P_1usoc.sh "$TOP_DIR"/output/run_for_310P_1usoc.sh
@@ -106,6 +107,7 @@ function modify_version() {
     cp "$CUR_DIR"/ascendplugin-310P-1usoc-volcano.yaml "$TOP_DIR"/output/device-plugin-310P-1usoc-volcano-"${build_version}".yaml
     cp "$CUR_DIR"/ascendplugin-npu-volcano.yaml "$TOP_DIR"/output/device-plugin-npu-volcano-"${build_version}".yaml
     cp "$CUR_DIR"/ascendplugin-npu.yaml "$TOP_DIR"/output/device-plugin-npu-"${build_version}".yaml
+    cp "$CUR_DIR"/ascendplugin-dra.yaml "$TOP_DIR"/output/device-plugin-dra-"${build_version}".yaml
 
     cp "$CUR_DIR"/faultCode.json "$TOP_DIR"/output/faultCode.json
     cp "$CUR_DIR"/faultCustomization.json "$TOP_DIR"/output/faultCustomization.json
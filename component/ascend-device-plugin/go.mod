@@ -10,6 +10,7 @@ require (
 	github.com/containerd/typeurl/v2 v2.1.1
 	github.com/docker/docker v24.0.9+incompatible
 	github.com/fsnotify/fsnotify v1.6.0
+	github.com/golang/protobuf v1.5.4
 	github.com/smartystreets/goconvey v1.7.2
 	github.com/stretchr/testify v1.8.4
 	golang.org/x/time v0.3.0
@@ -56,7 +57,6 @@ require (
 	github.com/go-openapi/swag v0.19.14 // indirect
 	github.com/gogo/protobuf v1.3.2 // indirect
 	github.com/golang/groupcache v0.0.0-20210331224755-41bb18bfe9da // indirect
-	github.com/golang/protobuf v1.5.4 // indirect
 	github.com/google/gnostic v0.5.7-v3refs // indirect
 	github.com/google/go-cmp v0.6.0 // indirect
 	github.com/google/gofuzz v1.2.0 // indirect
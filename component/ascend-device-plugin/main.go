@@ -23,6 +23,7 @@ import (
 	"path/filepath"
 
 	"Ascend-device-plugin/pkg/common"
+	"Ascend-device-plugin/pkg/dra"
 	"Ascend-device-plugin/pkg/duplicatedetector"
 	"Ascend-device-plugin/pkg/duplicatedetector/types"
 	"Ascend-device-plugin/pkg/next/devicefactory"
@@ -73,6 +74,8 @@ var (
 		"The log file path, if the file size exceeds 20MB, will be rotate")
 	logMaxBackups = flag.Int("maxBackups", common.MaxBackups,
 		"Maximum number of backup log files, range is (0, 30]")
+	logFormat = flag.String("logFormat", hwlog.FormatText,
+		"Log format, text or json, the json format is for shipping the logs to Loki, Elasticsearch and so on")
 	presetVirtualDevice = flag.Bool("presetVirtualDevice", true, "Open the static of "+
 		"computing power splitting function, only support "+api.Ascend910+" and "+api.Ascend310P)
 	use310PMixedInsert = flag.Bool(api.Use310PMixedInsert, false, "Whether to use mixed insert "+
@@ -97,6 +100,9 @@ var (
 	softShareDevConfigDir = flag.String("softShareDevConfigDir", "", "soft share device config dir")
 	useSingleDieMode      = flag.Bool("useSingleDieMode", false,
 		"A3 card whether to use single die mode")
+	enableDRA = flag.Bool("enableDRA", false,
+		"Whether to publish the npus by the dynamic resource allocation driver instead of registering the "+
+			"device plugin resources to kubelet, default false")
 )
 
 var (
@@ -123,6 +129,7 @@ func initLogModule(ctx context.Context) error {
 		MaxBackups:    *logMaxBackups,
 		MaxAge:        *logMaxAge,
 		MaxLineLength: maxLogLineLength,
+		Format:        *logFormat,
 	}
 	if err := hwlog.InitRunLogger(&hwLogConfig, ctx); err != nil {
 		fmt.Printf("log init failed, error is %v\n", err)
@@ -292,6 +299,9 @@ func main() {
 	go hdm.ListenDpu(ctx)
 	// start goroutine to dump topo of rack A5 for ras
 	go topology.RasTopoWriteTask(ctx, hdm)
+	if *enableDRA {
+		go dra.Start(ctx, hdm)
+	}
 	duplicatedetector.CheckDuplicateDevices(ctx, &types.DetectorConfig{
 		CriEndpoint: "",
 		RuntimeType: hdm.ContainerRuntime,
@@ -320,6 +330,7 @@ func setParameters() {
 		DeviceResetTimeout:    *deviceResetTimeout,
 		SoftShareDevConfigDir: *softShareDevConfigDir,
 		UseSingleDieMode:      *useSingleDieMode,
+		EnableDRA:             *enableDRA,
 	}
 }
 
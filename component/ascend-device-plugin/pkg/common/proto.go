@@ -178,6 +178,7 @@ type Option struct {
 	DeviceResetTimeout    int      // device reset timeout
 	SoftShareDevConfigDir string   // soft share device config dir
 	UseSingleDieMode      bool     // use single die mode
+	EnableDRA             bool     // the npus are advertised by the dra driver instead of the device plugin
 }
 
 // GetAllDeviceInfoTypeList Get All Device Info Type List
@@ -0,0 +1,102 @@
+/* Copyright(C) 2026. Huawei Technologies Co.,Ltd. All rights reserved.
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+// Package dra is the kubernetes dynamic resource allocation driver of the npus
+package dra
+
+import "time"
+
+const (
+	// DriverName is the name of the dra driver, the device classes select the devices by it
+	DriverName = "npu.huawei.com"
+
+	// draPluginType is the plugin type of the dra kubelet plugin in the plugin registration
+	draPluginType = "DRAPlugin"
+	// draPluginVersion is the service of the dra kubelet plugin api supported by the driver
+	draPluginVersion = "v1beta1.DRAPlugin"
+	// pluginRegistryDir is the directory watched by the kubelet plugin manager
+	pluginRegistryDir = "/var/lib/kubelet/plugins_registry"
+	// pluginDir is the directory of the dra socket and the checkpoint of the prepared claims
+	pluginDir = "/var/lib/kubelet/plugins/" + DriverName
+	// registrationSocketName is the name of the registration socket in the plugin registry directory
+	registrationSocketName = DriverName + "-reg.sock"
+	// draSocketName is the name of the dra socket in the plugin directory
+	draSocketName = "dra.sock"
+	// checkpointName is the name of the checkpoint of the prepared claims in the plugin directory
+	checkpointName = "checkpoint.json"
+
+	// cdiDir is the directory of the cdi specs read by the container runtime
+	cdiDir = "/var/run/cdi"
+	// cdiVendor and cdiClass make up the kind of the cdi devices
+	cdiVendor = "huawei.com"
+	cdiClass  = "npu"
+	// cdiVersion is the version of the cdi spec
+	cdiVersion = "0.6.0"
+
+	// resourceGroup and resourceVersion is the api of the resource slices and resource claims
+	resourceGroup   = "resource.k8s.io"
+	resourceVersion = "v1"
+	// resourceSliceCountWithCounters is the number of the slices of the pool when the chips are partitionable,
+	// the counters are published in a slice without the devices
+	resourceSliceCountWithCounters = 2
+	// maxDevicesPerSlice is the limit of the devices in one resource slice
+	maxDevicesPerSlice = 128
+
+	// publishPeriod is the period to check the changes of the devices and republish the resource slices
+	publishPeriod = 5 * time.Second
+	// apiTimeout is the timeout of one request to the api server
+	apiTimeout = 10 * time.Second
+
+	dirPerm        = 0750
+	cdiSpecPerm    = 0644
+	checkpointPerm = 0600
+)
+
+// the attributes of the devices, the unqualified names are in the domain of the driver
+const (
+	attrType        = "type"
+	attrProductType = "productType"
+	attrIndex       = "index"
+	attrCardID      = "cardID"
+	attrDie         = "die"
+	attrSuperPodID  = "superPodID"
+	attrRackID      = "rackID"
+	attrHealth      = "health"
+	attrTemplate    = "template"
+)
+
+// the capacities of the devices and the counters of the chips
+const (
+	capacityHBM         = "hbm"
+	capacityAICore      = "aicore"
+	capacityAICoreQuota = "aicoreQuota"
+)
+
+// the types of the devices published by the driver
+const (
+	// deviceTypeChip is the whole chip
+	deviceTypeChip = "chip"
+	// deviceTypeVNPU is the virtual npu created by the template when the claim is prepared
+	deviceTypeVNPU = "vnpu"
+	// deviceTypeShare is the soft share device which is shared by the claims with the aicore and hbm quota
+	deviceTypeShare = "share"
+)
+
+// the health of the chips published in the attribute, the claims select the healthy chips by it
+const (
+	healthHealthy     = "Healthy"
+	healthUnhealthy   = "Unhealthy"
+	healthSubHealthy  = "SubHealthy"
+	healthPreSeparate = "PreSeparate"
+)
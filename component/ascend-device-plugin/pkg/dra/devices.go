@@ -0,0 +1,319 @@
+/* Copyright(C) 2026. Huawei Technologies Co.,Ltd. All rights reserved.
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+// Package dra is the kubernetes dynamic resource allocation driver of the npus
+package dra
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/kubelet/pkg/apis/deviceplugin/v1beta1"
+
+	"Ascend-device-plugin/pkg/common"
+	"ascend-common/api"
+)
+
+const (
+	deviceNamePrefix = "npu-"
+	shareNameSuffix  = "-share"
+	// dieNum910A3 is the number of dies of one Ascend910A3 npu, each die is published as a chip
+	dieNum910A3 = 2
+	bytesPerMB  = 1024 * 1024
+)
+
+// vnpuTemplates is the templates of the dynamic vnpus of the products, the templates with more aicores than the
+// chip are not published
+var vnpuTemplates = map[string][]string{
+	api.Ascend310P: {common.Vir01, common.Vir02, common.Vir02C1, common.Vir04, common.Vir04C3,
+		common.Vir04C3Ndvpp, common.Vir04C4Dvpp},
+	api.Ascend910A: {common.Vir02, common.Vir04, common.Vir08, common.Vir16},
+	api.Ascend910B: {common.Vir03C1G8, common.Vir05C1G8, common.Vir05C1G16, common.Vir06C1G16, common.Vir10C3G16,
+		common.Vir10C3G16NM, common.Vir10C3G32, common.Vir10C4G16M, common.Vir12C3G32},
+}
+
+// nodeInfo is the node level information shared by the chips
+type nodeInfo struct {
+	productType string
+	superPodID  int32
+	rackID      int32
+	aiCore      int64
+	// templates is the vnpu templates published for each chip, empty when the dynamic vnpu is disabled
+	templates []string
+	// softShare publishes the soft share device instead of the whole chip
+	softShare bool
+}
+
+// chipInfo is the physical chip published as the devices
+type chipInfo struct {
+	phyID  int32
+	cardID int32
+	hbmMB  int64
+	health string
+}
+
+// deviceRef is what the device name refers to
+type deviceRef struct {
+	kind     string
+	phyID    int32
+	template string
+}
+
+func chipDeviceName(phyID int32) string {
+	return fmt.Sprintf("%s%d", deviceNamePrefix, phyID)
+}
+
+func vnpuDeviceName(phyID int32, template string) string {
+	return fmt.Sprintf("%s-%s", chipDeviceName(phyID),
+		strings.ReplaceAll(template, common.UnderLine, common.MiddelLine))
+}
+
+func shareDeviceName(phyID int32) string {
+	return chipDeviceName(phyID) + shareNameSuffix
+}
+
+// parseDeviceName parse the device name published by the driver
+func parseDeviceName(name string) (deviceRef, error) {
+	if !strings.HasPrefix(name, deviceNamePrefix) {
+		return deviceRef{}, fmt.Errorf("device %s is not published by %s", name, DriverName)
+	}
+	parts := strings.SplitN(strings.TrimPrefix(name, deviceNamePrefix), common.MiddelLine, 2)
+	phyID, err := strconv.Atoi(parts[0])
+	if err != nil || phyID < 0 || phyID >= common.MaxDevicesNum {
+		return deviceRef{}, fmt.Errorf("device %s has invalid physical id", name)
+	}
+	ref := deviceRef{kind: deviceTypeChip, phyID: int32(phyID)}
+	if len(parts) == 1 {
+		return ref, nil
+	}
+	if name == shareDeviceName(ref.phyID) {
+		ref.kind = deviceTypeShare
+		return ref, nil
+	}
+	for template := range common.GetTemplateName2DeviceTypeMap() {
+		if name == vnpuDeviceName(ref.phyID, template) {
+			ref.kind, ref.template = deviceTypeVNPU, template
+			return ref, nil
+		}
+	}
+	return deviceRef{}, fmt.Errorf("device %s has unknown template", name)
+}
+
+// templatesOf return the vnpu templates supported by the product with the aicores of the chip
+func templatesOf(productType string, aiCore int64) []string {
+	templates := make([]string, 0, len(vnpuTemplates[productType]))
+	for _, template := range vnpuTemplates[productType] {
+		core, err := common.GetAICore(template)
+		if err != nil || int64(core) > aiCore {
+			continue
+		}
+		templates = append(templates, template)
+	}
+	return templates
+}
+
+// healthOf return the published health of the chip
+func healthOf(npu common.NpuDevice) string {
+	if npu.Health != v1beta1.Healthy {
+		return healthUnhealthy
+	}
+	health := healthHealthy
+	for _, faultType := range []string{common.GetFaultType(npu.FaultCodes, npu.LogicID),
+		common.GetNetworkFaultType(npu.NetworkFaultCodes, npu.LogicID)} {
+		switch faultType {
+		case common.PreSeparateNPU:
+			return healthPreSeparate
+		case common.SubHealthFault:
+			health = healthSubHealthy
+		default:
+		}
+	}
+	return health
+}
+
+func stringAttr(value string) map[string]interface{} {
+	return map[string]interface{}{"string": value}
+}
+
+func intAttr(value int64) map[string]interface{} {
+	return map[string]interface{}{"int": value}
+}
+
+func quantity(value int64, format resource.Format) string {
+	return resource.NewQuantity(value, format).String()
+}
+
+func hbmQuantity(hbmMB int64) string {
+	return quantity(hbmMB*bytesPerMB, resource.BinarySI)
+}
+
+func capacityOf(value string) map[string]interface{} {
+	return map[string]interface{}{"value": value}
+}
+
+func (n nodeInfo) chipAttributes(chip chipInfo, kind string) map[string]interface{} {
+	attributes := map[string]interface{}{
+		attrType:        stringAttr(kind),
+		attrProductType: stringAttr(n.productType),
+		attrIndex:       intAttr(int64(chip.phyID)),
+		attrCardID:      intAttr(int64(chip.cardID)),
+		attrHealth:      stringAttr(chip.health),
+	}
+	if n.productType == api.Ascend910A3 {
+		attributes[attrDie] = intAttr(int64(chip.phyID % dieNum910A3))
+	}
+	if n.superPodID >= 0 {
+		attributes[attrSuperPodID] = intAttr(int64(n.superPodID))
+	}
+	if n.rackID >= 0 {
+		attributes[attrRackID] = intAttr(int64(n.rackID))
+	}
+	return attributes
+}
+
+func (n nodeInfo) chipDevice(chip chipInfo) map[string]interface{} {
+	capacity := map[string]interface{}{}
+	if chip.hbmMB > 0 {
+		capacity[capacityHBM] = capacityOf(hbmQuantity(chip.hbmMB))
+	}
+	if n.aiCore > 0 {
+		capacity[capacityAICore] = capacityOf(quantity(n.aiCore, resource.DecimalSI))
+	}
+	device := map[string]interface{}{
+		"name":       chipDeviceName(chip.phyID),
+		"attributes": n.chipAttributes(chip, deviceTypeChip),
+		"capacity":   capacity,
+	}
+	if len(n.templates) > 0 {
+		device["consumesCounters"] = consumesCounters(chip.phyID, n.aiCore)
+	}
+	return device
+}
+
+func (n nodeInfo) vnpuDevice(chip chipInfo, template string, aiCore int64) map[string]interface{} {
+	attributes := n.chipAttributes(chip, deviceTypeVNPU)
+	attributes[attrTemplate] = stringAttr(template)
+	return map[string]interface{}{
+		"name":       vnpuDeviceName(chip.phyID, template),
+		"attributes": attributes,
+		"capacity": map[string]interface{}{
+			capacityAICore: capacityOf(quantity(aiCore, resource.DecimalSI)),
+		},
+		"consumesCounters": consumesCounters(chip.phyID, aiCore),
+	}
+}
+
+// shareDevice is the soft share device, the claims consume the aicore quota in percent and the hbm of it
+func (n nodeInfo) shareDevice(chip chipInfo) map[string]interface{} {
+	capacity := map[string]interface{}{
+		capacityAICoreQuota: consumableCapacity(quantity(api.SoftShareDeviceMaxAICoreQuota, resource.DecimalSI),
+			quantity(1, resource.DecimalSI)),
+	}
+	if chip.hbmMB > 0 {
+		capacity[capacityHBM] = consumableCapacity(hbmQuantity(chip.hbmMB), hbmQuantity(1))
+	}
+	return map[string]interface{}{
+		"name":                     shareDeviceName(chip.phyID),
+		"attributes":               n.chipAttributes(chip, deviceTypeShare),
+		"capacity":                 capacity,
+		"allowMultipleAllocations": true,
+	}
+}
+
+func consumableCapacity(value, step string) map[string]interface{} {
+	return map[string]interface{}{
+		"value": value,
+		"requestPolicy": map[string]interface{}{
+			"default":    value,
+			"validRange": map[string]interface{}{"min": step, "max": value, "step": step},
+		},
+	}
+}
+
+func counterSetName(phyID int32) string {
+	return chipDeviceName(phyID)
+}
+
+func consumesCounters(phyID int32, aiCore int64) []interface{} {
+	return []interface{}{map[string]interface{}{
+		"counterSet": counterSetName(phyID),
+		"counters": map[string]interface{}{
+			capacityAICore: capacityOf(quantity(aiCore, resource.DecimalSI)),
+		},
+	}}
+}
+
+// buildDevices build the devices of the chips
+func buildDevices(node nodeInfo, chips []chipInfo) []interface{} {
+	devices := make([]interface{}, 0, len(chips)*(len(node.templates)+1))
+	for _, chip := range chips {
+		if node.softShare {
+			devices = append(devices, node.shareDevice(chip))
+			continue
+		}
+		devices = append(devices, node.chipDevice(chip))
+		for _, template := range node.templates {
+			core, err := common.GetAICore(template)
+			if err != nil {
+				continue
+			}
+			devices = append(devices, node.vnpuDevice(chip, template, int64(core)))
+		}
+	}
+	return devices
+}
+
+// buildCounters build the shared counters of the chips partitioned by the vnpu templates
+func buildCounters(node nodeInfo, chips []chipInfo) []interface{} {
+	if len(node.templates) == 0 || node.softShare {
+		return nil
+	}
+	counters := make([]interface{}, 0, len(chips))
+	for _, chip := range chips {
+		counters = append(counters, map[string]interface{}{
+			"name": counterSetName(chip.phyID),
+			"counters": map[string]interface{}{
+				capacityAICore: capacityOf(quantity(node.aiCore, resource.DecimalSI)),
+			},
+		})
+	}
+	return counters
+}
+
+// buildSliceSpecs build the specs of the resource slices of the node without the pool. the shared counters are
+// published in the first slice without the devices, and the devices are split by the limit of one slice
+func buildSliceSpecs(nodeName string, node nodeInfo, chips []chipInfo) []map[string]interface{} {
+	specs := make([]map[string]interface{}, 0, resourceSliceCountWithCounters)
+	newSpec := func() map[string]interface{} {
+		return map[string]interface{}{"driver": DriverName, "nodeName": nodeName}
+	}
+	if counters := buildCounters(node, chips); len(counters) > 0 {
+		spec := newSpec()
+		spec["sharedCounters"] = counters
+		specs = append(specs, spec)
+	}
+	devices := buildDevices(node, chips)
+	for start := 0; start < len(devices) || start == 0; start += maxDevicesPerSlice {
+		end := start + maxDevicesPerSlice
+		if end > len(devices) {
+			end = len(devices)
+		}
+		spec := newSpec()
+		spec["devices"] = devices[start:end]
+		specs = append(specs, spec)
+	}
+	return specs
+}
@@ -0,0 +1,159 @@
+/* Copyright(C) 2026. Huawei Technologies Co.,Ltd. All rights reserved.
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+// Package dra is the kubernetes dynamic resource allocation driver of the npus
+package dra
+
+import (
+	"testing"
+
+	"github.com/agiledragon/gomonkey/v2"
+	"github.com/smartystreets/goconvey/convey"
+	"k8s.io/kubelet/pkg/apis/deviceplugin/v1beta1"
+
+	"Ascend-device-plugin/pkg/common"
+	"ascend-common/api"
+)
+
+const (
+	testNodeName  = "node1"
+	testAICore    = 20
+	testHbmMB     = 65536
+	testChipNum   = 16
+	testSuperPod  = 3
+	testTemplates = 9
+)
+
+func testChips(num int) []chipInfo {
+	chips := make([]chipInfo, 0, num)
+	for phyID := 0; phyID < num; phyID++ {
+		chips = append(chips, chipInfo{phyID: int32(phyID), cardID: int32(phyID / dieNum910A3), hbmMB: testHbmMB,
+			health: healthHealthy})
+	}
+	return chips
+}
+
+func devicesOf(specs []map[string]interface{}) []interface{} {
+	var devices []interface{}
+	for _, spec := range specs {
+		if items, ok := spec["devices"].([]interface{}); ok {
+			devices = append(devices, items...)
+		}
+	}
+	return devices
+}
+
+// TestParseDeviceName test parse the names of the published devices
+func TestParseDeviceName(t *testing.T) {
+	convey.Convey("Test parseDeviceName", t, func() {
+		convey.Convey("01-chip, vnpu and share device names, should parse the references", func() {
+			cases := map[string]deviceRef{
+				chipDeviceName(1):                           {kind: deviceTypeChip, phyID: 1},
+				shareDeviceName(3):                          {kind: deviceTypeShare, phyID: 3},
+				vnpuDeviceName(common.MaxDevicesNum-1, "x"): {},
+			}
+			cases[vnpuDeviceName(2, common.Vir10C3G16NM)] = deviceRef{kind: deviceTypeVNPU, phyID: 2,
+				template: common.Vir10C3G16NM}
+			for name, expected := range cases {
+				ref, err := parseDeviceName(name)
+				if expected.kind == "" {
+					convey.So(err, convey.ShouldNotBeNil)
+					continue
+				}
+				convey.So(err, convey.ShouldBeNil)
+				convey.So(ref, convey.ShouldResemble, expected)
+			}
+		})
+		convey.Convey("02-device not published by the driver, should return error", func() {
+			_, err := parseDeviceName("gpu-0")
+			convey.So(err, convey.ShouldNotBeNil)
+			_, err = parseDeviceName("npu-x")
+			convey.So(err, convey.ShouldNotBeNil)
+		})
+	})
+}
+
+// TestHealthOf test the published health of the chips
+func TestHealthOf(t *testing.T) {
+	convey.Convey("Test healthOf", t, func() {
+		patches := gomonkey.ApplyFunc(common.GetFaultType, func(_ []int64, logicID int32) string {
+			if logicID == 1 {
+				return common.PreSeparateNPU
+			}
+			return common.NormalNPU
+		}).ApplyFunc(common.GetNetworkFaultType, func(_ []int64, logicID int32) string {
+			if logicID == 2 {
+				return common.SubHealthFault
+			}
+			return common.NormalNetwork
+		})
+		defer patches.Reset()
+		convey.So(healthOf(common.NpuDevice{Health: v1beta1.Unhealthy}), convey.ShouldEqual, healthUnhealthy)
+		for logicID, expected := range []string{healthHealthy, healthPreSeparate, healthSubHealthy} {
+			health := healthOf(common.NpuDevice{Health: v1beta1.Healthy, LogicID: int32(logicID)})
+			convey.So(health, convey.ShouldEqual, expected)
+		}
+	})
+}
+
+// TestBuildSliceSpecs test build the resource slices of the node
+func TestBuildSliceSpecs(t *testing.T) {
+	convey.Convey("Test buildSliceSpecs", t, func() {
+		node := nodeInfo{productType: api.Ascend910A3, superPodID: testSuperPod, rackID: -1, aiCore: testAICore}
+		convey.Convey("01-whole chips, should publish the chips with the attributes in one slice", func() {
+			specs := buildSliceSpecs(testNodeName, node, testChips(testChipNum))
+			convey.So(len(specs), convey.ShouldEqual, 1)
+			convey.So(specs[0]["nodeName"], convey.ShouldEqual, testNodeName)
+			devices := devicesOf(specs)
+			convey.So(len(devices), convey.ShouldEqual, testChipNum)
+			device := devices[testChipNum-1].(map[string]interface{})
+			convey.So(device["name"], convey.ShouldEqual, "npu-15")
+			attributes := device["attributes"].(map[string]interface{})
+			convey.So(attributes[attrDie], convey.ShouldResemble, intAttr(1))
+			convey.So(attributes[attrCardID], convey.ShouldResemble, intAttr(7))
+			convey.So(attributes[attrSuperPodID], convey.ShouldResemble, intAttr(testSuperPod))
+			convey.So(attributes[attrRackID], convey.ShouldBeNil)
+			convey.So(device["capacity"].(map[string]interface{})[capacityHBM], convey.ShouldResemble,
+				capacityOf("64Gi"))
+			convey.So(device["consumesCounters"], convey.ShouldBeNil)
+		})
+		convey.Convey("02-vnpu templates, should publish the counters and split the devices", func() {
+			node.productType = api.Ascend910B
+			node.templates = templatesOf(api.Ascend910B, testAICore)
+			convey.So(len(node.templates), convey.ShouldEqual, testTemplates)
+			specs := buildSliceSpecs(testNodeName, node, testChips(testChipNum))
+			const sliceNum = 3
+			convey.So(len(specs), convey.ShouldEqual, sliceNum)
+			convey.So(len(specs[0]["sharedCounters"].([]interface{})), convey.ShouldEqual, testChipNum)
+			convey.So(specs[0]["devices"], convey.ShouldBeNil)
+			convey.So(len(specs[1]["devices"].([]interface{})), convey.ShouldEqual, maxDevicesPerSlice)
+			devices := devicesOf(specs)
+			convey.So(len(devices), convey.ShouldEqual, testChipNum*(testTemplates+1))
+			vnpu := devices[1].(map[string]interface{})
+			convey.So(vnpu["name"], convey.ShouldEqual, "npu-0-vir03-1c-8g")
+			convey.So(vnpu["consumesCounters"], convey.ShouldResemble, consumesCounters(0, 3))
+		})
+		convey.Convey("03-soft share, should publish the share devices with the consumable capacity", func() {
+			node.softShare = true
+			node.templates = []string{common.Vir02}
+			specs := buildSliceSpecs(testNodeName, node, testChips(1))
+			convey.So(len(specs), convey.ShouldEqual, 1)
+			device := devicesOf(specs)[0].(map[string]interface{})
+			convey.So(device["name"], convey.ShouldEqual, "npu-0-share")
+			convey.So(device["allowMultipleAllocations"], convey.ShouldBeTrue)
+			quota := device["capacity"].(map[string]interface{})[capacityAICoreQuota].(map[string]interface{})
+			convey.So(quota["value"], convey.ShouldEqual, "100")
+		})
+	})
+}
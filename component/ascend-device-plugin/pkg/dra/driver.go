@@ -0,0 +1,330 @@
+/* Copyright(C) 2026. Huawei Technologies Co.,Ltd. All rights reserved.
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+// Package dra is the kubernetes dynamic resource allocation driver of the npus.
+// The driver publishes the chips found by the device manager as the ResourceSlices of resource.k8s.io/v1 and
+// serves the dra kubelet plugin api to prepare the allocated devices by the cdi specs. The vnpu templates are
+// published as the partitions of the chips by the shared counters, which needs the DRAPartitionableDevices
+// feature gate, and the soft share devices are published with the consumable capacity, which needs the
+// DRAConsumableCapacity feature gate.
+package dra
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/tools/clientcmd"
+	registerapi "k8s.io/kubelet/pkg/apis/pluginregistration/v1"
+
+	"Ascend-device-plugin/pkg/common"
+	"Ascend-device-plugin/pkg/device"
+	"Ascend-device-plugin/pkg/dra/v1beta1"
+	"Ascend-device-plugin/pkg/server"
+	"ascend-common/common-utils/hwlog"
+)
+
+// npuSource is the device discovery of the chips published by the driver
+type npuSource interface {
+	GetPhysicalNPUs() []common.NpuDevice
+	GetSuperPodID() int32
+	GetRackID() int32
+	GetDevManager() device.DevManager
+}
+
+// Driver is the dra driver of the npus on the node
+type Driver struct {
+	v1beta1.UnimplementedDRAPluginServer
+	source    npuSource
+	client    dynamic.Interface
+	nodeName  string
+	publisher *slicePublisher
+	cdiDir    string
+	pluginDir string
+	// lock guards the prepared claims, kubelet may prepare and unprepare the claims concurrently
+	lock     sync.Mutex
+	prepared map[string]*preparedClaim
+	// hbmCache is the hbm size in MB of the chips by the physical ids, it does not change at runtime
+	hbmCache map[int32]int64
+}
+
+func newDriver(source npuSource, client dynamic.Interface, nodeName string, owner *metav1.OwnerReference) *Driver {
+	return &Driver{
+		source:    source,
+		client:    client,
+		nodeName:  nodeName,
+		publisher: newSlicePublisher(client, nodeName, owner),
+		cdiDir:    cdiDir,
+		pluginDir: pluginDir,
+		prepared:  make(map[string]*preparedClaim),
+		hbmCache:  make(map[int32]int64),
+	}
+}
+
+// Start start the dra driver with the devices of the device manager, it returns when the context is done
+func Start(ctx context.Context, hdm *server.HwDevManager) {
+	if hdm == nil || hdm.GetDevManager() == nil || hdm.GetDevManager().GetKubeClient() == nil {
+		hwlog.RunLog.Error("kube client is nil, can't start the dra driver")
+		return
+	}
+	kubeClient := hdm.GetDevManager().GetKubeClient()
+	clientCfg, err := clientcmd.BuildConfigFromFlags("", "")
+	if err != nil {
+		hwlog.RunLog.Errorf("build client config err: %v", err)
+		return
+	}
+	client, err := dynamic.NewForConfig(clientCfg)
+	if err != nil {
+		hwlog.RunLog.Errorf("get dynamic client err: %v", err)
+		return
+	}
+	var owner *metav1.OwnerReference
+	if node, err := kubeClient.GetNode(); err != nil {
+		hwlog.RunLog.Warnf("get node %s failed, the resource slices have no owner, err: %v", kubeClient.NodeName, err)
+	} else {
+		owner = &metav1.OwnerReference{APIVersion: "v1", Kind: "Node", Name: node.Name, UID: node.UID}
+	}
+	driver := newDriver(hdm, client, kubeClient.NodeName, owner)
+	if err = driver.loadCheckpoint(); err != nil {
+		hwlog.RunLog.Warnf("load checkpoint of the prepared claims failed, err: %v", err)
+	}
+	stop, err := driver.serve()
+	if err != nil {
+		hwlog.RunLog.Errorf("start dra kubelet plugin failed, err: %v", err)
+		return
+	}
+	defer stop()
+	hwlog.RunLog.Infof("dra driver %s started on node %s", DriverName, driver.nodeName)
+	driver.publishLoop(ctx)
+}
+
+func (d *Driver) publishLoop(ctx context.Context) {
+	ticker := time.NewTicker(publishPeriod)
+	defer ticker.Stop()
+	for {
+		if err := d.publish(ctx); err != nil {
+			hwlog.RunLog.Errorf("publish resource slices failed, err: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			hwlog.RunLog.Info("stop the dra driver")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (d *Driver) publish(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, apiTimeout)
+	defer cancel()
+	node, chips := d.snapshot()
+	return d.publisher.publish(ctx, buildSliceSpecs(d.nodeName, node, chips))
+}
+
+// snapshot return the node information and the chips to publish, the unhealthy chips are published with the
+// Unhealthy health so the allocated claims still see them
+func (d *Driver) snapshot() (nodeInfo, []chipInfo) {
+	node := nodeInfo{
+		productType: common.ParamOption.RealCardType,
+		superPodID:  d.source.GetSuperPodID(),
+		rackID:      d.source.GetRackID(),
+		aiCore:      int64(d.source.GetDevManager().GetChipAICore()),
+		softShare:   common.IsSupportSoftShareDevice(),
+	}
+	if !common.ParamOption.PresetVDevice {
+		node.templates = templatesOf(node.productType, node.aiCore)
+	}
+	npus := d.source.GetPhysicalNPUs()
+	chips := make([]chipInfo, 0, len(npus))
+	for _, npu := range npus {
+		chips = append(chips, chipInfo{phyID: npu.PhyID, cardID: npu.CardID, hbmMB: d.hbmOf(npu),
+			health: healthOf(npu)})
+	}
+	return node, chips
+}
+
+func (d *Driver) hbmOf(npu common.NpuDevice) int64 {
+	if !common.HasOnChipMemory() {
+		return 0
+	}
+	if hbm, ok := d.hbmCache[npu.PhyID]; ok {
+		return hbm
+	}
+	hbmInfo, err := d.source.GetDevManager().GetDmgr().GetDeviceHbmInfo(npu.LogicID)
+	if err != nil || hbmInfo == nil {
+		hwlog.RunLog.Warnf("get hbm info of chip %d failed, err: %v", npu.PhyID, err)
+		return 0
+	}
+	d.hbmCache[npu.PhyID] = int64(hbmInfo.MemorySize)
+	return d.hbmCache[npu.PhyID]
+}
+
+// isUnhealthy return whether the chip is unhealthy now, the claims allocated before it turned unhealthy are not
+// prepared
+func (d *Driver) isUnhealthy(phyID int32) bool {
+	for _, npu := range d.source.GetPhysicalNPUs() {
+		if npu.PhyID == phyID {
+			return healthOf(npu) == healthUnhealthy
+		}
+	}
+	return false
+}
+
+// NodePrepareResources prepare the devices allocated to the claims and return the cdi devices
+func (d *Driver) NodePrepareResources(ctx context.Context,
+	req *v1beta1.NodePrepareResourcesRequest) (*v1beta1.NodePrepareResourcesResponse, error) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	resp := &v1beta1.NodePrepareResourcesResponse{
+		Claims: make(map[string]*v1beta1.NodePrepareResourceResponse, len(req.GetClaims())),
+	}
+	for _, claim := range req.GetClaims() {
+		devices, err := d.prepareClaim(ctx, claim)
+		if err != nil {
+			hwlog.RunLog.Errorf("prepare resource claim %s/%s failed, err: %v", claim.Namespace, claim.Name, err)
+			resp.Claims[claim.Uid] = &v1beta1.NodePrepareResourceResponse{Error: err.Error()}
+			continue
+		}
+		resp.Claims[claim.Uid] = &v1beta1.NodePrepareResourceResponse{Devices: devices}
+	}
+	return resp, nil
+}
+
+// NodeUnprepareResources release the devices of the claims, the claims not prepared are ignored
+func (d *Driver) NodeUnprepareResources(ctx context.Context,
+	req *v1beta1.NodeUnprepareResourcesRequest) (*v1beta1.NodeUnprepareResourcesResponse, error) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	resp := &v1beta1.NodeUnprepareResourcesResponse{
+		Claims: make(map[string]*v1beta1.NodeUnprepareResourceResponse, len(req.GetClaims())),
+	}
+	for _, claim := range req.GetClaims() {
+		resp.Claims[claim.Uid] = &v1beta1.NodeUnprepareResourceResponse{}
+		prepared, ok := d.prepared[claim.Uid]
+		if !ok {
+			continue
+		}
+		if err := d.releaseClaim(claim.Uid, prepared); err != nil {
+			hwlog.RunLog.Errorf("unprepare resource claim %s/%s failed, err: %v", claim.Namespace, claim.Name, err)
+			resp.Claims[claim.Uid].Error = err.Error()
+			continue
+		}
+		delete(d.prepared, claim.Uid)
+		hwlog.RunLog.Infof("unprepare resource claim %s/%s", claim.Namespace, claim.Name)
+	}
+	if err := d.saveCheckpoint(); err != nil {
+		hwlog.RunLog.Errorf("save checkpoint of the prepared claims failed, err: %v", err)
+	}
+	return resp, nil
+}
+
+func (d *Driver) loadCheckpoint() error {
+	data, err := os.ReadFile(filepath.Join(d.pluginDir, checkpointName))
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, &d.prepared)
+}
+
+func (d *Driver) saveCheckpoint() error {
+	data, err := json.Marshal(d.prepared)
+	if err != nil {
+		return fmt.Errorf("marshal checkpoint failed, err: %v", err)
+	}
+	return common.WriteToFileWithPerm(string(data), filepath.Join(d.pluginDir, checkpointName), dirPerm,
+		checkpointPerm)
+}
+
+// registrationServer is the registration service of the plugin watched by kubelet
+type registrationServer struct {
+	endpoint string
+}
+
+// GetInfo return the information of the dra kubelet plugin
+func (r *registrationServer) GetInfo(_ context.Context, _ *registerapi.InfoRequest) (*registerapi.PluginInfo, error) {
+	return &registerapi.PluginInfo{Type: draPluginType, Name: DriverName, Endpoint: r.endpoint,
+		SupportedVersions: []string{draPluginVersion}}, nil
+}
+
+// NotifyRegistrationStatus receive the result of the registration from kubelet
+func (r *registrationServer) NotifyRegistrationStatus(_ context.Context,
+	status *registerapi.RegistrationStatus) (*registerapi.RegistrationStatusResponse, error) {
+	if !status.PluginRegistered {
+		hwlog.RunLog.Errorf("register dra driver %s to kubelet failed, err: %s", DriverName, status.Error)
+	} else {
+		hwlog.RunLog.Infof("register dra driver %s to kubelet success", DriverName)
+	}
+	return &registerapi.RegistrationStatusResponse{}, nil
+}
+
+// serve start the dra service and then the registration service, kubelet finds the plugin by the registration
+// socket and connects to the dra socket
+func (d *Driver) serve() (func(), error) {
+	draSocket := filepath.Join(d.pluginDir, draSocketName)
+	draListener, err := listenUnix(draSocket)
+	if err != nil {
+		return nil, err
+	}
+	draServer := grpc.NewServer(grpc.MaxRecvMsgSize(common.MaxGRPCRecvMsgSize))
+	v1beta1.RegisterDRAPluginServer(draServer, d)
+	go serveGRPC(draServer, draListener, draSocketName)
+
+	regListener, err := listenUnix(filepath.Join(pluginRegistryDir, registrationSocketName))
+	if err != nil {
+		draServer.Stop()
+		return nil, err
+	}
+	regServer := grpc.NewServer()
+	registerapi.RegisterRegistrationServer(regServer, &registrationServer{endpoint: draSocket})
+	go serveGRPC(regServer, regListener, registrationSocketName)
+	return func() {
+		regServer.Stop()
+		draServer.Stop()
+	}, nil
+}
+
+func serveGRPC(grpcServer *grpc.Server, listener net.Listener, name string) {
+	if err := grpcServer.Serve(listener); err != nil {
+		hwlog.RunLog.Errorf("GRPC server for '%s' crashed with error: %v", name, err)
+	}
+}
+
+// need privilege
+func listenUnix(socketPath string) (net.Listener, error) {
+	if err := os.MkdirAll(filepath.Dir(socketPath), dirPerm); err != nil {
+		return nil, fmt.Errorf("create directory of %s failed, err: %v", socketPath, err)
+	}
+	if err := os.Remove(socketPath); err != nil && !os.IsNotExist(err) {
+		return nil, fmt.Errorf("remove sock file %s failed, err: %v", socketPath, err)
+	}
+	listener, err := net.Listen("unix", socketPath)
+	if err != nil {
+		return nil, fmt.Errorf("listen on %s failed, err: %v", socketPath, err)
+	}
+	if err = os.Chmod(socketPath, common.SocketChmod); err != nil {
+		return nil, fmt.Errorf("change file %s mode failed, err: %v", filepath.Base(socketPath), err)
+	}
+	return listener, nil
+}
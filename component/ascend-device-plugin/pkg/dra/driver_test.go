@@ -0,0 +1,263 @@
+/* Copyright(C) 2026. Huawei Technologies Co.,Ltd. All rights reserved.
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+// Package dra is the kubernetes dynamic resource allocation driver of the npus
+package dra
+
+import (
+	"context"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/agiledragon/gomonkey/v2"
+	"github.com/smartystreets/goconvey/convey"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic/fake"
+	kubeletapi "k8s.io/kubelet/pkg/apis/deviceplugin/v1beta1"
+
+	"Ascend-device-plugin/pkg/common"
+	"Ascend-device-plugin/pkg/device"
+	"Ascend-device-plugin/pkg/dra/v1beta1"
+	"ascend-common/api"
+	"ascend-common/common-utils/hwlog"
+)
+
+const (
+	testNamespace  = "default"
+	testClaimName  = "claim1"
+	testClaimUID   = "uid1"
+	testGeneration = 4
+	testVNPU       = "Ascend310P-2c-100-1"
+)
+
+func init() {
+	hwLogConfig := hwlog.LogConfig{
+		OnlyToStdout: true,
+	}
+	hwlog.InitRunLogger(&hwLogConfig, context.Background())
+}
+
+type fakeSource struct {
+	npus    []common.NpuDevice
+	manager device.DevManager
+}
+
+func (s *fakeSource) GetPhysicalNPUs() []common.NpuDevice {
+	return s.npus
+}
+
+func (s *fakeSource) GetSuperPodID() int32 {
+	return -1
+}
+
+func (s *fakeSource) GetRackID() int32 {
+	return -1
+}
+
+func (s *fakeSource) GetDevManager() device.DevManager {
+	return s.manager
+}
+
+func testObject(kind, name string, spec map[string]interface{}) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": resourceGroup + "/" + resourceVersion,
+		"kind":       kind,
+		"metadata":   map[string]interface{}{"name": name},
+		"spec":       spec,
+	}}
+}
+
+func testClaim(devices ...string) *unstructured.Unstructured {
+	claim := testObject("ResourceClaim", testClaimName, map[string]interface{}{})
+	claim.SetNamespace(testNamespace)
+	claim.SetUID(testClaimUID)
+	results := []interface{}{map[string]interface{}{"request": "npu", "driver": "gpu.example.com",
+		"pool": testNodeName, "device": "gpu-0"}}
+	for _, dev := range devices {
+		results = append(results, map[string]interface{}{"request": "npu", "driver": DriverName,
+			"pool": testNodeName, "device": dev})
+	}
+	claim.Object["status"] = map[string]interface{}{"allocation": map[string]interface{}{
+		"devices": map[string]interface{}{"results": results}}}
+	return claim
+}
+
+func newTestDriver(t *testing.T, objects ...runtime.Object) (*Driver, *fake.FakeDynamicClient) {
+	client := fake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+		map[schema.GroupVersionResource]string{
+			resourceSliceGVR: "ResourceSliceList",
+			resourceClaimGVR: "ResourceClaimList",
+		}, objects...)
+	source := &fakeSource{manager: device.NewHwAscend310PManager()}
+	for phyID := int32(0); phyID < 2; phyID++ {
+		source.npus = append(source.npus, common.NpuDevice{PhyID: phyID, LogicID: phyID,
+			Health: kubeletapi.Healthy})
+	}
+	driver := newDriver(source, client, testNodeName, nil)
+	driver.cdiDir, driver.pluginDir = t.TempDir(), t.TempDir()
+	return driver, client
+}
+
+func sliceGenerations(client *fake.FakeDynamicClient) map[string]int64 {
+	list, err := client.Resource(resourceSliceGVR).List(context.Background(), metav1.ListOptions{})
+	convey.So(err, convey.ShouldBeNil)
+	generations := make(map[string]int64, len(list.Items))
+	for _, item := range list.Items {
+		generations[item.GetName()], _, _ = unstructured.NestedInt64(item.Object, "spec", "pool", "generation")
+	}
+	return generations
+}
+
+func publishedHealth(client *fake.FakeDynamicClient) map[string]string {
+	list, err := client.Resource(resourceSliceGVR).List(context.Background(), metav1.ListOptions{})
+	convey.So(err, convey.ShouldBeNil)
+	healths := make(map[string]string)
+	for _, item := range list.Items {
+		devices, _, _ := unstructured.NestedSlice(item.Object, "spec", "devices")
+		for _, dev := range devices {
+			fields, ok := dev.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			name, _, _ := unstructured.NestedString(fields, "name")
+			healths[name], _, _ = unstructured.NestedString(fields, "attributes", attrHealth, "string")
+		}
+	}
+	return healths
+}
+
+func prepareRequest() *v1beta1.NodePrepareResourcesRequest {
+	return &v1beta1.NodePrepareResourcesRequest{Claims: []*v1beta1.Claim{
+		{Namespace: testNamespace, Name: testClaimName, Uid: testClaimUID}}}
+}
+
+// TestDriverPublish test publish the resource slices of the node
+func TestDriverPublish(t *testing.T) {
+	convey.Convey("Test Driver publish", t, func() {
+		patches := gomonkey.ApplyGlobalVar(&common.ParamOption, common.Option{RealCardType: api.Ascend310P,
+			PresetVDevice: true, AiCoreCount: testAICore}).
+			ApplyFuncReturn(common.GetFaultType, common.NormalNPU).
+			ApplyFuncReturn(common.GetNetworkFaultType, common.NormalNetwork)
+		defer patches.Reset()
+		stale := testObject("ResourceSlice", "node1-npu.huawei.com-5", map[string]interface{}{
+			"driver": DriverName, "nodeName": testNodeName,
+			"pool": map[string]interface{}{"name": testNodeName, "generation": int64(testGeneration)}})
+		driver, client := newTestDriver(t, stale)
+		ctx := context.Background()
+
+		convey.So(driver.publish(ctx), convey.ShouldBeNil)
+		convey.So(sliceGenerations(client), convey.ShouldResemble,
+			map[string]int64{"node1-npu.huawei.com-0": testGeneration + 1})
+
+		convey.So(driver.publish(ctx), convey.ShouldBeNil)
+		convey.So(driver.publisher.generation, convey.ShouldEqual, testGeneration+1)
+
+		driver.source.(*fakeSource).npus[0].Health = kubeletapi.Unhealthy
+		convey.So(driver.publish(ctx), convey.ShouldBeNil)
+		convey.So(sliceGenerations(client), convey.ShouldResemble,
+			map[string]int64{"node1-npu.huawei.com-0": testGeneration + 2})
+		healths := publishedHealth(client)
+		convey.So(healths[chipDeviceName(0)], convey.ShouldEqual, healthUnhealthy)
+		convey.So(healths[chipDeviceName(1)], convey.ShouldEqual, healthHealthy)
+	})
+}
+
+// TestNodePrepareResources test prepare and unprepare the claims
+func TestNodePrepareResources(t *testing.T) {
+	convey.Convey("Test NodePrepareResources", t, func() {
+		patches := gomonkey.ApplyFuncReturn(common.GetDefaultDevices, []string{common.HiAIManagerDevice}, nil)
+		defer patches.Reset()
+		ctx := context.Background()
+		convey.Convey("01-chips of the claim, should write the cdi spec with the visible devices", func() {
+			driver, _ := newTestDriver(t, testClaim("npu-0", "npu-1"))
+			resp, err := driver.NodePrepareResources(ctx, prepareRequest())
+			convey.So(err, convey.ShouldBeNil)
+			claim := resp.Claims[testClaimUID]
+			convey.So(claim.Error, convey.ShouldBeEmpty)
+			convey.So(len(claim.Devices), convey.ShouldEqual, 2)
+			convey.So(claim.Devices[1].CdiDeviceIDs, convey.ShouldResemble, []string{"huawei.com/npu=uid1-npu-1"})
+			convey.So(claim.Devices[1].PoolName, convey.ShouldEqual, testNodeName)
+			data, err := os.ReadFile(cdiSpecPath(driver.cdiDir, testClaimUID))
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(strings.Contains(string(data), common.AscendVisibleDevicesEnv+"=0,1"), convey.ShouldBeTrue)
+
+			restarted, _ := newTestDriver(t)
+			restarted.pluginDir, restarted.cdiDir = driver.pluginDir, driver.cdiDir
+			convey.So(restarted.loadCheckpoint(), convey.ShouldBeNil)
+			unprepared, err := restarted.NodeUnprepareResources(ctx, &v1beta1.NodeUnprepareResourcesRequest{
+				Claims: prepareRequest().Claims})
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(unprepared.Claims[testClaimUID].Error, convey.ShouldBeEmpty)
+			_, err = os.Stat(cdiSpecPath(driver.cdiDir, testClaimUID))
+			convey.So(os.IsNotExist(err), convey.ShouldBeTrue)
+		})
+		convey.Convey("02-vnpu template, should create and destroy the vnpu", func() {
+			destroyed := ""
+			patches.ApplyMethodReturn(&device.AscendTools{}, "CreateVirtualDevice", testVNPU, nil).
+				ApplyMethodFunc(&device.AscendTools{}, "DestroyVirtualDevice", func(name string) error {
+					destroyed = name
+					return nil
+				})
+			driver, _ := newTestDriver(t, testClaim(vnpuDeviceName(1, common.Vir02)))
+			resp, err := driver.NodePrepareResources(ctx, prepareRequest())
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(resp.Claims[testClaimUID].Error, convey.ShouldBeEmpty)
+			data, err := os.ReadFile(cdiSpecPath(driver.cdiDir, testClaimUID))
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(strings.Contains(string(data), "/dev/vdavinci100"), convey.ShouldBeTrue)
+			convey.So(strings.Contains(string(data), common.VirtualDev), convey.ShouldBeTrue)
+			_, err = driver.NodeUnprepareResources(ctx, &v1beta1.NodeUnprepareResourcesRequest{
+				Claims: prepareRequest().Claims})
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(destroyed, convey.ShouldEqual, testVNPU)
+		})
+		convey.Convey("03-invalid claims, should return the error of the claim", func() {
+			driver, _ := newTestDriver(t, testClaim(vnpuDeviceName(1, common.Vir02), "npu-0"))
+			resp, err := driver.NodePrepareResources(ctx, prepareRequest())
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(resp.Claims[testClaimUID].Error, convey.ShouldNotBeEmpty)
+
+			claim := testClaim("npu-0")
+			claim.SetUID("uid2")
+			driver, _ = newTestDriver(t, claim)
+			resp, err = driver.NodePrepareResources(ctx, prepareRequest())
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(resp.Claims[testClaimUID].Error, convey.ShouldNotBeEmpty)
+			convey.So(len(driver.prepared), convey.ShouldEqual, 0)
+		})
+		convey.Convey("04-unhealthy chip, should return the error of the claim", func() {
+			driver, _ := newTestDriver(t, testClaim("npu-0"))
+			driver.source.(*fakeSource).npus[0].Health = kubeletapi.Unhealthy
+			resp, err := driver.NodePrepareResources(ctx, prepareRequest())
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(resp.Claims[testClaimUID].Error, convey.ShouldNotBeEmpty)
+			convey.So(len(driver.prepared), convey.ShouldEqual, 0)
+		})
+	})
+}
+
+// TestRegistrationServer test the information of the plugin registered to kubelet
+func TestRegistrationServer(t *testing.T) {
+	convey.Convey("Test registrationServer GetInfo", t, func() {
+		info, err := (&registrationServer{endpoint: "/dra.sock"}).GetInfo(context.Background(), nil)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(info.Type, convey.ShouldEqual, draPluginType)
+		convey.So(info.Name, convey.ShouldEqual, DriverName)
+		convey.So(info.SupportedVersions, convey.ShouldResemble, []string{draPluginVersion})
+	})
+}
@@ -0,0 +1,360 @@
+/* Copyright(C) 2026. Huawei Technologies Co.,Ltd. All rights reserved.
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+// Package dra is the kubernetes dynamic resource allocation driver of the npus
+package dra
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"Ascend-device-plugin/pkg/common"
+	"Ascend-device-plugin/pkg/dra/v1beta1"
+	"ascend-common/api"
+	"ascend-common/common-utils/hwlog"
+	"ascend-common/devmanager/dcmi"
+)
+
+const (
+	cdiDeviceNodePerm = "rw"
+	cdiReadOnlyMount  = "ro"
+)
+
+// allocationResult is a device allocated to the claim on the node
+type allocationResult struct {
+	request  string
+	device   string
+	consumed map[string]string
+}
+
+// preparedDevice is a device of the claim prepared on the node
+type preparedDevice struct {
+	Requests    []string `json:"requests"`
+	Device      string   `json:"device"`
+	CDIDeviceID string   `json:"cdiDeviceID"`
+	// VNPU is the name of the vnpu created for the template device, it is destroyed when the claim is unprepared
+	VNPU string `json:"vnpu,omitempty"`
+}
+
+// preparedClaim is a claim prepared on the node, it is saved in the checkpoint to unprepare after the restart
+type preparedClaim struct {
+	Namespace string           `json:"namespace"`
+	Name      string           `json:"name"`
+	Devices   []preparedDevice `json:"devices"`
+	// ShareConfig is whether the config of the soft share device is written for the claim
+	ShareConfig bool `json:"shareConfig,omitempty"`
+}
+
+type cdiSpec struct {
+	Version        string            `json:"cdiVersion"`
+	Kind           string            `json:"kind"`
+	Devices        []cdiDevice       `json:"devices"`
+	ContainerEdits cdiContainerEdits `json:"containerEdits,omitempty"`
+}
+
+type cdiDevice struct {
+	Name           string            `json:"name"`
+	ContainerEdits cdiContainerEdits `json:"containerEdits"`
+}
+
+type cdiContainerEdits struct {
+	Env         []string        `json:"env,omitempty"`
+	DeviceNodes []cdiDeviceNode `json:"deviceNodes,omitempty"`
+	Mounts      []cdiMount      `json:"mounts,omitempty"`
+}
+
+type cdiDeviceNode struct {
+	Path        string `json:"path"`
+	HostPath    string `json:"hostPath,omitempty"`
+	Permissions string `json:"permissions,omitempty"`
+}
+
+type cdiMount struct {
+	HostPath      string   `json:"hostPath"`
+	ContainerPath string   `json:"containerPath"`
+	Options       []string `json:"options,omitempty"`
+}
+
+func cdiKind() string {
+	return cdiVendor + "/" + cdiClass
+}
+
+func cdiSpecPath(dir, claimUID string) string {
+	return filepath.Join(dir, fmt.Sprintf("%s-%s_%s.json", cdiVendor, cdiClass, claimUID))
+}
+
+func (c *preparedClaim) toDevices(poolName string) []*v1beta1.Device {
+	devices := make([]*v1beta1.Device, 0, len(c.Devices))
+	for _, dev := range c.Devices {
+		devices = append(devices, &v1beta1.Device{RequestNames: dev.Requests, PoolName: poolName,
+			DeviceName: dev.Device, CdiDeviceIDs: []string{dev.CDIDeviceID}})
+	}
+	return devices
+}
+
+// allocationResults return the devices allocated to the claim from the pool of the node
+func (d *Driver) allocationResults(ctx context.Context, claim *v1beta1.Claim) ([]allocationResult, error) {
+	ctx, cancel := context.WithTimeout(ctx, apiTimeout)
+	defer cancel()
+	obj, err := d.client.Resource(resourceClaimGVR).Namespace(claim.Namespace).Get(ctx, claim.Name,
+		metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("get resource claim %s/%s failed, err: %v", claim.Namespace, claim.Name, err)
+	}
+	if string(obj.GetUID()) != claim.Uid {
+		return nil, fmt.Errorf("uid of resource claim %s/%s is %s, not %s", claim.Namespace, claim.Name,
+			obj.GetUID(), claim.Uid)
+	}
+	items, found, err := unstructured.NestedSlice(obj.Object, "status", "allocation", "devices", "results")
+	if err != nil || !found {
+		return nil, fmt.Errorf("resource claim %s/%s is not allocated", claim.Namespace, claim.Name)
+	}
+	results := make([]allocationResult, 0, len(items))
+	for _, item := range items {
+		fields, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		driver, _, _ := unstructured.NestedString(fields, "driver")
+		pool, _, _ := unstructured.NestedString(fields, "pool")
+		if driver != DriverName || pool != d.nodeName {
+			continue
+		}
+		result := allocationResult{}
+		result.request, _, _ = unstructured.NestedString(fields, "request")
+		result.device, _, _ = unstructured.NestedString(fields, "device")
+		result.consumed, _, _ = unstructured.NestedStringMap(fields, "consumedCapacity")
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+// prepareClaim prepare the devices of the claim and write the cdi spec. the chips of the claim are visible
+// together, while the vnpu or the soft share device must be the only device of the claim
+func (d *Driver) prepareClaim(ctx context.Context, claim *v1beta1.Claim) ([]*v1beta1.Device, error) {
+	if prepared, ok := d.prepared[claim.Uid]; ok {
+		return prepared.toDevices(d.nodeName), nil
+	}
+	results, err := d.allocationResults(ctx, claim)
+	if err != nil {
+		return nil, err
+	}
+	prepared := &preparedClaim{Namespace: claim.Namespace, Name: claim.Name}
+	spec, err := d.prepareDevices(claim, results, prepared)
+	if err == nil && len(spec.Devices) > 0 {
+		err = writeCDISpec(cdiSpecPath(d.cdiDir, claim.Uid), spec)
+	}
+	if err == nil {
+		d.prepared[claim.Uid] = prepared
+		err = d.saveCheckpoint()
+	}
+	if err != nil {
+		if releaseErr := d.releaseClaim(claim.Uid, prepared); releaseErr != nil {
+			hwlog.RunLog.Warnf("release resource claim %s/%s failed, err: %v", claim.Namespace, claim.Name,
+				releaseErr)
+		}
+		delete(d.prepared, claim.Uid)
+		return nil, err
+	}
+	hwlog.RunLog.Infof("prepare resource claim %s/%s with %d devices", claim.Namespace, claim.Name,
+		len(prepared.Devices))
+	return prepared.toDevices(d.nodeName), nil
+}
+
+func (d *Driver) prepareDevices(claim *v1beta1.Claim, results []allocationResult,
+	prepared *preparedClaim) (*cdiSpec, error) {
+	spec := &cdiSpec{Version: cdiVersion, Kind: cdiKind()}
+	visibleDevices := make([]string, 0, len(results))
+	runtimeOptions := ""
+	for _, result := range results {
+		ref, err := parseDeviceName(result.device)
+		if err != nil {
+			return nil, err
+		}
+		if d.isUnhealthy(ref.phyID) {
+			return nil, fmt.Errorf("chip of device %s is unhealthy", result.device)
+		}
+		if ref.kind != deviceTypeChip && len(results) > 1 {
+			return nil, fmt.Errorf("%s device %s must be the only device of the claim", ref.kind, result.device)
+		}
+		name := fmt.Sprintf("%s-%s", claim.Uid, result.device)
+		prepared.Devices = append(prepared.Devices, preparedDevice{Requests: []string{result.request},
+			Device: result.device, CDIDeviceID: cdiKind() + "=" + name})
+		visibleID := int(ref.phyID)
+		var edits cdiContainerEdits
+		switch ref.kind {
+		case deviceTypeVNPU:
+			visibleID, edits, err = d.prepareVNPU(ref, &prepared.Devices[len(prepared.Devices)-1])
+			runtimeOptions = common.VirtualDev
+		case deviceTypeShare:
+			prepared.ShareConfig = true
+			edits, err = d.prepareShare(claim, ref.phyID, result.consumed)
+		default:
+			edits = cdiContainerEdits{DeviceNodes: []cdiDeviceNode{davinciNode(strconv.Itoa(visibleID), "")}}
+		}
+		if err != nil {
+			return nil, err
+		}
+		spec.Devices = append(spec.Devices, cdiDevice{Name: name, ContainerEdits: edits})
+		visibleDevices = append(visibleDevices, strconv.Itoa(visibleID))
+	}
+	spec.ContainerEdits = claimEdits(visibleDevices, runtimeOptions)
+	return spec, nil
+}
+
+func davinciNode(id, runtimeOptions string) cdiDeviceNode {
+	node := cdiDeviceNode{Path: "/dev/davinci" + id, Permissions: cdiDeviceNodePerm}
+	if runtimeOptions == common.VirtualDev {
+		node.HostPath = "/dev/vdavinci" + id
+	}
+	return node
+}
+
+// claimEdits is the edits applied when any device of the claim is injected, the visible devices env is shared
+// by the devices so that the container sees all the chips of the claim
+func claimEdits(visibleDevices []string, runtimeOptions string) cdiContainerEdits {
+	edits := cdiContainerEdits{Env: []string{
+		fmt.Sprintf("%s=%s", common.AscendVisibleDevicesEnv, strings.Join(visibleDevices, common.CommaSepDev)),
+		fmt.Sprintf("%s=%s", api.AscendRuntimeOptionsEnv, runtimeOptions),
+	}}
+	defaultDevices, err := common.GetDefaultDevices(common.ParamOption.GetFdFlag)
+	if err != nil {
+		hwlog.RunLog.Warnf("get default devices failed, err: %v", err)
+	}
+	for _, hostPath := range defaultDevices {
+		containerPath := hostPath
+		if hostPath == common.HiAIManagerDeviceDocker {
+			containerPath = common.HiAIManagerDevice
+		}
+		edits.DeviceNodes = append(edits.DeviceNodes, cdiDeviceNode{Path: containerPath, HostPath: hostPath,
+			Permissions: cdiDeviceNodePerm})
+	}
+	return edits
+}
+
+// prepareVNPU create the vnpu by the template and return the id of the vnpu
+func (d *Driver) prepareVNPU(ref deviceRef, prepared *preparedDevice) (int, cdiContainerEdits, error) {
+	vnpu, err := d.source.GetDevManager().CreateVirtualDevice(ref.phyID, ref.template)
+	if err != nil {
+		return 0, cdiContainerEdits{}, fmt.Errorf("create %s vnpu on chip %d failed, err: %v", ref.template,
+			ref.phyID, err)
+	}
+	prepared.VNPU = vnpu
+	_, ids, err := common.GetDeviceListID([]string{vnpu}, common.VirtualDev)
+	if err != nil || len(ids) != 1 {
+		return 0, cdiContainerEdits{}, fmt.Errorf("get id of vnpu %s failed, err: %v", vnpu, err)
+	}
+	edits := cdiContainerEdits{DeviceNodes: []cdiDeviceNode{davinciNode(strconv.Itoa(ids[0]), common.VirtualDev)}}
+	return ids[0], edits, nil
+}
+
+// prepareShare write the config of the soft share device with the quota consumed by the claim
+func (d *Driver) prepareShare(claim *v1beta1.Claim, phyID int32, consumed map[string]string) (cdiContainerEdits,
+	error) {
+	if !common.IsSupportSoftShareDevice() {
+		return cdiContainerEdits{}, fmt.Errorf("soft share device is not enabled")
+	}
+	aicoreQuota := strconv.Itoa(api.SoftShareDeviceMaxAICoreQuota)
+	if value, ok := consumed[capacityAICoreQuota]; ok {
+		aicoreQuota = value
+	}
+	hbmQuota := ""
+	if value, ok := consumed[capacityHBM]; ok {
+		hbm, err := resource.ParseQuantity(value)
+		if err != nil {
+			return cdiContainerEdits{}, fmt.Errorf("consumed hbm %s is invalid, err: %v", value, err)
+		}
+		hbmQuota = strconv.FormatInt(hbm.Value()/bytesPerMB, common.BaseDec)
+	}
+	dmgr := d.source.GetDevManager().GetDmgr()
+	logicID, err := dmgr.GetLogicIDFromPhysicID(phyID)
+	if err != nil {
+		return cdiContainerEdits{}, fmt.Errorf("get logic id of chip %d failed, err: %v", phyID, err)
+	}
+	dieID, err := dmgr.GetDieID(logicID, dcmi.VDIE)
+	if err != nil {
+		return cdiContainerEdits{}, fmt.Errorf("get die id of chip %d failed, err: %v", phyID, err)
+	}
+	maxVirtualID, err := common.GetMaxVirtualIDByPhysicalID(int(phyID))
+	if err != nil {
+		return cdiContainerEdits{}, fmt.Errorf("get max virtual id of chip %d failed, err: %v", phyID, err)
+	}
+	configDir := filepath.Join(common.SoftShareDevNPUInfoConfigParentDirPath,
+		fmt.Sprintf("%s.%s", claim.Namespace, claim.Name), fmt.Sprintf("%d_%d", phyID, maxVirtualID+1))
+	config := strings.Join([]string{
+		fmt.Sprintf("%s=%d", api.SoftShareDeviceConfigPhysicalNPUId, phyID),
+		fmt.Sprintf("%s=%d", api.SoftShareDeviceConfigVirtualNPUId, maxVirtualID+1),
+		fmt.Sprintf("%s=%s", api.SoftShareDeviceConfigAICoreQuota, aicoreQuota),
+		fmt.Sprintf("%s=%s", api.SoftShareDeviceConfigHbmQuota, hbmQuota),
+		fmt.Sprintf("%s=%s", api.SoftShareDeviceConfigShmId, dieID),
+	}, "\n")
+	if err = common.WriteToFileWithPerm(config, filepath.Join(configDir, api.SoftShareDeviceConfigFileName),
+		api.DefaultSoftShareDeviceConfigDirPerm, api.DefaultSoftShareDeviceConfigPerm); err != nil {
+		return cdiContainerEdits{}, err
+	}
+	shareMemoryFile := filepath.Join(common.ParamOption.SoftShareDevConfigDir, strconv.Itoa(int(phyID)), dieID)
+	if err = common.CreateFileIfNotExist(shareMemoryFile, common.DefaultPerm, common.DefaultPerm); err != nil {
+		return cdiContainerEdits{}, err
+	}
+	return cdiContainerEdits{
+		DeviceNodes: []cdiDeviceNode{davinciNode(strconv.Itoa(int(phyID)), "")},
+		Mounts: []cdiMount{
+			{HostPath: shareMemoryFile, ContainerPath: filepath.Join(common.SoftShareDevConfigDirContainerPath, dieID)},
+			{HostPath: configDir, ContainerPath: common.SoftShareDevNPUInfoConfigDirContainerPath,
+				Options: []string{cdiReadOnlyMount}},
+		},
+	}, nil
+}
+
+// releaseClaim destroy the vnpus, remove the soft share config and the cdi spec of the claim
+func (d *Driver) releaseClaim(claimUID string, prepared *preparedClaim) error {
+	var errs []string
+	for _, dev := range prepared.Devices {
+		if dev.VNPU == "" {
+			continue
+		}
+		if err := d.source.GetDevManager().DestroyVirtualDevice(dev.VNPU); err != nil {
+			errs = append(errs, fmt.Sprintf("destroy vnpu %s failed, err: %v", dev.VNPU, err))
+		}
+	}
+	if prepared.ShareConfig {
+		if err := common.RemoveSoftShareDeviceFileAndDir(prepared.Namespace, prepared.Name); err != nil {
+			errs = append(errs, err.Error())
+		}
+	}
+	if err := os.Remove(cdiSpecPath(d.cdiDir, claimUID)); err != nil && !os.IsNotExist(err) {
+		errs = append(errs, fmt.Sprintf("remove cdi spec failed, err: %v", err))
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("%s", strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+func writeCDISpec(path string, spec *cdiSpec) error {
+	data, err := json.Marshal(spec)
+	if err != nil {
+		return fmt.Errorf("marshal cdi spec failed, err: %v", err)
+	}
+	return common.WriteToFileWithPerm(string(data), path, dirPerm, cdiSpecPerm)
+}
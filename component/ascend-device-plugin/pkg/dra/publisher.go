@@ -0,0 +1,155 @@
+/* Copyright(C) 2026. Huawei Technologies Co.,Ltd. All rights reserved.
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+// Package dra is the kubernetes dynamic resource allocation driver of the npus
+package dra
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+
+	"ascend-common/common-utils/hwlog"
+)
+
+var (
+	resourceSliceGVR = schema.GroupVersionResource{Group: resourceGroup, Version: resourceVersion,
+		Resource: "resourceslices"}
+	resourceClaimGVR = schema.GroupVersionResource{Group: resourceGroup, Version: resourceVersion,
+		Resource: "resourceclaims"}
+)
+
+// slicePublisher publish the devices of the node as the resource slices of the pool named by the node
+type slicePublisher struct {
+	client   dynamic.Interface
+	nodeName string
+	owner    *metav1.OwnerReference
+	// generation is the generation of the pool, it is increased when the devices changed
+	generation int64
+	// published is the specs of the resource slices published last time
+	published []map[string]interface{}
+	// existing is the names of the resource slices of the node in the api server
+	existing map[string]struct{}
+}
+
+func newSlicePublisher(client dynamic.Interface, nodeName string, owner *metav1.OwnerReference) *slicePublisher {
+	return &slicePublisher{client: client, nodeName: nodeName, owner: owner}
+}
+
+func (p *slicePublisher) sliceName(index int) string {
+	return fmt.Sprintf("%s-%s-%d", p.nodeName, DriverName, index)
+}
+
+// publish create or update the resource slices when the specs changed, and delete the redundant slices
+func (p *slicePublisher) publish(ctx context.Context, specs []map[string]interface{}) error {
+	if p.existing != nil && reflect.DeepEqual(specs, p.published) {
+		return nil
+	}
+	if p.existing == nil {
+		if err := p.loadExisting(ctx); err != nil {
+			return err
+		}
+	}
+	p.generation++
+	desired := make(map[string]struct{}, len(specs))
+	for index, spec := range specs {
+		name := p.sliceName(index)
+		if err := p.apply(ctx, name, p.withPool(spec, len(specs))); err != nil {
+			return err
+		}
+		desired[name] = struct{}{}
+		p.existing[name] = struct{}{}
+	}
+	for name := range p.existing {
+		if _, ok := desired[name]; ok {
+			continue
+		}
+		err := p.client.Resource(resourceSliceGVR).Delete(ctx, name, metav1.DeleteOptions{})
+		if err != nil && !errors.IsNotFound(err) {
+			return fmt.Errorf("delete resource slice %s failed, err: %v", name, err)
+		}
+		delete(p.existing, name)
+	}
+	p.published = specs
+	hwlog.RunLog.Infof("publish %d resource slices of pool %s with generation %d", len(specs), p.nodeName,
+		p.generation)
+	return nil
+}
+
+// loadExisting load the resource slices published before the restart, the generation of the pool continues
+// from the published one so that the scheduler drops the old slices
+func (p *slicePublisher) loadExisting(ctx context.Context) error {
+	list, err := p.client.Resource(resourceSliceGVR).List(ctx, metav1.ListOptions{
+		FieldSelector: fmt.Sprintf("spec.nodeName=%s,spec.driver=%s", p.nodeName, DriverName),
+	})
+	if err != nil {
+		return fmt.Errorf("list resource slices of node %s failed, err: %v", p.nodeName, err)
+	}
+	p.existing = make(map[string]struct{}, len(list.Items))
+	for _, item := range list.Items {
+		p.existing[item.GetName()] = struct{}{}
+		generation, found, err := unstructured.NestedInt64(item.Object, "spec", "pool", "generation")
+		if err == nil && found && generation > p.generation {
+			p.generation = generation
+		}
+	}
+	return nil
+}
+
+func (p *slicePublisher) withPool(spec map[string]interface{}, sliceCount int) map[string]interface{} {
+	result := make(map[string]interface{}, len(spec)+1)
+	for key, value := range spec {
+		result[key] = value
+	}
+	result["pool"] = map[string]interface{}{
+		"name":               p.nodeName,
+		"generation":         p.generation,
+		"resourceSliceCount": int64(sliceCount),
+	}
+	return result
+}
+
+func (p *slicePublisher) apply(ctx context.Context, name string, spec map[string]interface{}) error {
+	slice := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": resourceGroup + "/" + resourceVersion,
+		"kind":       "ResourceSlice",
+		"metadata":   map[string]interface{}{"name": name},
+		"spec":       spec,
+	}}
+	if p.owner != nil {
+		slice.SetOwnerReferences([]metav1.OwnerReference{*p.owner})
+	}
+	client := p.client.Resource(resourceSliceGVR)
+	old, err := client.Get(ctx, name, metav1.GetOptions{})
+	if errors.IsNotFound(err) {
+		if _, err = client.Create(ctx, slice, metav1.CreateOptions{}); err != nil {
+			return fmt.Errorf("create resource slice %s failed, err: %v", name, err)
+		}
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("get resource slice %s failed, err: %v", name, err)
+	}
+	slice.SetResourceVersion(old.GetResourceVersion())
+	if _, err = client.Update(ctx, slice, metav1.UpdateOptions{}); err != nil {
+		return fmt.Errorf("update resource slice %s failed, err: %v", name, err)
+	}
+	return nil
+}
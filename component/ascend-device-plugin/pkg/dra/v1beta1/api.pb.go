@@ -0,0 +1,430 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: api.proto
+
+package v1beta1
+
+import (
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+type NodePrepareResourcesRequest struct {
+	Claims               []*Claim `protobuf:"bytes,1,rep,name=claims,proto3" json:"claims,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *NodePrepareResourcesRequest) Reset()         { *m = NodePrepareResourcesRequest{} }
+func (m *NodePrepareResourcesRequest) String() string { return proto.CompactTextString(m) }
+func (*NodePrepareResourcesRequest) ProtoMessage()    {}
+func (*NodePrepareResourcesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{0}
+}
+
+func (m *NodePrepareResourcesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NodePrepareResourcesRequest.Unmarshal(m, b)
+}
+func (m *NodePrepareResourcesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NodePrepareResourcesRequest.Marshal(b, m, deterministic)
+}
+func (m *NodePrepareResourcesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodePrepareResourcesRequest.Merge(m, src)
+}
+func (m *NodePrepareResourcesRequest) XXX_Size() int {
+	return xxx_messageInfo_NodePrepareResourcesRequest.Size(m)
+}
+func (m *NodePrepareResourcesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodePrepareResourcesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NodePrepareResourcesRequest proto.InternalMessageInfo
+
+func (m *NodePrepareResourcesRequest) GetClaims() []*Claim {
+	if m != nil {
+		return m.Claims
+	}
+	return nil
+}
+
+type NodePrepareResourcesResponse struct {
+	Claims               map[string]*NodePrepareResourceResponse `protobuf:"bytes,1,rep,name=claims,proto3" json:"claims,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}                                `json:"-"`
+	XXX_unrecognized     []byte                                  `json:"-"`
+	XXX_sizecache        int32                                   `json:"-"`
+}
+
+func (m *NodePrepareResourcesResponse) Reset()         { *m = NodePrepareResourcesResponse{} }
+func (m *NodePrepareResourcesResponse) String() string { return proto.CompactTextString(m) }
+func (*NodePrepareResourcesResponse) ProtoMessage()    {}
+func (*NodePrepareResourcesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{1}
+}
+
+func (m *NodePrepareResourcesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NodePrepareResourcesResponse.Unmarshal(m, b)
+}
+func (m *NodePrepareResourcesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NodePrepareResourcesResponse.Marshal(b, m, deterministic)
+}
+func (m *NodePrepareResourcesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodePrepareResourcesResponse.Merge(m, src)
+}
+func (m *NodePrepareResourcesResponse) XXX_Size() int {
+	return xxx_messageInfo_NodePrepareResourcesResponse.Size(m)
+}
+func (m *NodePrepareResourcesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodePrepareResourcesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NodePrepareResourcesResponse proto.InternalMessageInfo
+
+func (m *NodePrepareResourcesResponse) GetClaims() map[string]*NodePrepareResourceResponse {
+	if m != nil {
+		return m.Claims
+	}
+	return nil
+}
+
+type NodePrepareResourceResponse struct {
+	Devices              []*Device `protobuf:"bytes,1,rep,name=devices,proto3" json:"devices,omitempty"`
+	Error                string    `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *NodePrepareResourceResponse) Reset()         { *m = NodePrepareResourceResponse{} }
+func (m *NodePrepareResourceResponse) String() string { return proto.CompactTextString(m) }
+func (*NodePrepareResourceResponse) ProtoMessage()    {}
+func (*NodePrepareResourceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{2}
+}
+
+func (m *NodePrepareResourceResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NodePrepareResourceResponse.Unmarshal(m, b)
+}
+func (m *NodePrepareResourceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NodePrepareResourceResponse.Marshal(b, m, deterministic)
+}
+func (m *NodePrepareResourceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodePrepareResourceResponse.Merge(m, src)
+}
+func (m *NodePrepareResourceResponse) XXX_Size() int {
+	return xxx_messageInfo_NodePrepareResourceResponse.Size(m)
+}
+func (m *NodePrepareResourceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodePrepareResourceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NodePrepareResourceResponse proto.InternalMessageInfo
+
+func (m *NodePrepareResourceResponse) GetDevices() []*Device {
+	if m != nil {
+		return m.Devices
+	}
+	return nil
+}
+
+func (m *NodePrepareResourceResponse) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type Device struct {
+	RequestNames         []string `protobuf:"bytes,1,rep,name=requestNames,proto3" json:"requestNames,omitempty"`
+	PoolName             string   `protobuf:"bytes,2,opt,name=poolName,proto3" json:"poolName,omitempty"`
+	DeviceName           string   `protobuf:"bytes,3,opt,name=deviceName,proto3" json:"deviceName,omitempty"`
+	CdiDeviceIDs         []string `protobuf:"bytes,4,rep,name=cdiDeviceIDs,proto3" json:"cdiDeviceIDs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Device) Reset()         { *m = Device{} }
+func (m *Device) String() string { return proto.CompactTextString(m) }
+func (*Device) ProtoMessage()    {}
+func (*Device) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{3}
+}
+
+func (m *Device) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Device.Unmarshal(m, b)
+}
+func (m *Device) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Device.Marshal(b, m, deterministic)
+}
+func (m *Device) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Device.Merge(m, src)
+}
+func (m *Device) XXX_Size() int {
+	return xxx_messageInfo_Device.Size(m)
+}
+func (m *Device) XXX_DiscardUnknown() {
+	xxx_messageInfo_Device.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Device proto.InternalMessageInfo
+
+func (m *Device) GetRequestNames() []string {
+	if m != nil {
+		return m.RequestNames
+	}
+	return nil
+}
+
+func (m *Device) GetPoolName() string {
+	if m != nil {
+		return m.PoolName
+	}
+	return ""
+}
+
+func (m *Device) GetDeviceName() string {
+	if m != nil {
+		return m.DeviceName
+	}
+	return ""
+}
+
+func (m *Device) GetCdiDeviceIDs() []string {
+	if m != nil {
+		return m.CdiDeviceIDs
+	}
+	return nil
+}
+
+type NodeUnprepareResourcesRequest struct {
+	Claims               []*Claim `protobuf:"bytes,1,rep,name=claims,proto3" json:"claims,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *NodeUnprepareResourcesRequest) Reset()         { *m = NodeUnprepareResourcesRequest{} }
+func (m *NodeUnprepareResourcesRequest) String() string { return proto.CompactTextString(m) }
+func (*NodeUnprepareResourcesRequest) ProtoMessage()    {}
+func (*NodeUnprepareResourcesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{4}
+}
+
+func (m *NodeUnprepareResourcesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NodeUnprepareResourcesRequest.Unmarshal(m, b)
+}
+func (m *NodeUnprepareResourcesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NodeUnprepareResourcesRequest.Marshal(b, m, deterministic)
+}
+func (m *NodeUnprepareResourcesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodeUnprepareResourcesRequest.Merge(m, src)
+}
+func (m *NodeUnprepareResourcesRequest) XXX_Size() int {
+	return xxx_messageInfo_NodeUnprepareResourcesRequest.Size(m)
+}
+func (m *NodeUnprepareResourcesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodeUnprepareResourcesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NodeUnprepareResourcesRequest proto.InternalMessageInfo
+
+func (m *NodeUnprepareResourcesRequest) GetClaims() []*Claim {
+	if m != nil {
+		return m.Claims
+	}
+	return nil
+}
+
+type NodeUnprepareResourcesResponse struct {
+	Claims               map[string]*NodeUnprepareResourceResponse `protobuf:"bytes,1,rep,name=claims,proto3" json:"claims,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}                                  `json:"-"`
+	XXX_unrecognized     []byte                                    `json:"-"`
+	XXX_sizecache        int32                                     `json:"-"`
+}
+
+func (m *NodeUnprepareResourcesResponse) Reset()         { *m = NodeUnprepareResourcesResponse{} }
+func (m *NodeUnprepareResourcesResponse) String() string { return proto.CompactTextString(m) }
+func (*NodeUnprepareResourcesResponse) ProtoMessage()    {}
+func (*NodeUnprepareResourcesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{5}
+}
+
+func (m *NodeUnprepareResourcesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NodeUnprepareResourcesResponse.Unmarshal(m, b)
+}
+func (m *NodeUnprepareResourcesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NodeUnprepareResourcesResponse.Marshal(b, m, deterministic)
+}
+func (m *NodeUnprepareResourcesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodeUnprepareResourcesResponse.Merge(m, src)
+}
+func (m *NodeUnprepareResourcesResponse) XXX_Size() int {
+	return xxx_messageInfo_NodeUnprepareResourcesResponse.Size(m)
+}
+func (m *NodeUnprepareResourcesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodeUnprepareResourcesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NodeUnprepareResourcesResponse proto.InternalMessageInfo
+
+func (m *NodeUnprepareResourcesResponse) GetClaims() map[string]*NodeUnprepareResourceResponse {
+	if m != nil {
+		return m.Claims
+	}
+	return nil
+}
+
+type NodeUnprepareResourceResponse struct {
+	Error                string   `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *NodeUnprepareResourceResponse) Reset()         { *m = NodeUnprepareResourceResponse{} }
+func (m *NodeUnprepareResourceResponse) String() string { return proto.CompactTextString(m) }
+func (*NodeUnprepareResourceResponse) ProtoMessage()    {}
+func (*NodeUnprepareResourceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{6}
+}
+
+func (m *NodeUnprepareResourceResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NodeUnprepareResourceResponse.Unmarshal(m, b)
+}
+func (m *NodeUnprepareResourceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NodeUnprepareResourceResponse.Marshal(b, m, deterministic)
+}
+func (m *NodeUnprepareResourceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodeUnprepareResourceResponse.Merge(m, src)
+}
+func (m *NodeUnprepareResourceResponse) XXX_Size() int {
+	return xxx_messageInfo_NodeUnprepareResourceResponse.Size(m)
+}
+func (m *NodeUnprepareResourceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodeUnprepareResourceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NodeUnprepareResourceResponse proto.InternalMessageInfo
+
+func (m *NodeUnprepareResourceResponse) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type Claim struct {
+	Namespace            string   `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Uid                  string   `protobuf:"bytes,2,opt,name=uid,proto3" json:"uid,omitempty"`
+	Name                 string   `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Claim) Reset()         { *m = Claim{} }
+func (m *Claim) String() string { return proto.CompactTextString(m) }
+func (*Claim) ProtoMessage()    {}
+func (*Claim) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{7}
+}
+
+func (m *Claim) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Claim.Unmarshal(m, b)
+}
+func (m *Claim) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Claim.Marshal(b, m, deterministic)
+}
+func (m *Claim) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Claim.Merge(m, src)
+}
+func (m *Claim) XXX_Size() int {
+	return xxx_messageInfo_Claim.Size(m)
+}
+func (m *Claim) XXX_DiscardUnknown() {
+	xxx_messageInfo_Claim.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Claim proto.InternalMessageInfo
+
+func (m *Claim) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *Claim) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+func (m *Claim) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*NodePrepareResourcesRequest)(nil), "v1beta1.NodePrepareResourcesRequest")
+	proto.RegisterType((*NodePrepareResourcesResponse)(nil), "v1beta1.NodePrepareResourcesResponse")
+	proto.RegisterMapType((map[string]*NodePrepareResourceResponse)(nil), "v1beta1.NodePrepareResourcesResponse.ClaimsEntry")
+	proto.RegisterType((*NodePrepareResourceResponse)(nil), "v1beta1.NodePrepareResourceResponse")
+	proto.RegisterType((*Device)(nil), "v1beta1.Device")
+	proto.RegisterType((*NodeUnprepareResourcesRequest)(nil), "v1beta1.NodeUnprepareResourcesRequest")
+	proto.RegisterType((*NodeUnprepareResourcesResponse)(nil), "v1beta1.NodeUnprepareResourcesResponse")
+	proto.RegisterMapType((map[string]*NodeUnprepareResourceResponse)(nil), "v1beta1.NodeUnprepareResourcesResponse.ClaimsEntry")
+	proto.RegisterType((*NodeUnprepareResourceResponse)(nil), "v1beta1.NodeUnprepareResourceResponse")
+	proto.RegisterType((*Claim)(nil), "v1beta1.Claim")
+}
+
+func init() {
+	proto.RegisterFile("api.proto", fileDescriptor_00212fb1f9d3bf1c)
+}
+
+var fileDescriptor_00212fb1f9d3bf1c = []byte{
+	// 444 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0xdd, 0x6a, 0x13, 0x41,
+	0x14, 0xee, 0x34, 0x4d, 0xea, 0x9e, 0x88, 0xca, 0xa1, 0x48, 0x48, 0x7f, 0x08, 0x43, 0x8d, 0xf1,
+	0x26, 0x90, 0x14, 0x41, 0xaa, 0x37, 0x6a, 0x8a, 0x94, 0x42, 0x29, 0x03, 0xde, 0x78, 0xa1, 0x4c,
+	0x37, 0x07, 0x59, 0x9a, 0xee, 0xac, 0x33, 0xd9, 0x40, 0xdf, 0xc1, 0x27, 0xf3, 0xca, 0x37, 0xf0,
+	0x55, 0x64, 0x7e, 0x92, 0x6c, 0xca, 0x76, 0x13, 0xe8, 0xdd, 0xcc, 0x77, 0xce, 0xf9, 0xbe, 0xb3,
+	0xf3, 0x9d, 0xb3, 0x10, 0xc9, 0x2c, 0xe9, 0x67, 0x5a, 0x4d, 0x15, 0xee, 0xce, 0x06, 0xd7, 0x34,
+	0x95, 0x03, 0x7e, 0x06, 0xfb, 0x97, 0x6a, 0x4c, 0x57, 0x9a, 0x32, 0xa9, 0x49, 0x90, 0x51, 0xb9,
+	0x8e, 0xc9, 0x08, 0xfa, 0x95, 0x93, 0x99, 0x62, 0x17, 0x1a, 0xf1, 0x44, 0x26, 0xb7, 0xa6, 0xc5,
+	0x3a, 0xb5, 0x5e, 0x73, 0xf8, 0xac, 0x1f, 0x0a, 0xfb, 0x9f, 0x2d, 0x2c, 0x42, 0x94, 0xff, 0x61,
+	0x70, 0x50, 0xce, 0x63, 0x32, 0x95, 0x1a, 0xc2, 0xf3, 0x7b, 0x44, 0x83, 0x05, 0x51, 0x55, 0x99,
+	0x57, 0x31, 0x67, 0xe9, 0x54, 0xdf, 0xcd, 0xb5, 0xda, 0x3f, 0xa0, 0x59, 0x80, 0xf1, 0x05, 0xd4,
+	0x6e, 0xe8, 0xae, 0xc5, 0x3a, 0xac, 0x17, 0x09, 0x7b, 0xc4, 0x53, 0xa8, 0xcf, 0xe4, 0x24, 0xa7,
+	0xd6, 0x76, 0x87, 0xf5, 0x9a, 0xc3, 0xe3, 0x2a, 0xa9, 0xb9, 0x92, 0xf0, 0x25, 0xa7, 0xdb, 0xef,
+	0x18, 0xff, 0x0e, 0xfb, 0x15, 0x99, 0xf8, 0x06, 0x76, 0xc7, 0x34, 0x4b, 0x62, 0x9a, 0x7f, 0xcb,
+	0xf3, 0x85, 0xc0, 0xc8, 0xe1, 0x62, 0x1e, 0xc7, 0x3d, 0xa8, 0x93, 0xd6, 0x4a, 0xbb, 0x4e, 0x22,
+	0xe1, 0x2f, 0xfc, 0x37, 0x83, 0x86, 0xcf, 0x44, 0x0e, 0x4f, 0xb5, 0x7f, 0xea, 0x4b, 0x79, 0x1b,
+	0x08, 0x23, 0xb1, 0x82, 0x61, 0x1b, 0x9e, 0x64, 0x4a, 0x4d, 0xec, 0x25, 0xf0, 0x2c, 0xee, 0x78,
+	0x04, 0xe0, 0xb5, 0x5c, 0xb4, 0xe6, 0xa2, 0x05, 0xc4, 0xf2, 0xc7, 0xe3, 0xc4, 0x8b, 0x9d, 0x8f,
+	0x4c, 0x6b, 0xc7, 0xf3, 0x17, 0x31, 0xfe, 0x05, 0x0e, 0xed, 0xe7, 0x7e, 0x4d, 0xb3, 0x47, 0x0e,
+	0xc1, 0x5f, 0x06, 0x47, 0x0f, 0x31, 0x85, 0xb7, 0xbb, 0xb8, 0x47, 0x75, 0xb2, 0xe2, 0xcd, 0xc3,
+	0x85, 0xa5, 0x83, 0x20, 0xd7, 0x0d, 0xc2, 0x87, 0xd5, 0x41, 0xe8, 0x56, 0x8b, 0x95, 0x8d, 0xc2,
+	0x5b, 0x38, 0xac, 0xcc, 0x5d, 0x3a, 0xcc, 0x8a, 0x0e, 0x5f, 0x40, 0xdd, 0x75, 0x86, 0x07, 0x10,
+	0xa5, 0xd6, 0xc4, 0x4c, 0xc6, 0x14, 0x52, 0x96, 0x80, 0xed, 0x38, 0x4f, 0xc6, 0xc1, 0x54, 0x7b,
+	0x44, 0x84, 0x9d, 0x74, 0xe9, 0xa4, 0x3b, 0x0f, 0xff, 0x31, 0x88, 0x46, 0xe2, 0xe3, 0xd5, 0x24,
+	0xff, 0x99, 0xa4, 0x48, 0xb0, 0x57, 0xb6, 0x31, 0x78, 0xbc, 0x66, 0xa1, 0x9c, 0x95, 0xed, 0x57,
+	0x1b, 0xad, 0x1d, 0xdf, 0xc2, 0x1b, 0x78, 0x59, 0xee, 0x08, 0x76, 0xd7, 0x5a, 0xe6, 0xa5, 0x5e,
+	0x6f, 0x68, 0x2d, 0xdf, 0xfa, 0xd4, 0xfc, 0x16, 0xf5, 0xdf, 0x87, 0xec, 0xeb, 0x86, 0xfb, 0x43,
+	0x9d, 0xfc, 0x1f, 0x00, 0x6a, 0xd8, 0x96, 0xd4, 0xae, 0x04, 0x00, 0x00,
+}
@@ -596,7 +596,12 @@ func (hdm *HwDevManager) ListenDevice(ctx context.Context) {
 		go hdm.SwitchDevManager.GetSwitchFaultCodeByInterval(ctx, time.Second*common.GetSwitchFaultCodeInterval)
 	}
 	hdm.loadFaultCodeAndDeviceInfoCm(ctx)
-	go hdm.Serve(ctx)
+	if common.ParamOption.EnableDRA {
+		// the same chips must not be allocated by both the dra driver and kubelet
+		hwlog.RunLog.Info("dra is enabled, the device plugin resources are not registered to kubelet")
+	} else {
+		go hdm.Serve(ctx)
+	}
 	if common.ParamOption.CheckCachedPods {
 		go hdm.manager.GetKubeClient().PodInformerInspector(ctx)
 	}
@@ -164,6 +164,22 @@ func (hdm *HwDevManager) GetSuperPodType() int8 {
 	return hdm.manager.GetSuperPodType()
 }
 
+// GetPhysicalNPUs get the copy of the physical npus with the latest health and fault codes
+func (hdm *HwDevManager) GetPhysicalNPUs() []common.NpuDevice {
+	common.LockAllDeviceInfo()
+	defer common.UnlockAllDeviceInfo()
+	npus := make([]common.NpuDevice, 0, len(hdm.allInfo.AllDevs))
+	for _, dev := range hdm.allInfo.AllDevs {
+		if common.IsVirtualDev(dev.DevType) {
+			continue
+		}
+		dev.FaultCodes = append([]int64(nil), dev.FaultCodes...)
+		dev.NetworkFaultCodes = append([]int64(nil), dev.NetworkFaultCodes...)
+		npus = append(npus, dev)
+	}
+	return npus
+}
+
 // SetNodeInternalIPInK8s get super pod info then cache it
 func (hdm *HwDevManager) SetNodeInternalIPInK8s(node *v1.Node) {
 	if common.ParamOption.RealCardType != api.Ascend910A5 {
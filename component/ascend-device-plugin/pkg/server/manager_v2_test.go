@@ -288,3 +288,18 @@ func TestGetSuperPodType(t *testing.T) {
 		convey.So(hdm.GetSuperPodType(), convey.ShouldEqual, common.SuperPodTypeAbnormal)
 	})
 }
+
+// TestHwDevManagerMethodGetPhysicalNPUs test get the physical npus
+func TestHwDevManagerMethodGetPhysicalNPUs(t *testing.T) {
+	convey.Convey("test HwDevManager method GetPhysicalNPUs", t, func() {
+		hdm := HwDevManager{allInfo: common.NpuAllInfo{AllDevs: []common.NpuDevice{
+			{DevType: api.Ascend910, DeviceName: "Ascend910-0", FaultCodes: []int64{1}},
+			{DevType: common.Ascend910vir2, DeviceName: "Ascend910-2c-100-1"},
+		}}}
+		npus := hdm.GetPhysicalNPUs()
+		convey.So(len(npus), convey.ShouldEqual, 1)
+		convey.So(npus[0].DeviceName, convey.ShouldEqual, "Ascend910-0")
+		npus[0].FaultCodes[0] = 0
+		convey.So(hdm.allInfo.AllDevs[0].FaultCodes[0], convey.ShouldEqual, 1)
+	})
+}
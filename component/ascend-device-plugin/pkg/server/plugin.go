@@ -247,9 +247,12 @@ func (ps *PluginServer) deepCopyDevice(cachedDevices []*common.NpuDevice) {
 	ps.cachedDevices = ps.cachedDevices[:0]
 	for _, dev := range cachedDevices {
 		ps.cachedDevices = append(ps.cachedDevices, common.NpuDevice{
-			DeviceName: dev.DeviceName,
-			Health:     dev.Health,
-			PhyID:      dev.PhyID,
+			DeviceName:        dev.DeviceName,
+			Health:            dev.Health,
+			PhyID:             dev.PhyID,
+			LogicID:           dev.LogicID,
+			FaultCodes:        append([]int64(nil), dev.FaultCodes...),
+			NetworkFaultCodes: append([]int64(nil), dev.NetworkFaultCodes...),
 		})
 	}
 	ps.cachedLock.Unlock()
@@ -1285,16 +1288,44 @@ func (ps *PluginServer) SetSlowNodeNoticeEnv(resp *v1beta1.ContainerAllocateResp
 	hwlog.RunLog.Info("allocate step time env succeed")
 }
 
-// GetPreferredAllocation implement the kubelet device plugin interface
-func (ps *PluginServer) GetPreferredAllocation(context.Context, *v1beta1.PreferredAllocationRequest) (
+// GetPreferredAllocation implement the kubelet device plugin interface, in volcano mode the devices are chosen by
+// volcano, otherwise the devices are preferred by the chip topology and the health of the chips
+func (ps *PluginServer) GetPreferredAllocation(ctx context.Context, requests *v1beta1.PreferredAllocationRequest) (
 	*v1beta1.PreferredAllocationResponse, error) {
-	return nil, fmt.Errorf("not support")
+	if common.ParamOption.UseVolcanoType {
+		return nil, fmt.Errorf("not support")
+	}
+	if requests == nil {
+		return nil, fmt.Errorf("invalid requests")
+	}
+	if len(requests.ContainerRequests) > common.MaxContainerLimit {
+		return nil, fmt.Errorf("the number of container request %d exceeds the upper limit",
+			len(requests.ContainerRequests))
+	}
+	topology := topologyOf(common.ParamOption.RealCardType)
+	resp := &v1beta1.PreferredAllocationResponse{}
+	for _, rqt := range requests.ContainerRequests {
+		if rqt == nil {
+			return nil, fmt.Errorf("invalid container request")
+		}
+		if len(rqt.AvailableDeviceIDs) > common.MaxDevicesNum*common.MinAICoreNum ||
+			int(rqt.AllocationSize) > len(rqt.AvailableDeviceIDs) || rqt.AllocationSize < 0 {
+			return nil, fmt.Errorf("invalid allocation size %d of %d available devices", rqt.AllocationSize,
+				len(rqt.AvailableDeviceIDs))
+		}
+		deviceIDs := selectPreferredChips(topology, ps.getPreferredChips(rqt.AvailableDeviceIDs),
+			ps.getPreferredChips(rqt.MustIncludeDeviceIDs), int(rqt.AllocationSize))
+		hwlog.RunLog.Infof("preferred devices %v of available devices %v", deviceIDs, rqt.AvailableDeviceIDs)
+		resp.ContainerResponses = append(resp.ContainerResponses,
+			&v1beta1.ContainerPreferredAllocationResponse{DeviceIDs: deviceIDs})
+	}
+	return resp, nil
 }
 
 // GetDevicePluginOptions is Standard interface to kubelet.
 func (ps *PluginServer) GetDevicePluginOptions(ctx context.Context, e *v1beta1.Empty) (*v1beta1.DevicePluginOptions,
 	error) {
-	return &v1beta1.DevicePluginOptions{}, nil
+	return &v1beta1.DevicePluginOptions{GetPreferredAllocationAvailable: !common.ParamOption.UseVolcanoType}, nil
 }
 
 // PreStartContainer is Standard interface to kubelet with empty implement.
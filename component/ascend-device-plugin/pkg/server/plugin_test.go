@@ -942,6 +942,13 @@ func TestGetPreferredAllocation(t *testing.T) {
 		ps := NewPluginServer(api.Ascend910, devices, nil, device.NewHwAscend910Manager())
 		_, err := ps.GetPreferredAllocation(nil, nil)
 		convey.So(err, convey.ShouldNotBeNil)
+		patch := gomonkey.ApplyGlobalVar(&common.ParamOption, common.Option{UseVolcanoType: true})
+		defer patch.Reset()
+		_, err = ps.GetPreferredAllocation(nil, &v1beta1.PreferredAllocationRequest{})
+		convey.So(err, convey.ShouldNotBeNil)
+		options, err := ps.GetDevicePluginOptions(nil, nil)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(options.GetPreferredAllocationAvailable, convey.ShouldBeFalse)
 	})
 }
 
@@ -0,0 +1,294 @@
+/* Copyright(C) 2026. Huawei Technologies Co.,Ltd. All rights reserved.
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+// Package server holds the implementation of registration to kubelet, k8s pod resource interface.
+package server
+
+import (
+	"math"
+	"sort"
+
+	"k8s.io/kubelet/pkg/apis/deviceplugin/v1beta1"
+
+	"Ascend-device-plugin/pkg/common"
+	"Ascend-device-plugin/pkg/next/devicefactory/customname"
+	"ascend-common/api"
+)
+
+const (
+	// hccsRingNPUNum910 is the number of chips in one hccs ring of Ascend910, chips 0-3 and 4-7 are two rings
+	hccsRingNPUNum910 = 4
+	// hccsNPUNum910B is the number of chips in one hccs domain of Ascend910B, 16 chips server has two domains
+	hccsNPUNum910B = 8
+	// sioNPUNum910A3 is the number of dies of one Ascend910A3 npu, the two dies are linked by SIO
+	sioNPUNum910A3 = 2
+)
+
+// the health ranks of the chips, the smaller the better
+const (
+	rankHealthy = iota
+	rankSubHealth
+	rankPreSeparate
+	rankUnhealthy
+)
+
+// the pair penalties of the chips, the smaller the better
+const (
+	pairSelected = iota
+	pairPreferred
+	pairAccepted
+	pairBroken
+)
+
+// chipTopology is the interconnection of the chips in one node
+type chipTopology struct {
+	// groupSize is the number of chips in one hccs ring or hccs domain, 0 means the whole node
+	groupSize int
+	// pairSize is the number of dies linked by SIO in one npu, 0 means the chips are not paired
+	pairSize int
+}
+
+// preferredChip is the chip offered by kubelet
+type preferredChip struct {
+	id    string
+	phyID int
+	rank  int
+}
+
+func topologyOf(realCardType string) chipTopology {
+	switch realCardType {
+	case api.Ascend910A:
+		return chipTopology{groupSize: hccsRingNPUNum910}
+	case api.Ascend910B:
+		return chipTopology{groupSize: hccsNPUNum910B}
+	case api.Ascend910A3:
+		return chipTopology{pairSize: sioNPUNum910A3}
+	default:
+		return chipTopology{}
+	}
+}
+
+func (t chipTopology) groupOf(phyID int) int {
+	if t.groupSize <= 0 || phyID < 0 {
+		return 0
+	}
+	return phyID / t.groupSize
+}
+
+func (t chipTopology) partnerOf(phyID int) int {
+	if t.pairSize <= 1 || phyID < 0 {
+		return -1
+	}
+	return phyID ^ 1
+}
+
+// getPreferredChips return the chips offered by kubelet with the physical id and the health rank,
+// the devices unknown to the plugin, such as the soft share devices, are ranked last
+func (ps *PluginServer) getPreferredChips(ids []string) []preferredChip {
+	ps.cachedLock.RLock()
+	defer ps.cachedLock.RUnlock()
+	devices := make(map[string]common.NpuDevice, len(ps.cachedDevices))
+	for _, device := range ps.cachedDevices {
+		devices[device.DeviceName] = device
+	}
+	innerNames := customname.ReplaceDeviceInnerName(ps.deviceType, ids)
+	chips := make([]preferredChip, 0, len(ids))
+	for index, id := range ids {
+		device, ok := devices[innerNames[index]]
+		if !ok {
+			chips = append(chips, preferredChip{id: id, phyID: -1, rank: rankUnhealthy})
+			continue
+		}
+		chips = append(chips, preferredChip{id: id, phyID: int(device.PhyID), rank: healthRankOf(device)})
+	}
+	return chips
+}
+
+func healthRankOf(device common.NpuDevice) int {
+	if device.Health != v1beta1.Healthy {
+		return rankUnhealthy
+	}
+	rank := rankHealthy
+	for _, faultType := range []string{common.GetFaultType(device.FaultCodes, device.LogicID),
+		common.GetNetworkFaultType(device.NetworkFaultCodes, device.LogicID)} {
+		switch faultType {
+		case common.PreSeparateNPU:
+			return rankPreSeparate
+		case common.SubHealthFault:
+			rank = rankSubHealth
+		default:
+		}
+	}
+	return rank
+}
+
+// selectPreferredChips select the chips for one container. the must include chips are selected first, then the
+// healthier chips are preferred, and among the chips of the same health the hccs rings or domains are chosen by the
+// best fit, then the dies whose SIO partners are selected or unavailable, and the whole npus are preferred in order
+func selectPreferredChips(topology chipTopology, available, mustInclude []preferredChip, size int) []string {
+	selected := make([]preferredChip, 0, size)
+	selectedIDs := make(map[string]struct{}, size)
+	for _, chip := range mustInclude {
+		if _, ok := selectedIDs[chip.id]; ok || len(selected) >= size {
+			continue
+		}
+		selected = append(selected, chip)
+		selectedIDs[chip.id] = struct{}{}
+	}
+	candidates := make([]preferredChip, 0, len(available))
+	// the health ranks of the candidates by the physical ids
+	availablePhyIDs := make(map[int]int, len(available))
+	for _, chip := range available {
+		if _, ok := selectedIDs[chip.id]; ok {
+			continue
+		}
+		candidates = append(candidates, chip)
+		availablePhyIDs[chip.phyID] = chip.rank
+	}
+	groupRanks := rankGroups(topology, candidates, selected, size-len(selected))
+	for len(selected) < size && len(candidates) > 0 {
+		selectedPhyIDs := make(map[int]struct{}, len(selected))
+		for _, chip := range selected {
+			selectedPhyIDs[chip.phyID] = struct{}{}
+		}
+		remain := size - len(selected)
+		best := 0
+		bestKey := chipKey(topology, candidates[0], groupRanks, selectedPhyIDs, availablePhyIDs, remain)
+		for index := 1; index < len(candidates); index++ {
+			key := chipKey(topology, candidates[index], groupRanks, selectedPhyIDs, availablePhyIDs, remain)
+			if lessKey(key, bestKey) {
+				best, bestKey = index, key
+			}
+		}
+		selected = append(selected, candidates[best])
+		delete(availablePhyIDs, candidates[best].phyID)
+		candidates = append(candidates[:best], candidates[best+1:]...)
+	}
+	ids := make([]string, 0, len(selected))
+	for _, chip := range selected {
+		ids = append(ids, chip.id)
+	}
+	return ids
+}
+
+type groupStat struct {
+	group    int
+	selected bool
+	healthy  int
+	free     int
+}
+
+// rankGroups order the groups: the groups of the must include chips, the groups whose healthy chips fit the
+// remaining number best, the groups whose chips fit best, then the groups with more healthy chips
+func rankGroups(topology chipTopology, candidates, selected []preferredChip, remain int) map[int]int {
+	stats := make(map[int]*groupStat)
+	statOf := func(phyID int) *groupStat {
+		group := topology.groupOf(phyID)
+		if _, ok := stats[group]; !ok {
+			stats[group] = &groupStat{group: group}
+		}
+		return stats[group]
+	}
+	for _, chip := range selected {
+		statOf(chip.phyID).selected = true
+	}
+	for _, chip := range candidates {
+		stat := statOf(chip.phyID)
+		stat.free++
+		if chip.rank == rankHealthy {
+			stat.healthy++
+		}
+	}
+	ordered := make([]*groupStat, 0, len(stats))
+	for _, stat := range stats {
+		ordered = append(ordered, stat)
+	}
+	fitLevel := func(stat *groupStat) int {
+		switch {
+		case stat.selected:
+			return 0
+		case stat.healthy >= remain:
+			return 1
+		case stat.free >= remain:
+			return 2
+		default:
+			return 3
+		}
+	}
+	sort.Slice(ordered, func(i, j int) bool {
+		levelI, levelJ := fitLevel(ordered[i]), fitLevel(ordered[j])
+		if levelI != levelJ {
+			return levelI < levelJ
+		}
+		if ordered[i].healthy != ordered[j].healthy {
+			// best fit for the groups which can hold the chips, otherwise the group with more chips goes first
+			return (ordered[i].healthy < ordered[j].healthy) == (levelI < 3)
+		}
+		if ordered[i].free != ordered[j].free {
+			return (ordered[i].free < ordered[j].free) == (levelI < 3)
+		}
+		return ordered[i].group < ordered[j].group
+	})
+	ranks := make(map[int]int, len(ordered))
+	for index, stat := range ordered {
+		ranks[stat.group] = index
+	}
+	return ranks
+}
+
+func chipKey(topology chipTopology, chip preferredChip, groupRanks map[int]int, selectedPhyIDs map[int]struct{},
+	availablePhyIDs map[int]int, remain int) [4]int {
+	phyID := chip.phyID
+	if phyID < 0 {
+		phyID = math.MaxInt32
+	}
+	return [4]int{chip.rank, groupRanks[topology.groupOf(chip.phyID)],
+		pairPenalty(topology, chip, selectedPhyIDs, availablePhyIDs, remain), phyID}
+}
+
+func pairPenalty(topology chipTopology, chip preferredChip, selectedPhyIDs map[int]struct{},
+	availablePhyIDs map[int]int, remain int) int {
+	partner := topology.partnerOf(chip.phyID)
+	if partner < 0 {
+		return pairSelected
+	}
+	if _, ok := selectedPhyIDs[partner]; ok {
+		return pairSelected
+	}
+	// the die whose partner is unavailable or less healthy fills the odd remaining number,
+	// the whole npus fill the even one
+	oddRemain := remain%topology.pairSize != 0
+	if rank, ok := availablePhyIDs[partner]; !ok || rank > chip.rank {
+		if oddRemain {
+			return pairPreferred
+		}
+		return pairAccepted
+	}
+	if remain < topology.pairSize {
+		return pairBroken
+	}
+	if oddRemain {
+		return pairAccepted
+	}
+	return pairPreferred
+}
+
+func lessKey(a, b [4]int) bool {
+	for index := range a {
+		if a[index] != b[index] {
+			return a[index] < b[index]
+		}
+	}
+	return false
+}
@@ -0,0 +1,179 @@
+/* Copyright(C) 2026. Huawei Technologies Co.,Ltd. All rights reserved.
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+// Package server holds the implementation of registration to kubelet, k8s device plugin interface and grpc service.
+package server
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/agiledragon/gomonkey/v2"
+	"github.com/smartystreets/goconvey/convey"
+	"k8s.io/kubelet/pkg/apis/deviceplugin/v1beta1"
+
+	"Ascend-device-plugin/pkg/common"
+	"Ascend-device-plugin/pkg/device"
+	"ascend-common/api"
+)
+
+const a3NPUNum = 16
+
+func chipName(phyID int) string {
+	return fmt.Sprintf("%s-%d", api.Ascend910, phyID)
+}
+
+func chipNames(phyIDs ...int) []string {
+	names := make([]string, 0, len(phyIDs))
+	for _, phyID := range phyIDs {
+		names = append(names, chipName(phyID))
+	}
+	return names
+}
+
+// testChips return the healthy chips of the physical ids, the chips in ranks have the given health ranks
+func testChips(ranks map[int]int, phyIDs ...int) []preferredChip {
+	chips := make([]preferredChip, 0, len(phyIDs))
+	for _, phyID := range phyIDs {
+		chips = append(chips, preferredChip{id: chipName(phyID), phyID: phyID, rank: ranks[phyID]})
+	}
+	return chips
+}
+
+type preferredTestCase struct {
+	name        string
+	cardType    string
+	available   []int
+	mustInclude []int
+	ranks       map[int]int
+	size        int
+	expected    []string
+}
+
+func runPreferredTestCases(cases []preferredTestCase) {
+	for _, tc := range cases {
+		convey.Convey(tc.name, func() {
+			ids := selectPreferredChips(topologyOf(tc.cardType), testChips(tc.ranks, tc.available...),
+				testChips(tc.ranks, tc.mustInclude...), tc.size)
+			convey.So(ids, convey.ShouldResemble, tc.expected)
+		})
+	}
+}
+
+// TestSelectPreferredChips910 test the hccs ring grouping of Ascend910
+func TestSelectPreferredChips910(t *testing.T) {
+	convey.Convey("Test selectPreferredChips for Ascend910", t, func() {
+		runPreferredTestCases([]preferredTestCase{
+			{name: "01-the ring fits best, should select in the ring", cardType: api.Ascend910A,
+				available: []int{0, 1, 2, 4, 5, 6, 7}, size: 2, expected: chipNames(0, 1)},
+			{name: "02-the whole ring is free, should select the whole ring", cardType: api.Ascend910A,
+				available: []int{1, 2, 3, 4, 5, 6, 7}, size: 4, expected: chipNames(4, 5, 6, 7)},
+			{name: "03-one chip left in the ring, should select the left chip", cardType: api.Ascend910A,
+				available: []int{0, 1, 2, 3, 4}, size: 1, expected: chipNames(4)},
+			{name: "04-must include chip, should select in its ring", cardType: api.Ascend910A,
+				available: []int{0, 1, 2, 3, 5, 6}, mustInclude: []int{5}, size: 2, expected: chipNames(5, 6)},
+			{name: "05-pre separate chip, should select the healthy chips", cardType: api.Ascend910A,
+				available: []int{0, 1, 2, 3}, ranks: map[int]int{0: rankPreSeparate}, size: 2,
+				expected: chipNames(1, 2)},
+		})
+	})
+}
+
+// TestSelectPreferredChips910B test the hccs domain grouping of Ascend910B
+func TestSelectPreferredChips910B(t *testing.T) {
+	convey.Convey("Test selectPreferredChips for Ascend910B", t, func() {
+		runPreferredTestCases([]preferredTestCase{
+			{name: "01-one domain is free, should select the domain", cardType: api.Ascend910B,
+				available: []int{0, 1, 2, 3, 4, 5, 6, 8, 9, 10, 11, 12, 13, 14, 15}, size: 7,
+				expected: chipNames(0, 1, 2, 3, 4, 5, 6)},
+			{name: "02-sub health chip, should select the healthy chips first", cardType: api.Ascend910B,
+				available: []int{0, 1, 2, 3}, ranks: map[int]int{1: rankSubHealth, 2: rankPreSeparate}, size: 3,
+				expected: chipNames(0, 3, 1)},
+			{name: "03-pre separate chip in the domain, should prefer the healthy chips across the domains",
+				cardType: api.Ascend910B, available: []int{0, 1, 2, 3, 4, 5, 6, 7, 8},
+				ranks: map[int]int{3: rankPreSeparate}, size: 8, expected: chipNames(0, 1, 2, 4, 5, 6, 7, 8)},
+		})
+	})
+}
+
+// TestSelectPreferredChips910A3 test the SIO linked die pairs of Ascend910A3
+func TestSelectPreferredChips910A3(t *testing.T) {
+	convey.Convey("Test selectPreferredChips for Ascend910A3", t, func() {
+		runPreferredTestCases([]preferredTestCase{
+			{name: "01-even number, should select the whole npu", cardType: api.Ascend910A3,
+				available: []int{0, 1, 3, 4, 5}, size: 2, expected: chipNames(0, 1)},
+			{name: "02-odd number, should select the die whose partner is used", cardType: api.Ascend910A3,
+				available: []int{0, 1, 3}, size: 1, expected: chipNames(3)},
+			{name: "03-odd number more than one, should fill the lonely die and the whole npu",
+				cardType: api.Ascend910A3, available: []int{0, 1, 3, 4, 5}, size: 3, expected: chipNames(3, 0, 1)},
+			{name: "04-must include die, should select its partner", cardType: api.Ascend910A3,
+				available: []int{0, 1, 4, 5}, mustInclude: []int{4}, size: 2, expected: chipNames(4, 5)},
+		})
+	})
+}
+
+// TestSelectPreferredChipsOthers test the product without the chip topology
+func TestSelectPreferredChipsOthers(t *testing.T) {
+	convey.Convey("Test selectPreferredChips for the product without topology", t, func() {
+		runPreferredTestCases([]preferredTestCase{
+			{name: "01-pre separate chip, should select the healthy chips", cardType: api.Ascend310P,
+				available: []int{3, 1, 2}, ranks: map[int]int{1: rankPreSeparate}, size: 2, expected: chipNames(2, 3)},
+			{name: "02-size is zero, should select nothing", cardType: api.Ascend310P,
+				available: []int{0, 1}, size: 0, expected: []string{}},
+		})
+	})
+}
+
+// TestGetPreferredAllocationByTopology test get preferred allocation in non volcano mode
+func TestGetPreferredAllocationByTopology(t *testing.T) {
+	convey.Convey("Test GetPreferredAllocation by the topology", t, func() {
+		ps := NewPluginServer(api.Ascend910, nil, nil, device.NewHwAscend910Manager())
+		npuDevices := make([]*common.NpuDevice, 0, a3NPUNum)
+		for phyID := 0; phyID < a3NPUNum; phyID++ {
+			npuDevices = append(npuDevices, &common.NpuDevice{DeviceName: chipName(phyID), Health: v1beta1.Healthy,
+				PhyID: int32(phyID), LogicID: int32(phyID)})
+		}
+		ps.deepCopyDevice(npuDevices)
+		patches := gomonkey.ApplyGlobalVar(&common.ParamOption, common.Option{RealCardType: api.Ascend910A3}).
+			ApplyFunc(common.GetFaultType, func(_ []int64, logicID int32) string {
+				if logicID == 0 {
+					return common.PreSeparateNPU
+				}
+				return common.NormalNPU
+			}).
+			ApplyFunc(common.GetNetworkFaultType, func(_ []int64, _ int32) string {
+				return common.NormalNetwork
+			})
+		defer patches.Reset()
+		convey.Convey("01-pre separate die, should select the healthy whole npu", func() {
+			resp, err := ps.GetPreferredAllocation(context.Background(), &v1beta1.PreferredAllocationRequest{
+				ContainerRequests: []*v1beta1.ContainerPreferredAllocationRequest{{
+					AvailableDeviceIDs: chipNames(0, 1, 2, 3), AllocationSize: 2}}})
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(resp.ContainerResponses[0].DeviceIDs, convey.ShouldResemble, chipNames(2, 3))
+		})
+		convey.Convey("02-allocation size more than available, should return error", func() {
+			_, err := ps.GetPreferredAllocation(context.Background(), &v1beta1.PreferredAllocationRequest{
+				ContainerRequests: []*v1beta1.ContainerPreferredAllocationRequest{{
+					AvailableDeviceIDs: chipNames(0), AllocationSize: 2}}})
+			convey.So(err, convey.ShouldNotBeNil)
+		})
+		convey.Convey("03-options, should enable the preferred allocation", func() {
+			options, err := ps.GetDevicePluginOptions(context.Background(), nil)
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(options.GetPreferredAllocationAvailable, convey.ShouldBeTrue)
+		})
+	})
+}
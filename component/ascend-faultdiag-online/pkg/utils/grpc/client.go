@@ -28,9 +28,9 @@ import (
 
 	"github.com/google/uuid"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 
 	"ascend-common/common-utils/hwlog"
+	"ascend-common/common-utils/tlsutils"
 	"ascend-faultdiag-online/pkg/core/model/enum"
 	"ascend-faultdiag-online/pkg/model"
 	"ascend-faultdiag-online/pkg/utils"
@@ -91,12 +91,12 @@ func (c *Client) connect(host string) error {
 	if parsedIp == nil {
 		return fmt.Errorf("invalid host: %s, not the ip type", host)
 	}
-	var err error
+	opts, err := tlsutils.ClusterdDialOptions()
+	if err != nil {
+		return fmt.Errorf("failed to get grpc dial options: %v", err)
+	}
 	serverAddr := host + constants.GrpcPort
-	c.conn, err = grpc.Dial(
-		serverAddr,
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
-	)
+	c.conn, err = grpc.Dial(serverAddr, opts...)
 	if err != nil {
 		return fmt.Errorf("failed to connect to grpc server: %v", err)
 	}
@@ -5,8 +5,13 @@ package main
 
 import (
 	"context"
+	"errors"
 	"flag"
 	"fmt"
+	"net"
+	"os"
+	"strconv"
+	"strings"
 	"syscall"
 	"time"
 
@@ -14,15 +19,21 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/keepalive"
 
+	"ascend-common/api"
 	"ascend-common/common-utils/hwlog"
 	"clusterd/pkg/application/conf"
 	"clusterd/pkg/application/faultmanager"
+	"clusterd/pkg/application/faultmanager/faulthistory"
+	"clusterd/pkg/application/faultmanager/simulation"
 	"clusterd/pkg/application/fdapi"
+	"clusterd/pkg/application/ha"
 	"clusterd/pkg/application/jobv2"
+	"clusterd/pkg/application/maintenance"
 	"clusterd/pkg/application/manualfault"
 	"clusterd/pkg/application/node"
 	"clusterd/pkg/application/pingmesh"
 	"clusterd/pkg/application/publicfault"
+	"clusterd/pkg/application/recover"
 	"clusterd/pkg/application/resource"
 	"clusterd/pkg/application/schedulingexception"
 	"clusterd/pkg/application/statistics"
@@ -33,11 +44,21 @@ import (
 	"clusterd/pkg/domain/job"
 	manualfault2 "clusterd/pkg/domain/manualfault"
 	sv "clusterd/pkg/interface/grpc"
+	"clusterd/pkg/interface/grpc/auth"
+	"clusterd/pkg/interface/grpc/pubfault"
 	"clusterd/pkg/interface/kube"
+	"clusterd/pkg/interface/metrics"
 )
 
 const (
 	defaultLogFile        = "/var/log/mindx-dl/clusterd/clusterd.log"
+	defaultFaultHistory   = "/user1/mindx-dl/clusterd/fault-history.db"
+	defaultMaxFaultEvents = 100000
+	defaultMetricsPort    = 8900
+	defaultKafkaTopic     = "mindx-public-fault"
+	defaultKafkaGroup     = "clusterd"
+	minPort               = 1024
+	maxPort               = 65535
 	grpcKeepAliveTimeOut  = 5
 	grpcKeepAliveInterval = 3
 )
@@ -56,6 +77,28 @@ var (
 		constant.BusinessGrpcReq: rate.NewLimiter(rate.Every(time.Second/constant.QpsLimit), constant.QpsLimit),
 	}
 	useProxy bool
+	// ruleSpecFile the rule spec of the recover state machine, the embedded spec is used when empty
+	ruleSpecFile string
+	// fsmGraphFormat print the recover state machine as the format and exit
+	fsmGraphFormat string
+	grpcSecurity   auth.Config
+	// faultHistoryFile the local file to persist the fault timeline, the fault history is disabled when empty
+	faultHistoryFile string
+	maxFaultEvents   int
+	// simulateFiles the snapshot files to run the fault simulation on, clusterd exits after the simulation
+	simulateFiles  string
+	simulateOutput string
+	// metricsPort the port of the prometheus metrics endpoint, the endpoint is disabled when 0
+	metricsPort int
+	// leaderElect run as one of the active/standby replicas, only the replica elected as the leader serves
+	leaderElect bool
+	// pubFaultHttpPort the port of the http ingestion endpoint of the public faults, disabled when 0
+	pubFaultHttpPort int
+	// pubFaultHttpInsecure allow the http ingestion endpoint to be served without tls
+	pubFaultHttpInsecure bool
+	// pubFaultKafka the kafka input of the public faults, disabled when the brokers are empty
+	pubFaultKafka        publicfault.KafkaConfig
+	pubFaultKafkaBrokers string
 )
 
 func limitQPS(ctx context.Context, req interface{},
@@ -101,6 +144,48 @@ func startInformer(ctx context.Context) {
 func dealPubFault(ctx context.Context) {
 	go publicfault.WatchPubFaultCustomFile(ctx)
 	go publicfault.PubFaultNeedDelete.DealDelete(ctx)
+	initPubFaultInputs(ctx)
+}
+
+// initPubFaultInputs start the optional http and kafka inputs of the public faults, the other inputs keep working
+// when they fail to start
+func initPubFaultInputs(ctx context.Context) {
+	if pubFaultHttpPort != 0 {
+		if err := servePubFaultHttp(ctx); err != nil {
+			hwlog.RunLog.Errorf("public fault http server start failed, error: %v", err)
+		}
+	}
+	if pubFaultKafkaBrokers != "" {
+		pubFaultKafka.Brokers = strings.Split(pubFaultKafkaBrokers, ",")
+		if err := publicfault.ConsumeKafka(ctx, pubFaultKafka); err != nil {
+			hwlog.RunLog.Errorf("public fault kafka consumer start failed, error: %v", err)
+		}
+	}
+}
+
+func servePubFaultHttp(ctx context.Context) error {
+	ipStr := os.Getenv("POD_IP")
+	if useProxy {
+		ipStr = "127.0.0.1"
+	}
+	if net.ParseIP(ipStr) == nil {
+		return fmt.Errorf("invalid pod ip %s", ipStr)
+	}
+	// the endpoint shares the server certificates and the authorization policy of the grpc services
+	tlsConfig, err := grpcSecurity.TLSConfig()
+	if err != nil {
+		return err
+	}
+	if tlsConfig == nil && !pubFaultHttpInsecure {
+		return errors.New("the server certificates are not set by -grpcTlsDir, append -pubFaultHttpInsecure=true " +
+			"to serve the public faults without tls")
+	}
+	handler, err := grpcSecurity.HttpHandler(pubfault.PubFault_SendPublicFault_FullMethodName,
+		publicfault.NewHttpHandler())
+	if err != nil {
+		return err
+	}
+	return publicfault.ServeHttp(ctx, net.JoinHostPort(ipStr, strconv.Itoa(pubFaultHttpPort)), tlsConfig, handler)
 }
 
 func dealManuallySeparateNPUFault(ctx context.Context) {
@@ -144,6 +229,14 @@ func main() {
 		fmt.Printf("%s version: %s \n", BuildName, BuildVersion)
 		return
 	}
+	if fsmGraphFormat != "" {
+		printFsmGraph()
+		return
+	}
+	if simulateFiles != "" {
+		runSimulation()
+		return
+	}
 	ctx, cancel := context.WithCancel(context.Background())
 	if err := initLogger(ctx); err != nil {
 		fmt.Printf("logger init failed: %v\n", err)
@@ -157,18 +250,58 @@ func main() {
 		hwlog.RunLog.Errorf("init k8s servers failed, error: %v", err)
 		return
 	}
+	kube.InitEventRecorder()
 	conf.TryLoadGlobalConfig()
 	go conf.WatchGlobalConfig(ctx)
+	initMetricsServer(ctx)
+	if leaderElect {
+		go runLeaderElection(ctx)
+	} else {
+		startServing(ctx)
+	}
+	signalCatch(cancel)
+}
+
+// startServing start the fault processing and the grpc services, which are run by the leader only
+func startServing(ctx context.Context) {
 	// deal manually separate npu fault must before fault processor center
 	dealManuallySeparateNPUFault(ctx)
+	go maintenance.Manage(ctx)
 	initGrpcServer(ctx)
 	fdapi.StartFdOL()
+	initFaultHistory()
 	faultmanager.GlobalFaultProcessCenter.Work(ctx)
 	startInformer(ctx)
 	initStatisticModule(ctx)
 	go job.RefreshFaultJobInfo(ctx)
 	hwlog.RunLog.Info("clusterd starts to serve")
-	signalCatch(cancel)
+}
+
+func runLeaderElection(ctx context.Context) {
+	server = newGrpcServer()
+	if err := server.StartStandby(useProxy, ha.GetLeaderAddress); err != nil {
+		hwlog.RunLog.Errorf("clusterd standby grpc server start failed, error: %v", err)
+	}
+	namespace := os.Getenv("POD_NAMESPACE")
+	if namespace == "" {
+		namespace = api.DLNamespace
+	}
+	config := ha.Config{Namespace: namespace, PodName: os.Getenv("POD_NAME"), PodIP: os.Getenv("POD_IP")}
+	callbacks := ha.Callbacks{
+		OnStartedLeading: func(leaderCtx context.Context) {
+			// the standby server holds the grpc port until the services of the leader start
+			server.Stop(false)
+			startServing(leaderCtx)
+		},
+		OnLostLeading: func() {
+			// the jobs are recovered by the new leader from the persisted caches after clusterd restarts
+			faulthistory.Recorder.Close()
+			os.Exit(1)
+		},
+	}
+	if err := ha.Run(ctx, config, callbacks); err != nil {
+		hwlog.RunLog.Errorf("run leader election failed, error: %v", err)
+	}
 }
 
 func initStatisticModule(ctx context.Context) {
@@ -181,7 +314,25 @@ func initStatisticModule(ctx context.Context) {
 	schedulingexception.CheckSchedulingException(ctx, &schedulingexception.Config{})
 }
 
+func initFaultHistory() {
+	if faultHistoryFile == "" {
+		hwlog.RunLog.Info("fault history is disabled")
+		return
+	}
+	// the fault history is auxiliary, so clusterd keeps working without it
+	if err := faulthistory.Recorder.Init(faultHistoryFile, maxFaultEvents); err != nil {
+		hwlog.RunLog.Errorf("init fault history failed, error: %v", err)
+	}
+}
+
 func initGrpcServer(ctx context.Context) {
+	server = newGrpcServer()
+	if err := server.Start(ctx, useProxy); err != nil {
+		hwlog.RunLog.Errorf("clusterd grpc server start failed, error: %v", err)
+	}
+}
+
+func newGrpcServer() *sv.ClusterInfoMgrServer {
 	keepAlive := keepalive.ServerParameters{
 		Time:    time.Minute,
 		Timeout: grpcKeepAliveTimeOut * time.Second,
@@ -190,16 +341,36 @@ func initGrpcServer(ctx context.Context) {
 		MinTime:             grpcKeepAliveInterval * time.Second,
 		PermitWithoutStream: true,
 	}
-	server = sv.NewClusterInfoMgrServer([]grpc.ServerOption{
+	grpcServer := sv.NewClusterInfoMgrServer([]grpc.ServerOption{
 		grpc.MaxRecvMsgSize(constant.MaxGRPCRecvMsgSize),
 		grpc.MaxSendMsgSize(constant.MaxGRPCSendMsgSize),
 		grpc.MaxConcurrentStreams(constant.MaxGRPCConcurrentStreams),
 		grpc.UnaryInterceptor(limitQPS),
+		grpc.ChainUnaryInterceptor(metrics.UnaryServerInterceptor),
+		grpc.ChainStreamInterceptor(metrics.StreamServerInterceptor),
 		grpc.KeepaliveParams(keepAlive),
 		grpc.KeepaliveEnforcementPolicy(keepAlivePolicy),
 	})
-	if err := server.Start(ctx, useProxy); err != nil {
-		hwlog.RunLog.Errorf("clusterd grpc server start failed, error: %v", err)
+	grpcServer.SetSecurityConfig(grpcSecurity)
+	return grpcServer
+}
+
+func initMetricsServer(ctx context.Context) {
+	if metricsPort == 0 {
+		hwlog.RunLog.Info("metrics server is disabled")
+		return
+	}
+	ipStr := os.Getenv("POD_IP")
+	if useProxy {
+		ipStr = "127.0.0.1"
+	}
+	if net.ParseIP(ipStr) == nil {
+		hwlog.RunLog.Errorf("metrics server start failed, invalid pod ip %s", ipStr)
+		return
+	}
+	// the metrics are auxiliary, so clusterd keeps working without the endpoint
+	if err := metrics.Serve(ctx, net.JoinHostPort(ipStr, strconv.Itoa(metricsPort))); err != nil {
+		hwlog.RunLog.Errorf("metrics server start failed, error: %v", err)
 	}
 }
 
@@ -231,13 +402,99 @@ func init() {
 		"Run log file path. if the file size exceeds 20MB, will be rotated")
 	flag.IntVar(&hwLogConfig.MaxBackups, "maxBackups", hwlog.DefaultMaxBackups,
 		"Maximum number of backup operator logs, range is (0, 30]")
+	flag.StringVar(&hwLogConfig.Format, "logFormat", hwlog.FormatText,
+		"Log format, text or json, the json format is for shipping the logs to Loki, Elasticsearch and so on")
 	flag.BoolVar(&useProxy, "useProxy", false, "use local grpc proxy")
+	flag.StringVar(&ruleSpecFile, "fsmSpec", "",
+		"Rule spec file of the recover state machine, the built-in spec is used when empty")
+	flag.StringVar(&fsmGraphFormat, "fsmGraph", "",
+		"Print the recover state machine of the rule spec and exit, the format is dot or mermaid")
+	flag.StringVar(&grpcSecurity.CertDir, "grpcTlsDir", "",
+		"Directory of tls.crt, tls.key and ca.crt of the grpc server, the server is insecure when empty")
+	flag.BoolVar(&grpcSecurity.RequireClientCert, "grpcRequireClientCert", false,
+		"Reject the grpc connection without the client certificate verified by ca.crt")
+	flag.StringVar(&grpcSecurity.PolicyFile, "grpcAuthPolicy", "",
+		"Authorization policy file of the grpc services, all calls are allowed when empty")
+	flag.StringVar(&faultHistoryFile, "faultHistoryFile", defaultFaultHistory,
+		"File to persist the occurrence and recovery of the faults, the fault history is disabled when empty")
+	flag.IntVar(&maxFaultEvents, "maxFaultEvents", defaultMaxFaultEvents,
+		"Maximum number of fault events kept in the fault history file, the oldest ones are dropped")
+	flag.StringVar(&simulateFiles, simulation.SimulateFlag, "",
+		"Comma separated snapshot files of the jobs and the faults, run the fault handling on them offline and exit, "+
+			"the snapshot is read from the stdin when it is "+simulation.StdinFile)
+	flag.StringVar(&simulateOutput, simulation.SimulateOutputFlag, "",
+		"File to write the simulation result, the result is printed when empty")
+	flag.IntVar(&metricsPort, "metricsPort", defaultMetricsPort,
+		"Port of the prometheus metrics endpoint, range is [1024, 65535], the endpoint is disabled when 0")
+	flag.IntVar(&pubFaultHttpPort, "pubFaultHttpPort", 0,
+		"Port of the http ingestion endpoint of the public faults, range is [1024, 65535], disabled when 0")
+	flag.BoolVar(&pubFaultHttpInsecure, "pubFaultHttpInsecure", false,
+		"Serve the http ingestion endpoint of the public faults without tls when -grpcTlsDir is empty")
+	flag.StringVar(&pubFaultKafkaBrokers, "pubFaultKafkaBrokers", "",
+		"Comma separated kafka brokers to consume the public faults from, the kafka input is disabled when empty")
+	flag.StringVar(&pubFaultKafka.Topic, "pubFaultKafkaTopic", defaultKafkaTopic,
+		"Kafka topic of the public faults")
+	flag.StringVar(&pubFaultKafka.Group, "pubFaultKafkaGroup", defaultKafkaGroup,
+		"Kafka consumer group of the public faults")
+	flag.BoolVar(&leaderElect, "leaderElect", false,
+		"Elect the leader among the replicas by the kubernetes lease, the standby replicas redirect the grpc "+
+			"clients to the leader and take over when it is lost. POD_NAME and POD_IP are required")
 }
 
 func checkParameters() bool {
+	if !checkPort("metricsPort", metricsPort) || !checkPort("pubFaultHttpPort", pubFaultHttpPort) {
+		return false
+	}
+	if leaderElect && (os.Getenv("POD_NAME") == "" || os.Getenv("POD_IP") == "") {
+		hwlog.RunLog.Error("env POD_NAME and POD_IP are required by the leader election")
+		return false
+	}
+	if err := recover.LoadRuleSpec(ruleSpecFile); err != nil {
+		hwlog.RunLog.Errorf("check rule spec of the recover state machine failed, error: %v", err)
+		return false
+	}
 	return true
 }
 
+// checkPort check the port is disabled as 0 or in the range of the non-privileged ports
+func checkPort(name string, port int) bool {
+	if port != 0 && (port < minPort || port > maxPort) {
+		hwlog.RunLog.Errorf("%s %d is out of range [%d, %d]", name, port, minPort, maxPort)
+		return false
+	}
+	return true
+}
+
+func printFsmGraph() {
+	if err := recover.LoadRuleSpec(ruleSpecFile); err != nil {
+		fmt.Printf("check rule spec of the recover state machine failed, error: %v\n", err)
+		return
+	}
+	graph, err := recover.RenderRuleSpec(fsmGraphFormat)
+	if err != nil {
+		fmt.Printf("render the recover state machine failed, error: %v\n", err)
+		return
+	}
+	fmt.Print(graph)
+}
+
+func runSimulation() {
+	// the offline simulation only logs to the stderr, the stdout is kept for the result read by the serving clusterd
+	stdout := os.Stdout
+	os.Stdout = os.Stderr
+	hwLogConfig.OnlyToStdout = true
+	err := hwlog.InitRunLogger(hwLogConfig, context.Background())
+	os.Stdout = stdout
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "logger init failed: %v\n", err)
+		os.Exit(1)
+	}
+	if err = simulation.RunFiles(strings.Split(simulateFiles, ","), simulateOutput); err != nil {
+		fmt.Fprintf(os.Stderr, "run fault simulation failed, error: %v\n", err)
+		os.Exit(1)
+	}
+}
+
 func signalCatch(cancel context.CancelFunc) {
 	osSignalChan := util.NewSignalWatcher(syscall.SIGINT, syscall.SIGTERM, syscall.SIGQUIT, syscall.SIGKILL)
 	if osSignalChan == nil {
@@ -255,6 +512,7 @@ func signalCatch(cancel context.CancelFunc) {
 			server.Stop(false)
 		}
 		cancel()
+		faulthistory.Recorder.Close()
 	}
 }
 
@@ -271,5 +529,9 @@ func initLogger(ctx context.Context) error {
 		hwlog.RunLog.Errorf("GrpcEventLog init failed, error is %v", err)
 		return fmt.Errorf("grpc event log init failed, error is %v", err)
 	}
+	if err := logs.InitPubFaultDeadLetterLogger(ctx); err != nil {
+		hwlog.RunLog.Errorf("PubFaultDeadLetterLog init failed, error is %v", err)
+		return fmt.Errorf("public fault dead-letter log init failed, error is %v", err)
+	}
 	return nil
 }
@@ -24,6 +24,7 @@ import (
 
 	"ascend-common/api"
 	"ascend-common/common-utils/hwlog"
+	"clusterd/pkg/application/faultmanager/cmprocess"
 	"clusterd/pkg/common/constant"
 	"clusterd/pkg/domain/conf"
 	"clusterd/pkg/domain/manualfault"
@@ -55,6 +56,7 @@ func loadGlobalConfig(cm *v1.ConfigMap) {
 		hwlog.RunLog.Errorf("cm <%s/%s> or its data is nil", api.ClusterNS, constant.ConfigCmName)
 		return
 	}
+	loadProcessorChain(cm)
 	data, ok := cm.Data[constant.ManuallySeparateNPUConfigKey]
 	if !ok {
 		hwlog.RunLog.Errorf("key %s is not found in cm <%s/%s>", constant.ManuallySeparateNPUConfigKey,
@@ -80,6 +82,22 @@ func loadGlobalConfig(cm *v1.ConfigMap) {
 	hwlog.RunLog.Info("load manually separate policy config success")
 }
 
+// loadProcessorChain load the fault processor chain, the built-in chains are restored when the key is removed and the
+// chains are unchanged when the config is invalid
+func loadProcessorChain(cm *v1.ConfigMap) {
+	var chain conf.ProcessorChain
+	if data, ok := cm.Data[constant.FaultProcessorChainConfigKey]; ok {
+		if err := yaml.UnmarshalStrict([]byte(data), &chain); err != nil {
+			hwlog.RunLog.Errorf("unmarshal fault processor chain config failed from cm <%s/%s>, error: %v",
+				api.ClusterNS, constant.ConfigCmName, err)
+			return
+		}
+	}
+	if err := cmprocess.SetProcessorChain(chain); err != nil {
+		hwlog.RunLog.Errorf("set fault processor chain failed, error: %v", err)
+	}
+}
+
 // TryLoadGlobalConfig try load global config from cm
 func TryLoadGlobalConfig() {
 	const retryTime = 3
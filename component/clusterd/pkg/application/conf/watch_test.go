@@ -25,6 +25,7 @@ import (
 	"k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	"clusterd/pkg/application/faultmanager/cmprocess"
 	"clusterd/pkg/common/constant"
 	"clusterd/pkg/domain/conf"
 	"clusterd/pkg/interface/kube"
@@ -66,6 +67,14 @@ release:
 `
 )
 
+const processorChainCase = `
+device:
+  - name: retry
+  - name: filter
+    webhook:
+      url: http://filter.default.svc/filter
+`
+
 func getDemoCm() *v1.ConfigMap {
 	data := map[string]string{constant.ManuallySeparateNPUConfigKey: testCase1}
 	cm := &v1.ConfigMap{
@@ -178,6 +187,35 @@ func testNilWindow() {
 	convey.So(conf.GetReleaseDuration(), convey.ShouldEqual, 0)
 }
 
+func TestLoadProcessorChain(t *testing.T) {
+	convey.Convey("test func loadProcessorChain", t, func() {
+		var chains []conf.ProcessorChain
+		p1 := gomonkey.ApplyFunc(cmprocess.SetProcessorChain, func(chain conf.ProcessorChain) error {
+			chains = append(chains, chain)
+			return nil
+		})
+		defer p1.Reset()
+		convey.Convey("key is found, should set the chain", func() {
+			cm := getDemoCm()
+			cm.Data[constant.FaultProcessorChainConfigKey] = processorChainCase
+			loadGlobalConfig(cm)
+			convey.So(len(chains), convey.ShouldEqual, 1)
+			convey.So(chains[0].Device[1].Webhook.URL, convey.ShouldEqual, "http://filter.default.svc/filter")
+			convey.So(chains[0].Node, convey.ShouldBeNil)
+		})
+		convey.Convey("key is not found, should restore the default chain", func() {
+			loadGlobalConfig(getDemoCm())
+			convey.So(chains, convey.ShouldResemble, []conf.ProcessorChain{{}})
+		})
+		convey.Convey("unknown field, should not set the chain", func() {
+			cm := getDemoCm()
+			cm.Data[constant.FaultProcessorChainConfigKey] = "device:\n  - nam: retry\n"
+			loadGlobalConfig(cm)
+			convey.So(chains, convey.ShouldBeNil)
+		})
+	})
+}
+
 func resetGlobalConfig() {
 	conf.SetManualSeparatePolicy(conf.ManuallySeparatePolicy{})
 }
@@ -0,0 +1,84 @@
+/* Copyright(C) 2026. Huawei Technologies Co.,Ltd. All rights reserved.
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+// Package fault service for grpc client
+package fault
+
+import (
+	"context"
+
+	"ascend-common/common-utils/hwlog"
+	"clusterd/pkg/application/faultmanager/faulthistory"
+	"clusterd/pkg/domain/common"
+	historystore "clusterd/pkg/domain/faulthistory"
+	"clusterd/pkg/interface/grpc/fault"
+)
+
+// QueryFaultHistory return the occur and recover events of the faults matched the filter in the order of occurrence,
+// the latest events are returned when the number exceeds the limit
+func (s *FaultServer) QueryFaultHistory(ctx context.Context,
+	req *fault.QueryFaultHistoryRequest) (*fault.QueryFaultHistoryResponse, error) {
+	if !s.limiter.Allow(ctx) {
+		return &fault.QueryFaultHistoryResponse{Status: &fault.Status{Code: common.RateLimitedCode,
+			Info: "rate limited, there is too many requests, please retry later"}}, nil
+	}
+	if req.StartTime < 0 || req.EndTime < 0 || req.Limit < 0 ||
+		(req.EndTime > 0 && req.StartTime > req.EndTime) {
+		return &fault.QueryFaultHistoryResponse{Status: &fault.Status{Code: common.InvalidReqParam,
+			Info: "time range and limit should not be negative, and start time should not be after end time"}}, nil
+	}
+	hwlog.RunLog.Infof("query fault history, request: %v", req)
+	events, err := faulthistory.Recorder.Query(historystore.Filter{
+		StartTime: req.StartTime,
+		EndTime:   req.EndTime,
+		NodeName:  req.NodeName,
+		JobId:     req.JobId,
+		FaultCode: req.FaultCode,
+		Limit:     int(req.Limit),
+	})
+	if err != nil {
+		hwlog.RunLog.Errorf("query fault history failed: %v", err)
+		return &fault.QueryFaultHistoryResponse{Status: &fault.Status{Code: int32(common.ServerInnerError),
+			Info: err.Error()}}, nil
+	}
+	resp := &fault.QueryFaultHistoryResponse{
+		Status: &fault.Status{Code: int32(common.SuccessCode), Info: "ok"},
+		Events: make([]*fault.FaultEvent, 0, len(events)),
+	}
+	for _, event := range events {
+		resp.Events = append(resp.Events, toFaultEvent(event))
+	}
+	return resp, nil
+}
+
+func toFaultEvent(event historystore.FaultEvent) *fault.FaultEvent {
+	faultEvent := &fault.FaultEvent{
+		Seq:         event.Seq,
+		EventType:   event.Type,
+		Source:      event.Source,
+		NodeName:    event.NodeName,
+		DeviceId:    event.DeviceId,
+		DeviceType:  event.DeviceType,
+		FaultCode:   event.FaultCode,
+		FaultLevel:  event.FaultLevel,
+		OccurTime:   event.OccurTime,
+		RecoverTime: event.RecoverTime,
+		Jobs:        make([]*fault.AffectedJob, 0, len(event.Jobs)),
+	}
+	for _, job := range event.Jobs {
+		faultEvent.Jobs = append(faultEvent.Jobs, &fault.AffectedJob{JobId: job.JobId, JobName: job.JobName,
+			Namespace: job.Namespace, RankIds: job.RankIds})
+	}
+	return faultEvent
+}
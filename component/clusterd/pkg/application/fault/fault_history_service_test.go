@@ -0,0 +1,75 @@
+/* Copyright(C) 2026. Huawei Technologies Co.,Ltd. All rights reserved.
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+// Package fault test for the fault history service
+package fault
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/agiledragon/gomonkey/v2"
+	"github.com/smartystreets/goconvey/convey"
+
+	"clusterd/pkg/application/faultmanager/faulthistory"
+	"clusterd/pkg/common/util"
+	"clusterd/pkg/domain/common"
+	historystore "clusterd/pkg/domain/faulthistory"
+	"clusterd/pkg/interface/grpc/fault"
+)
+
+func TestQueryFaultHistory(t *testing.T) {
+	convey.Convey("Test QueryFaultHistory", t, func() {
+		service := fakeFaultService()
+		ctx := context.Background()
+		convey.Convey("01-query succeed, should return the events with the jobs", func() {
+			var filter historystore.Filter
+			patches := gomonkey.ApplyMethodFunc(faulthistory.Recorder, "Query",
+				func(f historystore.Filter) ([]historystore.FaultEvent, error) {
+					filter = f
+					return []historystore.FaultEvent{{Seq: 1, Type: historystore.EventOccur, NodeName: "node1",
+						Jobs: []historystore.AffectedJob{{JobId: fakeJobID1, RankIds: []string{"0"}}}}}, nil
+				})
+			defer patches.Reset()
+			resp, err := service.QueryFaultHistory(ctx, &fault.QueryFaultHistoryRequest{NodeName: "node1",
+				JobId: fakeJobID1, StartTime: 1, Limit: 1})
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(resp.Status.Code, convey.ShouldEqual, int32(common.SuccessCode))
+			convey.So(filter, convey.ShouldResemble, historystore.Filter{StartTime: 1, NodeName: "node1",
+				JobId: fakeJobID1, Limit: 1})
+			convey.So(resp.Events[0].EventType, convey.ShouldEqual, historystore.EventOccur)
+			convey.So(resp.Events[0].Jobs[0].RankIds, convey.ShouldResemble, []string{"0"})
+		})
+		convey.Convey("02-invalid time range, should return invalid param", func() {
+			resp, err := service.QueryFaultHistory(ctx, &fault.QueryFaultHistoryRequest{StartTime: 2, EndTime: 1})
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(resp.Status.Code, convey.ShouldEqual, int32(common.InvalidReqParam))
+		})
+		convey.Convey("03-fault history not enabled, should return server inner error", func() {
+			patches := gomonkey.ApplyMethodReturn(faulthistory.Recorder, "Query", nil,
+				errors.New("fault history is not enabled"))
+			defer patches.Reset()
+			resp, err := service.QueryFaultHistory(ctx, &fault.QueryFaultHistoryRequest{})
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(resp.Status.Code, convey.ShouldEqual, int32(common.ServerInnerError))
+		})
+		convey.Convey("04-rate limited, should return rate limited", func() {
+			service.limiter = util.NewAdvancedRateLimiter(0, 0, 0)
+			resp, err := service.QueryFaultHistory(ctx, &fault.QueryFaultHistoryRequest{})
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(resp.Status.Code, convey.ShouldEqual, int32(common.RateLimitedCode))
+		})
+	})
+}
@@ -0,0 +1,110 @@
+/* Copyright(C) 2026. Huawei Technologies Co.,Ltd. All rights reserved.
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+// Package fault service for grpc client
+package fault
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"k8s.io/api/core/v1"
+	"sigs.k8s.io/yaml"
+
+	"ascend-common/common-utils/hwlog"
+	"clusterd/pkg/application/faultmanager/simulation"
+	"clusterd/pkg/domain/common"
+	"clusterd/pkg/interface/grpc/fault"
+)
+
+const maxSimulateConfigMaps = 5000
+
+// SimulateFault run the hypothetical device info and node info configmaps through the fault processors with the
+// current jobs, and return the fault ranks and the recover strategy of the affected jobs. nothing is published
+func (s *FaultServer) SimulateFault(ctx context.Context,
+	req *fault.SimulateFaultRequest) (*fault.SimulateFaultResponse, error) {
+	if !s.limiter.Allow(ctx) {
+		return &fault.SimulateFaultResponse{Status: &fault.Status{Code: common.RateLimitedCode,
+			Info: "rate limited, there is too many requests, please retry later"}}, nil
+	}
+	configMaps, err := parseSimulateConfigMaps(req.ConfigMaps)
+	if err != nil {
+		return &fault.SimulateFaultResponse{Status: &fault.Status{Code: common.InvalidReqParam,
+			Info: err.Error()}}, nil
+	}
+	hwlog.RunLog.Infof("simulate fault of %d configmaps", len(configMaps))
+	result, snapshot, err := simulation.Simulate(ctx, configMaps)
+	if errors.Is(err, simulation.ErrBusy) {
+		return &fault.SimulateFaultResponse{Status: &fault.Status{Code: common.RateLimitedCode,
+			Info: err.Error()}}, nil
+	}
+	if err != nil {
+		hwlog.RunLog.Errorf("simulate fault failed: %v", err)
+		return &fault.SimulateFaultResponse{Status: &fault.Status{Code: int32(common.ServerInnerError),
+			Info: err.Error()}}, nil
+	}
+	resp := &fault.SimulateFaultResponse{
+		Status: &fault.Status{Code: int32(common.SuccessCode), Info: "ok"},
+		Jobs:   make([]*fault.SimulateJobResult, 0, len(result.Jobs)),
+	}
+	if req.ReturnSnapshot {
+		resp.Snapshot = string(snapshot)
+	}
+	for _, jobResult := range result.Jobs {
+		resp.Jobs = append(resp.Jobs, toSimulateJobResult(jobResult))
+	}
+	return resp, nil
+}
+
+func parseSimulateConfigMaps(contents []string) ([]v1.ConfigMap, error) {
+	if len(contents) == 0 || len(contents) > maxSimulateConfigMaps {
+		return nil, fmt.Errorf("the number of configmaps should be in [1, %d]", maxSimulateConfigMaps)
+	}
+	configMaps := make([]v1.ConfigMap, 0, len(contents))
+	for i, content := range contents {
+		var cm v1.ConfigMap
+		if err := yaml.Unmarshal([]byte(content), &cm); err != nil {
+			return nil, fmt.Errorf("configmap %d is invalid: %v", i, err)
+		}
+		if cm.Name == "" {
+			return nil, fmt.Errorf("name of configmap %d is empty", i)
+		}
+		configMaps = append(configMaps, cm)
+	}
+	return configMaps, nil
+}
+
+func toSimulateJobResult(jobResult simulation.JobResult) *fault.SimulateJobResult {
+	result := &fault.SimulateJobResult{
+		JobId:        jobResult.JobId,
+		JobName:      jobResult.JobName,
+		Namespace:    jobResult.Namespace,
+		HealthyState: jobResult.HealthyState,
+		Strategy:     jobResult.Strategy,
+		FaultRanks:   make([]*fault.SimulateFaultRank, 0, len(jobResult.FaultRanks)),
+	}
+	for _, faultRank := range jobResult.FaultRanks {
+		result.FaultRanks = append(result.FaultRanks, &fault.SimulateFaultRank{
+			RankId:           faultRank.RankId,
+			PodRank:          faultRank.PodRank,
+			FaultCode:        faultRank.FaultCode,
+			FaultLevel:       faultRank.FaultLevel,
+			DeviceId:         faultRank.DeviceId,
+			DoStepRetry:      faultRank.DoStepRetry,
+			DoRestartInPlace: faultRank.DoRestartInPlace,
+		})
+	}
+	return result
+}
@@ -0,0 +1,81 @@
+/* Copyright(C) 2026. Huawei Technologies Co.,Ltd. All rights reserved.
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+// Package fault test for the fault simulation service
+package fault
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/agiledragon/gomonkey/v2"
+	"github.com/smartystreets/goconvey/convey"
+	"k8s.io/api/core/v1"
+
+	"clusterd/pkg/application/faultmanager/simulation"
+	"clusterd/pkg/common/constant"
+	"clusterd/pkg/domain/common"
+	"clusterd/pkg/interface/grpc/fault"
+)
+
+const testDeviceCm = `{"metadata":{"name":"mindx-dl-deviceinfo-node1","namespace":"kube-system"},
+"data":{"DeviceInfoCfg":"{}"}}`
+
+func TestSimulateFault(t *testing.T) {
+	convey.Convey("Test SimulateFault", t, func() {
+		service := fakeFaultService()
+		ctx := context.Background()
+		convey.Convey("01-simulate succeed, should return the job results and the snapshot", func() {
+			var cmName string
+			patches := gomonkey.ApplyFunc(simulation.Simulate,
+				func(_ context.Context, configMaps []v1.ConfigMap) (*simulation.Result, []byte, error) {
+					cmName = configMaps[0].Name
+					return &simulation.Result{Jobs: []simulation.JobResult{{JobId: fakeJobID1,
+						Strategy:   constant.ProcessRecoverStrategyName,
+						FaultRanks: []constant.FaultRank{{RankId: "1", FaultCode: "80E01801"}}}}}, []byte("{}"), nil
+				})
+			defer patches.Reset()
+			resp, err := service.SimulateFault(ctx, &fault.SimulateFaultRequest{ConfigMaps: []string{testDeviceCm},
+				ReturnSnapshot: true})
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(resp.Status.Code, convey.ShouldEqual, int32(common.SuccessCode))
+			convey.So(cmName, convey.ShouldEqual, "mindx-dl-deviceinfo-node1")
+			convey.So(resp.Jobs[0].Strategy, convey.ShouldEqual, constant.ProcessRecoverStrategyName)
+			convey.So(resp.Jobs[0].FaultRanks[0].RankId, convey.ShouldEqual, "1")
+			convey.So(resp.Snapshot, convey.ShouldEqual, "{}")
+		})
+		convey.Convey("02-invalid configmap, should return invalid param", func() {
+			for _, configMaps := range [][]string{nil, {"[invalid"}, {`{"data":{}}`}} {
+				resp, err := service.SimulateFault(ctx, &fault.SimulateFaultRequest{ConfigMaps: configMaps})
+				convey.So(err, convey.ShouldBeNil)
+				convey.So(resp.Status.Code, convey.ShouldEqual, int32(common.InvalidReqParam))
+			}
+		})
+		convey.Convey("03-another simulation running, should return rate limited", func() {
+			patches := gomonkey.ApplyFuncReturn(simulation.Simulate, nil, nil, simulation.ErrBusy)
+			defer patches.Reset()
+			resp, err := service.SimulateFault(ctx, &fault.SimulateFaultRequest{ConfigMaps: []string{testDeviceCm}})
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(resp.Status.Code, convey.ShouldEqual, int32(common.RateLimitedCode))
+		})
+		convey.Convey("04-simulation failed, should return server inner error", func() {
+			patches := gomonkey.ApplyFuncReturn(simulation.Simulate, nil, nil, errors.New("run simulation failed"))
+			defer patches.Reset()
+			resp, err := service.SimulateFault(ctx, &fault.SimulateFaultRequest{ConfigMaps: []string{testDeviceCm}})
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(resp.Status.Code, convey.ShouldEqual, int32(common.ServerInnerError))
+		})
+	})
+}
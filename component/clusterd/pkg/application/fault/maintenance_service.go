@@ -0,0 +1,202 @@
+/* Copyright(C) 2026. Huawei Technologies Co.,Ltd. All rights reserved.
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+// Package fault service for grpc client
+package fault
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"time"
+
+	"ascend-common/common-utils/hwlog"
+	"clusterd/pkg/domain/common"
+	"clusterd/pkg/domain/maintenance"
+	"clusterd/pkg/interface/grpc/auth"
+	"clusterd/pkg/interface/grpc/fault"
+)
+
+const (
+	maxMaintenanceTargets = 1000
+	maxMaintenanceText    = 256
+	// maxMaintenanceDuration the longest maintenance window
+	maxMaintenanceDuration = 7 * 24 * time.Hour
+)
+
+var deviceNamePattern = regexp.MustCompile(`^[A-Za-z0-9]+-\d+$`)
+
+// CreateMaintenanceWindow schedule the maintenance window of the nodes or devices. once the window starts, the
+// jobs on the devices are handed off, then the devices are separated until the window ends
+func (s *FaultServer) CreateMaintenanceWindow(ctx context.Context,
+	req *fault.CreateMaintenanceWindowRequest) (*fault.MaintenanceWindowResponse, error) {
+	if !s.limiter.Allow(ctx) {
+		return &fault.MaintenanceWindowResponse{Status: &fault.Status{Code: common.RateLimitedCode,
+			Info: "rate limited, there is too many requests, please retry later"}}, nil
+	}
+	operator := maintenanceOperator(ctx, req.Operator)
+	window, err := parseMaintenanceWindow(req, operator, time.Now().UnixMilli())
+	if err != nil {
+		return &fault.MaintenanceWindowResponse{Status: &fault.Status{Code: common.InvalidReqParam,
+			Info: err.Error()}}, nil
+	}
+	id, err := maintenance.Windows.Add(window)
+	if err != nil {
+		return &fault.MaintenanceWindowResponse{Status: &fault.Status{Code: common.InvalidReqParam,
+			Info: err.Error()}}, nil
+	}
+	created, _ := maintenance.Windows.Get(id)
+	hwlog.RunLog.Infof("maintenance window %s is created by %s, targets: %v, time: [%d, %d)", id, operator,
+		created.Targets, created.StartTime, created.EndTime)
+	return &fault.MaintenanceWindowResponse{Status: &fault.Status{Code: int32(common.SuccessCode), Info: "ok"},
+		Window: toMaintenanceWindow(created)}, nil
+}
+
+// CancelMaintenanceWindow cancel the unfinished maintenance window, the devices are released
+func (s *FaultServer) CancelMaintenanceWindow(ctx context.Context,
+	req *fault.CancelMaintenanceWindowRequest) (*fault.MaintenanceWindowResponse, error) {
+	if !s.limiter.Allow(ctx) {
+		return &fault.MaintenanceWindowResponse{Status: &fault.Status{Code: common.RateLimitedCode,
+			Info: "rate limited, there is too many requests, please retry later"}}, nil
+	}
+	operator := maintenanceOperator(ctx, req.Operator)
+	if err := checkOperatorAndReason(operator, req.Reason); err != nil {
+		return &fault.MaintenanceWindowResponse{Status: &fault.Status{Code: common.InvalidReqParam,
+			Info: err.Error()}}, nil
+	}
+	window, err := maintenance.Windows.Cancel(req.Id, operator, req.Reason)
+	if errors.Is(err, maintenance.ErrNotFound) || errors.Is(err, maintenance.ErrFinished) {
+		return &fault.MaintenanceWindowResponse{Status: &fault.Status{Code: common.InvalidReqParam,
+			Info: err.Error()}}, nil
+	}
+	if err != nil {
+		return &fault.MaintenanceWindowResponse{Status: &fault.Status{Code: int32(common.ServerInnerError),
+			Info: err.Error()}}, nil
+	}
+	hwlog.RunLog.Infof("maintenance window %s is cancelled by %s, reason: %s", req.Id, operator, req.Reason)
+	return &fault.MaintenanceWindowResponse{Status: &fault.Status{Code: int32(common.SuccessCode), Info: "ok"},
+		Window: toMaintenanceWindow(window)}, nil
+}
+
+// ListMaintenanceWindows return the maintenance windows with the audit records, filtered by the id and status
+func (s *FaultServer) ListMaintenanceWindows(ctx context.Context,
+	req *fault.ListMaintenanceWindowsRequest) (*fault.ListMaintenanceWindowsResponse, error) {
+	if !s.limiter.Allow(ctx) {
+		return &fault.ListMaintenanceWindowsResponse{Status: &fault.Status{Code: common.RateLimitedCode,
+			Info: "rate limited, there is too many requests, please retry later"}}, nil
+	}
+	var statuses []string
+	if req.Status != "" {
+		statuses = append(statuses, req.Status)
+	}
+	resp := &fault.ListMaintenanceWindowsResponse{Status: &fault.Status{Code: int32(common.SuccessCode),
+		Info: "ok"}}
+	for _, window := range maintenance.Windows.List(statuses...) {
+		if req.Id != "" && window.Id != req.Id {
+			continue
+		}
+		resp.Windows = append(resp.Windows, toMaintenanceWindow(window))
+	}
+	return resp, nil
+}
+
+// maintenanceOperator return the identity of the authorized caller as the operator of the audit records, the
+// operator in the request is only used when the authorization is disabled
+func maintenanceOperator(ctx context.Context, reqOperator string) string {
+	if caller := auth.Caller(ctx); caller != "" {
+		return caller
+	}
+	return reqOperator
+}
+
+func checkOperatorAndReason(operator, reason string) error {
+	if operator == "" || len(operator) > maxMaintenanceText || len(reason) > maxMaintenanceText {
+		return fmt.Errorf("operator is required, operator and reason should be at most %d characters",
+			maxMaintenanceText)
+	}
+	return nil
+}
+
+func parseMaintenanceWindow(req *fault.CreateMaintenanceWindowRequest, operator string,
+	now int64) (maintenance.Window, error) {
+	if err := checkOperatorAndReason(operator, req.Reason); err != nil {
+		return maintenance.Window{}, err
+	}
+	if len(req.Targets) == 0 || len(req.Targets) > maxMaintenanceTargets {
+		return maintenance.Window{}, fmt.Errorf("the number of targets should be in [1, %d]", maxMaintenanceTargets)
+	}
+	startTime := req.StartTime
+	if startTime == 0 {
+		startTime = now
+	}
+	if startTime < 0 || req.EndTime <= startTime || req.EndTime <= now ||
+		req.EndTime-startTime > maxMaintenanceDuration.Milliseconds() {
+		return maintenance.Window{}, fmt.Errorf("end time should be after the start time and now, and the window "+
+			"should be at most %v", maxMaintenanceDuration)
+	}
+	if req.DrainTimeout < 0 {
+		return maintenance.Window{}, errors.New("drain timeout should not be negative")
+	}
+	drainTimeout := req.DrainTimeout
+	if drainTimeout == 0 {
+		drainTimeout = maintenance.DefaultDrainTimeout
+	}
+	window := maintenance.Window{
+		Targets:      make([]maintenance.Target, 0, len(req.Targets)),
+		StartTime:    startTime,
+		EndTime:      req.EndTime,
+		DrainTimeout: drainTimeout,
+		Operator:     operator,
+		Reason:       req.Reason,
+	}
+	for _, target := range req.Targets {
+		if target == nil || target.NodeName == "" {
+			return maintenance.Window{}, errors.New("node name of the target is required")
+		}
+		for _, deviceName := range target.DeviceNames {
+			if !deviceNamePattern.MatchString(deviceName) {
+				return maintenance.Window{}, fmt.Errorf("device name %s of node %s is invalid, e.g. Ascend910-0",
+					deviceName, target.NodeName)
+			}
+		}
+		window.Targets = append(window.Targets, maintenance.Target{NodeName: target.NodeName,
+			DeviceNames: target.DeviceNames})
+	}
+	return window, nil
+}
+
+func toMaintenanceWindow(window maintenance.Window) *fault.MaintenanceWindow {
+	result := &fault.MaintenanceWindow{
+		Id:           window.Id,
+		Targets:      make([]*fault.MaintenanceTarget, 0, len(window.Targets)),
+		StartTime:    window.StartTime,
+		EndTime:      window.EndTime,
+		DrainTimeout: window.DrainTimeout,
+		Operator:     window.Operator,
+		Reason:       window.Reason,
+		Status:       window.Status,
+		AffectedJobs: window.AffectedJobs,
+		Audits:       make([]*fault.MaintenanceAudit, 0, len(window.Audits)),
+	}
+	for _, target := range window.Targets {
+		result.Targets = append(result.Targets, &fault.MaintenanceTarget{NodeName: target.NodeName,
+			DeviceNames: target.DeviceNames})
+	}
+	for _, audit := range window.Audits {
+		result.Audits = append(result.Audits, &fault.MaintenanceAudit{Time: audit.Time, Action: audit.Action,
+			Operator: audit.Operator, Message: audit.Message})
+	}
+	return result
+}
@@ -0,0 +1,100 @@
+/* Copyright(C) 2026. Huawei Technologies Co.,Ltd. All rights reserved.
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+// Package fault test for the maintenance window service
+package fault
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/agiledragon/gomonkey/v2"
+	"github.com/smartystreets/goconvey/convey"
+
+	"clusterd/pkg/common/util"
+	"clusterd/pkg/domain/common"
+	"clusterd/pkg/domain/maintenance"
+	"clusterd/pkg/interface/grpc/auth"
+	"clusterd/pkg/interface/grpc/fault"
+)
+
+func testMaintenanceRequest() *fault.CreateMaintenanceWindowRequest {
+	return &fault.CreateMaintenanceWindowRequest{
+		Targets:  []*fault.MaintenanceTarget{{NodeName: "node1", DeviceNames: []string{"Ascend910-0"}}},
+		EndTime:  time.Now().Add(time.Hour).UnixMilli(),
+		Operator: "ops",
+		Reason:   "firmware upgrade",
+	}
+}
+
+func TestMaintenanceWindowService(t *testing.T) {
+	convey.Convey("Test maintenance window service", t, func() {
+		maintenance.Windows = maintenance.NewStore()
+		service := fakeFaultService()
+		ctx := context.Background()
+		created, err := service.CreateMaintenanceWindow(ctx, testMaintenanceRequest())
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(created.Status.Code, convey.ShouldEqual, int32(common.SuccessCode))
+		convey.So(created.Window.Status, convey.ShouldEqual, maintenance.StatusScheduled)
+		convey.So(created.Window.StartTime, convey.ShouldBeGreaterThan, 0)
+		convey.So(created.Window.DrainTimeout, convey.ShouldEqual, maintenance.DefaultDrainTimeout)
+		convey.Convey("01-overlapped window, should return invalid param", func() {
+			resp, err := service.CreateMaintenanceWindow(ctx, testMaintenanceRequest())
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(resp.Status.Code, convey.ShouldEqual, int32(common.InvalidReqParam))
+		})
+		convey.Convey("02-invalid request, should return invalid param", func() {
+			req := testMaintenanceRequest()
+			req.Targets[0].DeviceNames = []string{"0"}
+			resp, err := service.CreateMaintenanceWindow(ctx, req)
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(resp.Status.Code, convey.ShouldEqual, int32(common.InvalidReqParam))
+			req = testMaintenanceRequest()
+			req.EndTime = 1
+			resp, err = service.CreateMaintenanceWindow(ctx, req)
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(resp.Status.Code, convey.ShouldEqual, int32(common.InvalidReqParam))
+		})
+		convey.Convey("03-cancel and list, should return the window with the audit records", func() {
+			resp, err := service.CancelMaintenanceWindow(ctx, &fault.CancelMaintenanceWindowRequest{
+				Id: created.Window.Id, Operator: "ops"})
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(resp.Window.Status, convey.ShouldEqual, maintenance.StatusCancelled)
+			resp, err = service.CancelMaintenanceWindow(ctx, &fault.CancelMaintenanceWindowRequest{
+				Id: created.Window.Id, Operator: "ops"})
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(resp.Status.Code, convey.ShouldEqual, int32(common.InvalidReqParam))
+			list, err := service.ListMaintenanceWindows(ctx, &fault.ListMaintenanceWindowsRequest{
+				Status: maintenance.StatusCancelled})
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(len(list.Windows), convey.ShouldEqual, 1)
+			convey.So(len(list.Windows[0].Audits), convey.ShouldEqual, 2)
+		})
+		convey.Convey("04-authorized caller, should be the operator instead of the one in the request", func() {
+			patches := gomonkey.ApplyFuncReturn(auth.Caller, "cn:ops-portal")
+			defer patches.Reset()
+			resp, err := service.CancelMaintenanceWindow(ctx, &fault.CancelMaintenanceWindowRequest{
+				Id: created.Window.Id, Operator: "forged"})
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(resp.Window.Audits[len(resp.Window.Audits)-1].Operator, convey.ShouldEqual, "cn:ops-portal")
+		})
+		convey.Convey("05-rate limited, should return rate limited", func() {
+			service.limiter = util.NewAdvancedRateLimiter(0, 0, 0)
+			resp, err := service.ListMaintenanceWindows(ctx, &fault.ListMaintenanceWindowsRequest{})
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(resp.Status.Code, convey.ShouldEqual, int32(common.RateLimitedCode))
+		})
+	})
+}
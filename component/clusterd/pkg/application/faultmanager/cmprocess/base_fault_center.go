@@ -8,13 +8,22 @@ import (
 	"sync"
 
 	"ascend-common/common-utils/hwlog"
+	"clusterd/pkg/application/faultmanager/cmprocess/webhook"
 	"clusterd/pkg/common/constant"
+	"clusterd/pkg/domain/conf"
 	"clusterd/pkg/domain/faultdomain"
 	"clusterd/pkg/domain/faultdomain/cmmanager"
 )
 
+type namedProcessor struct {
+	name      string
+	processor constant.FaultProcessor
+}
+
 type baseFaultCenter[T constant.ConfigMapInterface] struct {
+	builtinProcessors    []namedProcessor
 	processorList        []constant.FaultProcessor
+	processorLock        sync.RWMutex
 	subscribeChannelList []chan int
 	mutex                sync.Mutex
 	cmManager            *cmmanager.FaultCenterCmManager[T]
@@ -43,7 +52,10 @@ func (baseCenter *baseFaultCenter[T]) Process() {
 	} else {
 		processingCm = origCm
 	}
-	for _, processor := range baseCenter.processorList {
+	baseCenter.processorLock.RLock()
+	processorList := baseCenter.processorList
+	baseCenter.processorLock.RUnlock()
+	for _, processor := range processorList {
 		info := constant.OneConfigmapContent[T]{
 			AllConfigmap:    processingCm,
 			UpdateConfigmap: updateOriginalCm,
@@ -76,8 +88,70 @@ func (baseCenter *baseFaultCenter[T]) notifySubscriber() {
 	}
 }
 
-func (baseCenter *baseFaultCenter[T]) addProcessors(processors []constant.FaultProcessor) {
-	baseCenter.processorList = append(baseCenter.processorList, processors...)
+// addProcessors add the built-in processors, which compose the default chain in order
+func (baseCenter *baseFaultCenter[T]) addProcessors(processors []namedProcessor) {
+	baseCenter.processorLock.Lock()
+	defer baseCenter.processorLock.Unlock()
+	baseCenter.builtinProcessors = append(baseCenter.builtinProcessors, processors...)
+	for _, processor := range processors {
+		baseCenter.processorList = append(baseCenter.processorList, processor.processor)
+	}
+}
+
+// buildProcessorList build the chain of the configs, the default chain is returned when configs is nil
+func (baseCenter *baseFaultCenter[T]) buildProcessorList(configs []conf.ProcessorConfig) (
+	[]constant.FaultProcessor, error) {
+	baseCenter.processorLock.RLock()
+	defer baseCenter.processorLock.RUnlock()
+	if configs == nil {
+		processorList := make([]constant.FaultProcessor, 0, len(baseCenter.builtinProcessors))
+		for _, processor := range baseCenter.builtinProcessors {
+			processorList = append(processorList, processor.processor)
+		}
+		return processorList, nil
+	}
+	centerName := centerNames[baseCenter.centerType]
+	processorList := make([]constant.FaultProcessor, 0, len(configs))
+	for _, config := range configs {
+		if config.Webhook != nil {
+			processor, err := webhook.NewProcessor[T](centerName, config.Name, *config.Webhook)
+			if err != nil {
+				return nil, err
+			}
+			processorList = append(processorList, processor)
+			continue
+		}
+		processor := baseCenter.getBuiltinProcessor(config.Name)
+		if processor == nil {
+			return nil, fmt.Errorf("processor %s is not supported by %s center, the built-in processors are %v",
+				config.Name, centerName, baseCenter.builtinProcessorNames())
+		}
+		processorList = append(processorList, processor)
+	}
+	return processorList, nil
+}
+
+func (baseCenter *baseFaultCenter[T]) setProcessorList(processorList []constant.FaultProcessor) {
+	baseCenter.processorLock.Lock()
+	defer baseCenter.processorLock.Unlock()
+	baseCenter.processorList = processorList
+}
+
+func (baseCenter *baseFaultCenter[T]) getBuiltinProcessor(name string) constant.FaultProcessor {
+	for _, processor := range baseCenter.builtinProcessors {
+		if processor.name == name {
+			return processor.processor
+		}
+	}
+	return nil
+}
+
+func (baseCenter *baseFaultCenter[T]) builtinProcessorNames() []string {
+	names := make([]string, 0, len(baseCenter.builtinProcessors))
+	for _, processor := range baseCenter.builtinProcessors {
+		names = append(names, processor.name)
+	}
+	return names
 }
 
 // Register notify chan
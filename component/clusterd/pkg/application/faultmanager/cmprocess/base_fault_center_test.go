@@ -29,7 +29,7 @@ func TestBaseFaultCenterProcess(t *testing.T) {
 	t.Run("TestBaseFaultCenterProcess", func(t *testing.T) {
 		manager := cmmanager.DeviceCenterCmManager
 		baseCenter := newBaseFaultCenter(manager, constant.DeviceProcessType)
-		baseCenter.addProcessors([]constant.FaultProcessor{&fakeProcessor{}})
+		baseCenter.addProcessors([]namedProcessor{{"fake", &fakeProcessor{}}})
 		notifyChan := make(chan int, 1)
 		baseCenter.Register(notifyChan)
 		baseCenter.Process()
@@ -6,6 +6,7 @@ package cmprocess
 import (
 	"clusterd/pkg/application/faultmanager/cmprocess/custom"
 	"clusterd/pkg/application/faultmanager/cmprocess/incrementfault"
+	"clusterd/pkg/application/faultmanager/cmprocess/maintenance"
 	"clusterd/pkg/application/faultmanager/cmprocess/manualfault"
 	"clusterd/pkg/application/faultmanager/cmprocess/preseparate"
 	"clusterd/pkg/application/faultmanager/cmprocess/publicfault"
@@ -31,15 +32,25 @@ func init() {
 		baseFaultCenter: newBaseFaultCenter(manager, constant.DeviceProcessType),
 	}
 
-	DeviceCenter.addProcessors([]constant.FaultProcessor{
-		publicfault.PubFaultProcessor,
-		custom.CustomProcessor,                 // this processor process the faults defined in job yaml and l2 faults.
-		uceaccompany.UceAccompanyProcessor,     // this processor filter the uce accompany faults, before processorForUceFault
-		retry.RetryProcessor,                   // this processor filter the retry faults.
-		recoverinplace.RecoverInplaceProcessor, // this processor filter the single process faults.
-		stresstest.StressTestProcessor,         // this processor filter the stress test faults.
-		preseparate.PreSeparateFaultProcessor,  // this processor process the preSeparate faults.
-		incrementfault.IncrementFaultProcessor, // this processor process the increment faults.
-		manualfault.ManualFaultProcessor,       // this processor process the manually separate faults.
+	DeviceCenter.addProcessors([]namedProcessor{
+		{publicFaultProcessorName, publicfault.PubFaultProcessor},
+		// this processor process the faults defined in job yaml and l2 faults.
+		{customProcessorName, custom.CustomProcessor},
+		// this processor filter the uce accompany faults, before processorForUceFault
+		{uceAccompanyProcessorName, uceaccompany.UceAccompanyProcessor},
+		// this processor filter the retry faults.
+		{retryProcessorName, retry.RetryProcessor},
+		// this processor filter the single process faults.
+		{recoverInplaceProcessorName, recoverinplace.RecoverInplaceProcessor},
+		// this processor filter the stress test faults.
+		{stressTestProcessorName, stresstest.StressTestProcessor},
+		// this processor process the preSeparate faults.
+		{preSeparateProcessorName, preseparate.PreSeparateFaultProcessor},
+		// this processor process the increment faults.
+		{incrementFaultProcessorName, incrementfault.IncrementFaultProcessor},
+		// this processor process the manually separate faults.
+		{manualFaultProcessorName, manualfault.ManualFaultProcessor},
+		// this processor process the devices under the maintenance windows.
+		{maintenanceProcessorName, maintenance.MaintenanceProcessor},
 	})
 }
@@ -0,0 +1,95 @@
+/* Copyright(C) 2026. Huawei Technologies Co.,Ltd. All rights reserved.
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+// Package maintenance is used to process the devices under the maintenance windows
+package maintenance
+
+import (
+	"ascend-common/common-utils/hwlog"
+	"clusterd/pkg/common/constant"
+	"clusterd/pkg/domain/maintenance"
+)
+
+// MaintenanceProcessor is used to process the devices under the maintenance windows
+var MaintenanceProcessor *maintenanceProcessor
+
+type maintenanceProcessor struct{}
+
+func init() {
+	MaintenanceProcessor = &maintenanceProcessor{}
+}
+
+// Process mark the devices of the draining windows as sub-healthy with the maintenance fault code, the recover
+// controller hands off the jobs on them by the hot switch flow, or by the grace exit flow even if their sub-healthy
+// strategy is ignore, and mark the devices of the in progress windows as manually separated. the devices
+// are released once the window is finished since nothing is added for them
+func (p *maintenanceProcessor) Process(info any) any {
+	processContent, ok := info.(constant.OneConfigmapContent[*constant.AdvanceDeviceFaultCm])
+	if !ok {
+		hwlog.RunLog.Error("input is not deviceinfo type")
+		return info
+	}
+	windows := maintenance.Windows.List(maintenance.StatusDraining, maintenance.StatusInProgress)
+	for _, window := range windows {
+		faultLevel := constant.SubHealthFault
+		if window.Status == maintenance.StatusInProgress {
+			faultLevel = constant.ManuallySeparateNPU
+		}
+		for _, target := range window.Targets {
+			devInfo, ok := processContent.AllConfigmap[target.NodeName]
+			if !ok {
+				continue
+			}
+			deviceNames := target.DeviceNames
+			if len(deviceNames) == 0 {
+				deviceNames = allDeviceNames(devInfo)
+			}
+			for _, deviceName := range deviceNames {
+				devInfo.AddFaultAndFix(constant.DeviceFault{
+					FaultType:            constant.CardUnhealthy,
+					NPUName:              deviceName,
+					LargeModelFaultLevel: faultLevel,
+					FaultLevel:           faultLevel,
+					FaultHandling:        faultLevel,
+					FaultCode:            constant.MaintenanceFaultCode,
+				})
+			}
+		}
+		hwlog.RunLog.Debugf("load devices of maintenance window %s as %s", window.Id, faultLevel)
+	}
+	return info
+}
+
+func allDeviceNames(devInfo *constant.AdvanceDeviceFaultCm) []string {
+	deviceNames := make([]string, 0, len(devInfo.AvailableDeviceList))
+	seen := make(map[string]struct{})
+	add := func(names []string) {
+		for _, name := range names {
+			if _, ok := seen[name]; ok {
+				continue
+			}
+			seen[name] = struct{}{}
+			deviceNames = append(deviceNames, name)
+		}
+	}
+	add(devInfo.AvailableDeviceList)
+	add(devInfo.Recovering)
+	add(devInfo.CardUnHealthy)
+	add(devInfo.NetworkUnhealthy)
+	add(devInfo.DPUUnhealthy)
+	for name := range devInfo.FaultDeviceList {
+		add([]string{name})
+	}
+	return deviceNames
+}
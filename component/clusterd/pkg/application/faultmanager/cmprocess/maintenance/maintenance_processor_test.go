@@ -0,0 +1,79 @@
+/* Copyright(C) 2026. Huawei Technologies Co.,Ltd. All rights reserved.
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+// Package maintenance is test for processing the devices under the maintenance windows
+package maintenance
+
+import (
+	"context"
+	"testing"
+
+	"github.com/smartystreets/goconvey/convey"
+
+	"ascend-common/common-utils/hwlog"
+	"clusterd/pkg/common/constant"
+	"clusterd/pkg/domain/maintenance"
+)
+
+const (
+	node1 = "node1"
+	node2 = "node2"
+	dev0  = "Ascend910-0"
+	dev1  = "Ascend910-1"
+)
+
+func init() {
+	if err := hwlog.InitRunLogger(&hwlog.LogConfig{OnlyToStdout: true}, context.Background()); err != nil {
+		panic(err)
+	}
+}
+
+func addWindow(status string, target maintenance.Target) {
+	id, err := maintenance.Windows.Add(maintenance.Window{Targets: []maintenance.Target{target}, EndTime: 1})
+	convey.So(err, convey.ShouldBeNil)
+	_, err = maintenance.Windows.Update(id, func(w *maintenance.Window) bool {
+		w.Status = status
+		return true
+	})
+	convey.So(err, convey.ShouldBeNil)
+}
+
+func TestProcess(t *testing.T) {
+	convey.Convey("Test maintenance processor Process", t, func() {
+		maintenance.Windows = maintenance.NewStore()
+		addWindow(maintenance.StatusDraining, maintenance.Target{NodeName: node1, DeviceNames: []string{dev0}})
+		addWindow(maintenance.StatusInProgress, maintenance.Target{NodeName: node2})
+		devInfos := map[string]*constant.AdvanceDeviceFaultCm{
+			node1: {AvailableDeviceList: []string{dev0, dev1}},
+			node2: {AvailableDeviceList: []string{dev0}, CardUnHealthy: []string{dev1}},
+		}
+		MaintenanceProcessor.Process(constant.OneConfigmapContent[*constant.AdvanceDeviceFaultCm]{
+			AllConfigmap: devInfos})
+		convey.Convey("01-draining device, should be sub-healthy and still available", func() {
+			convey.So(devInfos[node1].FaultDeviceList[dev0][0].FaultLevel, convey.ShouldEqual,
+				constant.SubHealthFault)
+			convey.So(devInfos[node1].FaultDeviceList[dev1], convey.ShouldBeNil)
+			convey.So(devInfos[node1].AvailableDeviceList, convey.ShouldContain, dev0)
+		})
+		convey.Convey("02-in progress node, all devices should be separated", func() {
+			for _, dev := range []string{dev0, dev1} {
+				convey.So(devInfos[node2].FaultDeviceList[dev][0].FaultLevel, convey.ShouldEqual,
+					constant.ManuallySeparateNPU)
+				convey.So(devInfos[node2].FaultDeviceList[dev][0].FaultCode, convey.ShouldEqual,
+					constant.MaintenanceFaultCode)
+			}
+			convey.So(devInfos[node2].AvailableDeviceList, convey.ShouldBeEmpty)
+		})
+	})
+}
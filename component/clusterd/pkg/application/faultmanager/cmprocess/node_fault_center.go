@@ -17,8 +17,9 @@ func init() {
 	NodeCenter = &nodeFaultProcessCenter{
 		baseFaultCenter: newBaseFaultCenter(manager, constant.NodeProcessType),
 	}
-	NodeCenter.addProcessors([]constant.FaultProcessor{
-		preseparate.PreSeparateFaultProcessor, // this processor process the preSeparate faults.
+	NodeCenter.addProcessors([]namedProcessor{
+		// this processor process the preSeparate faults.
+		{preSeparateProcessorName, preseparate.PreSeparateFaultProcessor},
 	})
 }
 
@@ -0,0 +1,100 @@
+/* Copyright(C) 2026. Huawei Technologies Co.,Ltd. All rights reserved.
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+// Package cmprocess contain cm processor
+package cmprocess
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+
+	"ascend-common/common-utils/hwlog"
+	"clusterd/pkg/common/constant"
+	"clusterd/pkg/domain/conf"
+)
+
+// the names of the built-in processors used in the processor chain config
+const (
+	publicFaultProcessorName    = "publicfault"
+	customProcessorName         = "custom"
+	uceAccompanyProcessorName   = "uceaccompany"
+	retryProcessorName          = "retry"
+	recoverInplaceProcessorName = "recoverinplace"
+	stressTestProcessorName     = "stresstest"
+	preSeparateProcessorName    = "preseparate"
+	incrementFaultProcessorName = "incrementfault"
+	manualFaultProcessorName    = "manualfault"
+	maintenanceProcessorName    = "maintenance"
+)
+
+var centerNames = map[int]string{
+	constant.DeviceProcessType: "device",
+	constant.NodeProcessType:   "node",
+	constant.SwitchProcessType: "switch",
+	constant.DpuProcessType:    "dpu",
+}
+
+var (
+	chainLock    sync.Mutex
+	currentChain = conf.ProcessorChain{}
+)
+
+// SetProcessorChain replace the processor chains of the fault centers. all the chains are built before any of them
+// is applied, so the chains are unchanged when the config is invalid
+func SetProcessorChain(chain conf.ProcessorChain) error {
+	chainLock.Lock()
+	defer chainLock.Unlock()
+	if err := conf.CheckProcessorChain(&chain); err != nil {
+		return err
+	}
+	if reflect.DeepEqual(chain, currentChain) {
+		return nil
+	}
+	deviceList, err := DeviceCenter.buildProcessorList(chain.Device)
+	if err != nil {
+		return err
+	}
+	nodeList, err := NodeCenter.buildProcessorList(chain.Node)
+	if err != nil {
+		return err
+	}
+	switchList, err := SwitchCenter.buildProcessorList(chain.Switch)
+	if err != nil {
+		return err
+	}
+	dpuList, err := DpuCenter.buildProcessorList(chain.Dpu)
+	if err != nil {
+		return err
+	}
+	DeviceCenter.setProcessorList(deviceList)
+	NodeCenter.setProcessorList(nodeList)
+	SwitchCenter.setProcessorList(switchList)
+	DpuCenter.setProcessorList(dpuList)
+	currentChain = chain
+	hwlog.RunLog.Infof("fault processor chain is updated, device: %s, node: %s, switch: %s, dpu: %s",
+		chainString(chain.Device), chainString(chain.Node), chainString(chain.Switch), chainString(chain.Dpu))
+	return nil
+}
+
+func chainString(configs []conf.ProcessorConfig) string {
+	if configs == nil {
+		return "default"
+	}
+	names := make([]string, 0, len(configs))
+	for _, config := range configs {
+		names = append(names, config.Name)
+	}
+	return fmt.Sprintf("%v", names)
+}
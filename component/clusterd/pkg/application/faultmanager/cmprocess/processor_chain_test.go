@@ -0,0 +1,60 @@
+/* Copyright(C) 2026. Huawei Technologies Co.,Ltd. All rights reserved.
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+// Package cmprocess test for the processor chain
+package cmprocess
+
+import (
+	"testing"
+
+	"github.com/smartystreets/goconvey/convey"
+
+	"clusterd/pkg/application/faultmanager/cmprocess/preseparate"
+	"clusterd/pkg/application/faultmanager/cmprocess/retry"
+	"clusterd/pkg/application/faultmanager/cmprocess/webhook"
+	"clusterd/pkg/common/constant"
+	"clusterd/pkg/domain/conf"
+)
+
+func TestSetProcessorChain(t *testing.T) {
+	convey.Convey("Test SetProcessorChain", t, func() {
+		defaultDeviceLen := len(DeviceCenter.builtinProcessors)
+		defer func() {
+			convey.So(SetProcessorChain(conf.ProcessorChain{}), convey.ShouldBeNil)
+			convey.So(len(DeviceCenter.processorList), convey.ShouldEqual, defaultDeviceLen)
+		}()
+		convey.Convey("01-reorder and add webhook, should replace the chains", func() {
+			err := SetProcessorChain(conf.ProcessorChain{
+				Switch: []conf.ProcessorConfig{{Name: preSeparateProcessorName}, {Name: retryProcessorName},
+					{Name: "filter", Webhook: &conf.WebhookConfig{URL: "http://127.0.0.1:1/filter"}}},
+				Node: []conf.ProcessorConfig{},
+			})
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(SwitchCenter.processorList[0], convey.ShouldEqual, preseparate.PreSeparateFaultProcessor)
+			convey.So(SwitchCenter.processorList[1], convey.ShouldEqual, retry.RetryProcessor)
+			_, ok := SwitchCenter.processorList[2].(*webhook.Processor[*constant.SwitchInfo])
+			convey.So(ok, convey.ShouldBeTrue)
+			convey.So(len(NodeCenter.processorList), convey.ShouldEqual, 0)
+			convey.So(len(DeviceCenter.processorList), convey.ShouldEqual, defaultDeviceLen)
+		})
+		convey.Convey("02-processor not built in the center, should return error and keep the chains", func() {
+			err := SetProcessorChain(conf.ProcessorChain{
+				Device: []conf.ProcessorConfig{{Name: retryProcessorName}},
+				Node:   []conf.ProcessorConfig{{Name: manualFaultProcessorName}},
+			})
+			convey.So(err, convey.ShouldNotBeNil)
+			convey.So(len(DeviceCenter.processorList), convey.ShouldEqual, defaultDeviceLen)
+		})
+	})
+}
@@ -19,10 +19,11 @@ func init() {
 	SwitchCenter = &switchFaultProcessCenter{
 		baseFaultCenter: newBaseFaultCenter(manager, constant.SwitchProcessType),
 	}
-	SwitchCenter.addProcessors([]constant.FaultProcessor{
-		custom.CustomProcessor,
-		retry.RetryProcessor,
-		preseparate.PreSeparateFaultProcessor, // this processor process the preSeparate faults.
+	SwitchCenter.addProcessors([]namedProcessor{
+		{customProcessorName, custom.CustomProcessor},
+		{retryProcessorName, retry.RetryProcessor},
+		// this processor process the preSeparate faults.
+		{preSeparateProcessorName, preseparate.PreSeparateFaultProcessor},
 	})
 }
 
@@ -0,0 +1,266 @@
+/* Copyright(C) 2026. Huawei Technologies Co.,Ltd. All rights reserved.
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+// Package webhook contain the out-of-process fault processor
+package webhook
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"ascend-common/common-utils/hwlog"
+	"ascend-common/common-utils/tlsutils"
+	"ascend-common/common-utils/utils"
+	"clusterd/pkg/common/constant"
+	"clusterd/pkg/domain/conf"
+)
+
+const (
+	maxResponseBytes = 64 * 1024 * 1024
+	maxCaFileBytes   = 1024 * 1024
+	nullContent      = "null"
+	// responseCacheTTL the webhook is called again after the ttl even if the content is not changed, so that the
+	// change of the webhook itself takes effect
+	responseCacheTTL = time.Minute
+)
+
+// Request the body posted to the webhook
+type Request[T constant.ConfigMapInterface] struct {
+	Center          string                       `json:"center"`
+	Processor       string                       `json:"processor"`
+	AllConfigmap    map[string]T                 `json:"allConfigmap"`
+	UpdateConfigmap []constant.InformerCmItem[T] `json:"updateConfigmap"`
+}
+
+// Response the body returned by the webhook, only the modified configmaps need to be returned
+type Response struct {
+	AllConfigmap map[string]json.RawMessage `json:"allConfigmap"`
+}
+
+// Processor post the configmap content to the webhook and apply the filtered content returned. the content is passed
+// through when the webhook fails, so that the fault center is never blocked by the webhook
+type Processor[T constant.ConfigMapInterface] struct {
+	center  string
+	name    string
+	url     string
+	caFile  string
+	timeout time.Duration
+	client  *http.Client
+
+	caData      []byte
+	caCheckTime time.Time
+
+	lastRequest  [sha256.Size]byte
+	lastResponse *Response
+	lastCallTime time.Time
+}
+
+// NewProcessor return the webhook processor of the center, the config should have been checked
+func NewProcessor[T constant.ConfigMapInterface](center, name string,
+	config conf.WebhookConfig) (*Processor[T], error) {
+	p := &Processor[T]{
+		center:  center,
+		name:    name,
+		url:     config.URL,
+		caFile:  config.CaFile,
+		timeout: time.Duration(config.TimeoutSeconds) * time.Second,
+	}
+	var caData []byte
+	if p.caFile != "" {
+		var err error
+		if caData, err = p.readCa(); err != nil {
+			return nil, err
+		}
+	}
+	if err := p.setClient(caData); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+func (p *Processor[T]) readCa() ([]byte, error) {
+	caData, err := utils.ReadLimitBytesWithSymlink(p.caFile, maxCaFileBytes, func(string) bool { return true })
+	if err != nil {
+		return nil, fmt.Errorf("read ca file of webhook %s failed: %v", p.name, err)
+	}
+	return caData, nil
+}
+
+// setClient replace the http client with the one trusting caData, the system roots are used when caData is empty
+func (p *Processor[T]) setClient(caData []byte) error {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if len(caData) != 0 {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(caData) {
+			return fmt.Errorf("no certificate found in ca file of webhook %s", p.name)
+		}
+		transport.TLSClientConfig = &tls.Config{MinVersion: tls.VersionTLS12, RootCAs: pool}
+	}
+	if p.client != nil {
+		p.client.CloseIdleConnections()
+	}
+	p.client = &http.Client{Transport: transport, Timeout: p.timeout}
+	p.caData, p.caCheckTime = caData, time.Now()
+	return nil
+}
+
+// reloadCa reload the ca file at most once per interval, so that the rotated ca takes effect without restarting.
+// the previous ca is used when the new file is invalid
+func (p *Processor[T]) reloadCa() {
+	if p.caFile == "" || time.Since(p.caCheckTime) < tlsutils.DefaultReloadInterval {
+		return
+	}
+	p.caCheckTime = time.Now()
+	caData, err := p.readCa()
+	if err == nil && bytes.Equal(caData, p.caData) {
+		return
+	}
+	if err == nil {
+		err = p.setClient(caData)
+	}
+	if err != nil {
+		hwlog.RunLog.Errorf("reload ca file of webhook processor %s failed, the previous one is used, err: %v",
+			p.name, err)
+		return
+	}
+	hwlog.RunLog.Infof("ca file of webhook processor %s is reloaded", p.name)
+}
+
+// Process call the webhook and replace the configmaps with the returned ones
+func (p *Processor[T]) Process(info any) any {
+	content, ok := info.(constant.OneConfigmapContent[T])
+	if !ok {
+		hwlog.RunLog.Errorf("webhook processor %s of %s center got unexpected content", p.name, p.center)
+		return info
+	}
+	resp, err := p.call(content)
+	if err != nil {
+		hwlog.RunLog.Warnf("call webhook processor %s of %s center failed, pass through the content: %v",
+			p.name, p.center, err)
+		return content
+	}
+	for cmName, raw := range resp.AllConfigmap {
+		original, exist := content.AllConfigmap[cmName]
+		if !exist {
+			hwlog.RunLog.Warnf("webhook processor %s returned unknown configmap %s, ignore it", p.name, cmName)
+			continue
+		}
+		if string(bytes.TrimSpace(raw)) == nullContent {
+			continue
+		}
+		var filtered T
+		if err = json.Unmarshal(raw, &filtered); err != nil {
+			hwlog.RunLog.Warnf("webhook processor %s returned invalid configmap %s, ignore it: %v",
+				p.name, cmName, err)
+			continue
+		}
+		restoreHiddenFields(original, filtered)
+		content.AllConfigmap[cmName] = filtered
+	}
+	return content
+}
+
+// call post the content, the last response is reused within the ttl when the content is not changed since the
+// fault center processes every second
+func (p *Processor[T]) call(content constant.OneConfigmapContent[T]) (*Response, error) {
+	body, err := json.Marshal(Request[T]{
+		Center:          p.center,
+		Processor:       p.name,
+		AllConfigmap:    content.AllConfigmap,
+		UpdateConfigmap: content.UpdateConfigmap,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("marshal request failed: %v", err)
+	}
+	hash := sha256.Sum256(body)
+	if p.lastResponse != nil && hash == p.lastRequest && time.Since(p.lastCallTime) < responseCacheTTL {
+		return p.lastResponse, nil
+	}
+	p.reloadCa()
+	httpResp, err := p.client.Post(p.url, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	defer httpResp.Body.Close()
+	if httpResp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %d", httpResp.StatusCode)
+	}
+	data, err := io.ReadAll(io.LimitReader(httpResp.Body, maxResponseBytes+1))
+	if err != nil {
+		return nil, fmt.Errorf("read response failed: %v", err)
+	}
+	if len(data) > maxResponseBytes {
+		return nil, errors.New("response is too large")
+	}
+	resp := &Response{}
+	if err = json.Unmarshal(data, resp); err != nil {
+		return nil, fmt.Errorf("unmarshal response failed: %v", err)
+	}
+	p.lastRequest, p.lastResponse, p.lastCallTime = hash, resp, time.Now()
+	return resp, nil
+}
+
+// restoreHiddenFields restore the fields not serialized, which are used by the following processors
+func restoreHiddenFields[T constant.ConfigMapInterface](original, filtered T) {
+	filtered.UpdateFaultReceiveTime(original)
+	switch filteredCm := any(filtered).(type) {
+	case *constant.AdvanceDeviceFaultCm:
+		originalCm, ok := any(original).(*constant.AdvanceDeviceFaultCm)
+		if !ok || originalCm == nil {
+			return
+		}
+		for deviceName, faults := range filteredCm.FaultDeviceList {
+			for i := range faults {
+				faults[i].ForceAdd = hasForceAddDeviceFault(originalCm.FaultDeviceList[deviceName], faults[i])
+			}
+		}
+	case *constant.SwitchInfo:
+		originalCm, ok := any(original).(*constant.SwitchInfo)
+		if !ok || originalCm == nil {
+			return
+		}
+		for i := range filteredCm.FaultInfo {
+			filteredCm.FaultInfo[i].ForceAdd = hasForceAddSwitchFault(originalCm.FaultInfo, filteredCm.FaultInfo[i])
+		}
+	default:
+	}
+}
+
+func hasForceAddDeviceFault(faults []constant.DeviceFault, target constant.DeviceFault) bool {
+	for _, fault := range faults {
+		if fault.ForceAdd && fault.FaultType == target.FaultType && fault.NPUName == target.NPUName &&
+			fault.FaultCode == target.FaultCode {
+			return true
+		}
+	}
+	return false
+}
+
+func hasForceAddSwitchFault(faults []constant.SimpleSwitchFaultInfo, target constant.SimpleSwitchFaultInfo) bool {
+	for _, fault := range faults {
+		if fault.ForceAdd && fault.AssembledFaultCode == target.AssembledFaultCode &&
+			fault.SwitchChipId == target.SwitchChipId && fault.SwitchPortId == target.SwitchPortId {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,184 @@
+/* Copyright(C) 2026. Huawei Technologies Co.,Ltd. All rights reserved.
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+// Package webhook test for the webhook processor
+package webhook
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/goconvey/convey"
+
+	"ascend-common/common-utils/hwlog"
+	"clusterd/pkg/common/constant"
+	"clusterd/pkg/domain/conf"
+)
+
+const (
+	testNode        = "node1"
+	testDevice      = "Ascend910-0"
+	testLinkDown    = "81078603"
+	testFiltered    = "80E01801"
+	testReceiveTime = 1771059600000
+)
+
+func init() {
+	if err := hwlog.InitRunLogger(&hwlog.LogConfig{OnlyToStdout: true}, context.Background()); err != nil {
+		fmt.Printf("init hwlog failed, %v\n", err)
+	}
+}
+
+func testContent() constant.OneConfigmapContent[*constant.AdvanceDeviceFaultCm] {
+	return constant.OneConfigmapContent[*constant.AdvanceDeviceFaultCm]{
+		AllConfigmap: map[string]*constant.AdvanceDeviceFaultCm{testNode: {CmName: testNode,
+			FaultDeviceList: map[string][]constant.DeviceFault{testDevice: {
+				{NPUName: testDevice, FaultCode: testLinkDown, ForceAdd: true, FaultTimeAndLevelMap: map[string]constant.
+					FaultTimeAndLevel{testLinkDown: {FaultReceivedTime: testReceiveTime}}},
+				{NPUName: testDevice, FaultCode: testFiltered},
+			}}}},
+	}
+}
+
+// filterHandler drop the fault of testFiltered and return an unknown configmap
+func filterHandler(calls *int) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		*calls++
+		var req Request[*constant.AdvanceDeviceFaultCm]
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil || req.Center != "device" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		cm := req.AllConfigmap[testNode]
+		cm.FaultDeviceList[testDevice] = cm.FaultDeviceList[testDevice][:1]
+		resp := map[string]any{"allConfigmap": map[string]any{testNode: cm, "unknown": cm}}
+		if err := json.NewEncoder(w).Encode(resp); err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}
+}
+
+func TestProcess(t *testing.T) {
+	convey.Convey("Test webhook Process", t, func() {
+		calls := 0
+		server := httptest.NewServer(filterHandler(&calls))
+		defer server.Close()
+		processor, err := NewProcessor[*constant.AdvanceDeviceFaultCm]("device", "filter",
+			conf.WebhookConfig{URL: server.URL, TimeoutSeconds: conf.DefaultWebhookTimeoutSeconds})
+		convey.So(err, convey.ShouldBeNil)
+		convey.Convey("01-webhook succeed, should apply the filtered content and restore the hidden fields", func() {
+			result, ok := processor.Process(testContent()).(constant.OneConfigmapContent[*constant.AdvanceDeviceFaultCm])
+			convey.So(ok, convey.ShouldBeTrue)
+			convey.So(len(result.AllConfigmap), convey.ShouldEqual, 1)
+			faults := result.AllConfigmap[testNode].FaultDeviceList[testDevice]
+			convey.So(len(faults), convey.ShouldEqual, 1)
+			convey.So(faults[0].ForceAdd, convey.ShouldBeTrue)
+			convey.So(faults[0].FaultTimeAndLevelMap[testLinkDown].FaultReceivedTime, convey.ShouldEqual,
+				testReceiveTime)
+		})
+		convey.Convey("02-content unchanged, should reuse the last response", func() {
+			processor.Process(testContent())
+			processor.Process(testContent())
+			convey.So(calls, convey.ShouldEqual, 1)
+		})
+		convey.Convey("03-content unchanged but the ttl expired, should call the webhook again", func() {
+			processor.Process(testContent())
+			processor.lastCallTime = time.Now().Add(-responseCacheTTL)
+			processor.Process(testContent())
+			convey.So(calls, convey.ShouldEqual, len([]string{"first", "expired"}))
+		})
+		convey.Convey("04-webhook failed, should pass through the content", func() {
+			server.Close()
+			content := testContent()
+			content.AllConfigmap[testNode].UpdateTime = 1
+			result, ok := processor.Process(content).(constant.OneConfigmapContent[*constant.AdvanceDeviceFaultCm])
+			convey.So(ok, convey.ShouldBeTrue)
+			convey.So(len(result.AllConfigmap[testNode].FaultDeviceList[testDevice]), convey.ShouldEqual,
+				len([]string{testLinkDown, testFiltered}))
+		})
+	})
+}
+
+func TestNewProcessor(t *testing.T) {
+	convey.Convey("Test NewProcessor, ca file not exist, should return error", t, func() {
+		_, err := NewProcessor[*constant.SwitchInfo]("switch", "filter",
+			conf.WebhookConfig{URL: "https://127.0.0.1/filter", CaFile: "/not/exist/ca.crt"})
+		convey.So(err, convey.ShouldNotBeNil)
+	})
+}
+
+// selfSignedPEM return the pem of a self-signed certificate, which does not sign the certificate of the test server
+func selfSignedPEM(t *testing.T) []byte {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test-ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+}
+
+func TestReloadCa(t *testing.T) {
+	convey.Convey("Test webhook reload the ca file", t, func() {
+		calls := 0
+		server := httptest.NewTLSServer(filterHandler(&calls))
+		defer server.Close()
+		caFile := filepath.Join(t.TempDir(), "ca.crt")
+		convey.So(os.WriteFile(caFile, selfSignedPEM(t), 0600), convey.ShouldBeNil)
+		processor, err := NewProcessor[*constant.AdvanceDeviceFaultCm]("device", "filter", conf.WebhookConfig{
+			URL: server.URL, TimeoutSeconds: conf.DefaultWebhookTimeoutSeconds, CaFile: caFile})
+		convey.So(err, convey.ShouldBeNil)
+		_, err = processor.call(testContent())
+		convey.So(err, convey.ShouldNotBeNil)
+
+		serverCa := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+		convey.So(os.WriteFile(caFile, serverCa, 0600), convey.ShouldBeNil)
+		_, err = processor.call(testContent())
+		convey.So(err, convey.ShouldNotBeNil)
+		processor.caCheckTime = time.Time{}
+		_, err = processor.call(testContent())
+		convey.So(err, convey.ShouldBeNil)
+
+		convey.So(os.WriteFile(caFile, []byte("invalid"), 0600), convey.ShouldBeNil)
+		processor.caCheckTime, processor.lastResponse = time.Time{}, nil
+		_, err = processor.call(testContent())
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(string(processor.caData), convey.ShouldEqual, string(serverCa))
+	})
+}
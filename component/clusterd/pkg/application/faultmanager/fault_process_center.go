@@ -11,6 +11,7 @@ import (
 	"ascend-common/common-utils/hwlog"
 	"clusterd/pkg/application/faultmanager/cmprocess"
 	"clusterd/pkg/application/faultmanager/cmprocess/stresstest"
+	"clusterd/pkg/application/faultmanager/faulthistory"
 	"clusterd/pkg/application/faultmanager/jobprocess"
 	"clusterd/pkg/application/publicfault"
 	"clusterd/pkg/common/constant"
@@ -43,6 +44,7 @@ func (center *faultProcessCenter) Process() {
 	cmprocess.DeviceCenter.NotifySubscriber()
 	cmprocess.NodeCenter.NotifySubscriber()
 	cmprocess.DpuCenter.NotifySubscriber()
+	faulthistory.Recorder.Record()
 }
 
 func (center *faultProcessCenter) notifyFaultCenterProcess(whichToProcess int) {
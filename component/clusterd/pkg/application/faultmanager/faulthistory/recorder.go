@@ -0,0 +1,278 @@
+/* Copyright(C) 2026. Huawei Technologies Co.,Ltd. All rights reserved.
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+// Package faulthistory record the occurrence and recovery of the processed faults as the timeline
+package faulthistory
+
+import (
+	"errors"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"ascend-common/api"
+	"ascend-common/common-utils/hwlog"
+	"clusterd/pkg/application/faultmanager/cmprocess"
+	"clusterd/pkg/common/constant"
+	historystore "clusterd/pkg/domain/faulthistory"
+	jobstorage "clusterd/pkg/domain/job"
+)
+
+const (
+	// SourceDevice the npu fault reported by device plugin
+	SourceDevice = "device"
+	// SourceNode the node fault reported by noded
+	SourceNode = "node"
+	// SourceSwitch the switch fault reported by device plugin
+	SourceSwitch = "switch"
+	// SourceDpu the dpu down reported by device plugin
+	SourceDpu = "dpu"
+	// SourcePublic the public fault
+	SourcePublic = "public"
+	// SourceManual the npu manually separated
+	SourceManual = "manual"
+	// SourceMaintenance the npu under the maintenance window
+	SourceMaintenance = "maintenance"
+
+	faultTypeDpu     = "DPU"
+	dpuDownFaultCode = "DpuStatusDown"
+	keySeparator     = "/"
+	npuNameSeparator = "-"
+	switchSeparator  = "_"
+)
+
+// Recorder the global fault history recorder, it does nothing until the store is opened by Init
+var Recorder = &recorder{}
+
+type recorder struct {
+	mu    sync.RWMutex
+	store *historystore.Store
+}
+
+// Init open the fault history file
+func (r *recorder) Init(path string, maxEvents int) error {
+	store, err := historystore.Open(path, maxEvents)
+	if err != nil {
+		return err
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.store = store
+	return nil
+}
+
+// Close close the fault history file
+func (r *recorder) Close() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.store == nil {
+		return
+	}
+	if err := r.store.Close(); err != nil {
+		hwlog.RunLog.Errorf("close fault history failed: %v", err)
+	}
+	r.store = nil
+}
+
+// Record compare the processed faults with the previous ones and record the changes
+func (r *recorder) Record() {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	if r.store == nil {
+		return
+	}
+	current := collectFaults(cmprocess.DeviceCenter.GetProcessedCm(), cmprocess.NodeCenter.GetProcessedCm(),
+		cmprocess.SwitchCenter.GetProcessedCm(), cmprocess.DpuCenter.GetProcessedCm())
+	var jobs map[string]constant.JobInfo
+	attach := func(event *historystore.FaultEvent) {
+		// the jobs are got only when there is new fault
+		if jobs == nil {
+			jobs = jobstorage.GetAllJobCache()
+		}
+		event.Jobs = affectedJobs(jobs, event)
+	}
+	if err := r.store.Sync(current, time.Now().UnixMilli(), attach); err != nil {
+		hwlog.RunLog.Errorf("record fault history failed: %v", err)
+	}
+}
+
+// Query return the fault events matched the filter
+func (r *recorder) Query(filter historystore.Filter) ([]historystore.FaultEvent, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	if r.store == nil {
+		return nil, errors.New("fault history is not enabled")
+	}
+	return r.store.Query(filter)
+}
+
+func collectFaults(deviceCms map[string]*constant.AdvanceDeviceFaultCm, nodeCms map[string]*constant.NodeInfo,
+	switchCms map[string]*constant.SwitchInfo, dpuCms map[string]*constant.DpuInfoCM) map[string]historystore.FaultEvent {
+	now := time.Now().UnixMilli()
+	faults := make(map[string]historystore.FaultEvent)
+	add := func(event historystore.FaultEvent) {
+		if event.OccurTime <= 0 {
+			event.OccurTime = now
+		}
+		key := strings.Join([]string{event.Source, event.NodeName, event.DeviceId, event.FaultCode}, keySeparator)
+		faults[key] = event
+	}
+	for cmName, deviceCm := range deviceCms {
+		collectDeviceFaults(strings.TrimPrefix(cmName, constant.DeviceInfoPrefix), deviceCm, add)
+	}
+	for cmName, nodeCm := range nodeCms {
+		collectNodeFaults(strings.TrimPrefix(cmName, constant.NodeInfoPrefix), nodeCm, add)
+	}
+	for cmName, switchCm := range switchCms {
+		collectSwitchFaults(strings.TrimPrefix(cmName, constant.SwitchInfoPrefix), switchCm, add)
+	}
+	// the dpu info is reported in the device info configmap
+	for cmName, dpuCm := range dpuCms {
+		collectDpuFaults(strings.TrimPrefix(cmName, constant.DeviceInfoPrefix), dpuCm, add)
+	}
+	return faults
+}
+
+func collectDeviceFaults(nodeName string, deviceCm *constant.AdvanceDeviceFaultCm,
+	add func(historystore.FaultEvent)) {
+	if deviceCm == nil {
+		return
+	}
+	for npuName, deviceFaults := range deviceCm.FaultDeviceList {
+		deviceId := constant.EmptyDeviceId
+		if parts := strings.Split(npuName, npuNameSeparator); len(parts) > 1 {
+			deviceId = parts[1]
+		}
+		for _, deviceFault := range deviceFaults {
+			event := historystore.FaultEvent{
+				Source:     SourceDevice,
+				NodeName:   nodeName,
+				DeviceId:   deviceId,
+				DeviceType: constant.FaultTypeNPU,
+				FaultCode:  deviceFault.FaultCode,
+				FaultLevel: deviceFault.FaultLevel,
+				OccurTime:  deviceFault.FaultTimeAndLevelMap[deviceFault.FaultCode].FaultTime,
+			}
+			if deviceFault.FaultType == constant.PublicFaultType {
+				event.Source = SourcePublic
+			}
+			if deviceFault.FaultLevel == constant.ManuallySeparateNPU {
+				event.Source = SourceManual
+				if event.FaultCode == "" {
+					event.FaultCode = constant.ManuallySeparateNPU
+				}
+			}
+			if deviceFault.FaultCode == constant.MaintenanceFaultCode {
+				event.Source = SourceMaintenance
+			}
+			add(event)
+		}
+	}
+}
+
+func collectNodeFaults(nodeName string, nodeCm *constant.NodeInfo, add func(historystore.FaultEvent)) {
+	if nodeCm == nil {
+		return
+	}
+	for _, faultDev := range nodeCm.FaultDevList {
+		if faultDev == nil {
+			continue
+		}
+		for _, faultCode := range faultDev.FaultCode {
+			add(historystore.FaultEvent{
+				Source:     SourceNode,
+				NodeName:   nodeName,
+				DeviceId:   strconv.FormatInt(faultDev.DeviceId, constant.FormatBase),
+				DeviceType: faultDev.DeviceType,
+				FaultCode:  faultCode,
+				FaultLevel: faultDev.FaultLevel,
+			})
+		}
+	}
+}
+
+func collectSwitchFaults(nodeName string, switchCm *constant.SwitchInfo, add func(historystore.FaultEvent)) {
+	if switchCm == nil {
+		return
+	}
+	for _, switchFault := range switchCm.FaultInfo {
+		port := strconv.Itoa(int(switchFault.SwitchChipId)) + switchSeparator +
+			strconv.Itoa(int(switchFault.SwitchPortId))
+		level := switchCm.FaultLevel
+		if levelInfo, ok := switchCm.FaultTimeAndLevelMap[switchFault.AssembledFaultCode+switchSeparator+port]; ok {
+			level = levelInfo.FaultLevel
+		}
+		add(historystore.FaultEvent{
+			Source:     SourceSwitch,
+			NodeName:   nodeName,
+			DeviceId:   port,
+			DeviceType: constant.FaultTypeSwitch,
+			FaultCode:  switchFault.AssembledFaultCode,
+			FaultLevel: level,
+			OccurTime:  switchFault.AlarmRaisedTime,
+		})
+	}
+}
+
+func collectDpuFaults(nodeName string, dpuCm *constant.DpuInfoCM, add func(historystore.FaultEvent)) {
+	if dpuCm == nil {
+		return
+	}
+	for _, dpu := range dpuCm.DPUList {
+		if dpu.Operstate != api.DpuStatusDown {
+			continue
+		}
+		add(historystore.FaultEvent{
+			Source:     SourceDpu,
+			NodeName:   nodeName,
+			DeviceId:   dpu.Name,
+			DeviceType: faultTypeDpu,
+			FaultCode:  dpuDownFaultCode,
+		})
+	}
+}
+
+// affectedJobs return the running jobs using the faulty device. the fault of the npu affects the rank on it only,
+// and the other faults affect all the ranks on the node
+func affectedJobs(jobs map[string]constant.JobInfo, event *historystore.FaultEvent) []historystore.AffectedJob {
+	wholeNode := event.DeviceType != constant.FaultTypeNPU || event.DeviceId == constant.EmptyDeviceId
+	var affected []historystore.AffectedJob
+	for _, jobInfo := range jobs {
+		if jobInfo.Status != jobstorage.StatusJobRunning {
+			continue
+		}
+		var rankIds []string
+		for _, server := range jobInfo.JobRankTable.ServerList {
+			if server.ServerName != event.NodeName {
+				continue
+			}
+			for _, device := range server.DeviceList {
+				if wholeNode || device.DeviceID == event.DeviceId {
+					rankIds = append(rankIds, device.RankID)
+				}
+			}
+		}
+		if len(rankIds) == 0 {
+			continue
+		}
+		affected = append(affected, historystore.AffectedJob{JobId: jobInfo.Key, JobName: jobInfo.Name,
+			Namespace: jobInfo.NameSpace, RankIds: rankIds})
+	}
+	sort.Slice(affected, func(i, j int) bool {
+		return affected[i].JobId < affected[j].JobId
+	})
+	return affected
+}
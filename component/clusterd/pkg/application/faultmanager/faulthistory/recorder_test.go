@@ -0,0 +1,141 @@
+/* Copyright(C) 2026. Huawei Technologies Co.,Ltd. All rights reserved.
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+// Package faulthistory test for the fault history recorder
+package faulthistory
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/agiledragon/gomonkey/v2"
+	"github.com/smartystreets/goconvey/convey"
+
+	"ascend-common/api"
+	"ascend-common/common-utils/hwlog"
+	"clusterd/pkg/common/constant"
+	historystore "clusterd/pkg/domain/faulthistory"
+	jobstorage "clusterd/pkg/domain/job"
+)
+
+const (
+	testNode      = "node1"
+	testOtherNode = "node2"
+	testCode      = "80E01801"
+	testJob       = "job1"
+	testFaultTime = 1771059600000 // 2026-02-14 09:00:00
+	testMaxEvents = 100
+)
+
+func init() {
+	if err := hwlog.InitRunLogger(&hwlog.LogConfig{OnlyToStdout: true}, context.Background()); err != nil {
+		fmt.Printf("init hwlog failed, %v\n", err)
+	}
+}
+
+func testDeviceCms() map[string]*constant.AdvanceDeviceFaultCm {
+	return map[string]*constant.AdvanceDeviceFaultCm{
+		constant.DeviceInfoPrefix + testNode: {FaultDeviceList: map[string][]constant.DeviceFault{
+			"Ascend910-1": {
+				{FaultCode: testCode, FaultLevel: constant.RestartRequest,
+					FaultTimeAndLevelMap: map[string]constant.FaultTimeAndLevel{testCode: {FaultTime: testFaultTime}}},
+				{FaultType: constant.PublicFaultType, FaultCode: "010001001", FaultLevel: constant.SeparateNPU},
+			},
+			"Ascend910-2": {{FaultLevel: constant.ManuallySeparateNPU}},
+		}},
+	}
+}
+
+func TestCollectFaults(t *testing.T) {
+	convey.Convey("Test collectFaults", t, func() {
+		nodeCms := map[string]*constant.NodeInfo{constant.NodeInfoPrefix + testNode: {NodeInfoNoName: constant.
+			NodeInfoNoName{FaultDevList: []*constant.FaultDev{{DeviceType: "CPU", FaultCode: []string{"1", "2"}}}}}}
+		switchCms := map[string]*constant.SwitchInfo{constant.SwitchInfoPrefix + testNode: {SwitchFaultInfo: constant.
+			SwitchFaultInfo{FaultInfo: []constant.SimpleSwitchFaultInfo{{AssembledFaultCode: "[0x1,0,na]",
+			SwitchChipId: 1, SwitchPortId: 2}}, FaultTimeAndLevelMap: map[string]constant.FaultTimeAndLevel{
+			"[0x1,0,na]_1_2": {FaultLevel: constant.SubHealthFault}}}}}
+		dpuCms := map[string]*constant.DpuInfoCM{constant.DeviceInfoPrefix + testNode: {DPUList: []constant.
+			DpuCMDataItem{{Name: "eth0", Operstate: api.DpuStatusDown}, {Name: "eth1", Operstate: "up"}}}}
+		faults := collectFaults(testDeviceCms(), nodeCms, switchCms, dpuCms)
+		convey.So(len(faults), convey.ShouldEqual, len([]string{"device", "public", "manual", "node1", "node2",
+			"switch", "dpu"}))
+		device := faults["device/node1/1/"+testCode]
+		convey.So(device.OccurTime, convey.ShouldEqual, testFaultTime)
+		convey.So(device.FaultLevel, convey.ShouldEqual, constant.RestartRequest)
+		convey.So(faults["public/node1/1/010001001"].FaultLevel, convey.ShouldEqual, constant.SeparateNPU)
+		convey.So(faults["manual/node1/2/"+constant.ManuallySeparateNPU].OccurTime, convey.ShouldBeGreaterThan, 0)
+		convey.So(faults["switch/node1/1_2/[0x1,0,na]"].FaultLevel, convey.ShouldEqual, constant.SubHealthFault)
+		convey.So(faults["dpu/node1/eth0/"+dpuDownFaultCode].DeviceType, convey.ShouldEqual, faultTypeDpu)
+		convey.So(faults["node/node1/0/2"].DeviceType, convey.ShouldEqual, "CPU")
+	})
+}
+
+func testJobs() map[string]constant.JobInfo {
+	rankTable := constant.RankTable{ServerList: []constant.ServerHccl{
+		{ServerName: testNode, DeviceList: []constant.Device{{DeviceID: "0", RankID: "0"},
+			{DeviceID: "1", RankID: "1"}}},
+		{ServerName: testOtherNode, DeviceList: []constant.Device{{DeviceID: "0", RankID: "2"}}},
+	}}
+	return map[string]constant.JobInfo{
+		testJob: {Key: testJob, Name: "job", NameSpace: "default", Status: jobstorage.StatusJobRunning,
+			JobRankTable: rankTable},
+		"job2": {Key: "job2", Status: jobstorage.StatusJobCompleted, JobRankTable: rankTable},
+	}
+}
+
+func TestAffectedJobs(t *testing.T) {
+	convey.Convey("Test affectedJobs", t, func() {
+		convey.Convey("01-npu fault, should return the rank on the npu", func() {
+			jobs := affectedJobs(testJobs(), &historystore.FaultEvent{NodeName: testNode, DeviceId: "1",
+				DeviceType: constant.FaultTypeNPU})
+			convey.So(jobs, convey.ShouldResemble, []historystore.AffectedJob{{JobId: testJob, JobName: "job",
+				Namespace: "default", RankIds: []string{"1"}}})
+		})
+		convey.Convey("02-switch fault, should return all the ranks on the node", func() {
+			jobs := affectedJobs(testJobs(), &historystore.FaultEvent{NodeName: testNode, DeviceId: "1_2",
+				DeviceType: constant.FaultTypeSwitch})
+			convey.So(jobs[0].RankIds, convey.ShouldResemble, []string{"0", "1"})
+		})
+		convey.Convey("03-node not used by the running job, should return nil", func() {
+			jobs := affectedJobs(testJobs(), &historystore.FaultEvent{NodeName: "node3",
+				DeviceId: constant.EmptyDeviceId, DeviceType: constant.FaultTypeNode})
+			convey.So(jobs, convey.ShouldBeNil)
+		})
+	})
+}
+
+func TestRecorder(t *testing.T) {
+	convey.Convey("Test recorder", t, func() {
+		r := &recorder{}
+		convey.Convey("01-not enabled, should do nothing and return error when query", func() {
+			r.Record()
+			_, err := r.Query(historystore.Filter{})
+			convey.So(err, convey.ShouldNotBeNil)
+		})
+		convey.Convey("02-enabled, should record the faults with the affected jobs", func() {
+			convey.So(r.Init(filepath.Join(t.TempDir(), "fault-history.db"), testMaxEvents), convey.ShouldBeNil)
+			defer r.Close()
+			patches := gomonkey.ApplyFuncReturn(collectFaults, collectFaults(testDeviceCms(), nil, nil, nil)).
+				ApplyFuncReturn(jobstorage.GetAllJobCache, testJobs())
+			defer patches.Reset()
+			r.Record()
+			events, err := r.Query(historystore.Filter{JobId: testJob})
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(len(events), convey.ShouldEqual, len([]string{"device", "public"}))
+			convey.So(events[0].Type, convey.ShouldEqual, historystore.EventOccur)
+		})
+	})
+}
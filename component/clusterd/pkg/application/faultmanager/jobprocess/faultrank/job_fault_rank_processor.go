@@ -10,6 +10,7 @@ import (
 	"strings"
 	"sync"
 
+	"k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
 
 	"ascend-common/common-utils/hwlog"
@@ -23,9 +24,14 @@ import (
 	"clusterd/pkg/domain/job"
 	"clusterd/pkg/domain/pod"
 	"clusterd/pkg/domain/podgroup"
+	"clusterd/pkg/domain/statistics"
 	"clusterd/pkg/interface/kube"
+	"clusterd/pkg/interface/metrics"
 )
 
+// jobHealthChangedReason the reason of the kubernetes event reported when the healthy state of the job changes
+const jobHealthChangedReason = "JobHealthChanged"
+
 // JobFaultRankProcessor process job fault rank
 var JobFaultRankProcessor *jobRankFaultInfoProcessor
 
@@ -126,6 +132,47 @@ func (processor *jobRankFaultInfoProcessor) setJobFaultRankInfos(faultInfos map[
 	processor.jobFaultInfoMap = faultInfos
 }
 
+// observeJobFaultChanges count the new faults of the jobs and report the kubernetes events when the healthy
+// state of the jobs changes
+func (processor *jobRankFaultInfoProcessor) observeJobFaultChanges(faultInfos map[string]constant.JobFaultInfo) {
+	processor.mutex.RLock()
+	defer processor.mutex.RUnlock()
+	for jobId, faultInfo := range faultInfos {
+		previous, ok := processor.jobFaultInfoMap[jobId]
+		if !ok {
+			previous = constant.JobFaultInfo{HealthyState: constant.HealthyState}
+		}
+		previousFaults := sets.NewString()
+		for _, fault := range previous.FaultList {
+			previousFaults.Insert(fault.RankId + "-" + fault.FaultCode)
+		}
+		// the fault of the node or switch is reported on all the ranks of the node, count it only once
+		countedFaults := sets.NewString()
+		for _, fault := range faultInfo.FaultList {
+			if previousFaults.Has(fault.RankId + "-" + fault.FaultCode) {
+				continue
+			}
+			faultKey := fmt.Sprintf("%s-%s-%d", fault.FaultCode, fault.NodeName, fault.FaultTime)
+			if countedFaults.Has(faultKey) {
+				continue
+			}
+			countedFaults.Insert(faultKey)
+			metrics.ObserveJobFault(fault.FaultCode, fault.FaultLevel)
+			statistics.JobStcMgrInst.AddJobFault(jobId, fault.FaultLevel)
+		}
+		if previous.HealthyState == faultInfo.HealthyState {
+			continue
+		}
+		eventType := v1.EventTypeWarning
+		if faultInfo.HealthyState == constant.HealthyState {
+			eventType = v1.EventTypeNormal
+		}
+		kube.RecordEvent(podgroup.GetJobObjectReference(jobId), eventType, jobHealthChangedReason,
+			fmt.Sprintf("job healthy state changed from %s to %s with %d fault ranks",
+				previous.HealthyState, faultInfo.HealthyState, len(faultInfo.FaultList)))
+	}
+}
+
 func (processor *jobRankFaultInfoProcessor) findFaultRankForJob(
 	advanceDeviceInfo *constant.AdvanceDeviceFaultCm, nodeName string,
 	serverList map[string]constant.ServerHccl, podInfo *jobPodInfoMap) []constant.FaultRank {
@@ -154,7 +201,8 @@ func (processor *jobRankFaultInfoProcessor) findFaultRankForJob(
 			}
 			faultRank := constant.FaultRank{RankId: deviceInfo.RankID, PodUid: podUid, PodRank: podRankStr,
 				FaultCode: fault.FaultCode, FaultLevel: fault.FaultLevel, DoStepRetry: false,
-				DoRestartInPlace: restartInPlace, DeviceId: deviceInfo.DeviceID,
+				DoRestartInPlace: restartInPlace, DeviceId: deviceInfo.DeviceID, NodeName: nodeName,
+				FaultTime: fault.FaultTimeAndLevelMap[fault.FaultCode].FaultTime,
 			}
 			if faultdomain.IsUceFault(fault.FaultCode) || faultdomain.IsHcclRetryFault(fault.FaultCode) {
 				retryInManagementPlane = true
@@ -172,6 +220,8 @@ func (processor *jobRankFaultInfoProcessor) findFaultRankForJob(
 				FaultLevel:  constant.RestartBusiness,
 				DoStepRetry: processor.canDoStepRetry(podInfo.jobId, nodeName, deviceName),
 				DeviceId:    deviceInfo.DeviceID,
+				NodeName:    nodeName,
+				FaultTime:   deviceDetail.FaultTime,
 			})
 		}
 	}
@@ -286,6 +336,7 @@ func (processor *jobRankFaultInfoProcessor) Process(info any) any {
 			allConfigmap.NodeCm, delSwitchCm, delDeviceCm, jobId)
 		deletedJobFaultDeviceMap[jobId] = deletedFaultDeviceList
 	}
+	processor.observeJobFaultChanges(jobFaultInfos)
 	processor.setJobFaultRankInfos(jobFaultInfos)
 	custom.FaultCache.SetDeletedJobFaultDeviceMap(deletedJobFaultDeviceMap)
 	return nil
@@ -409,6 +460,7 @@ func getFaultListInfoByRelationFault(jobId, nodeName string, server *constant.Se
 			}
 			faultRank.FaultCode = fault.FaultCode
 			faultRank.FaultLevel = convertRelationFaultLevel(fault.ExecutedStrategy)
+			faultRank.FaultTime = fault.FaultTime
 			faultList = append(faultList, faultRank)
 			continue
 		}
@@ -424,6 +476,7 @@ func getFaultListInfoByRelationFault(jobId, nodeName string, server *constant.Se
 			}
 			faultRank.FaultCode = fault.FaultCode
 			faultRank.FaultLevel = convertRelationFaultLevel(fault.ExecutedStrategy)
+			faultRank.FaultTime = fault.FaultTime
 			faultList = append(faultList, faultRank)
 		}
 	}
@@ -445,6 +498,7 @@ func buildFaultRankCache(server *constant.ServerHccl, podInfos *jobPodInfoMap) m
 			PodUid:   podUid,
 			PodRank:  podRankStr,
 			DeviceId: deviceInfo.DeviceID,
+			NodeName: server.ServerName,
 		}
 	}
 	return faultRankCache
@@ -575,6 +629,7 @@ func serverHcclToFaultRank(server constant.ServerHccl, podInfos *jobPodInfoMap,
 			FaultCode:   faultCode,
 			FaultLevel:  faultLevel,
 			DoStepRetry: false,
+			NodeName:    server.ServerName,
 		})
 	}
 	return faultRanks
@@ -21,6 +21,7 @@ import (
 	"clusterd/pkg/domain/pod"
 	"clusterd/pkg/domain/podgroup"
 	"clusterd/pkg/interface/kube"
+	"clusterd/pkg/interface/metrics"
 )
 
 const (
@@ -747,3 +748,46 @@ func TestGetFaultListInfoByRelationFault(t *testing.T) {
 		})
 	})
 }
+
+func TestObserveJobFaultChanges(t *testing.T) {
+	convey.Convey("Test observeJobFaultChanges", t, func() {
+		var faultCodes, eventTypes []string
+		patches := gomonkey.ApplyFunc(metrics.ObserveJobFault, func(faultCode, _ string) {
+			faultCodes = append(faultCodes, faultCode)
+		}).ApplyFunc(kube.RecordEvent, func(_ *v1.ObjectReference, eventType, _, _ string) {
+			eventTypes = append(eventTypes, eventType)
+		})
+		defer patches.Reset()
+		processor := &jobRankFaultInfoProcessor{jobFaultInfoMap: map[string]constant.JobFaultInfo{
+			jobId: {JobId: jobId, HealthyState: constant.UnHealthyState,
+				FaultList: []constant.FaultRank{{RankId: rankId0, FaultCode: constant.UceFaultCode}}},
+		}}
+		convey.Convey("01-new fault and the same state, should only count the new fault", func() {
+			processor.observeJobFaultChanges(map[string]constant.JobFaultInfo{
+				jobId: {JobId: jobId, HealthyState: constant.UnHealthyState, FaultList: []constant.FaultRank{
+					{RankId: rankId0, FaultCode: constant.UceFaultCode}, {RankId: rankId1, FaultCode: "81078603"}}},
+			})
+			convey.So(faultCodes, convey.ShouldResemble, []string{"81078603"})
+			convey.So(len(eventTypes), convey.ShouldEqual, 0)
+		})
+		convey.Convey("02-job back to healthy, should report the normal event", func() {
+			processor.observeJobFaultChanges(map[string]constant.JobFaultInfo{
+				jobId: {JobId: jobId, HealthyState: constant.HealthyState},
+			})
+			convey.So(len(faultCodes), convey.ShouldEqual, 0)
+			convey.So(eventTypes, convey.ShouldResemble, []string{v1.EventTypeNormal})
+		})
+		convey.Convey("03-node fault on all the ranks of the node, should count it once", func() {
+			nodeFault := constant.FaultRank{FaultCode: "81078603", NodeName: nodeName, FaultTime: 1}
+			faultList := make([]constant.FaultRank, 0)
+			for _, rank := range []string{rankId0, rankId1} {
+				nodeFault.RankId = rank
+				faultList = append(faultList, nodeFault)
+			}
+			processor.observeJobFaultChanges(map[string]constant.JobFaultInfo{
+				jobId: {JobId: jobId, HealthyState: constant.UnHealthyState, FaultList: faultList},
+			})
+			convey.So(faultCodes, convey.ShouldResemble, []string{"81078603"})
+		})
+	})
+}
@@ -0,0 +1,122 @@
+/* Copyright(C) 2026. Huawei Technologies Co.,Ltd. All rights reserved.
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package simulation
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"sync"
+	"time"
+
+	"k8s.io/api/core/v1"
+
+	"ascend-common/common-utils/hwlog"
+)
+
+const (
+	// SimulateFlag the flag of clusterd to run the simulation on the snapshot files and exit
+	SimulateFlag = "simulate"
+	// SimulateOutputFlag the flag of clusterd to write the simulation result to the file
+	SimulateOutputFlag = "simulateOutput"
+	// StdinFile the snapshot file name of reading the snapshot from the stdin
+	StdinFile = "-"
+
+	simulateTimeout = time.Minute
+	maxResultBytes  = 64 * 1024 * 1024
+	maxStderrBytes  = 64 * 1024
+	// maxSubprocessSnapshotBytes the subprocess holds another copy of the snapshot within the memory limit of the
+	// serving clusterd, so the snapshot passed to it is smaller than the one of the offline simulation
+	maxSubprocessSnapshotBytes = 64 * 1024 * 1024
+	// subprocessMemoryLimit the soft memory limit of the subprocess, the gc runs more often when it is reached
+	subprocessMemoryLimit = "GOMEMLIMIT=256MiB"
+	errorLogLevel         = "2"
+)
+
+// ErrBusy is returned when another simulation is running
+var ErrBusy = errors.New("another simulation is running")
+
+var simulateLock sync.Mutex
+
+// Simulate run the simulation of the configmaps on the current jobs. the processors hold the global states, so the
+// simulation runs in a clusterd subprocess and the serving one is never touched. the snapshot is passed by the
+// stdin of the subprocess and the result is read from its stdout, so nothing is written to the file system. the
+// snapshot is returned as well
+func Simulate(ctx context.Context, configMaps []v1.ConfigMap) (*Result, []byte, error) {
+	if !simulateLock.TryLock() {
+		return nil, nil, ErrBusy
+	}
+	defer simulateLock.Unlock()
+	snapshotData, err := json.Marshal(BuildSnapshot(configMaps))
+	if err != nil {
+		return nil, nil, fmt.Errorf("marshal snapshot failed: %v", err)
+	}
+	if len(snapshotData) > maxSubprocessSnapshotBytes {
+		return nil, nil, fmt.Errorf("snapshot of %d bytes exceeds %d bytes, run the simulation offline instead",
+			len(snapshotData), maxSubprocessSnapshotBytes)
+	}
+	data, err := runSubprocess(ctx, snapshotData)
+	if err != nil {
+		return nil, nil, err
+	}
+	result := &Result{}
+	if err = json.Unmarshal(data, result); err != nil {
+		return nil, nil, fmt.Errorf("unmarshal simulation result failed: %v", err)
+	}
+	return result, snapshotData, nil
+}
+
+func runSubprocess(ctx context.Context, snapshotData []byte) ([]byte, error) {
+	binary, err := os.Executable()
+	if err != nil {
+		return nil, fmt.Errorf("get clusterd executable failed: %v", err)
+	}
+	ctx, cancel := context.WithTimeout(ctx, simulateTimeout)
+	defer cancel()
+	cmd := exec.CommandContext(ctx, binary, "-"+SimulateFlag, StdinFile, "-logLevel", errorLogLevel)
+	cmd.Env = append(os.Environ(), subprocessMemoryLimit)
+	cmd.Stdin = bytes.NewReader(snapshotData)
+	stdout := &limitedBuffer{limit: maxResultBytes}
+	stderr := &limitedBuffer{limit: maxStderrBytes}
+	cmd.Stdout, cmd.Stderr = stdout, stderr
+	if err = cmd.Run(); err != nil {
+		hwlog.RunLog.Errorf("simulation subprocess failed, output: %s", stderr.String())
+		return nil, fmt.Errorf("run simulation failed: %v", err)
+	}
+	if stdout.exceeded {
+		return nil, fmt.Errorf("simulation result exceeds %d bytes", maxResultBytes)
+	}
+	return stdout.Bytes(), nil
+}
+
+// limitedBuffer keep the output up to the limit and discard the rest, so the subprocess is never blocked
+type limitedBuffer struct {
+	bytes.Buffer
+	limit    int
+	exceeded bool
+}
+
+// Write keep p when the buffer is not full
+func (b *limitedBuffer) Write(p []byte) (int, error) {
+	if b.exceeded || b.Len()+len(p) > b.limit {
+		b.exceeded = true
+		return len(p), nil
+	}
+	return b.Buffer.Write(p)
+}
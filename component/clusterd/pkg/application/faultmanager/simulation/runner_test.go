@@ -0,0 +1,95 @@
+/* Copyright(C) 2026. Huawei Technologies Co.,Ltd. All rights reserved.
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+// Package simulation test for the simulation runner
+package simulation
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/agiledragon/gomonkey/v2"
+	"github.com/smartystreets/goconvey/convey"
+	"k8s.io/api/core/v1"
+)
+
+func TestSimulate(t *testing.T) {
+	convey.Convey("Test Simulate", t, func() {
+		patches := gomonkey.ApplyFuncReturn(BuildSnapshot, testSnapshot())
+		defer patches.Reset()
+		configMaps := []v1.ConfigMap{testDeviceCm()}
+		convey.Convey("01-subprocess succeed, should return the result and the snapshot", func() {
+			patches.ApplyFunc(runSubprocess, func(_ context.Context, snapshotData []byte) ([]byte, error) {
+				snapshot := &Snapshot{}
+				if err := json.Unmarshal(snapshotData, snapshot); err != nil {
+					return nil, err
+				}
+				return json.Marshal(Result{Jobs: []JobResult{{JobId: testJobKey,
+					Strategy: snapshot.Jobs[testJobKey].Name}}})
+			})
+			result, snapshot, err := Simulate(context.Background(), configMaps)
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(result.Jobs[0].Strategy, convey.ShouldEqual, testJobName)
+			convey.So(len(snapshot), convey.ShouldBeGreaterThan, 0)
+		})
+		convey.Convey("02-subprocess failed, should return error", func() {
+			patches.ApplyFuncReturn(runSubprocess, nil, errors.New("exit status 1"))
+			_, _, err := Simulate(context.Background(), configMaps)
+			convey.So(err, convey.ShouldNotBeNil)
+		})
+		convey.Convey("03-another simulation running, should return busy", func() {
+			simulateLock.Lock()
+			defer simulateLock.Unlock()
+			_, _, err := Simulate(context.Background(), configMaps)
+			convey.So(err, convey.ShouldEqual, ErrBusy)
+		})
+	})
+}
+
+func TestLimitedBuffer(t *testing.T) {
+	convey.Convey("Test limitedBuffer, the output exceeding the limit should be discarded", t, func() {
+		const limit = 4
+		buffer := &limitedBuffer{limit: limit}
+		n, err := buffer.Write([]byte("abc"))
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(n, convey.ShouldEqual, len("abc"))
+		n, err = buffer.Write([]byte("de"))
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(n, convey.ShouldEqual, len("de"))
+		convey.So(buffer.exceeded, convey.ShouldBeTrue)
+		convey.So(buffer.String(), convey.ShouldEqual, "abc")
+	})
+}
+
+func TestReadSnapshotFromStdin(t *testing.T) {
+	convey.Convey("Test LoadSnapshots, should read the snapshot from the stdin", t, func() {
+		data, err := json.Marshal(testSnapshot())
+		convey.So(err, convey.ShouldBeNil)
+		stdinFile := filepath.Join(t.TempDir(), "stdin")
+		convey.So(os.WriteFile(stdinFile, data, resultFileMode), convey.ShouldBeNil)
+		stdin, err := os.Open(stdinFile)
+		convey.So(err, convey.ShouldBeNil)
+		defer stdin.Close()
+		original := os.Stdin
+		os.Stdin = stdin
+		defer func() { os.Stdin = original }()
+		snapshot, err := LoadSnapshots([]string{StdinFile})
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(snapshot.Jobs[testJobKey].Name, convey.ShouldEqual, testJobName)
+	})
+}
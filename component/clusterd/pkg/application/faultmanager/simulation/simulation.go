@@ -0,0 +1,263 @@
+/* Copyright(C) 2026. Huawei Technologies Co.,Ltd. All rights reserved.
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package simulation
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"slices"
+	"sort"
+	"strings"
+
+	"k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"ascend-common/api"
+	"ascend-common/common-utils/hwlog"
+	"clusterd/pkg/application/conf"
+	"clusterd/pkg/application/faultmanager"
+	"clusterd/pkg/application/faultmanager/jobprocess/faultrank"
+	"clusterd/pkg/common/constant"
+	"clusterd/pkg/domain/common"
+	"clusterd/pkg/domain/device"
+	"clusterd/pkg/domain/job"
+	"clusterd/pkg/domain/node"
+	"clusterd/pkg/domain/pod"
+	"clusterd/pkg/domain/podgroup"
+	"clusterd/pkg/domain/switchinfo"
+	"clusterd/pkg/interface/kube"
+)
+
+const resultFileMode = 0600
+
+// Result the fault handling of the jobs affected by the faults
+type Result struct {
+	Jobs []JobResult `json:"jobs"`
+}
+
+// JobResult the fault ranks and the recover strategy chosen for the job
+type JobResult struct {
+	JobId        string                 `json:"jobId"`
+	JobName      string                 `json:"jobName"`
+	Namespace    string                 `json:"namespace"`
+	HealthyState string                 `json:"healthyState"`
+	Strategy     string                 `json:"strategy"`
+	FaultRanks   []constant.FaultRank   `json:"faultRanks"`
+	FaultDevices []constant.FaultDevice `json:"faultDevices"`
+}
+
+// Run the fault centers and the job center on the snapshot. the kube client is replaced by the in-memory one and the
+// caches are overwritten, so it must run in a standalone process rather than the serving clusterd
+func Run(snapshot *Snapshot) (*Result, error) {
+	if snapshot == nil {
+		return nil, fmt.Errorf("snapshot is nil")
+	}
+	// nothing is read from or written to the cluster
+	kube.InitClientK8sWith(fake.NewSimpleClientset(snapshotObjects(snapshot)...))
+	for i := range snapshot.PodGroups {
+		podgroup.SavePodGroup(&snapshot.PodGroups[i])
+	}
+	for i := range snapshot.Pods {
+		pod.SavePod(&snapshot.Pods[i])
+	}
+	// the pod groups are required to save the job cache
+	for key, jobInfo := range snapshot.Jobs {
+		job.SaveJobCache(key, jobInfo)
+	}
+	if hasConfigMap(snapshot, constant.ConfigCmName) {
+		conf.TryLoadGlobalConfig()
+	}
+	for i := range snapshot.ConfigMaps {
+		if err := collectConfigMap(&snapshot.ConfigMaps[i]); err != nil {
+			return nil, err
+		}
+	}
+	faultmanager.GlobalFaultProcessCenter.Process()
+	return buildResult(snapshot), nil
+}
+
+// RunFiles run the simulation on the snapshot files and write the result to the output file, the result is printed
+// when the output file is empty
+func RunFiles(files []string, output string) error {
+	snapshot, err := LoadSnapshots(files)
+	if err != nil {
+		return err
+	}
+	result, err := Run(snapshot)
+	if err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshal simulation result failed: %v", err)
+	}
+	if output == "" {
+		fmt.Println(string(data))
+		return nil
+	}
+	if err = os.WriteFile(output, data, resultFileMode); err != nil {
+		return fmt.Errorf("write simulation result failed: %v", err)
+	}
+	return nil
+}
+
+// snapshotObjects return the objects served by the in-memory client, the nodes of the pods and the jobs are
+// regarded as ready when they are not in the snapshot
+func snapshotObjects(snapshot *Snapshot) []runtime.Object {
+	objects := make([]runtime.Object, 0, len(snapshot.ConfigMaps)+len(snapshot.Pods)+len(snapshot.Nodes))
+	nodeNames := make(map[string]struct{}, len(snapshot.Nodes))
+	for i := range snapshot.Nodes {
+		nodeNames[snapshot.Nodes[i].Name] = struct{}{}
+		objects = append(objects, &snapshot.Nodes[i])
+	}
+	addReadyNode := func(nodeName string) {
+		if _, ok := nodeNames[nodeName]; ok || nodeName == "" {
+			return
+		}
+		nodeNames[nodeName] = struct{}{}
+		objects = append(objects, readyNode(nodeName))
+	}
+	for i := range snapshot.Pods {
+		objects = append(objects, &snapshot.Pods[i])
+		addReadyNode(snapshot.Pods[i].Spec.NodeName)
+	}
+	for _, jobInfo := range snapshot.Jobs {
+		for _, server := range jobInfo.PreServerList {
+			addReadyNode(server.ServerName)
+		}
+	}
+	for i := range snapshot.ConfigMaps {
+		objects = append(objects, &snapshot.ConfigMaps[i])
+	}
+	return objects
+}
+
+func readyNode(name string) *v1.Node {
+	return &v1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Status: v1.NodeStatus{Conditions: []v1.NodeCondition{
+			{Type: v1.NodeReady, Status: v1.ConditionTrue},
+		}},
+	}
+}
+
+func hasConfigMap(snapshot *Snapshot, name string) bool {
+	for _, cm := range snapshot.ConfigMaps {
+		if cm.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// collectConfigMap hand the fault configmap to the collectors as the informer does
+func collectConfigMap(cm *v1.ConfigMap) error {
+	switch {
+	case strings.HasPrefix(cm.Name, constant.DeviceInfoPrefix):
+		devInfo, err := device.ParseDeviceInfoCM(cm)
+		if err != nil {
+			return fmt.Errorf("parse device info cm %s failed: %v", cm.Name, err)
+		}
+		faultmanager.DeviceInfoCollector(nil, devInfo, constant.AddOperator)
+		if _, ok := cm.Data[api.SwitchInfoCMDataKey]; !ok {
+			return nil
+		}
+		switchInfo, err := switchinfo.ParseSwitchInfoCM(cm)
+		if err != nil {
+			return fmt.Errorf("parse switch info cm %s failed: %v", cm.Name, err)
+		}
+		faultmanager.SwitchInfoCollector(nil, switchInfo, constant.AddOperator)
+	case strings.HasPrefix(cm.Name, constant.NodeInfoPrefix):
+		nodeInfo, err := node.ParseNodeInfoCM(cm)
+		if err != nil {
+			return fmt.Errorf("parse node info cm %s failed: %v", cm.Name, err)
+		}
+		faultmanager.NodeCollector(nil, nodeInfo, constant.AddOperator)
+	case cm.Name == constant.ConfigCmName:
+		// the clusterd config has been loaded before collecting the faults
+	default:
+		hwlog.RunLog.Warnf("cm %s is neither device info nor node info, ignore it", cm.Name)
+	}
+	return nil
+}
+
+func buildResult(snapshot *Snapshot) *Result {
+	result := &Result{Jobs: make([]JobResult, 0)}
+	for jobId, faultInfo := range faultrank.JobFaultRankProcessor.GetJobFaultRankInfos() {
+		if len(faultInfo.FaultList) == 0 && len(faultInfo.FaultDevice) == 0 {
+			continue
+		}
+		jobInfo := snapshot.Jobs[jobId]
+		pg := podgroup.GetPodGroup(jobId)
+		config := common.GetRecoverConfigByPG(&pg)
+		result.Jobs = append(result.Jobs, JobResult{
+			JobId:        jobId,
+			JobName:      jobInfo.Name,
+			Namespace:    jobInfo.NameSpace,
+			HealthyState: faultInfo.HealthyState,
+			Strategy:     predictStrategy(faultInfo, config),
+			FaultRanks:   faultInfo.FaultList,
+			FaultDevices: faultInfo.FaultDevice,
+		})
+	}
+	sort.Slice(result.Jobs, func(i, j int) bool {
+		return result.Jobs[i].JobId < result.Jobs[j].JobId
+	})
+	return result
+}
+
+// predictStrategy predict the strategy chosen by the recover controller for the first fault of the job. the agent
+// of the job is assumed to support all the strategies configured, and the platform to agree with the controller
+func predictStrategy(faultInfo constant.JobFaultInfo, config common.RecoverConfig) string {
+	if len(faultInfo.FaultList) == 0 {
+		return ""
+	}
+	if faultInfo.HealthyState == constant.SubHealthyState {
+		if config.SubHealthyStrategy == "" {
+			return constant.SubHealthyIngore
+		}
+		return config.SubHealthyStrategy
+	}
+	configured := func(strategy string) bool {
+		return slices.Contains(config.MindXConfigStrategies, strategy)
+	}
+	if !config.ProcessRecoverEnable {
+		for _, strategy := range []string{constant.PodReschedulingStrategyName, constant.JobReschedulingStrategyName} {
+			if configured(strategy) {
+				return strategy
+			}
+		}
+		return constant.ProcessExitStrategyName
+	}
+	retryOnly, restartInPlace, rankZeroFault := true, true, false
+	for _, faultRank := range faultInfo.FaultList {
+		retryOnly = retryOnly && faultRank.DoStepRetry
+		restartInPlace = restartInPlace && faultRank.DoRestartInPlace
+		rankZeroFault = rankZeroFault || faultRank.PodRank == constant.RankZeroNodeId
+	}
+	return common.ChooseFirstStrategy(retryOnly, func(strategy string) bool {
+		switch strategy {
+		case constant.ProcessRecoverInPlaceStrategyName:
+			return restartInPlace && configured(strategy)
+		case constant.ScaleInStrategyName:
+			return !rankZeroFault && configured(constant.ElasticTrainingStrategyName)
+		default:
+			return configured(strategy)
+		}
+	})
+}
@@ -0,0 +1,166 @@
+/* Copyright(C) 2026. Huawei Technologies Co.,Ltd. All rights reserved.
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+// Package simulation test for the fault simulation
+package simulation
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/goconvey/convey"
+	"k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+
+	"ascend-common/api"
+	"ascend-common/common-utils/hwlog"
+	"clusterd/pkg/common/constant"
+	"clusterd/pkg/common/util"
+	"clusterd/pkg/domain/common"
+)
+
+const (
+	testJobKey    = "job1-uid"
+	testJobName   = "job1"
+	testNamespace = "default"
+	testNode      = "node1"
+	testFaultCode = "80E01801"
+	testRankId    = "1"
+)
+
+func init() {
+	if err := hwlog.InitRunLogger(&hwlog.LogConfig{OnlyToStdout: true}, context.Background()); err != nil {
+		fmt.Printf("init hwlog failed, %v\n", err)
+	}
+}
+
+func testDeviceCm() v1.ConfigMap {
+	faults := fmt.Sprintf(`[{"fault_type":"CardUnhealthy","npu_name":"Ascend910-1","fault_code":"%s",`+
+		`"fault_level":"SeparateNPU","fault_time_and_level_map":{"%s":{"fault_time":%d,"fault_level":"SeparateNPU"}}}]`,
+		testFaultCode, testFaultCode, time.Now().UnixMilli())
+	deviceInfo := constant.DeviceInfoNoName{
+		DeviceList: map[string]string{
+			api.ResourceNamePrefix + api.Ascend910:                         "Ascend910-0",
+			api.ResourceNamePrefix + api.Ascend910 + api.CmFaultListSuffix: faults,
+		},
+		UpdateTime: time.Now().Unix(),
+	}
+	devInfoCM := constant.DeviceInfoCM{DeviceInfo: deviceInfo, CheckCode: util.MakeDataHash(deviceInfo)}
+	return v1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: constant.DeviceInfoPrefix + testNode, Namespace: api.KubeNS},
+		Data:       map[string]string{api.DeviceInfoCMDataKey: util.ObjToString(devInfoCM)},
+	}
+}
+
+func testSnapshot() *Snapshot {
+	isController := true
+	return &Snapshot{
+		ConfigMaps: []v1.ConfigMap{testDeviceCm()},
+		Jobs: map[string]constant.JobInfo{testJobKey: {
+			Key: testJobKey, Name: testJobName, NameSpace: testNamespace, PgName: testJobName,
+			Status: "running",
+			PreServerList: []constant.ServerHccl{{ServerName: testNode, DeviceList: []constant.Device{
+				{DeviceID: "0", RankID: "0"}, {DeviceID: "1", RankID: testRankId}}}},
+		}},
+		PodGroups: []v1beta1.PodGroup{{ObjectMeta: metav1.ObjectMeta{
+			Name: testJobName, Namespace: testNamespace,
+			Labels:          map[string]string{constant.ProcessRecoverEnableLabel: constant.ProcessRecoverEnable},
+			Annotations:     map[string]string{constant.RecoverStrategies: constant.ProcessRecoverStrategyName},
+			OwnerReferences: []metav1.OwnerReference{{Name: testJobName, UID: testJobKey, Controller: &isController}},
+		}}},
+	}
+}
+
+func TestRun(t *testing.T) {
+	convey.Convey("Test Run, device fault on the node of the job, should return the fault rank and strategy",
+		t, func() {
+			result, err := Run(testSnapshot())
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(len(result.Jobs), convey.ShouldEqual, 1)
+			jobResult := result.Jobs[0]
+			convey.So(jobResult.JobId, convey.ShouldEqual, testJobKey)
+			convey.So(jobResult.JobName, convey.ShouldEqual, testJobName)
+			convey.So(len(jobResult.FaultRanks), convey.ShouldBeGreaterThan, 0)
+			convey.So(jobResult.FaultRanks[0].RankId, convey.ShouldEqual, testRankId)
+			convey.So(jobResult.FaultRanks[0].FaultCode, convey.ShouldEqual, testFaultCode)
+			convey.So(jobResult.Strategy, convey.ShouldEqual, constant.ProcessRecoverStrategyName)
+		})
+}
+
+func TestRunFiles(t *testing.T) {
+	convey.Convey("Test RunFiles", t, func() {
+		dir := t.TempDir()
+		convey.Convey("01-snapshot file not exist, should return error", func() {
+			convey.So(RunFiles([]string{filepath.Join(dir, "not-exist.json")}, ""), convey.ShouldNotBeNil)
+		})
+		convey.Convey("02-valid snapshot, should write the result", func() {
+			data, err := json.Marshal(testSnapshot())
+			convey.So(err, convey.ShouldBeNil)
+			snapshotFile := filepath.Join(dir, "snapshot.json")
+			convey.So(os.WriteFile(snapshotFile, data, resultFileMode), convey.ShouldBeNil)
+			resultFile := filepath.Join(dir, "result.json")
+			convey.So(RunFiles([]string{snapshotFile}, resultFile), convey.ShouldBeNil)
+			resultData, err := os.ReadFile(resultFile)
+			convey.So(err, convey.ShouldBeNil)
+			result := &Result{}
+			convey.So(json.Unmarshal(resultData, result), convey.ShouldBeNil)
+			convey.So(len(result.Jobs), convey.ShouldEqual, 1)
+		})
+	})
+}
+
+func TestPredictStrategy(t *testing.T) {
+	convey.Convey("Test predictStrategy", t, func() {
+		faultInfo := constant.JobFaultInfo{HealthyState: constant.UnHealthyState,
+			FaultList: []constant.FaultRank{{PodRank: "1", DoStepRetry: true, DoRestartInPlace: true}}}
+		strategies := []string{constant.ProcessRetryStrategyName, constant.ProcessRecoverInPlaceStrategyName,
+			constant.ProcessRecoverStrategyName, constant.ElasticTrainingStrategyName}
+		config := common.RecoverConfig{ProcessRecoverEnable: true, MindXConfigStrategies: strategies}
+		convey.Convey("01-no fault rank, should return empty", func() {
+			convey.So(predictStrategy(constant.JobFaultInfo{}, config), convey.ShouldBeEmpty)
+		})
+		convey.Convey("02-retry fault, should choose retry", func() {
+			convey.So(predictStrategy(faultInfo, config), convey.ShouldEqual, constant.ProcessRetryStrategyName)
+		})
+		convey.Convey("03-restart in place fault, should choose recover in place", func() {
+			faultInfo.FaultList[0].DoStepRetry = false
+			convey.So(predictStrategy(faultInfo, config), convey.ShouldEqual,
+				constant.ProcessRecoverInPlaceStrategyName)
+		})
+		convey.Convey("04-recover not configured, should scale in when rank 0 is fine", func() {
+			faultInfo.FaultList[0] = constant.FaultRank{PodRank: "1"}
+			config.MindXConfigStrategies = []string{constant.ElasticTrainingStrategyName}
+			convey.So(predictStrategy(faultInfo, config), convey.ShouldEqual, constant.ScaleInStrategyName)
+			faultInfo.FaultList[0].PodRank = constant.RankZeroNodeId
+			convey.So(predictStrategy(faultInfo, config), convey.ShouldEqual, constant.ProcessExitStrategyName)
+		})
+		convey.Convey("05-process recover disabled, should choose the rescheduling", func() {
+			config.ProcessRecoverEnable = false
+			config.MindXConfigStrategies = []string{constant.JobReschedulingStrategyName}
+			convey.So(predictStrategy(faultInfo, config), convey.ShouldEqual, constant.JobReschedulingStrategyName)
+		})
+		convey.Convey("06-sub healthy, should choose the sub healthy strategy", func() {
+			faultInfo.HealthyState = constant.SubHealthyState
+			convey.So(predictStrategy(faultInfo, config), convey.ShouldEqual, constant.SubHealthyIngore)
+			config.SubHealthyStrategy = constant.SubHealthyGraceExit
+			convey.So(predictStrategy(faultInfo, config), convey.ShouldEqual, constant.SubHealthyGraceExit)
+		})
+	})
+}
@@ -0,0 +1,126 @@
+/* Copyright(C) 2026. Huawei Technologies Co.,Ltd. All rights reserved.
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+// Package simulation simulate the fault handling of the hypothetical faults without publishing anything
+package simulation
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"k8s.io/api/core/v1"
+	"sigs.k8s.io/yaml"
+	"volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+
+	"ascend-common/api"
+	"ascend-common/common-utils/hwlog"
+	"ascend-common/common-utils/utils"
+	"clusterd/pkg/common/constant"
+	"clusterd/pkg/domain/job"
+	"clusterd/pkg/domain/pod"
+	"clusterd/pkg/domain/podgroup"
+	"clusterd/pkg/interface/kube"
+)
+
+const maxSnapshotBytes = 200 * 1024 * 1024
+
+// Snapshot the input of the simulation, it is read from the json or yaml files
+type Snapshot struct {
+	// ConfigMaps the device info and node info configmaps of the hypothetical faults, the nodes without configmap
+	// are fault-free. the clusterd config configmap is loaded as well when it is present
+	ConfigMaps []v1.ConfigMap              `json:"configMaps,omitempty"`
+	Jobs       map[string]constant.JobInfo `json:"jobs,omitempty"`
+	Pods       []v1.Pod                    `json:"pods,omitempty"`
+	PodGroups  []v1beta1.PodGroup          `json:"podGroups,omitempty"`
+	// Nodes the k8s nodes, the nodes used by the jobs but not present are regarded as ready
+	Nodes []v1.Node `json:"nodes,omitempty"`
+}
+
+// LoadSnapshots load and merge the snapshot files, so the jobs and the faults can be kept in different files
+func LoadSnapshots(files []string) (*Snapshot, error) {
+	snapshot := &Snapshot{}
+	for _, file := range files {
+		data, err := readSnapshotFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("read snapshot file %s failed: %v", file, err)
+		}
+		var part Snapshot
+		if err = yaml.Unmarshal(data, &part); err != nil {
+			return nil, fmt.Errorf("unmarshal snapshot file %s failed: %v", file, err)
+		}
+		snapshot.merge(&part)
+	}
+	return snapshot, nil
+}
+
+// readSnapshotFile read the snapshot file, or the stdin when the file is StdinFile
+func readSnapshotFile(file string) ([]byte, error) {
+	if file != StdinFile {
+		return utils.ReadLimitBytes(file, maxSnapshotBytes)
+	}
+	data, err := io.ReadAll(io.LimitReader(os.Stdin, maxSnapshotBytes+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(data) > maxSnapshotBytes {
+		return nil, fmt.Errorf("snapshot exceeds %d bytes", maxSnapshotBytes)
+	}
+	return data, nil
+}
+
+func (s *Snapshot) merge(other *Snapshot) {
+	s.ConfigMaps = append(s.ConfigMaps, other.ConfigMaps...)
+	s.Pods = append(s.Pods, other.Pods...)
+	s.PodGroups = append(s.PodGroups, other.PodGroups...)
+	s.Nodes = append(s.Nodes, other.Nodes...)
+	if len(other.Jobs) > 0 && s.Jobs == nil {
+		s.Jobs = make(map[string]constant.JobInfo, len(other.Jobs))
+	}
+	for key, jobInfo := range other.Jobs {
+		s.Jobs[key] = jobInfo
+	}
+}
+
+// BuildSnapshot build the snapshot of the configmaps with the current jobs, pods, pod groups, nodes and the
+// clusterd config of the cluster
+func BuildSnapshot(configMaps []v1.ConfigMap) *Snapshot {
+	snapshot := &Snapshot{
+		ConfigMaps: configMaps,
+		Jobs:       job.GetAllJobCache(),
+	}
+	nodeNames := make(map[string]struct{})
+	for jobKey := range snapshot.Jobs {
+		for _, jobPod := range pod.GetPodByJobId(jobKey) {
+			snapshot.Pods = append(snapshot.Pods, jobPod)
+			nodeNames[jobPod.Spec.NodeName] = struct{}{}
+		}
+		if pg := podgroup.GetPodGroup(jobKey); pg.Name != "" {
+			snapshot.PodGroups = append(snapshot.PodGroups, pg)
+		}
+	}
+	for nodeName := range nodeNames {
+		if node := kube.GetNode(nodeName); node != nil {
+			snapshot.Nodes = append(snapshot.Nodes, *node)
+		}
+	}
+	configCm, err := kube.GetConfigMap(constant.ConfigCmName, api.ClusterNS)
+	if err != nil {
+		hwlog.RunLog.Warnf("get cm <%s/%s> failed, simulate with the default config, error: %v",
+			api.ClusterNS, constant.ConfigCmName, err)
+		return snapshot
+	}
+	snapshot.ConfigMaps = append(snapshot.ConfigMaps, *configCm)
+	return snapshot
+}
@@ -0,0 +1,79 @@
+/* Copyright(C) 2026. Huawei Technologies Co.,Ltd. All rights reserved.
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+// Package simulation test for the snapshot
+package simulation
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/agiledragon/gomonkey/v2"
+	"github.com/smartystreets/goconvey/convey"
+	"k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"clusterd/pkg/common/constant"
+	"clusterd/pkg/domain/job"
+	"clusterd/pkg/domain/pod"
+	"clusterd/pkg/interface/kube"
+)
+
+const (
+	testJobsYaml = `
+jobs:
+  job1-uid:
+    Name: job1
+    NameSpace: default
+`
+	testFaultsJson = `{"configMaps":[{"metadata":{"name":"mindx-dl-deviceinfo-node1"}}]}`
+)
+
+func TestLoadSnapshots(t *testing.T) {
+	convey.Convey("Test LoadSnapshots", t, func() {
+		dir := t.TempDir()
+		jobsFile, faultsFile := filepath.Join(dir, "jobs.yaml"), filepath.Join(dir, "faults.json")
+		convey.So(os.WriteFile(jobsFile, []byte(testJobsYaml), resultFileMode), convey.ShouldBeNil)
+		convey.So(os.WriteFile(faultsFile, []byte(testFaultsJson), resultFileMode), convey.ShouldBeNil)
+		convey.Convey("01-yaml and json files, should merge them", func() {
+			snapshot, err := LoadSnapshots([]string{jobsFile, faultsFile})
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(snapshot.Jobs[testJobKey].Name, convey.ShouldEqual, testJobName)
+			convey.So(snapshot.ConfigMaps[0].Name, convey.ShouldEqual, constant.DeviceInfoPrefix+testNode)
+		})
+		convey.Convey("02-invalid file, should return error", func() {
+			invalidFile := filepath.Join(dir, "invalid.yaml")
+			convey.So(os.WriteFile(invalidFile, []byte("jobs: [invalid"), resultFileMode), convey.ShouldBeNil)
+			_, err := LoadSnapshots([]string{jobsFile, invalidFile})
+			convey.So(err, convey.ShouldNotBeNil)
+		})
+	})
+}
+
+func TestBuildSnapshot(t *testing.T) {
+	convey.Convey("Test BuildSnapshot, should snapshot the jobs with their pods and nodes", t, func() {
+		jobPod := v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "pod1"}, Spec: v1.PodSpec{NodeName: testNode}}
+		patches := gomonkey.ApplyFuncReturn(job.GetAllJobCache, testSnapshot().Jobs).
+			ApplyFuncReturn(pod.GetPodByJobId, map[string]v1.Pod{jobPod.Name: jobPod}).
+			ApplyFuncReturn(kube.GetNode, readyNode(testNode)).
+			ApplyFuncReturn(kube.GetConfigMap, nil, errors.New("not found"))
+		defer patches.Reset()
+		snapshot := BuildSnapshot([]v1.ConfigMap{testDeviceCm()})
+		convey.So(len(snapshot.ConfigMaps), convey.ShouldEqual, 1)
+		convey.So(snapshot.Pods[0].Name, convey.ShouldEqual, jobPod.Name)
+		convey.So(snapshot.Nodes[0].Name, convey.ShouldEqual, testNode)
+	})
+}
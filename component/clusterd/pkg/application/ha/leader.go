@@ -0,0 +1,160 @@
+/* Copyright(C) 2026. Huawei Technologies Co.,Ltd. All rights reserved.
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+// Package ha the active/standby high availability of clusterd. the replicas elect the leader by the kubernetes
+// lease, only the leader processes the faults and serves the grpc services, the standby replicas take over
+// through the persisted caches after the leader is lost
+package ha
+
+import (
+	"context"
+	"errors"
+	"net"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/leaderelection"
+	"k8s.io/client-go/tools/leaderelection/resourcelock"
+
+	"ascend-common/common-utils/hwlog"
+	"clusterd/pkg/common/constant"
+	"clusterd/pkg/interface/kube"
+)
+
+const (
+	// LeaseName the name of the lease held by the leader of clusterd
+	LeaseName = "clusterd-leader"
+	// RoleLabelKey the label of the clusterd pod marking its role, the grpc service selects the leader by it
+	RoleLabelKey = "clusterd-role"
+	// RoleLeader the role of the replica holding the lease
+	RoleLeader = "leader"
+	// RoleStandby the role of the replica waiting for the lease
+	RoleStandby = "standby"
+
+	// identitySeparator separate the pod name and the pod ip in the identity of the replica
+	identitySeparator = "_"
+	leaseDuration     = 15 * time.Second
+	renewDeadline     = 10 * time.Second
+	retryPeriod       = 2 * time.Second
+	patchRetryTimes   = 3
+)
+
+var (
+	isLeader atomic.Bool
+	leader   atomic.Value
+)
+
+// Config the identity of the replica in the election
+type Config struct {
+	Namespace string
+	PodName   string
+	PodIP     string
+}
+
+func (c Config) identity() string {
+	return c.PodName + identitySeparator + c.PodIP
+}
+
+// Callbacks the functions called when the leadership of the replica changes
+type Callbacks struct {
+	// OnStartedLeading start the services of the leader, the ctx is done when the leadership is lost
+	OnStartedLeading func(ctx context.Context)
+	// OnLostLeading called when the leadership is lost before ctx of Run is done. the process wide caches can not
+	// be reset, so it is expected to exit the process and restart as the standby replica
+	OnLostLeading func()
+}
+
+// Run the election until ctx is done, the replica serves as the standby one until the lease is acquired
+func Run(ctx context.Context, config Config, callbacks Callbacks) error {
+	if config.PodName == "" || net.ParseIP(config.PodIP) == nil {
+		return errors.New("the pod name and the pod ip are required by the leader election")
+	}
+	client := kube.GetClientK8s()
+	if client == nil || client.ClientSet == nil {
+		return errors.New("k8s client is not initialized")
+	}
+	// the label may be left by the last run of the pod as the leader or set by the pod template for the single
+	// replica, which routes the grpc service to the standby replica
+	setRole(config, RoleStandby)
+	lock := &resourcelock.LeaseLock{
+		LeaseMeta:  metav1.ObjectMeta{Name: LeaseName, Namespace: config.Namespace},
+		Client:     client.ClientSet.CoordinationV1(),
+		LockConfig: resourcelock.ResourceLockConfig{Identity: config.identity()},
+	}
+	elector, err := leaderelection.NewLeaderElector(leaderelection.LeaderElectionConfig{
+		Lock:            lock,
+		LeaseDuration:   leaseDuration,
+		RenewDeadline:   renewDeadline,
+		RetryPeriod:     retryPeriod,
+		ReleaseOnCancel: true,
+		Name:            LeaseName,
+		Callbacks: leaderelection.LeaderCallbacks{
+			OnStartedLeading: func(leaderCtx context.Context) {
+				hwlog.RunLog.Infof("%s becomes the leader of clusterd", config.identity())
+				isLeader.Store(true)
+				setRole(config, RoleLeader)
+				callbacks.OnStartedLeading(leaderCtx)
+			},
+			OnStoppedLeading: func() {
+				isLeader.Store(false)
+				if ctx.Err() != nil {
+					hwlog.RunLog.Infof("%s releases the leadership of clusterd", config.identity())
+					return
+				}
+				hwlog.RunLog.Errorf("%s lost the leadership of clusterd", config.identity())
+				callbacks.OnLostLeading()
+			},
+			OnNewLeader: func(identity string) {
+				hwlog.RunLog.Infof("the leader of clusterd is %s", identity)
+				leader.Store(identity)
+			},
+		},
+	})
+	if err != nil {
+		return err
+	}
+	elector.Run(ctx)
+	return nil
+}
+
+func setRole(config Config, role string) {
+	if err := kube.RetryPatchPodLabels(config.PodName, config.Namespace, patchRetryTimes,
+		map[string]string{RoleLabelKey: role}); err != nil {
+		hwlog.RunLog.Errorf("patch the role %s of pod %s failed, err: %v", role, config.PodName, err)
+	}
+}
+
+// IsLeader return whether the replica holds the lease
+func IsLeader() bool {
+	return isLeader.Load()
+}
+
+// GetLeaderAddress return the grpc address of the leader, empty when the leader is unknown
+func GetLeaderAddress() string {
+	identity, ok := leader.Load().(string)
+	if !ok {
+		return ""
+	}
+	index := strings.LastIndex(identity, identitySeparator)
+	if index < 0 {
+		return ""
+	}
+	ip := net.ParseIP(identity[index+len(identitySeparator):])
+	if ip == nil {
+		return ""
+	}
+	return net.JoinHostPort(ip.String(), strings.TrimPrefix(constant.GrpcPort, ":"))
+}
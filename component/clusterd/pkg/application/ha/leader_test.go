@@ -0,0 +1,89 @@
+/* Copyright(C) 2026. Huawei Technologies Co.,Ltd. All rights reserved.
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+// Package ha test for the leader election
+package ha
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/agiledragon/gomonkey/v2"
+	"github.com/smartystreets/goconvey/convey"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"ascend-common/common-utils/hwlog"
+	"clusterd/pkg/interface/kube"
+)
+
+const (
+	testNamespace = "mindx-dl"
+	testPodName   = "clusterd-0"
+	testPodIP     = "10.0.0.1"
+	electWait     = 10 * time.Second
+)
+
+func init() {
+	if err := hwlog.InitRunLogger(&hwlog.LogConfig{OnlyToStdout: true}, context.Background()); err != nil {
+		panic(err)
+	}
+}
+
+func TestRun(t *testing.T) {
+	convey.Convey("Test Run", t, func() {
+		var roles []string
+		patches := gomonkey.ApplyFuncReturn(kube.GetClientK8s, &kube.K8sClient{ClientSet: fake.NewSimpleClientset()}).
+			ApplyFunc(kube.RetryPatchPodLabels, func(_, _ string, _ int, labels map[string]string) error {
+				roles = append(roles, labels[RoleLabelKey])
+				return nil
+			})
+		defer patches.Reset()
+		config := Config{Namespace: testNamespace, PodName: testPodName, PodIP: testPodIP}
+		convey.Convey("01-lease acquired, should start leading until ctx is done", func() {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			started, lost, done := make(chan struct{}), false, make(chan error)
+			callbacks := Callbacks{
+				OnStartedLeading: func(context.Context) { close(started) },
+				OnLostLeading:    func() { lost = true },
+			}
+			go func() { done <- Run(ctx, config, callbacks) }()
+			select {
+			case <-started:
+			case <-time.After(electWait):
+			}
+			convey.So(IsLeader(), convey.ShouldBeTrue)
+			convey.So(roles, convey.ShouldResemble, []string{RoleStandby, RoleLeader})
+			convey.So(GetLeaderAddress(), convey.ShouldEqual, testPodIP+":8899")
+			cancel()
+			convey.So(<-done, convey.ShouldBeNil)
+			convey.So(IsLeader(), convey.ShouldBeFalse)
+			convey.So(lost, convey.ShouldBeFalse)
+		})
+		convey.Convey("02-pod ip missing, should return error", func() {
+			config.PodIP = ""
+			convey.So(Run(context.Background(), config, Callbacks{}), convey.ShouldNotBeNil)
+		})
+	})
+}
+
+func TestGetLeaderAddress(t *testing.T) {
+	convey.Convey("Test GetLeaderAddress", t, func() {
+		leader.Store("clusterd-1_fd00::1")
+		convey.So(GetLeaderAddress(), convey.ShouldEqual, "[fd00::1]:8899")
+		leader.Store("unknown")
+		convey.So(GetLeaderAddress(), convey.ShouldEqual, "")
+	})
+}
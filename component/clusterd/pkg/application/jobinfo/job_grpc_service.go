@@ -304,6 +304,7 @@ func (s *JobServer) startBroadcasting(ctx context.Context) {
 				hwlog.RunLog.Info("job info service stop broadcasting")
 				return
 			case jobSignal := <-jobUpdateChan:
+				jobHistory.append(jobSignal)
 				s.broadcastJobUpdate(jobSignal)
 			}
 		}
@@ -0,0 +1,225 @@
+/* Copyright(C) 2026. Huawei Technologies Co.,Ltd. All rights reserved.
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+// Package jobinfo is used to return job info by subscribe
+package jobinfo
+
+import (
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"clusterd/pkg/common/constant"
+	"clusterd/pkg/interface/grpc/job"
+)
+
+const (
+	maxJobEventHistory = 1000
+	maxJobWatchers     = 100
+	watcherChanCache   = 100
+	versionSeparator   = "-"
+	uint64BitSize      = 64
+)
+
+// jobHistory keeps the latest job events to resume the watch by the resource version
+var jobHistory = newJobEventHistory()
+
+// jobEvent the event kept in the history, the rank table is not kept to limit the memory
+type jobEvent struct {
+	revision uint64
+	signal   *job.JobSummarySignal
+	// previous the last signal of the job before the event, nil when the job is new
+	previous *job.JobSummarySignal
+}
+
+// jobWatcher receive the events matched the filter, the channel is closed when the watcher is too slow
+type jobWatcher struct {
+	filter *job.JobFilter
+	events chan *job.JobEvent
+	// startVersion is the resource version when the watcher is registered
+	startVersion string
+}
+
+// jobEventHistory assign the monotonic revision to the job events. the resource version is epoch-revision,
+// the epoch is changed when clusterd restarts, so the resource version of the previous clusterd is expired
+type jobEventHistory struct {
+	mu       sync.Mutex
+	epoch    string
+	revision uint64
+	events   []jobEvent
+	watchers map[*jobWatcher]struct{}
+	// latest the last signal of the existing jobs, to tell whether the job leaves the filter of the watchers
+	latest map[string]*job.JobSummarySignal
+}
+
+func newJobEventHistory() *jobEventHistory {
+	return &jobEventHistory{
+		epoch:    strconv.FormatInt(time.Now().UnixNano(), ten),
+		events:   make([]jobEvent, 0, maxJobEventHistory),
+		watchers: make(map[*jobWatcher]struct{}),
+		latest:   make(map[string]*job.JobSummarySignal),
+	}
+}
+
+func (h *jobEventHistory) version(revision uint64) string {
+	return h.epoch + versionSeparator + strconv.FormatUint(revision, ten)
+}
+
+// currentVersion return the resource version of the latest event
+func (h *jobEventHistory) currentVersion() string {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return h.version(h.revision)
+}
+
+// parseVersion return the revision of the resource version, which should be neither expired nor in the future
+func (h *jobEventHistory) parseVersion(resourceVersion string) (uint64, error) {
+	epoch, revisionStr, ok := strings.Cut(resourceVersion, versionSeparator)
+	if !ok {
+		return 0, status.Errorf(codes.InvalidArgument, "invalid resource version %s", resourceVersion)
+	}
+	revision, err := strconv.ParseUint(revisionStr, ten, uint64BitSize)
+	if err != nil {
+		return 0, status.Errorf(codes.InvalidArgument, "invalid resource version %s", resourceVersion)
+	}
+	if epoch != h.epoch {
+		return 0, status.Errorf(codes.OutOfRange, "resource version %s is expired, please list again",
+			resourceVersion)
+	}
+	if revision > h.revision {
+		return 0, status.Errorf(codes.InvalidArgument, "resource version %s is newer than current %s",
+			resourceVersion, h.version(h.revision))
+	}
+	if len(h.events) > 0 && revision+1 < h.events[0].revision {
+		return 0, status.Errorf(codes.OutOfRange, "resource version %s is compacted, please list again",
+			resourceVersion)
+	}
+	return revision, nil
+}
+
+// append record the event and dispatch it to the watchers, the slow watcher is closed instead of blocking others.
+// the event is kept without the rank table, so the replayed events of the watch carry no rank table
+func (h *jobEventHistory) append(signal job.JobSummarySignal) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.revision++
+	if len(h.events) >= maxJobEventHistory {
+		h.events = append(h.events[:0], h.events[1:]...)
+	}
+	kept := cloneSignal(&signal)
+	kept.HcclJson = ""
+	previous := h.latest[signal.JobId]
+	h.events = append(h.events, jobEvent{revision: h.revision, signal: kept, previous: previous})
+	if signal.Operator == constant.DeleteOperator {
+		delete(h.latest, signal.JobId)
+	} else {
+		h.latest[signal.JobId] = kept
+	}
+	for w := range h.watchers {
+		event := h.eventFor(w.filter, h.revision, &signal, previous)
+		if event == nil {
+			continue
+		}
+		select {
+		case w.events <- event:
+		default:
+			close(w.events)
+			delete(h.watchers, w)
+		}
+	}
+}
+
+// eventFor return the event of the signal for the watcher of the filter, nil when the watcher is not interested.
+// the job which matched the filter before but not anymore is sent once with the leave operator
+func (h *jobEventHistory) eventFor(filter *job.JobFilter, revision uint64,
+	signal, previous *job.JobSummarySignal) *job.JobEvent {
+	if matchJobFilter(filter, signal) {
+		return h.newEvent(revision, signal)
+	}
+	if previous == nil || !matchJobFilter(filter, previous) {
+		return nil
+	}
+	event := h.newEvent(revision, signal)
+	event.Job.Operator = constant.LeaveFilterOperator
+	return event
+}
+
+// newEvent return the event owning a copy of the signal, since the rank table of the event may be cleared before
+// it is sent
+func (h *jobEventHistory) newEvent(revision uint64, signal *job.JobSummarySignal) *job.JobEvent {
+	return &job.JobEvent{ResourceVersion: h.version(revision), Job: cloneSignal(signal)}
+}
+
+func cloneSignal(signal *job.JobSummarySignal) *job.JobSummarySignal {
+	cloned, ok := proto.Clone(signal).(*job.JobSummarySignal)
+	if !ok {
+		return &job.JobSummarySignal{}
+	}
+	return cloned
+}
+
+// watch return the events after the resource version and register the watcher atomically, so no event is lost
+// between them
+func (h *jobEventHistory) watch(filter *job.JobFilter, resourceVersion string) ([]*job.JobEvent, *jobWatcher,
+	error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if len(h.watchers) >= maxJobWatchers {
+		return nil, nil, status.Errorf(codes.ResourceExhausted, "watcher num limit, max watcher num is %d",
+			maxJobWatchers)
+	}
+	var replay []*job.JobEvent
+	if resourceVersion != "" {
+		revision, err := h.parseVersion(resourceVersion)
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, event := range h.events {
+			if event.revision <= revision {
+				continue
+			}
+			if replayed := h.eventFor(filter, event.revision, event.signal, event.previous); replayed != nil {
+				replay = append(replay, replayed)
+			}
+		}
+	}
+	w := &jobWatcher{filter: filter, events: make(chan *job.JobEvent, watcherChanCache),
+		startVersion: h.version(h.revision)}
+	h.watchers[w] = struct{}{}
+	return replay, w, nil
+}
+
+// stopWatch unregister the watcher
+func (h *jobEventHistory) stopWatch(w *jobWatcher) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if _, ok := h.watchers[w]; ok {
+		close(w.events)
+		delete(h.watchers, w)
+	}
+}
+
+func matchJobFilter(filter *job.JobFilter, signal *job.JobSummarySignal) bool {
+	if filter == nil {
+		return true
+	}
+	return (filter.Namespace == "" || filter.Namespace == signal.Namespace) &&
+		(filter.JobStatus == "" || filter.JobStatus == signal.JobStatus) &&
+		(filter.FrameWork == "" || filter.FrameWork == signal.FrameWork)
+}
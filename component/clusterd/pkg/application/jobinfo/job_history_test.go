@@ -0,0 +1,133 @@
+/* Copyright(C) 2026. Huawei Technologies Co.,Ltd. All rights reserved.
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+// Package jobinfo test for the job event history
+package jobinfo
+
+import (
+	"testing"
+
+	"github.com/smartystreets/goconvey/convey"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"clusterd/pkg/common/constant"
+	"clusterd/pkg/interface/grpc/job"
+)
+
+const (
+	testNamespace = "default"
+	testPtJob     = "pt-job"
+)
+
+func TestJobEventHistoryWatch(t *testing.T) {
+	convey.Convey("Test jobEventHistory watch", t, func() {
+		history := newJobEventHistory()
+		history.append(job.JobSummarySignal{JobId: testJob1, Namespace: testNamespace})
+		rv := history.currentVersion()
+		history.append(job.JobSummarySignal{JobId: testJob2, Namespace: "other"})
+		history.append(job.JobSummarySignal{JobId: testJob1, Namespace: testNamespace, JobStatus: "running"})
+		convey.Convey("01-resume from the resource version, should replay the matched events", func() {
+			replay, w, err := history.watch(&job.JobFilter{Namespace: testNamespace}, rv)
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(len(replay), convey.ShouldEqual, 1)
+			convey.So(replay[0].Job.JobStatus, convey.ShouldEqual, "running")
+			convey.So(replay[0].ResourceVersion, convey.ShouldEqual, history.currentVersion())
+			history.append(job.JobSummarySignal{JobId: testJob2, Namespace: "other"})
+			history.append(job.JobSummarySignal{JobId: testJob1, Namespace: testNamespace})
+			event := <-w.events
+			convey.So(event.Job.JobId, convey.ShouldEqual, testJob1)
+			history.stopWatch(w)
+			_, ok := <-w.events
+			convey.So(ok, convey.ShouldBeFalse)
+		})
+		convey.Convey("02-empty resource version, should not replay", func() {
+			replay, w, err := history.watch(nil, "")
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(replay, convey.ShouldBeEmpty)
+			convey.So(w.startVersion, convey.ShouldEqual, history.currentVersion())
+		})
+		convey.Convey("03-invalid resource version, should return error", func() {
+			for _, version := range []string{"invalid", history.epoch + "-x", history.version(history.revision + 1)} {
+				_, _, err := history.watch(nil, version)
+				convey.So(status.Code(err), convey.ShouldEqual, codes.InvalidArgument)
+			}
+		})
+		convey.Convey("04-resource version of the previous clusterd, should return out of range", func() {
+			_, _, err := history.watch(nil, "1-1")
+			convey.So(status.Code(err), convey.ShouldEqual, codes.OutOfRange)
+		})
+	})
+}
+
+func TestJobEventHistoryLeaveFilter(t *testing.T) {
+	convey.Convey("Test jobEventHistory the job leaves the filter", t, func() {
+		history := newJobEventHistory()
+		filter := &job.JobFilter{JobStatus: "running"}
+		rv := history.currentVersion()
+		_, w, err := history.watch(filter, "")
+		convey.So(err, convey.ShouldBeNil)
+		running := job.JobSummarySignal{JobId: testJob1, JobStatus: "running", HcclJson: "rank table"}
+		history.append(running)
+		history.append(job.JobSummarySignal{JobId: testJob1, JobStatus: StatusJobFail})
+		history.append(job.JobSummarySignal{JobId: testJob1, JobStatus: StatusJobFail})
+		convey.Convey("01-watching, should receive the leave event once", func() {
+			event := <-w.events
+			convey.So(event.Job.HcclJson, convey.ShouldEqual, running.HcclJson)
+			event.Job.HcclJson = ""
+			event = <-w.events
+			convey.So(event.Job.Operator, convey.ShouldEqual, constant.LeaveFilterOperator)
+			convey.So(len(w.events), convey.ShouldEqual, 0)
+			convey.So(history.events[0].signal.HcclJson, convey.ShouldBeEmpty)
+		})
+		convey.Convey("02-resume, should replay the leave event without the rank table", func() {
+			replay, _, err := history.watch(filter, rv)
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(len(replay), convey.ShouldEqual, len([]string{"running", "leave"}))
+			convey.So(replay[0].Job.HcclJson, convey.ShouldBeEmpty)
+			convey.So(replay[1].Job.Operator, convey.ShouldEqual, constant.LeaveFilterOperator)
+		})
+	})
+}
+
+func TestJobEventHistoryCompact(t *testing.T) {
+	convey.Convey("Test jobEventHistory compact and slow watcher", t, func() {
+		history := newJobEventHistory()
+		rv := history.currentVersion()
+		_, w, err := history.watch(nil, "")
+		convey.So(err, convey.ShouldBeNil)
+		for i := 0; i <= maxJobEventHistory; i++ {
+			history.append(job.JobSummarySignal{JobId: testJob1})
+		}
+		convey.So(len(history.events), convey.ShouldEqual, maxJobEventHistory)
+		_, _, err = history.watch(nil, rv)
+		convey.So(status.Code(err), convey.ShouldEqual, codes.OutOfRange)
+		received := 0
+		for range w.events {
+			received++
+		}
+		convey.So(received, convey.ShouldEqual, watcherChanCache)
+		convey.So(len(history.watchers), convey.ShouldEqual, 0)
+	})
+}
+
+func TestMatchJobFilter(t *testing.T) {
+	convey.Convey("Test matchJobFilter", t, func() {
+		signal := &job.JobSummarySignal{Namespace: testNamespace, JobStatus: "running", FrameWork: ptFramework}
+		convey.So(matchJobFilter(nil, signal), convey.ShouldBeTrue)
+		convey.So(matchJobFilter(&job.JobFilter{Namespace: testNamespace, FrameWork: ptFramework}, signal),
+			convey.ShouldBeTrue)
+		convey.So(matchJobFilter(&job.JobFilter{JobStatus: StatusJobFail}, signal), convey.ShouldBeFalse)
+	})
+}
@@ -0,0 +1,179 @@
+/* Copyright(C) 2026. Huawei Technologies Co.,Ltd. All rights reserved.
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+// Package jobinfo is used to return job info by subscribe
+package jobinfo
+
+import (
+	"context"
+	"sort"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"ascend-common/common-utils/hwlog"
+	"clusterd/pkg/common/constant"
+	"clusterd/pkg/common/util"
+	"clusterd/pkg/domain/common"
+	jobstorage "clusterd/pkg/domain/job"
+	"clusterd/pkg/interface/grpc/job"
+)
+
+const (
+	defaultPageSize = 100
+	maxPageSize     = 500
+	rateLimitedInfo = "rate limited, there is too many requests, please retry later"
+)
+
+// GetJob return the current summary of the job, including the rank table
+func (s *JobServer) GetJob(ctx context.Context, req *job.GetJobRequest) (*job.GetJobResponse, error) {
+	if !s.limiter.Allow() {
+		return &job.GetJobResponse{Status: &job.Status{Code: int32(common.RateLimitedCode),
+			Info: rateLimitedInfo}}, nil
+	}
+	if req.JobId == "" {
+		return &job.GetJobResponse{Status: &job.Status{Code: int32(common.InvalidReqParam),
+			Info: "jobId is required"}}, nil
+	}
+	jobInfo, ok := jobstorage.GetJobCache(req.JobId)
+	if !ok {
+		return &job.GetJobResponse{Status: &job.Status{Code: int32(common.JobNotExist),
+			Info: "job " + req.JobId + " not exist"}}, nil
+	}
+	return &job.GetJobResponse{Status: &job.Status{Code: int32(common.SuccessCode), Info: "ok"},
+		Job: buildQueryJobSignal(jobInfo, true)}, nil
+}
+
+// ListJobs return the jobs matched the filter page by page in the order of jobId. the resource version of the
+// response can be used to watch the changes after the list
+func (s *JobServer) ListJobs(ctx context.Context, req *job.ListJobsRequest) (*job.ListJobsResponse, error) {
+	if !s.limiter.Allow() {
+		return &job.ListJobsResponse{Status: &job.Status{Code: int32(common.RateLimitedCode),
+			Info: rateLimitedInfo}}, nil
+	}
+	if req.PageSize < 0 {
+		return &job.ListJobsResponse{Status: &job.Status{Code: int32(common.InvalidReqParam),
+			Info: "pageSize should not be negative"}}, nil
+	}
+	pageSize := int(req.PageSize)
+	if pageSize == 0 {
+		pageSize = defaultPageSize
+	}
+	if pageSize > maxPageSize {
+		pageSize = maxPageSize
+	}
+	// the version is got before the jobs, so the changes during the list are not missed by the watch
+	resourceVersion := jobHistory.currentVersion()
+	jobInfos := listJobs(req.Filter)
+	start := sort.Search(len(jobInfos), func(i int) bool {
+		return jobInfos[i].Key > req.PageToken
+	})
+	end := start + pageSize
+	if end > len(jobInfos) {
+		end = len(jobInfos)
+	}
+	resp := &job.ListJobsResponse{
+		Status:          &job.Status{Code: int32(common.SuccessCode), Info: "ok"},
+		Jobs:            make([]*job.JobSummarySignal, 0, end-start),
+		Total:           int32(len(jobInfos)),
+		ResourceVersion: resourceVersion,
+	}
+	for _, jobInfo := range jobInfos[start:end] {
+		resp.Jobs = append(resp.Jobs, buildQueryJobSignal(jobInfo, req.WithRankTable))
+	}
+	if end < len(jobInfos) {
+		resp.NextPageToken = jobInfos[end-1].Key
+	}
+	return resp, nil
+}
+
+// WatchJobs send the job events matched the filter. when the resource version is empty, all the current jobs are
+// sent as add events first, otherwise the events after the resource version are replayed without the rank table.
+// the job no longer matched the filter is sent once with the leave operator. the stream is aborted
+// when the client is too slow, and it should watch again with the resource version of the last received event
+func (s *JobServer) WatchJobs(req *job.WatchJobsRequest, stream job.Job_WatchJobsServer) error {
+	if !s.limiter.Allow() {
+		return status.Error(codes.ResourceExhausted, rateLimitedInfo)
+	}
+	replay, watcher, err := jobHistory.watch(req.Filter, req.ResourceVersion)
+	if err != nil {
+		hwlog.RunLog.Warnf("watch jobs from resource version %s failed: %v", req.ResourceVersion, err)
+		return err
+	}
+	defer jobHistory.stopWatch(watcher)
+	hwlog.RunLog.Infof("start to watch jobs, filter: %v, resource version: %s", req.Filter, req.ResourceVersion)
+	if req.ResourceVersion == "" {
+		for _, jobInfo := range listJobs(req.Filter) {
+			replay = append(replay, &job.JobEvent{ResourceVersion: watcher.startVersion,
+				Job: buildQueryJobSignal(jobInfo, true)})
+		}
+	}
+	for _, event := range replay {
+		if err = s.sendJobEvent(stream, event); err != nil {
+			return err
+		}
+	}
+	for {
+		select {
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		case event, ok := <-watcher.events:
+			if !ok {
+				return status.Error(codes.Aborted,
+					"watcher is too slow, please watch again with the last resource version")
+			}
+			if err = s.sendJobEvent(stream, event); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (s *JobServer) sendJobEvent(stream job.Job_WatchJobsServer, event *job.JobEvent) error {
+	if err := s.handleSingleJobInfo(event.Job); err != nil {
+		return err
+	}
+	if err := stream.Send(event); err != nil {
+		hwlog.RunLog.Errorf("send job event of %s failed: %v", event.Job.JobId, err)
+		return err
+	}
+	return nil
+}
+
+// listJobs return the jobs matched the filter in the order of jobId
+func listJobs(filter *job.JobFilter) []constant.JobInfo {
+	jobInfos := make([]constant.JobInfo, 0)
+	for _, jobInfo := range jobstorage.GetAllJobCache() {
+		if filter != nil && ((filter.Namespace != "" && filter.Namespace != jobInfo.NameSpace) ||
+			(filter.JobStatus != "" && filter.JobStatus != jobInfo.Status) ||
+			(filter.FrameWork != "" && filter.FrameWork != jobInfo.Framework)) {
+			continue
+		}
+		jobInfos = append(jobInfos, jobInfo)
+	}
+	sort.Slice(jobInfos, func(i, j int) bool {
+		return jobInfos[i].Key < jobInfos[j].Key
+	})
+	return jobInfos
+}
+
+func buildQueryJobSignal(jobInfo constant.JobInfo, withRankTable bool) *job.JobSummarySignal {
+	hccl := ""
+	// the rank table of the large job is omitted like the subscription, to keep the message size limited
+	if withRankTable && calcJobNPUNum(jobInfo) <= constant.MaxNPUsPerBatch {
+		hccl = util.ObjToString(jobInfo.JobRankTable)
+	}
+	jobSignal := BuildJobSignalFromJobInfo(jobInfo, hccl, constant.AddOperator)
+	return &jobSignal
+}
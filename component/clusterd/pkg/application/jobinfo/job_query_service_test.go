@@ -0,0 +1,186 @@
+/* Copyright(C) 2026. Huawei Technologies Co.,Ltd. All rights reserved.
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+// Package jobinfo test for the job query service
+package jobinfo
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/agiledragon/gomonkey/v2"
+	"github.com/smartystreets/goconvey/convey"
+	"golang.org/x/time/rate"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"clusterd/pkg/common/constant"
+	"clusterd/pkg/domain/common"
+	jobstorage "clusterd/pkg/domain/job"
+	"clusterd/pkg/interface/grpc/job"
+)
+
+const watchTimeout = time.Second
+
+func newQueryTestServer() *JobServer {
+	return &JobServer{limiter: rate.NewLimiter(rate.Inf, 0)}
+}
+
+func testJobCache() map[string]constant.JobInfo {
+	return map[string]constant.JobInfo{
+		testJob1: {Key: testJob1, Name: testJob1, NameSpace: testNamespace, Status: "running",
+			Framework: ptFramework, MasterAddr: "127.0.0.1", JobRankTable: constant.RankTable{Status: "completed"}},
+		testJob2: {Key: testJob2, Name: testJob2, NameSpace: testNamespace, Status: "pending"},
+		testPtJob: {Key: testPtJob, Name: testPtJob, NameSpace: "other", Status: "running",
+			Framework: ptFramework},
+	}
+}
+
+func TestGetJob(t *testing.T) {
+	convey.Convey("Test GetJob", t, func() {
+		server := newQueryTestServer()
+		patches := gomonkey.ApplyFunc(jobstorage.GetJobCache, func(jobKey string) (constant.JobInfo, bool) {
+			jobInfo, ok := testJobCache()[jobKey]
+			return jobInfo, ok
+		})
+		defer patches.Reset()
+		convey.Convey("01-job exists, should return the job with rank table", func() {
+			resp, err := server.GetJob(context.Background(), &job.GetJobRequest{JobId: testJob1})
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(resp.Status.Code, convey.ShouldEqual, int32(common.SuccessCode))
+			convey.So(resp.Job.MasterAddr, convey.ShouldEqual, "127.0.0.1")
+			convey.So(resp.Job.HcclJson, convey.ShouldContainSubstring, "completed")
+		})
+		convey.Convey("02-job not exists, should return job not exist", func() {
+			resp, err := server.GetJob(context.Background(), &job.GetJobRequest{JobId: "unknown"})
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(resp.Status.Code, convey.ShouldEqual, int32(common.JobNotExist))
+		})
+		convey.Convey("03-empty jobId, should return invalid param", func() {
+			resp, err := server.GetJob(context.Background(), &job.GetJobRequest{})
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(resp.Status.Code, convey.ShouldEqual, int32(common.InvalidReqParam))
+		})
+		convey.Convey("04-rate limited, should return rate limited", func() {
+			server.limiter = rate.NewLimiter(0, 0)
+			resp, err := server.GetJob(context.Background(), &job.GetJobRequest{JobId: testJob1})
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(resp.Status.Code, convey.ShouldEqual, int32(common.RateLimitedCode))
+		})
+	})
+}
+
+func TestListJobs(t *testing.T) {
+	convey.Convey("Test ListJobs", t, func() {
+		server := newQueryTestServer()
+		patches := gomonkey.ApplyFuncReturn(jobstorage.GetAllJobCache, testJobCache())
+		defer patches.Reset()
+		convey.Convey("01-list by page, should return the jobs in order of jobId", func() {
+			resp, err := server.ListJobs(context.Background(), &job.ListJobsRequest{PageSize: 1})
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(resp.Total, convey.ShouldEqual, len(testJobCache()))
+			convey.So(resp.Jobs[0].JobId, convey.ShouldEqual, testPtJob)
+			convey.So(resp.Jobs[0].HcclJson, convey.ShouldBeEmpty)
+			convey.So(resp.ResourceVersion, convey.ShouldNotBeEmpty)
+			var jobIds []string
+			for token := ""; ; {
+				resp, err = server.ListJobs(context.Background(), &job.ListJobsRequest{PageSize: 1, PageToken: token})
+				convey.So(err, convey.ShouldBeNil)
+				jobIds = append(jobIds, resp.Jobs[0].JobId)
+				if token = resp.NextPageToken; token == "" {
+					break
+				}
+			}
+			convey.So(jobIds, convey.ShouldResemble, []string{testPtJob, testJob1, testJob2})
+		})
+		convey.Convey("02-list with filter, should return the matched jobs", func() {
+			resp, err := server.ListJobs(context.Background(), &job.ListJobsRequest{WithRankTable: true,
+				Filter: &job.JobFilter{Namespace: testNamespace, FrameWork: ptFramework}})
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(len(resp.Jobs), convey.ShouldEqual, 1)
+			convey.So(resp.Jobs[0].JobId, convey.ShouldEqual, testJob1)
+			convey.So(resp.Jobs[0].HcclJson, convey.ShouldNotBeEmpty)
+			convey.So(resp.NextPageToken, convey.ShouldBeEmpty)
+		})
+		convey.Convey("03-negative page size, should return invalid param", func() {
+			resp, err := server.ListJobs(context.Background(), &job.ListJobsRequest{PageSize: -1})
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(resp.Status.Code, convey.ShouldEqual, int32(common.InvalidReqParam))
+		})
+	})
+}
+
+type mockWatchJobsServer struct {
+	grpc.ServerStream
+	ctx    context.Context
+	events chan *job.JobEvent
+}
+
+func (m *mockWatchJobsServer) Context() context.Context { return m.ctx }
+
+func (m *mockWatchJobsServer) Send(event *job.JobEvent) error {
+	m.events <- event
+	return nil
+}
+
+func receiveJobEvent(events chan *job.JobEvent) *job.JobEvent {
+	select {
+	case event := <-events:
+		return event
+	case <-time.After(watchTimeout):
+		return nil
+	}
+}
+
+func TestWatchJobs(t *testing.T) {
+	convey.Convey("Test WatchJobs", t, func() {
+		server := newQueryTestServer()
+		patches := gomonkey.ApplyFuncReturn(jobstorage.GetAllJobCache, testJobCache())
+		defer patches.Reset()
+		originHistory := jobHistory
+		jobHistory = newJobEventHistory()
+		defer func() { jobHistory = originHistory }()
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		stream := &mockWatchJobsServer{ctx: ctx, events: make(chan *job.JobEvent, len(testJobCache()))}
+		convey.Convey("01-watch without resource version, should send the current jobs then the changes", func() {
+			errCh := make(chan error, 1)
+			go func() {
+				errCh <- server.WatchJobs(&job.WatchJobsRequest{Filter: &job.JobFilter{Namespace: testNamespace}},
+					stream)
+			}()
+			convey.So(receiveJobEvent(stream.events).Job.JobId, convey.ShouldEqual, testJob1)
+			convey.So(receiveJobEvent(stream.events).Job.JobId, convey.ShouldEqual, testJob2)
+			jobHistory.append(job.JobSummarySignal{JobId: testPtJob, Namespace: "other"})
+			jobHistory.append(job.JobSummarySignal{JobId: testJob2, Namespace: testNamespace,
+				Operator: constant.DeleteOperator})
+			event := receiveJobEvent(stream.events)
+			convey.So(event.Job.Operator, convey.ShouldEqual, constant.DeleteOperator)
+			convey.So(event.ResourceVersion, convey.ShouldEqual, jobHistory.currentVersion())
+			cancel()
+			convey.So(<-errCh, convey.ShouldEqual, context.Canceled)
+		})
+		convey.Convey("02-watch with the expired resource version, should return out of range", func() {
+			err := server.WatchJobs(&job.WatchJobsRequest{ResourceVersion: "1-0"}, stream)
+			convey.So(status.Code(err), convey.ShouldEqual, codes.OutOfRange)
+		})
+		convey.Convey("03-rate limited, should return resource exhausted", func() {
+			server.limiter = rate.NewLimiter(0, 0)
+			err := server.WatchJobs(&job.WatchJobsRequest{}, stream)
+			convey.So(status.Code(err), convey.ShouldEqual, codes.ResourceExhausted)
+		})
+	})
+}
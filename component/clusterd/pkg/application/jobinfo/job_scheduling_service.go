@@ -0,0 +1,78 @@
+/* Copyright(C) 2026. Huawei Technologies Co.,Ltd. All rights reserved.
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+// Package jobinfo is used to return job info by subscribe
+package jobinfo
+
+import (
+	"context"
+
+	"clusterd/pkg/application/schedulingexception"
+	"clusterd/pkg/domain/common"
+	"clusterd/pkg/interface/grpc/job"
+)
+
+// GetSchedulingExceptions return the scheduling exceptions of the pending jobs with the diagnosis of the root
+// cause. all the jobs are returned when the job id is empty
+func (s *JobServer) GetSchedulingExceptions(ctx context.Context,
+	req *job.GetSchedulingExceptionsRequest) (*job.GetSchedulingExceptionsResponse, error) {
+	if !s.limiter.Allow() {
+		return &job.GetSchedulingExceptionsResponse{Status: &job.Status{Code: int32(common.RateLimitedCode),
+			Info: rateLimitedInfo}}, nil
+	}
+	exceptions := schedulingexception.GetJobExceptions(req.JobId)
+	if req.JobId != "" && len(exceptions) == 0 {
+		return &job.GetSchedulingExceptionsResponse{Status: &job.Status{Code: int32(common.JobNotExist),
+			Info: "the job has no scheduling exception"}}, nil
+	}
+	resp := &job.GetSchedulingExceptionsResponse{
+		Status: &job.Status{Code: int32(common.SuccessCode), Info: "ok"},
+		Jobs:   make([]*job.JobSchedulingException, 0, len(exceptions)),
+	}
+	for _, exception := range exceptions {
+		resp.Jobs = append(resp.Jobs, &job.JobSchedulingException{
+			JobId:     exception.JobId,
+			JobName:   exception.JobName,
+			Namespace: exception.NameSpace,
+			JobType:   exception.JobType,
+			Status:    exception.Status,
+			Reason:    exception.Reason,
+			Message:   exception.Message,
+			Diagnosis: toSchedulingDiagnosis(exception.Diagnosis),
+		})
+	}
+	return resp, nil
+}
+
+func toSchedulingDiagnosis(diagnosis *schedulingexception.Diagnosis) *job.SchedulingDiagnosis {
+	if diagnosis == nil {
+		return nil
+	}
+	result := &job.SchedulingDiagnosis{
+		Category:         diagnosis.Category,
+		Summary:          diagnosis.Summary,
+		RequiredNPU:      int64(diagnosis.RequiredNPU),
+		NpuPerPod:        int64(diagnosis.NPUPerPod),
+		BlockSize:        int64(diagnosis.BlockSize),
+		FreeNPU:          int64(diagnosis.FreeNPU),
+		AllocatableNPU:   int64(diagnosis.AllocatableNPU),
+		LargestFreeBlock: int64(diagnosis.LargestFreeBlock),
+		Causes:           make([]*job.SchedulingCause, 0, len(diagnosis.Causes)),
+	}
+	for _, cause := range diagnosis.Causes {
+		result.Causes = append(result.Causes, &job.SchedulingCause{Reason: cause.Reason,
+			NpuNum: int64(cause.NPUNum), Devices: cause.Devices})
+	}
+	return result
+}
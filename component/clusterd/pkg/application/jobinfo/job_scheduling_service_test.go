@@ -0,0 +1,67 @@
+/* Copyright(C) 2026. Huawei Technologies Co.,Ltd. All rights reserved.
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+// Package jobinfo test for the job scheduling exception service
+package jobinfo
+
+import (
+	"context"
+	"testing"
+
+	"github.com/agiledragon/gomonkey/v2"
+	"github.com/smartystreets/goconvey/convey"
+	"golang.org/x/time/rate"
+
+	"clusterd/pkg/application/schedulingexception"
+	"clusterd/pkg/domain/common"
+	"clusterd/pkg/interface/grpc/job"
+)
+
+func TestGetSchedulingExceptions(t *testing.T) {
+	convey.Convey("Test GetSchedulingExceptions", t, func() {
+		server := newQueryTestServer()
+		patches := gomonkey.ApplyFunc(schedulingexception.GetJobExceptions,
+			func(jobId string) []schedulingexception.JobException {
+				if jobId != "" && jobId != testJob1 {
+					return []schedulingexception.JobException{}
+				}
+				return []schedulingexception.JobException{{JobId: testJob1, Reason: "NotEnoughResources",
+					Diagnosis: &schedulingexception.Diagnosis{Category: "NPUSeparated", RequiredNPU: 64,
+						Causes: []schedulingexception.DiagnosisCause{{Reason: "NPUSeparated", NPUNum: 16,
+							Devices: []string{"node1/Ascend910-0"}}}}}}
+			})
+		defer patches.Reset()
+		convey.Convey("01-query the job, should return the diagnosis", func() {
+			resp, err := server.GetSchedulingExceptions(context.Background(),
+				&job.GetSchedulingExceptionsRequest{JobId: testJob1})
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(resp.Status.Code, convey.ShouldEqual, int32(common.SuccessCode))
+			convey.So(len(resp.Jobs), convey.ShouldEqual, 1)
+			convey.So(resp.Jobs[0].Diagnosis.RequiredNPU, convey.ShouldEqual, 64)
+			convey.So(resp.Jobs[0].Diagnosis.Causes[0].NpuNum, convey.ShouldEqual, 16)
+		})
+		convey.Convey("02-job without exception, should return job not exist", func() {
+			resp, err := server.GetSchedulingExceptions(context.Background(),
+				&job.GetSchedulingExceptionsRequest{JobId: "unknown"})
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(resp.Status.Code, convey.ShouldEqual, int32(common.JobNotExist))
+		})
+		convey.Convey("03-rate limited, should return rate limited", func() {
+			server.limiter = rate.NewLimiter(0, 0)
+			resp, err := server.GetSchedulingExceptions(context.Background(), &job.GetSchedulingExceptionsRequest{})
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(resp.Status.Code, convey.ShouldEqual, int32(common.RateLimitedCode))
+		})
+	})
+}
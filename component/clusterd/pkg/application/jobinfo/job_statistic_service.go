@@ -0,0 +1,84 @@
+/* Copyright(C) 2026. Huawei Technologies Co.,Ltd. All rights reserved.
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+// Package jobinfo is used to return job info by subscribe
+package jobinfo
+
+import (
+	"context"
+	"fmt"
+
+	"clusterd/pkg/domain/common"
+	"clusterd/pkg/domain/statistics"
+	"clusterd/pkg/interface/grpc/job"
+)
+
+const maxStatisticJobIds = 1000
+
+// GetJobStatistics return the goodput, the time lost to the faults and the recoveries of the jobs, and the sum of
+// them as the cluster goodput. all the jobs are returned when the job ids are empty
+func (s *JobServer) GetJobStatistics(ctx context.Context,
+	req *job.GetJobStatisticsRequest) (*job.GetJobStatisticsResponse, error) {
+	if !s.limiter.Allow() {
+		return &job.GetJobStatisticsResponse{Status: &job.Status{Code: int32(common.RateLimitedCode),
+			Info: rateLimitedInfo}}, nil
+	}
+	if len(req.JobIds) > maxStatisticJobIds {
+		return &job.GetJobStatisticsResponse{Status: &job.Status{Code: int32(common.InvalidReqParam),
+			Info: fmt.Sprintf("the number of jobIds should be at most %d", maxStatisticJobIds)}}, nil
+	}
+	goodputs := statistics.JobStcMgrInst.GetJobGoodputs(req.JobIds...)
+	resp := &job.GetJobStatisticsResponse{
+		Status:  &job.Status{Code: int32(common.SuccessCode), Info: "ok"},
+		Jobs:    make([]*job.JobGoodput, 0, len(goodputs)),
+		Cluster: toClusterGoodput(statistics.SumGoodput(goodputs)),
+	}
+	for _, goodput := range goodputs {
+		resp.Jobs = append(resp.Jobs, toJobGoodput(goodput))
+	}
+	return resp, nil
+}
+
+func toJobGoodput(goodput statistics.JobGoodput) *job.JobGoodput {
+	return &job.JobGoodput{
+		JobId:                 goodput.JobId,
+		CustomJobId:           goodput.CustomJobId,
+		Name:                  goodput.Name,
+		Namespace:             goodput.Namespace,
+		CardNum:               goodput.CardNum,
+		WallTime:              goodput.WallTime,
+		RecoverPausedTime:     goodput.RecoverPausedTime,
+		RescheduleLostTime:    goodput.RescheduleLostTime,
+		ProductiveTime:        goodput.ProductiveTime,
+		Goodput:               goodput.Goodput,
+		FaultNums:             goodput.FaultNums,
+		FaultTimes:            goodput.FaultTimes,
+		RecoverTimes:          goodput.RecoverTimes,
+		MeanTimeToRecover:     goodput.MeanTimeToRecover,
+		MeanTimeBetweenFaults: goodput.MeanTimeBetweenFaults,
+	}
+}
+
+func toClusterGoodput(cluster statistics.ClusterGoodput) *job.ClusterGoodput {
+	return &job.ClusterGoodput{
+		JobNum:                cluster.JobNum,
+		CardWallTime:          cluster.CardWallTime,
+		CardProductiveTime:    cluster.CardProductiveTime,
+		Goodput:               cluster.Goodput,
+		FaultTimes:            cluster.FaultTimes,
+		RecoverTimes:          cluster.RecoverTimes,
+		MeanTimeToRecover:     cluster.MeanTimeToRecover,
+		MeanTimeBetweenFaults: cluster.MeanTimeBetweenFaults,
+	}
+}
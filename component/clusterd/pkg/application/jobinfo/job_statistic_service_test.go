@@ -0,0 +1,65 @@
+/* Copyright(C) 2026. Huawei Technologies Co.,Ltd. All rights reserved.
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+// Package jobinfo test for the job statistic service
+package jobinfo
+
+import (
+	"context"
+	"testing"
+
+	"github.com/agiledragon/gomonkey/v2"
+	"github.com/smartystreets/goconvey/convey"
+	"golang.org/x/time/rate"
+
+	"clusterd/pkg/domain/common"
+	"clusterd/pkg/domain/statistics"
+	"clusterd/pkg/interface/grpc/job"
+)
+
+func TestGetJobStatistics(t *testing.T) {
+	convey.Convey("Test GetJobStatistics", t, func() {
+		server := newQueryTestServer()
+		var queried []string
+		patches := gomonkey.ApplyMethod(statistics.JobStcMgrInst, "GetJobGoodputs",
+			func(_ *statistics.JobStcMgr, jobKeys ...string) []statistics.JobGoodput {
+				queried = jobKeys
+				return []statistics.JobGoodput{{JobId: testJob1, CardNum: 1, WallTime: 100, ProductiveTime: 80,
+					Goodput: 0.8, FaultNums: map[string]int64{"RestartBusiness": 1}, FaultTimes: 1}}
+			})
+		defer patches.Reset()
+		convey.Convey("01-query the jobs, should return the job and cluster goodput", func() {
+			resp, err := server.GetJobStatistics(context.Background(),
+				&job.GetJobStatisticsRequest{JobIds: []string{testJob1}})
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(resp.Status.Code, convey.ShouldEqual, int32(common.SuccessCode))
+			convey.So(queried, convey.ShouldResemble, []string{testJob1})
+			convey.So(resp.Jobs[0].FaultNums["RestartBusiness"], convey.ShouldEqual, 1)
+			convey.So(resp.Cluster.Goodput, convey.ShouldAlmostEqual, 0.8)
+			convey.So(resp.Cluster.MeanTimeBetweenFaults, convey.ShouldEqual, 80)
+		})
+		convey.Convey("02-too many job ids, should return invalid param", func() {
+			resp, err := server.GetJobStatistics(context.Background(),
+				&job.GetJobStatisticsRequest{JobIds: make([]string, maxStatisticJobIds+1)})
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(resp.Status.Code, convey.ShouldEqual, int32(common.InvalidReqParam))
+		})
+		convey.Convey("03-rate limited, should return rate limited", func() {
+			server.limiter = rate.NewLimiter(0, 0)
+			resp, err := server.GetJobStatistics(context.Background(), &job.GetJobStatisticsRequest{})
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(resp.Status.Code, convey.ShouldEqual, int32(common.RateLimitedCode))
+		})
+	})
+}
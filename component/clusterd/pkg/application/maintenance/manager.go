@@ -0,0 +1,185 @@
+/* Copyright(C) 2026. Huawei Technologies Co.,Ltd. All rights reserved.
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+// Package maintenance drive the maintenance windows through draining, separating and releasing the devices
+package maintenance
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"k8s.io/api/core/v1"
+
+	"ascend-common/common-utils/hwlog"
+	"clusterd/pkg/domain/maintenance"
+	"clusterd/pkg/domain/pod"
+	"clusterd/pkg/interface/kube"
+)
+
+const (
+	checkInterval = 5 * time.Second
+
+	reasonDrain    = "MaintenanceDraining"
+	reasonSeparate = "MaintenanceSeparated"
+	reasonRelease  = "MaintenanceReleased"
+)
+
+// Manage load the saved windows and advance their status every checkInterval until ctx is done. the devices are
+// marked by the maintenance fault processor according to the status
+func Manage(ctx context.Context) {
+	maintenance.Windows.Load()
+	ticker := time.NewTicker(checkInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			hwlog.RunLog.Info("maintenance window manager stopped")
+			return
+		case <-ticker.C:
+			advance(time.Now().UnixMilli())
+		}
+	}
+}
+
+func advance(now int64) {
+	for _, window := range maintenance.Windows.List(maintenance.StatusScheduled, maintenance.StatusDraining,
+		maintenance.StatusInProgress) {
+		var action, reason, message string
+		updated, err := maintenance.Windows.Update(window.Id, func(w *maintenance.Window) bool {
+			jobs := w.AffectedJobs
+			action, message = nextStatus(w, now)
+			if action == "" {
+				// only the affected jobs are refreshed, it is saved without an audit record
+				return !slices.Equal(jobs, w.AffectedJobs)
+			}
+			w.AddAudit(action, maintenance.SystemOperator, message)
+			return true
+		})
+		if err != nil {
+			hwlog.RunLog.Warnf("advance maintenance window %s failed, err: %v", window.Id, err)
+			continue
+		}
+		switch action {
+		case maintenance.ActionDrain:
+			reason = reasonDrain
+		case maintenance.ActionSeparate:
+			reason = reasonSeparate
+		case maintenance.ActionRelease:
+			reason = reasonRelease
+		default:
+			continue
+		}
+		hwlog.RunLog.Infof("maintenance window %s is %s, %s", updated.Id, updated.Status, message)
+		recordNodeEvents(updated, reason, message)
+	}
+}
+
+// nextStatus move the window to the next status and return the action, the action is empty when the status is
+// unchanged. the affected jobs of the draining window are refreshed as well
+func nextStatus(w *maintenance.Window, now int64) (string, string) {
+	if now >= w.EndTime {
+		w.Status = maintenance.StatusCompleted
+		w.AffectedJobs = nil
+		return maintenance.ActionRelease, "the window ended, the devices are released"
+	}
+	switch w.Status {
+	case maintenance.StatusScheduled:
+		if now < w.StartTime {
+			return "", ""
+		}
+		w.Status = maintenance.StatusDraining
+		w.AffectedJobs = affectedJobs(w)
+		return maintenance.ActionDrain, fmt.Sprintf("the devices are sub-healthy, handing off jobs %v", w.AffectedJobs)
+	case maintenance.StatusDraining:
+		jobs := affectedJobs(w)
+		if len(jobs) == 0 {
+			w.Status = maintenance.StatusInProgress
+			w.AffectedJobs = nil
+			return maintenance.ActionSeparate, "all jobs left the devices, the devices are separated"
+		}
+		drainTimeout := w.DrainTimeout
+		if drainTimeout <= 0 {
+			// the window saved without the drain timeout
+			drainTimeout = maintenance.DefaultDrainTimeout
+		}
+		if now >= w.StartTime+drainTimeout*time.Second.Milliseconds() {
+			w.Status = maintenance.StatusInProgress
+			w.AffectedJobs = jobs
+			return maintenance.ActionSeparate, fmt.Sprintf("drain timed out, the devices are separated with jobs %v "+
+				"still on them", jobs)
+		}
+		w.AffectedJobs = jobs
+		return "", ""
+	default:
+		return "", ""
+	}
+}
+
+// affectedJobs the jobs whose running pods use the devices of the window
+func affectedJobs(w *maintenance.Window) []string {
+	jobs := make(map[string]struct{})
+	for _, target := range w.Targets {
+		pods, _ := pod.GetPodsByNodeName(target.NodeName)
+		for _, podInfo := range pods {
+			if podInfo.Status.Phase == v1.PodSucceeded || podInfo.Status.Phase == v1.PodFailed {
+				continue
+			}
+			jobId := pod.GetJobKeyByPod(&podInfo)
+			if jobId == "" || !usesTarget(w, target.NodeName, podInfo) {
+				continue
+			}
+			jobs[jobId] = struct{}{}
+		}
+	}
+	result := make([]string, 0, len(jobs))
+	for jobId := range jobs {
+		result = append(result, jobId)
+	}
+	sort.Strings(result)
+	return result
+}
+
+func usesTarget(w *maintenance.Window, nodeName string, podInfo v1.Pod) bool {
+	for _, target := range w.Targets {
+		if target.NodeName == nodeName && len(target.DeviceNames) == 0 {
+			return true
+		}
+	}
+	for _, deviceId := range pod.GetPodUsedDev(podInfo) {
+		for _, target := range w.Targets {
+			for _, deviceName := range target.DeviceNames {
+				if target.NodeName == nodeName && deviceIdOf(deviceName) == deviceId {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// deviceIdOf the id of the device name, e.g. 0 of Ascend910-0
+func deviceIdOf(deviceName string) string {
+	return deviceName[strings.LastIndex(deviceName, "-")+1:]
+}
+
+func recordNodeEvents(w maintenance.Window, reason, message string) {
+	for _, target := range w.Targets {
+		ref := &v1.ObjectReference{Kind: "Node", Name: target.NodeName, APIVersion: "v1"}
+		kube.RecordEvent(ref, v1.EventTypeNormal, reason, fmt.Sprintf("maintenance window %s: %s", w.Id, message))
+	}
+}
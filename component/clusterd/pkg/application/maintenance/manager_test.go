@@ -0,0 +1,103 @@
+/* Copyright(C) 2026. Huawei Technologies Co.,Ltd. All rights reserved.
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+// Package maintenance test for the maintenance window manager
+package maintenance
+
+import (
+	"context"
+	"testing"
+
+	"github.com/agiledragon/gomonkey/v2"
+	"github.com/smartystreets/goconvey/convey"
+	"k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"ascend-common/common-utils/hwlog"
+	"clusterd/pkg/domain/maintenance"
+	"clusterd/pkg/domain/pod"
+)
+
+const (
+	testNode  = "node1"
+	testJobId = "job1-uid"
+	testStart = 1000
+	testEnd   = 1000000
+)
+
+func init() {
+	if err := hwlog.InitRunLogger(&hwlog.LogConfig{OnlyToStdout: true}, context.Background()); err != nil {
+		panic(err)
+	}
+}
+
+func testPods() map[string]v1.Pod {
+	testPod := v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "pod1"}, Status: v1.PodStatus{Phase: v1.PodRunning}}
+	return map[string]v1.Pod{testPod.Name: testPod}
+}
+
+func TestAdvance(t *testing.T) {
+	convey.Convey("Test advance", t, func() {
+		maintenance.Windows = maintenance.NewStore()
+		id, err := maintenance.Windows.Add(maintenance.Window{Targets: []maintenance.Target{{NodeName: testNode,
+			DeviceNames: []string{"Ascend910-0"}}}, StartTime: testStart, EndTime: testEnd, DrainTimeout: 10})
+		convey.So(err, convey.ShouldBeNil)
+		usedDevs := []string{"0"}
+		patches := gomonkey.ApplyFuncReturn(pod.GetPodsByNodeName, testPods(), true).
+			ApplyFuncReturn(pod.GetJobKeyByPod, testJobId).
+			ApplyFunc(pod.GetPodUsedDev, func(v1.Pod) []string { return usedDevs })
+		defer patches.Reset()
+		status := func() maintenance.Window {
+			window, _ := maintenance.Windows.Get(id)
+			return window
+		}
+		advance(testStart - 1)
+		convey.So(status().Status, convey.ShouldEqual, maintenance.StatusScheduled)
+		advance(testStart)
+		convey.So(status().Status, convey.ShouldEqual, maintenance.StatusDraining)
+		convey.So(status().AffectedJobs, convey.ShouldResemble, []string{testJobId})
+		convey.Convey("01-jobs left the devices, should separate the devices then release them", func() {
+			usedDevs = []string{"1"}
+			advance(testStart + 1)
+			convey.So(status().Status, convey.ShouldEqual, maintenance.StatusInProgress)
+			convey.So(status().AffectedJobs, convey.ShouldBeEmpty)
+			advance(testEnd)
+			convey.So(status().Status, convey.ShouldEqual, maintenance.StatusCompleted)
+			actions := make([]string, 0)
+			for _, audit := range status().Audits {
+				actions = append(actions, audit.Action)
+			}
+			convey.So(actions, convey.ShouldResemble, []string{maintenance.ActionCreate, maintenance.ActionDrain,
+				maintenance.ActionSeparate, maintenance.ActionRelease})
+		})
+		convey.Convey("02-drain timed out, should separate the devices with the jobs left", func() {
+			advance(testStart + 1)
+			convey.So(status().Status, convey.ShouldEqual, maintenance.StatusDraining)
+			advance(testStart + 10*1000)
+			convey.So(status().Status, convey.ShouldEqual, maintenance.StatusInProgress)
+			convey.So(status().AffectedJobs, convey.ShouldResemble, []string{testJobId})
+		})
+		convey.Convey("03-window without drain timeout, should separate the devices after the default timeout", func() {
+			_, err = maintenance.Windows.Update(id, func(w *maintenance.Window) bool {
+				w.DrainTimeout = 0
+				return true
+			})
+			convey.So(err, convey.ShouldBeNil)
+			advance(testStart + maintenance.DefaultDrainTimeout*1000 - 1)
+			convey.So(status().Status, convey.ShouldEqual, maintenance.StatusDraining)
+			advance(testStart + maintenance.DefaultDrainTimeout*1000)
+			convey.So(status().Status, convey.ShouldEqual, maintenance.StatusInProgress)
+		})
+	})
+}
@@ -46,7 +46,7 @@ func PubFaultCollector(newPubFault *api.PubFaultInfo) error {
 
 	if err := LimitByResource(newPubFault.Resource); err != nil {
 		hwlog.RunLog.Errorf("limiter work by resource failed, error: %v", err)
-		return errors.New("limiter work by resource failed")
+		return errors.New(limiterErrInfo)
 	}
 	if err := NewPubFaultInfoChecker(newPubFault).CheckAndFlush(); err != nil {
 		hwlog.RunLog.Errorf("check public fault info failed, error: %v", err)
@@ -44,21 +44,15 @@ func (s *PubFaultService) SendPublicFault(ctx context.Context,
 
 	pubFaultInfo := constructPubFaultInfo(req)
 	if err := PubFaultCollector(pubFaultInfo); err != nil {
-		if err.Error() == "limiter work by resource failed" {
-			return &pubfault.RespStatus{
-				Code: int32(common.InvalidReqRate),
-				Info: err.Error(),
-			}, nil
-		}
 		return &pubfault.RespStatus{
-			Code: int32(common.InvalidReqParam),
+			Code: collectErrorCode(err),
 			Info: err.Error(),
 		}, nil
 	}
 	res = constant.Success
 	return &pubfault.RespStatus{
 		Code: int32(common.OK),
-		Info: "public fault send successfully",
+		Info: successInfo,
 	}, nil
 }
 
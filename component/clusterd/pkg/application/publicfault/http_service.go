@@ -0,0 +1,141 @@
+// Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+
+package publicfault
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"net/http"
+	"time"
+
+	"ascend-common/api"
+	"ascend-common/common-utils/hwlog"
+	"ascend-common/common-utils/limiter"
+	"clusterd/pkg/common/constant"
+)
+
+const (
+	// PubFaultHttpPath the path of the http ingestion endpoint
+	PubFaultHttpPath = "/v1/publicfaults"
+	// maxBatchSize the upper limit of the public fault messages in a batch
+	maxBatchSize        = 100
+	maxHttpBodyBytes    = 4 * 1024 * 1024
+	httpServerTimeout   = 30 * time.Second
+	httpShutdownTimeout = 5 * time.Second
+	httpMaxHeaderBytes  = 4096
+)
+
+// BatchResponse the acknowledgements of the public fault messages in the order of the batch
+type BatchResponse struct {
+	Results []IngestResult `json:"results"`
+}
+
+// NewHttpHandler return the handler of the http ingestion endpoint. the body is a public fault message or an array
+// of them, each one is acknowledged in the response
+func NewHttpHandler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc(PubFaultHttpPath, handleHttpIngest)
+	return mux
+}
+
+func handleHttpIngest(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "only POST is allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxHttpBodyBytes))
+	if err != nil {
+		RecordDeadLetter(SourceHttp, r.RemoteAddr, err.Error(), body)
+		http.Error(w, "read body failed", http.StatusRequestEntityTooLarge)
+		return
+	}
+	pubFaultInfos, err := decodeBatch(body)
+	if err != nil {
+		RecordDeadLetter(SourceHttp, r.RemoteAddr, err.Error(), body)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	resp := BatchResponse{Results: make([]IngestResult, 0, len(pubFaultInfos))}
+	for i := range pubFaultInfos {
+		resp.Results = append(resp.Results, Ingest(SourceHttp, &pubFaultInfos[i]))
+	}
+	data, err := json.Marshal(resp)
+	if err != nil {
+		http.Error(w, "marshal response failed", http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if _, err = w.Write(data); err != nil {
+		hwlog.RunLog.Errorf("write public fault response failed, err: %v", err)
+	}
+}
+
+// decodeBatch decode a public fault message or an array of them
+func decodeBatch(payload []byte) ([]api.PubFaultInfo, error) {
+	trimmed := bytes.TrimSpace(payload)
+	if len(trimmed) == 0 {
+		return nil, errors.New("payload is empty")
+	}
+	var pubFaultInfos []api.PubFaultInfo
+	if trimmed[0] == '[' {
+		if err := json.Unmarshal(trimmed, &pubFaultInfos); err != nil {
+			return nil, fmt.Errorf("decode public fault batch failed: %v", err)
+		}
+	} else {
+		var pubFaultInfo api.PubFaultInfo
+		if err := json.Unmarshal(trimmed, &pubFaultInfo); err != nil {
+			return nil, fmt.Errorf("decode public fault failed: %v", err)
+		}
+		pubFaultInfos = append(pubFaultInfos, pubFaultInfo)
+	}
+	if len(pubFaultInfos) == 0 || len(pubFaultInfos) > maxBatchSize {
+		return nil, fmt.Errorf("the size of the batch should be in [1, %d]", maxBatchSize)
+	}
+	return pubFaultInfos, nil
+}
+
+// ServeHttp serve the handler of the http ingestion endpoint on the address until ctx is done, the endpoint is https
+// when tlsConfig is not nil
+func ServeHttp(ctx context.Context, address string, tlsConfig *tls.Config, handler http.Handler) error {
+	tcpListener, err := net.Listen("tcp", address)
+	if err != nil {
+		return fmt.Errorf("public fault http server listen failed: %v", err)
+	}
+	limitedListener, err := limiter.LimitListener(tcpListener, constant.MaxConcurrentLimit,
+		constant.MaxIPConnectionLimit, constant.CacheSize)
+	if err != nil {
+		return fmt.Errorf("create limit listener of public fault http server failed: %v", err)
+	}
+	if tlsConfig != nil {
+		limitedListener = tls.NewListener(limitedListener, tlsConfig)
+	}
+	server := &http.Server{
+		Handler:        handler,
+		ReadTimeout:    httpServerTimeout,
+		WriteTimeout:   httpServerTimeout,
+		MaxHeaderBytes: httpMaxHeaderBytes,
+		ErrorLog:       log.New(&hwlog.SelfLogWriter{}, "", log.Lshortfile),
+	}
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), httpShutdownTimeout)
+		defer cancel()
+		if err := server.Shutdown(shutdownCtx); err != nil {
+			hwlog.RunLog.Warnf("shutdown public fault http server failed: %v", err)
+		}
+	}()
+	go func() {
+		hwlog.RunLog.Infof("public fault http server listen on: %s, tls: %v", address, tlsConfig != nil)
+		if err := server.Serve(limitedListener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			hwlog.RunLog.Errorf("public fault http server crashed, err: %v", err)
+		}
+	}()
+	return nil
+}
@@ -0,0 +1,54 @@
+// Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+
+// Package publicfault test for the http ingestion endpoint
+package publicfault
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/agiledragon/gomonkey/v2"
+	"github.com/smartystreets/goconvey/convey"
+
+	"ascend-common/api"
+	"clusterd/pkg/domain/common"
+)
+
+func serveTestRequest(method, body string) *httptest.ResponseRecorder {
+	recorder := httptest.NewRecorder()
+	NewHttpHandler().ServeHTTP(recorder, httptest.NewRequest(method, PubFaultHttpPath, strings.NewReader(body)))
+	return recorder
+}
+
+func TestHandleHttpIngest(t *testing.T) {
+	convey.Convey("Test handleHttpIngest", t, func() {
+		patches := gomonkey.ApplyFunc(Ingest, func(_ string, info *api.PubFaultInfo) IngestResult {
+			return IngestResult{Id: info.Id, Code: int32(common.OK)}
+		}).ApplyFunc(RecordDeadLetter, func(string, string, string, interface{}) {})
+		defer patches.Reset()
+		convey.Convey("01-single message, should acknowledge it", func() {
+			recorder := serveTestRequest(http.MethodPost, `{"id":"1"}`)
+			convey.So(recorder.Code, convey.ShouldEqual, http.StatusOK)
+			var resp BatchResponse
+			convey.So(json.Unmarshal(recorder.Body.Bytes(), &resp), convey.ShouldBeNil)
+			convey.So(resp.Results, convey.ShouldResemble, []IngestResult{{Id: "1", Code: int32(common.OK)}})
+		})
+		convey.Convey("02-batch, should acknowledge each message in order", func() {
+			recorder := serveTestRequest(http.MethodPost, `[{"id":"1"},{"id":"2"}]`)
+			var resp BatchResponse
+			convey.So(json.Unmarshal(recorder.Body.Bytes(), &resp), convey.ShouldBeNil)
+			convey.So(len(resp.Results), convey.ShouldEqual, 2)
+			convey.So(resp.Results[1].Id, convey.ShouldEqual, "2")
+		})
+		convey.Convey("03-invalid payload, should return bad request", func() {
+			convey.So(serveTestRequest(http.MethodPost, `[]`).Code, convey.ShouldEqual, http.StatusBadRequest)
+			convey.So(serveTestRequest(http.MethodPost, `{invalid`).Code, convey.ShouldEqual, http.StatusBadRequest)
+		})
+		convey.Convey("04-not POST, should return method not allowed", func() {
+			convey.So(serveTestRequest(http.MethodGet, "").Code, convey.ShouldEqual, http.StatusMethodNotAllowed)
+		})
+	})
+}
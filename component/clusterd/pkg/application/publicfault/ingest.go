@@ -0,0 +1,158 @@
+// Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+
+// Package publicfault ingestion for public fault from http and kafka
+package publicfault
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"ascend-common/api"
+	"ascend-common/common-utils/hwlog"
+	"clusterd/pkg/common/logs"
+	"clusterd/pkg/domain/common"
+)
+
+const (
+	// SourceHttp the public faults posted to the http ingestion endpoint
+	SourceHttp = "http"
+	// SourceKafka the public faults consumed from kafka
+	SourceKafka = "kafka"
+
+	// dedupWindow the redelivered fault within the window is acknowledged without being processed again
+	dedupWindow = 10 * time.Minute
+	// maxDedupKeys the upper limit of the remembered faults
+	maxDedupKeys = 100000
+	// evictInterval the interval of dropping the expired faults
+	evictInterval = 60
+	// maxDeadLetterPayload the rejected payload longer than it is truncated in the dead-letter log
+	maxDeadLetterPayload = 4096
+
+	limiterErrInfo = "limiter work by resource failed"
+	successInfo    = "public fault send successfully"
+	duplicateInfo  = "public fault is duplicate, ignored"
+)
+
+var deduplicator = &faultDeduplicator{seen: make(map[string]int64)}
+
+// IngestResult the acknowledgement of a public fault message
+type IngestResult struct {
+	Id   string `json:"id"`
+	Code int32  `json:"code"`
+	Info string `json:"info"`
+}
+
+// Ingest collect the public fault message from the source through the same limiter, checker and cache as the grpc
+// service. the faults received before are acknowledged without being processed again, and the rejected message
+// is written to the dead-letter log unless it is rate limited, which is expected to be sent again
+func Ingest(source string, pubFaultInfo *api.PubFaultInfo) IngestResult {
+	if pubFaultInfo == nil {
+		return IngestResult{Code: int32(common.InvalidReqParam), Info: "public fault info is nil"}
+	}
+	keys, faults := deduplicator.reserve(pubFaultInfo)
+	if len(faults) == 0 && len(pubFaultInfo.Faults) > 0 {
+		hwlog.RunLog.Infof("public fault %s from %s is duplicate", pubFaultInfo.Id, source)
+		return IngestResult{Id: pubFaultInfo.Id, Code: int32(common.OK), Info: duplicateInfo}
+	}
+	freshInfo := *pubFaultInfo
+	freshInfo.Faults = faults
+	if err := PubFaultCollector(&freshInfo); err != nil {
+		deduplicator.release(keys)
+		code := collectErrorCode(err)
+		if code != int32(common.InvalidReqRate) {
+			RecordDeadLetter(source, pubFaultInfo.Id, err.Error(), pubFaultInfo)
+		}
+		return IngestResult{Id: pubFaultInfo.Id, Code: code, Info: err.Error()}
+	}
+	return IngestResult{Id: pubFaultInfo.Id, Code: int32(common.OK), Info: successInfo}
+}
+
+// RecordDeadLetter write the rejected payload to the dead-letter log
+func RecordDeadLetter(source, id, reason string, payload interface{}) {
+	var content string
+	switch p := payload.(type) {
+	case []byte:
+		content = string(p)
+	default:
+		content = fmt.Sprintf("%+v", p)
+	}
+	if len(content) > maxDeadLetterPayload {
+		content = content[:maxDeadLetterPayload] + "...(truncated)"
+	}
+	content = strings.ReplaceAll(content, "\n", " ")
+	if logs.PubFaultDeadLetterLog == nil {
+		hwlog.RunLog.Warnf("reject public fault from %s, id: %s, reason: %s", source, id, reason)
+		return
+	}
+	logs.PubFaultDeadLetterLog.Warnf("source: %s, id: %s, reason: %s, payload: %s", source, id, reason, content)
+}
+
+func collectErrorCode(err error) int32 {
+	if err.Error() == limiterErrInfo {
+		return int32(common.InvalidReqRate)
+	}
+	return int32(common.InvalidReqParam)
+}
+
+// faultDeduplicator remember the faults collected within dedupWindow
+type faultDeduplicator struct {
+	mutex sync.Mutex
+	// seen key: resource/faultId/assertion/faultTime; value: unix seconds when it expires
+	seen      map[string]int64
+	lastEvict int64
+}
+
+func dedupKey(resource string, fault api.Fault) string {
+	return strings.Join([]string{resource, fault.FaultId, fault.Assertion,
+		strconv.FormatInt(fault.FaultTime, 10)}, "/")
+}
+
+// reserve remember the faults not seen before and return their keys, the keys should be released when the faults
+// are not collected
+func (d *faultDeduplicator) reserve(pubFaultInfo *api.PubFaultInfo) ([]string, []api.Fault) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	now := time.Now().Unix()
+	d.evict(now)
+	var keys []string
+	var faults []api.Fault
+	for _, fault := range pubFaultInfo.Faults {
+		key := dedupKey(pubFaultInfo.Resource, fault)
+		if expire, ok := d.seen[key]; ok && expire > now {
+			continue
+		}
+		d.seen[key] = now + int64(dedupWindow.Seconds())
+		keys = append(keys, key)
+		faults = append(faults, fault)
+	}
+	return keys, faults
+}
+
+func (d *faultDeduplicator) release(keys []string) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	for _, key := range keys {
+		delete(d.seen, key)
+	}
+}
+
+// evict drop the expired keys every evictInterval, and drop the keys randomly when they exceed maxDedupKeys
+func (d *faultDeduplicator) evict(now int64) {
+	if now-d.lastEvict >= evictInterval {
+		d.lastEvict = now
+		for key, expire := range d.seen {
+			if expire <= now {
+				delete(d.seen, key)
+			}
+		}
+	}
+	for key := range d.seen {
+		if len(d.seen) < maxDedupKeys {
+			break
+		}
+		delete(d.seen, key)
+	}
+}
@@ -0,0 +1,73 @@
+// Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+
+// Package publicfault test for the public fault ingestion
+package publicfault
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/agiledragon/gomonkey/v2"
+	"github.com/smartystreets/goconvey/convey"
+
+	"ascend-common/api"
+	"clusterd/pkg/domain/common"
+)
+
+func testIngestInfo(faultId string) *api.PubFaultInfo {
+	return &api.PubFaultInfo{
+		Id:       faultId,
+		Resource: testResource1,
+		Faults:   []api.Fault{{FaultId: faultId, FaultTime: testTimeStamp, Assertion: "occur"}},
+	}
+}
+
+func TestIngest(t *testing.T) {
+	convey.Convey("Test Ingest", t, func() {
+		deduplicator = &faultDeduplicator{seen: make(map[string]int64)}
+		collected, deadLetters := 0, 0
+		var collectErr error
+		patches := gomonkey.ApplyFunc(PubFaultCollector, func(info *api.PubFaultInfo) error {
+			collected += len(info.Faults)
+			return collectErr
+		}).ApplyFunc(RecordDeadLetter, func(string, string, string, interface{}) { deadLetters++ })
+		defer patches.Reset()
+		convey.Convey("01-nil info, should return invalid param", func() {
+			result := Ingest(SourceHttp, nil)
+			convey.So(result.Code, convey.ShouldEqual, int32(common.InvalidReqParam))
+		})
+		convey.Convey("02-redelivered fault, should be acknowledged without collecting again", func() {
+			convey.So(Ingest(SourceHttp, testIngestInfo(testId1)).Info, convey.ShouldEqual, successInfo)
+			result := Ingest(SourceKafka, testIngestInfo(testId1))
+			convey.So(result.Code, convey.ShouldEqual, int32(common.OK))
+			convey.So(result.Info, convey.ShouldEqual, duplicateInfo)
+			convey.So(collected, convey.ShouldEqual, 1)
+		})
+		convey.Convey("03-collect failed, should release the fault and record the dead letter", func() {
+			collectErr = errors.New("invalid fault")
+			result := Ingest(SourceHttp, testIngestInfo(testId2))
+			convey.So(result.Code, convey.ShouldEqual, int32(common.InvalidReqParam))
+			convey.So(deadLetters, convey.ShouldEqual, 1)
+			convey.So(len(deduplicator.seen), convey.ShouldEqual, 0)
+		})
+		convey.Convey("04-rate limited, should release the fault without the dead letter", func() {
+			collectErr = errors.New(limiterErrInfo)
+			result := Ingest(SourceHttp, testIngestInfo(testId2))
+			convey.So(result.Code, convey.ShouldEqual, int32(common.InvalidReqRate))
+			convey.So(deadLetters, convey.ShouldEqual, 0)
+			convey.So(len(deduplicator.seen), convey.ShouldEqual, 0)
+		})
+	})
+}
+
+func TestFaultDeduplicatorEvict(t *testing.T) {
+	convey.Convey("Test faultDeduplicator evict, should drop the expired keys", t, func() {
+		now := time.Now().Unix()
+		d := &faultDeduplicator{seen: map[string]int64{"expired": now - 1, "valid": now + 1}}
+		d.evict(now)
+		_, expired := d.seen["expired"]
+		convey.So(expired, convey.ShouldBeFalse)
+		convey.So(len(d.seen), convey.ShouldEqual, 1)
+	})
+}
@@ -0,0 +1,150 @@
+// Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+
+package publicfault
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Shopify/sarama"
+
+	"ascend-common/common-utils/hwlog"
+	"clusterd/pkg/domain/common"
+)
+
+const (
+	// commitInterval the offsets of the handled messages are committed in batch every interval
+	commitInterval = time.Second
+	consumeRetry   = 5 * time.Second
+	// rateLimitBackoff the first backoff of the rate limited message, it doubles up to maxRateLimitBackoff
+	rateLimitBackoff    = time.Second
+	maxRateLimitBackoff = 30 * time.Second
+)
+
+// KafkaConfig the kafka input of the public faults
+type KafkaConfig struct {
+	Brokers []string
+	Topic   string
+	Group   string
+}
+
+func newSaramaConfig() *sarama.Config {
+	saramaConfig := sarama.NewConfig()
+	saramaConfig.ClientID = "clusterd"
+	saramaConfig.Version = sarama.V2_1_0_0
+	saramaConfig.Consumer.Return.Errors = true
+	saramaConfig.Consumer.Offsets.Initial = sarama.OffsetNewest
+	saramaConfig.Consumer.Offsets.AutoCommit.Enable = true
+	saramaConfig.Consumer.Offsets.AutoCommit.Interval = commitInterval
+	return saramaConfig
+}
+
+// ConsumeKafka consume the public fault messages of the topic as the member of the consumer group until ctx is
+// done. a message is marked after all the public faults in it are ingested or rejected to the dead-letter log, the
+// rate limited message is ingested again after the backoff
+func ConsumeKafka(ctx context.Context, config KafkaConfig) error {
+	if len(config.Brokers) == 0 || config.Topic == "" || config.Group == "" {
+		return errors.New("the brokers, topic and group of kafka are required")
+	}
+	group, err := sarama.NewConsumerGroup(config.Brokers, config.Group, newSaramaConfig())
+	if err != nil {
+		return fmt.Errorf("create kafka consumer group failed: %v", err)
+	}
+	go func() {
+		for err := range group.Errors() {
+			hwlog.RunLog.Errorf("consume public faults from kafka failed, err: %v", err)
+		}
+	}()
+	go func() {
+		defer func() {
+			if err := group.Close(); err != nil {
+				hwlog.RunLog.Warnf("close kafka consumer group failed, err: %v", err)
+			}
+		}()
+		hwlog.RunLog.Infof("start consuming public faults from kafka topic %s, group: %s", config.Topic, config.Group)
+		for ctx.Err() == nil {
+			// Consume returns when the group rebalances, and it is called again to join the new generation
+			if err := group.Consume(ctx, []string{config.Topic}, kafkaHandler{}); err != nil {
+				hwlog.RunLog.Errorf("kafka consumer group session failed, err: %v", err)
+				select {
+				case <-ctx.Done():
+				case <-time.After(consumeRetry):
+				}
+			}
+		}
+		hwlog.RunLog.Infof("stop consuming public faults from kafka topic %s", config.Topic)
+	}()
+	return nil
+}
+
+type kafkaHandler struct{}
+
+// Setup is run at the beginning of a new session
+func (kafkaHandler) Setup(sarama.ConsumerGroupSession) error {
+	return nil
+}
+
+// Cleanup is run at the end of a session
+func (kafkaHandler) Cleanup(sarama.ConsumerGroupSession) error {
+	return nil
+}
+
+// ConsumeClaim ingest the messages of the claimed partition. the rate limited message is not marked, it is
+// ingested again after the backoff, and the faults of it ingested before are acknowledged as duplicate
+func (kafkaHandler) ConsumeClaim(session sarama.ConsumerGroupSession, claim sarama.ConsumerGroupClaim) error {
+	for {
+		select {
+		case msg, ok := <-claim.Messages():
+			if !ok {
+				return nil
+			}
+			if !ingestUntilNotLimited(session.Context(), msg) {
+				return nil
+			}
+			session.MarkMessage(msg, "")
+		case <-session.Context().Done():
+			return nil
+		}
+	}
+}
+
+// ingestUntilNotLimited ingest the message until it is not rate limited, return false when ctx is done before
+func ingestUntilNotLimited(ctx context.Context, msg *sarama.ConsumerMessage) bool {
+	backoff := rateLimitBackoff
+	for handleKafkaMessage(msg) {
+		hwlog.RunLog.Warnf("kafka message %s/%d/%d is rate limited, retry after %v", msg.Topic, msg.Partition,
+			msg.Offset, backoff)
+		select {
+		case <-ctx.Done():
+			return false
+		case <-time.After(backoff):
+		}
+		backoff = min(backoff*2, maxRateLimitBackoff)
+	}
+	return true
+}
+
+// handleKafkaMessage ingest the public faults of the message and return whether some of them are rate limited
+func handleKafkaMessage(msg *sarama.ConsumerMessage) bool {
+	msgId := fmt.Sprintf("%s/%d/%d", msg.Topic, msg.Partition, msg.Offset)
+	pubFaultInfos, err := decodeBatch(msg.Value)
+	if err != nil {
+		RecordDeadLetter(SourceKafka, msgId, err.Error(), msg.Value)
+		return false
+	}
+	limited := false
+	for i := range pubFaultInfos {
+		result := Ingest(SourceKafka, &pubFaultInfos[i])
+		if result.Code == int32(common.InvalidReqRate) {
+			limited = true
+			continue
+		}
+		if result.Code != int32(common.OK) {
+			hwlog.RunLog.Warnf("public fault %s in kafka message %s is rejected, code: %d, info: %s",
+				result.Id, msgId, result.Code, result.Info)
+		}
+	}
+	return limited
+}
@@ -0,0 +1,92 @@
+// Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+
+// Package publicfault test for the kafka consumer
+package publicfault
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Shopify/sarama"
+	"github.com/agiledragon/gomonkey/v2"
+	"github.com/smartystreets/goconvey/convey"
+
+	"ascend-common/api"
+	"clusterd/pkg/domain/common"
+)
+
+const maxRetryTimes = 100
+
+type fakeSession struct {
+	sarama.ConsumerGroupSession
+	ctx    context.Context
+	marked []int64
+}
+
+func (s *fakeSession) Context() context.Context {
+	return s.ctx
+}
+
+func (s *fakeSession) MarkMessage(msg *sarama.ConsumerMessage, _ string) {
+	s.marked = append(s.marked, msg.Offset)
+}
+
+type fakeClaim struct {
+	sarama.ConsumerGroupClaim
+	messages chan *sarama.ConsumerMessage
+}
+
+func (c *fakeClaim) Messages() <-chan *sarama.ConsumerMessage {
+	return c.messages
+}
+
+func TestConsumeClaim(t *testing.T) {
+	convey.Convey("Test ConsumeClaim, should ingest and mark the valid and invalid messages", t, func() {
+		ingested, deadLetters := 0, 0
+		patches := gomonkey.ApplyFunc(Ingest, func(_ string, info *api.PubFaultInfo) IngestResult {
+			ingested++
+			return IngestResult{Id: info.Id}
+		}).ApplyFunc(RecordDeadLetter, func(string, string, string, interface{}) { deadLetters++ })
+		defer patches.Reset()
+		claim := &fakeClaim{messages: make(chan *sarama.ConsumerMessage, 2)}
+		claim.messages <- &sarama.ConsumerMessage{Offset: 1, Value: []byte(`[{"id":"1"},{"id":"2"}]`)}
+		claim.messages <- &sarama.ConsumerMessage{Offset: 2, Value: []byte(`invalid`)}
+		close(claim.messages)
+		session := &fakeSession{ctx: context.Background()}
+		convey.So(kafkaHandler{}.ConsumeClaim(session, claim), convey.ShouldBeNil)
+		convey.So(ingested, convey.ShouldEqual, 2)
+		convey.So(deadLetters, convey.ShouldEqual, 1)
+		convey.So(session.marked, convey.ShouldResemble, []int64{1, 2})
+	})
+	convey.Convey("Test ConsumeClaim, should not mark the rate limited message until it is ingested", t, func() {
+		ingested, limitedTimes := 0, 1
+		patches := gomonkey.ApplyFunc(Ingest, func(_ string, info *api.PubFaultInfo) IngestResult {
+			ingested++
+			if ingested <= limitedTimes {
+				return IngestResult{Id: info.Id, Code: int32(common.InvalidReqRate)}
+			}
+			return IngestResult{Id: info.Id}
+		})
+		defer patches.Reset()
+		claim := &fakeClaim{messages: make(chan *sarama.ConsumerMessage, 1)}
+		claim.messages <- &sarama.ConsumerMessage{Offset: 1, Value: []byte(`{"id":"1"}`)}
+		close(claim.messages)
+		session := &fakeSession{ctx: context.Background()}
+		convey.So(kafkaHandler{}.ConsumeClaim(session, claim), convey.ShouldBeNil)
+		convey.So(ingested, convey.ShouldEqual, len([]string{"limited", "ingested"}))
+		convey.So(session.marked, convey.ShouldResemble, []int64{1})
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		ingested, limitedTimes = 0, maxRetryTimes
+		msg := &sarama.ConsumerMessage{Offset: 2, Value: []byte(`{"id":"2"}`)}
+		convey.So(ingestUntilNotLimited(ctx, msg), convey.ShouldBeFalse)
+		convey.So(ingested, convey.ShouldEqual, 1)
+	})
+}
+
+func TestConsumeKafka(t *testing.T) {
+	convey.Convey("Test ConsumeKafka, incomplete config should return error", t, func() {
+		convey.So(ConsumeKafka(context.Background(), KafkaConfig{Topic: "topic"}), convey.ShouldNotBeNil)
+	})
+}
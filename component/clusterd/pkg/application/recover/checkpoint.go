@@ -0,0 +1,461 @@
+// Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+
+// Package recover a series of service function
+package recover
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"maps"
+	"slices"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"ascend-common/api"
+	"ascend-common/common-utils/hwlog"
+	"clusterd/pkg/common/constant"
+	"clusterd/pkg/domain/common"
+	"clusterd/pkg/domain/podgroup"
+	"clusterd/pkg/interface/grpc/recover"
+	"clusterd/pkg/interface/kube"
+)
+
+const (
+	// restoreCheckDelay wait for the informers synced before dropping the restored jobs which have been deleted
+	restoreCheckDelay = time.Minute
+	// checkpointFlushDelay merge the checkpoints changed in a short time into one write
+	checkpointFlushDelay = 100 * time.Millisecond
+	// checkpointRetryDelay the delay of writing the checkpoints again after failed
+	checkpointRetryDelay = 5 * time.Second
+	// maxCheckpointBytes the checkpoint larger than it is not saved, the size of a configmap is limited to 1MiB
+	maxCheckpointBytes = 1000 * 1024
+	checkpointDataKey  = "checkpoint"
+	// checkpointCmHashLen the bytes of the hashed job id in the name of the configmap
+	checkpointCmHashLen = 16
+)
+
+// controllerCheckpoint the durable state of EventController. it is updated before the handler of every transition
+// is called and after every event enqueue, and written in the background, so the state machine can be rebuilt
+// after clusterd restarts
+type controllerCheckpoint struct {
+	JobInfo common.JobBaseInfo `json:"jobInfo"`
+	Uuid    string             `json:"uuid"`
+	State   string             `json:"state"`
+	// Src and Event the last transition to State, used to call the handler of State again when interrupted
+	Src   string `json:"src"`
+	Event string `json:"event"`
+	// Handling the handler of State has not returned
+	Handling bool `json:"handling"`
+	// PendingEvents the events enqueued and not triggered yet
+	PendingEvents []string `json:"pendingEvents"`
+	// ReportDeadline unix milliseconds when waiting for the report of State times out
+	ReportDeadline              int64                             `json:"reportDeadline"`
+	FaultPod                    map[string]string                 `json:"faultPod"`
+	CacheNormalFault            []*pb.FaultRank                   `json:"cacheNormalFault"`
+	CacheRetryFault             []*pb.FaultRank                   `json:"cacheRetryFault"`
+	LatestStrategy              []string                          `json:"latestStrategy"`
+	LatestRecoverResult         []*pb.RecoverStatusRequest        `json:"latestRecoverResult"`
+	AgentReportStrategies       []string                          `json:"agentReportStrategies"`
+	PlatStrategy                string                            `json:"platStrategy"`
+	HealthState                 string                            `json:"healthState"`
+	RestartFaultProcess         bool                              `json:"restartFaultProcess"`
+	RecoverInPlacePodFaults     map[string]*constant.PodFaultInfo `json:"recoverInPlacePodFaults"`
+	GlobalSwitchRankIDs         []string                          `json:"globalSwitchRankIDs"`
+	GlobalOps                   []bool                            `json:"globalOps"`
+	StressTestParam             common.StressTestParam            `json:"stressTestParam"`
+	IsolateNodes                []string                          `json:"isolateNodes"`
+	CurrentHotSwitchFaultPodId  string                            `json:"currentHotSwitchFaultPodId"`
+	CurrentHotSwitchBackupPodId string                            `json:"currentHotSwitchBackupPodId"`
+}
+
+// checkpointStore save the checkpoint of each job in its own configmap. the checkpoints are written
+// asynchronously by one goroutine, the changes in a short time are merged into one write
+type checkpointStore struct {
+	lock        sync.Mutex
+	checkpoints map[string]string
+	// dirty the jobs whose checkpoint has changed and not been written
+	dirty  map[string]struct{}
+	notify chan struct{}
+	once   sync.Once
+}
+
+var recoverCheckpoints = newCheckpointStore()
+
+func newCheckpointStore() *checkpointStore {
+	return &checkpointStore{
+		checkpoints: make(map[string]string),
+		dirty:       make(map[string]struct{}),
+		notify:      make(chan struct{}, 1),
+	}
+}
+
+func checkpointEnabled() bool {
+	client := kube.GetClientK8s()
+	return client != nil && client.ClientSet != nil
+}
+
+// checkpointCmName the name of the configmap saving the checkpoint of the job, the job id is hashed to make up a
+// valid name
+func checkpointCmName(jobId string) string {
+	sum := sha256.Sum256([]byte(jobId))
+	return constant.RecoverCheckpointCmName + "-" + hex.EncodeToString(sum[:checkpointCmHashLen])
+}
+
+// save update the checkpoint when valid returns true, the checkpoint of an idle controller is deleted
+func (s *checkpointStore) save(cp *controllerCheckpoint, valid func() bool) {
+	if cp.State == common.InitState && !cp.Handling && len(cp.PendingEvents) == 0 {
+		s.deleteIf(cp.JobInfo.JobId, valid)
+		return
+	}
+	data, err := json.Marshal(cp)
+	if err != nil {
+		hwlog.RunLog.Errorf("marshal checkpoint failed, jobId=%s, err=%v", cp.JobInfo.JobId, err)
+		return
+	}
+	s.lock.Lock()
+	if !valid() || s.checkpoints[cp.JobInfo.JobId] == string(data) {
+		s.lock.Unlock()
+		return
+	}
+	s.checkpoints[cp.JobInfo.JobId] = string(data)
+	s.dirty[cp.JobInfo.JobId] = struct{}{}
+	s.lock.Unlock()
+	s.schedule()
+}
+
+func (s *checkpointStore) deleteIf(jobId string, valid func() bool) {
+	s.lock.Lock()
+	if _, ok := s.checkpoints[jobId]; !ok || !valid() {
+		s.lock.Unlock()
+		return
+	}
+	delete(s.checkpoints, jobId)
+	s.dirty[jobId] = struct{}{}
+	s.lock.Unlock()
+	s.schedule()
+}
+
+func (s *checkpointStore) delete(jobId string) {
+	if !checkpointEnabled() {
+		return
+	}
+	s.deleteIf(jobId, func() bool { return true })
+}
+
+// schedule wake up the writer of the checkpoints
+func (s *checkpointStore) schedule() {
+	s.once.Do(func() { go s.run() })
+	select {
+	case s.notify <- struct{}{}:
+	default:
+	}
+}
+
+func (s *checkpointStore) run() {
+	for range s.notify {
+		time.Sleep(checkpointFlushDelay)
+		if !s.flush() {
+			time.AfterFunc(checkpointRetryDelay, s.schedule)
+		}
+	}
+}
+
+// flush write the changed checkpoints to the configmaps, the failed writes are kept dirty and retried later
+func (s *checkpointStore) flush() bool {
+	s.lock.Lock()
+	changes := make(map[string]*string, len(s.dirty))
+	for jobId := range s.dirty {
+		if data, ok := s.checkpoints[jobId]; ok {
+			changes[jobId] = &data
+		} else {
+			changes[jobId] = nil
+		}
+	}
+	s.dirty = make(map[string]struct{})
+	s.lock.Unlock()
+	succeeded := true
+	for jobId, data := range changes {
+		if err := writeCheckpoint(jobId, data); err != nil {
+			hwlog.RunLog.Errorf("write recover checkpoint of jobId=%s failed, err=%v", jobId, err)
+			s.lock.Lock()
+			s.dirty[jobId] = struct{}{}
+			s.lock.Unlock()
+			succeeded = false
+		}
+	}
+	return succeeded
+}
+
+// writeCheckpoint write the checkpoint of the job, the configmap is deleted when data is nil. the checkpoint
+// exceeding the size limit of the configmap is dropped, the job is not restored after clusterd restarts
+func writeCheckpoint(jobId string, data *string) error {
+	name := checkpointCmName(jobId)
+	if data != nil && len(*data) > maxCheckpointBytes {
+		hwlog.RunLog.Errorf("checkpoint of jobId=%s exceeds %d bytes, drop it and the job will not be "+
+			"restored after clusterd restarts", jobId, maxCheckpointBytes)
+		data = nil
+	}
+	if data == nil {
+		if err := kube.DeleteConfigMap(name, api.ClusterNS); err != nil && !errors.IsNotFound(err) {
+			return err
+		}
+		return nil
+	}
+	err := kube.UpdateOrCreateConfigMap(name, api.ClusterNS, map[string]string{checkpointDataKey: *data},
+		map[string]string{constant.RecoverCheckpointLabelKey: constant.CmConsumerValue})
+	if errors.IsRequestEntityTooLargeError(err) {
+		hwlog.RunLog.Errorf("checkpoint of jobId=%s is too large, drop it, err=%v", jobId, err)
+		return writeCheckpoint(jobId, nil)
+	}
+	return err
+}
+
+// load read the checkpoints saved before clusterd restarted, the invalid checkpoints are dropped
+func (s *checkpointStore) load() map[string]*controllerCheckpoint {
+	checkpoints := make(map[string]*controllerCheckpoint)
+	if !checkpointEnabled() {
+		return checkpoints
+	}
+	cms, err := kube.ListConfigMaps(api.ClusterNS, constant.RecoverCheckpointLabelKey+"="+constant.CmConsumerValue)
+	if err != nil {
+		hwlog.RunLog.Errorf("list recover checkpoint configmaps failed, err=%v", err)
+		return checkpoints
+	}
+	for _, cm := range cms.Items {
+		data := cm.Data[checkpointDataKey]
+		cp := &controllerCheckpoint{}
+		if err = json.Unmarshal([]byte(data), cp); err != nil || checkpointCmName(cp.JobInfo.JobId) != cm.Name {
+			hwlog.RunLog.Errorf("drop invalid recover checkpoint %s, err=%v", cm.Name, err)
+			if err = kube.DeleteConfigMap(cm.Name, api.ClusterNS); err != nil && !errors.IsNotFound(err) {
+				hwlog.RunLog.Errorf("delete invalid recover checkpoint %s failed, err=%v", cm.Name, err)
+			}
+			continue
+		}
+		checkpoints[cp.JobInfo.JobId] = cp
+		s.lock.Lock()
+		s.checkpoints[cp.JobInfo.JobId] = data
+		s.lock.Unlock()
+	}
+	return checkpoints
+}
+
+// onTransition record the transition and save the checkpoint before the handler is called
+func (ctl *EventController) onTransition(src, event, dst string) {
+	ctl.lock.Lock()
+	ctl.checkpointSrc = src
+	ctl.checkpointEvent = event
+	ctl.handling = true
+	ctl.reportDeadline = time.Now().Add(time.Duration(reportTimeoutMinutes) * time.Minute)
+	ctl.resumingWait = false
+	ctl.lock.Unlock()
+	ctl.eventLock.Lock()
+	if i := slices.Index(ctl.queuedEvents, event); i >= 0 {
+		ctl.queuedEvents = slices.Delete(ctl.queuedEvents, i, i+1)
+	}
+	ctl.eventLock.Unlock()
+	hwlog.RunLog.Debugf("jobId=%s transition %s(%s)-->%s", ctl.jobInfo.JobId, src, event, dst)
+	ctl.observeTransition(src, event, dst)
+	ctl.saveCheckpoint()
+}
+
+func (ctl *EventController) setHandled() {
+	ctl.lock.Lock()
+	defer ctl.lock.Unlock()
+	ctl.handling = false
+}
+
+// remainReportTimeout return the timeout of waiting for the report of the current state. only the first wait of
+// the handler resumed after clusterd restarts continues with the persisted deadline, the others wait the whole
+// timeout
+func (ctl *EventController) remainReportTimeout() time.Duration {
+	ctl.lock.Lock()
+	defer ctl.lock.Unlock()
+	if !ctl.resumingWait || ctl.reportDeadline.IsZero() {
+		return time.Duration(reportTimeoutMinutes) * time.Minute
+	}
+	ctl.resumingWait = false
+	return max(time.Until(ctl.reportDeadline), 0)
+}
+
+func (ctl *EventController) saveCheckpoint() {
+	if !checkpointEnabled() {
+		return
+	}
+	epoch := ctl.checkpointEpoch.Load()
+	recoverCheckpoints.save(ctl.checkpoint(), func() bool {
+		return ctl.checkpointEpoch.Load() == epoch
+	})
+}
+
+// dropCheckpoint delete the checkpoint, the saving in progress of the previous recover process is discarded
+func (ctl *EventController) dropCheckpoint() {
+	ctl.checkpointEpoch.Add(1)
+	recoverCheckpoints.delete(ctl.jobInfo.JobId)
+}
+
+func (ctl *EventController) checkpoint() *controllerCheckpoint {
+	ctl.lock.RLock()
+	cp := &controllerCheckpoint{
+		JobInfo:                     ctl.jobInfo,
+		Uuid:                        ctl.uuid,
+		State:                       ctl.state.GetState(),
+		Src:                         ctl.checkpointSrc,
+		Event:                       ctl.checkpointEvent,
+		Handling:                    ctl.handling,
+		FaultPod:                    maps.Clone(ctl.faultPod),
+		CacheNormalFault:            slices.Clone(ctl.cacheNormalFault),
+		CacheRetryFault:             slices.Clone(ctl.cacheRetryFault),
+		LatestStrategy:              slices.Clone(ctl.latestStrategy),
+		LatestRecoverResult:         slices.Clone(ctl.latestRecoverResult),
+		AgentReportStrategies:       slices.Clone(ctl.agentReportStrategies),
+		PlatStrategy:                ctl.platStrategy,
+		HealthState:                 ctl.healthState,
+		RestartFaultProcess:         ctl.restartFaultProcess,
+		RecoverInPlacePodFaults:     maps.Clone(ctl.recoverInPlacePodFaults),
+		GlobalSwitchRankIDs:         slices.Clone(ctl.globalSwitchRankIDs),
+		GlobalOps:                   slices.Clone(ctl.globalOps),
+		StressTestParam:             maps.Clone(ctl.stressTestParam),
+		IsolateNodes:                ctl.isolateNodes.List(),
+		CurrentHotSwitchFaultPodId:  ctl.currentHotSwitchFaultPodId,
+		CurrentHotSwitchBackupPodId: ctl.currentHotSwitchBackupPodId,
+	}
+	if !ctl.reportDeadline.IsZero() {
+		cp.ReportDeadline = ctl.reportDeadline.UnixMilli()
+	}
+	ctl.lock.RUnlock()
+	ctl.eventLock.Lock()
+	cp.PendingEvents = slices.Clone(ctl.queuedEvents)
+	ctl.eventLock.Unlock()
+	return cp
+}
+
+func (ctl *EventController) applyCheckpoint(cp *controllerCheckpoint) {
+	ctl.lock.Lock()
+	defer ctl.lock.Unlock()
+	ctl.uuid = cp.Uuid
+	ctl.checkpointSrc = cp.Src
+	ctl.checkpointEvent = cp.Event
+	ctl.handling = cp.Handling
+	if cp.ReportDeadline > 0 {
+		ctl.reportDeadline = time.UnixMilli(cp.ReportDeadline)
+		ctl.resumingWait = cp.Handling && cp.Src != ""
+	}
+	if cp.FaultPod != nil {
+		ctl.faultPod = cp.FaultPod
+	}
+	ctl.cacheNormalFault = append(ctl.cacheNormalFault, cp.CacheNormalFault...)
+	ctl.cacheRetryFault = append(ctl.cacheRetryFault, cp.CacheRetryFault...)
+	ctl.latestStrategy = append(ctl.latestStrategy, cp.LatestStrategy...)
+	ctl.latestRecoverResult = append(ctl.latestRecoverResult, cp.LatestRecoverResult...)
+	ctl.agentReportStrategies = append(ctl.agentReportStrategies, cp.AgentReportStrategies...)
+	ctl.platStrategy = cp.PlatStrategy
+	if cp.HealthState != "" {
+		ctl.healthState = cp.HealthState
+	}
+	ctl.restartFaultProcess = cp.RestartFaultProcess
+	if cp.RecoverInPlacePodFaults != nil {
+		ctl.recoverInPlacePodFaults = cp.RecoverInPlacePodFaults
+	}
+	ctl.globalSwitchRankIDs = append(ctl.globalSwitchRankIDs, cp.GlobalSwitchRankIDs...)
+	ctl.globalOps = append(ctl.globalOps, cp.GlobalOps...)
+	if cp.StressTestParam != nil {
+		ctl.stressTestParam = cp.StressTestParam
+	}
+	ctl.isolateNodes = sets.NewString(cp.IsolateNodes...)
+	ctl.currentHotSwitchFaultPodId = cp.CurrentHotSwitchFaultPodId
+	ctl.currentHotSwitchBackupPodId = cp.CurrentHotSwitchBackupPodId
+	ctl.restored = true
+}
+
+// restoreEventController rebuild the controller from the checkpoint and continue the recover process. the
+// interrupted handler is called again, so the signal being sent when clusterd restarted may be sent twice
+func restoreEventController(cp *controllerCheckpoint, keepAlive int,
+	serviceCtx context.Context) *EventController {
+	ctl := NewEventController(cp.JobInfo, keepAlive, serviceCtx)
+	ctl.applyCheckpoint(cp)
+	if !cp.Handling || cp.Src == "" {
+		ctl.state.Restore(cp.State)
+	}
+	ctx, ch := ctl.getCtxAndEventChan()
+	ctl.eventLock.Lock()
+	for _, event := range cp.PendingEvents {
+		select {
+		case ch <- event:
+			ctl.queuedEvents = append(ctl.queuedEvents, event)
+		case <-ctx.Done():
+		default:
+			hwlog.RunLog.Warnf("event queue is full, drop the restored event=%s, jobId=%s", event, cp.JobInfo.JobId)
+		}
+	}
+	ctl.eventLock.Unlock()
+	hwlog.RunLog.Infof("restore jobId=%s from checkpoint, uuid=%s, state=%s, handling=%v, pending events=%v",
+		cp.JobInfo.JobId, cp.Uuid, cp.State, cp.Handling, ctl.queuedEvents)
+	go ctl.resumeListenEvent(cp.Handling && cp.Src != "")
+	return ctl
+}
+
+// resumeListenEvent call the interrupted handler again before listening the events
+func (ctl *EventController) resumeListenEvent(resumeHandler bool) {
+	if resumeHandler {
+		ctl.lock.RLock()
+		src, event := ctl.checkpointSrc, ctl.checkpointEvent
+		ctl.lock.RUnlock()
+		nextEvent, code, err := ctl.state.Resume(src, event)
+		if ctl.handleTriggerResult(nextEvent, code, err) {
+			return
+		}
+	}
+	ctl.listenEvent()
+}
+
+// takeRestored return whether the controller is restored and not subscribed yet
+func (ctl *EventController) takeRestored() bool {
+	ctl.lock.Lock()
+	defer ctl.lock.Unlock()
+	restored := ctl.restored
+	ctl.restored = false
+	return restored
+}
+
+// restoreControllers rebuild the controllers of the jobs in recovering when clusterd restarted
+func (s *FaultRecoverService) restoreControllers() {
+	checkpoints := recoverCheckpoints.load()
+	if len(checkpoints) == 0 {
+		return
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	jobIds := make([]string, 0, len(checkpoints))
+	for jobId, cp := range checkpoints {
+		if len(s.eventCtl) >= constant.MaxServeJobs {
+			hwlog.RunLog.Errorf("out of max serve jobs, drop the checkpoint of jobId=%s", jobId)
+			recoverCheckpoints.delete(jobId)
+			continue
+		}
+		s.eventCtl[jobId] = restoreEventController(cp, s.keepAliveInterval, s.serviceCtx)
+		s.initJob[jobId] = cp.JobInfo
+		jobIds = append(jobIds, jobId)
+	}
+	go s.dropDeletedRestoredJobs(jobIds)
+}
+
+// dropDeletedRestoredJobs delete the restored jobs deleted when clusterd was not running
+func (s *FaultRecoverService) dropDeletedRestoredJobs(jobIds []string) {
+	select {
+	case <-s.serviceCtx.Done():
+		return
+	case <-time.After(restoreCheckDelay):
+	}
+	for _, jobId := range jobIds {
+		if podgroup.CheckPodGroupExist(jobId) {
+			continue
+		}
+		hwlog.RunLog.Warnf("jobId=%s restored from checkpoint not exists, delete it", jobId)
+		s.DeleteJob(jobId)
+	}
+}
@@ -0,0 +1,253 @@
+// Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+
+// Package recover a series of checkpoint test function
+package recover
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/agiledragon/gomonkey/v2"
+	"github.com/smartystreets/goconvey/convey"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"ascend-common/api"
+	"clusterd/pkg/common/constant"
+	"clusterd/pkg/domain/common"
+	"clusterd/pkg/interface/grpc/recover"
+	"clusterd/pkg/interface/kube"
+)
+
+const (
+	testFaultEvent   = "testFaultEvent"
+	testReportEvent  = "testReportEvent"
+	testTimeoutEvent = "testTimeoutEvent"
+	testNotifyState  = "testNotifyState"
+	testWaitState    = "testWaitState"
+	checkpointWait   = 5 * time.Second
+)
+
+// fakeCheckpointCluster route the checkpoint configmaps to a fake clientset, and record the checkpoints after
+// every write. the checkpoints are written synchronously
+type fakeCheckpointCluster struct {
+	client    kubernetes.Interface
+	snapshots []map[string]string
+	patches   *gomonkey.Patches
+}
+
+func newFakeCheckpointCluster() *fakeCheckpointCluster {
+	c := &fakeCheckpointCluster{client: fake.NewSimpleClientset()}
+	recoverCheckpoints = newCheckpointStore()
+	c.patches = gomonkey.ApplyFuncReturn(kube.GetClientK8s, &kube.K8sClient{ClientSet: c.client}).
+		ApplyFunc(kube.ListConfigMaps, func(namespace, labelSelector string) (*v1.ConfigMapList, error) {
+			return c.client.CoreV1().ConfigMaps(namespace).List(context.TODO(),
+				metav1.ListOptions{LabelSelector: labelSelector})
+		}).
+		ApplyFunc(kube.DeleteConfigMap, func(name, namespace string) error {
+			err := c.client.CoreV1().ConfigMaps(namespace).Delete(context.TODO(), name, metav1.DeleteOptions{})
+			c.snapshots = append(c.snapshots, c.savedData())
+			return err
+		}).
+		ApplyFunc(kube.UpdateOrCreateConfigMap, func(name, namespace string, data, labels map[string]string) error {
+			cm := &v1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace, Labels: labels},
+				Data: data}
+			_, err := c.client.CoreV1().ConfigMaps(namespace).Update(context.TODO(), cm, metav1.UpdateOptions{})
+			if errors.IsNotFound(err) {
+				_, err = c.client.CoreV1().ConfigMaps(namespace).Create(context.TODO(), cm, metav1.CreateOptions{})
+			}
+			c.snapshots = append(c.snapshots, c.savedData())
+			return err
+		}).
+		ApplyPrivateMethod(reflect.TypeOf(&checkpointStore{}), "schedule", func(s *checkpointStore) {
+			s.flush()
+		}).
+		ApplyFuncReturn(common.RetryWriteResetCM, &v1.ConfigMap{}, nil)
+	return c
+}
+
+// restart simulate clusterd restarting with the checkpoints in data, key is the jobId
+func (c *fakeCheckpointCluster) restart(data map[string]string) {
+	c.client = fake.NewSimpleClientset()
+	for jobId, checkpoint := range data {
+		cm := &v1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: checkpointCmName(jobId), Namespace: api.ClusterNS,
+			Labels: map[string]string{constant.RecoverCheckpointLabelKey: constant.CmConsumerValue}},
+			Data: map[string]string{checkpointDataKey: checkpoint}}
+		if _, err := c.client.CoreV1().ConfigMaps(api.ClusterNS).Create(context.TODO(), cm,
+			metav1.CreateOptions{}); err != nil {
+			panic(err)
+		}
+	}
+	recoverCheckpoints = newCheckpointStore()
+}
+
+// savedData the saved checkpoints, key is the jobId
+func (c *fakeCheckpointCluster) savedData() map[string]string {
+	cms, err := c.client.CoreV1().ConfigMaps(api.ClusterNS).List(context.TODO(), metav1.ListOptions{})
+	if err != nil {
+		return nil
+	}
+	data := make(map[string]string, len(cms.Items))
+	for _, cm := range cms.Items {
+		cp := &controllerCheckpoint{}
+		if err = json.Unmarshal([]byte(cm.Data[checkpointDataKey]), cp); err != nil {
+			data[cm.Name] = cm.Data[checkpointDataKey]
+			continue
+		}
+		data[cp.JobInfo.JobId] = cm.Data[checkpointDataKey]
+	}
+	return data
+}
+
+// getTestRules a simplified process recover: notify the fault, wait for the report and finish
+func getTestRules(ctl *EventController) []common.TransRule {
+	return []common.TransRule{
+		{Src: common.InitState, Event: testFaultEvent, Dst: testNotifyState,
+			Handler: func() (string, common.RespCode, error) { return common.NotifySuccessEvent, common.OK, nil }},
+		{Src: testNotifyState, Event: common.NotifySuccessEvent, Dst: testWaitState, Handler: func() (string,
+			common.RespCode, error) {
+			ctx, reportChan := ctl.getCtxAndStopCompleteChan()
+			select {
+			case <-ctx.Done():
+				return "", common.ControllerEventCancel, nil
+			case <-reportChan:
+				return testReportEvent, common.OK, nil
+			case <-time.After(ctl.remainReportTimeout()):
+				return testTimeoutEvent, common.WaitReportTimeout, nil
+			}
+		}},
+		{Src: testWaitState, Event: testReportEvent, Dst: common.InitState,
+			Handler: func() (string, common.RespCode, error) { return "", common.OK, nil }},
+		{Src: testWaitState, Event: testTimeoutEvent, Dst: common.InitState,
+			Handler: func() (string, common.RespCode, error) { return "", common.OK, nil }},
+	}
+}
+
+func waitCheckpointDeleted(c *fakeCheckpointCluster, jobId string) bool {
+	deadline := time.Now().Add(checkpointWait)
+	for time.Now().Before(deadline) {
+		if _, ok := c.savedData()[jobId]; !ok {
+			return true
+		}
+		time.Sleep(time.Millisecond * 10)
+	}
+	return false
+}
+
+func TestCheckpointStore(t *testing.T) {
+	convey.Convey("Test checkpoint store", t, func() {
+		c := newFakeCheckpointCluster()
+		defer c.patches.Reset()
+		valid := func() bool { return true }
+		cp := &controllerCheckpoint{JobInfo: common.JobBaseInfo{JobId: fakeJobID}, State: testWaitState,
+			Uuid: "uuid", CacheNormalFault: []*pb.FaultRank{{RankId: testRankId1}}}
+		recoverCheckpoints.save(cp, valid)
+		convey.So(len(c.savedData()), convey.ShouldEqual, 1)
+
+		convey.Convey("the saving of the previous recover process should be discarded", func() {
+			recoverCheckpoints.save(&controllerCheckpoint{JobInfo: cp.JobInfo, State: testNotifyState},
+				func() bool { return false })
+			c.restart(c.savedData())
+			loaded := recoverCheckpoints.load()
+			convey.So(loaded[fakeJobID].State, convey.ShouldEqual, testWaitState)
+			convey.So(loaded[fakeJobID].CacheNormalFault[0].RankId, convey.ShouldEqual, testRankId1)
+		})
+		convey.Convey("the checkpoint of the idle controller should be deleted", func() {
+			recoverCheckpoints.save(&controllerCheckpoint{JobInfo: cp.JobInfo, State: common.InitState}, valid)
+			convey.So(len(c.savedData()), convey.ShouldEqual, 0)
+		})
+		convey.Convey("the checkpoint exceeding the size limit should be dropped", func() {
+			large := &controllerCheckpoint{JobInfo: cp.JobInfo, State: testWaitState,
+				Uuid: strings.Repeat("u", maxCheckpointBytes)}
+			recoverCheckpoints.save(large, valid)
+			convey.So(len(c.savedData()), convey.ShouldEqual, 0)
+		})
+		convey.Convey("the checkpoint failed to write should be written again", func() {
+			failed := gomonkey.ApplyFuncReturn(kube.DeleteConfigMap, errors.NewBadRequest("mock error"))
+			recoverCheckpoints.delete(fakeJobID)
+			convey.So(len(recoverCheckpoints.dirty), convey.ShouldEqual, 1)
+			failed.Reset()
+			convey.So(recoverCheckpoints.flush(), convey.ShouldBeTrue)
+			convey.So(len(c.savedData()), convey.ShouldEqual, 0)
+		})
+		convey.Convey("the invalid checkpoint should be dropped when loading", func() {
+			data := c.savedData()
+			data[fakeJobID1] = "invalid"
+			c.restart(data)
+			convey.So(len(recoverCheckpoints.load()), convey.ShouldEqual, 1)
+			convey.So(len(c.savedData()), convey.ShouldEqual, 1)
+		})
+	})
+}
+
+func TestRestoreAtEveryTransition(t *testing.T) {
+	convey.Convey("Test restore the controller after restarting at every transition", t, func() {
+		c := newFakeCheckpointCluster()
+		defer c.patches.Reset()
+		c.patches.ApplyPrivateMethod(reflect.TypeOf(&EventController{}), "getBaseRules",
+			func(ctl *EventController) []common.TransRule { return getTestRules(ctl) })
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		jobInfo := common.JobBaseInfo{JobId: fakeJobID, JobName: fakeJobID}
+		ctl := NewEventController(jobInfo, keepAliveSecond, ctx)
+		go ctl.listenEvent()
+		ctl.addEvent(testFaultEvent)
+		ctl.reportStopCompleteChan <- &pb.StopCompleteRequest{}
+		convey.So(waitCheckpointDeleted(c, fakeJobID), convey.ShouldBeTrue)
+		snapshots := c.snapshots
+		convey.So(len(snapshots), convey.ShouldBeGreaterThan, len([]string{testNotifyState, testWaitState}))
+
+		for _, snapshot := range snapshots {
+			if _, ok := snapshot[fakeJobID]; !ok {
+				continue
+			}
+			c.restart(snapshot)
+			svr := &FaultRecoverService{eventCtl: make(map[string]*EventController),
+				initJob: make(map[string]common.JobBaseInfo), serviceCtx: ctx, keepAliveInterval: keepAliveSecond}
+			svr.restoreControllers()
+			restored, ok := svr.getController(fakeJobID)
+			convey.So(ok, convey.ShouldBeTrue)
+			_, inited := svr.inited(fakeJobID)
+			convey.So(inited, convey.ShouldBeTrue)
+			convey.So(restored.takeRestored(), convey.ShouldBeTrue)
+			convey.So(restored.takeRestored(), convey.ShouldBeFalse)
+			// the report is sent by the reconnected client
+			restored.reportStopCompleteChan <- &pb.StopCompleteRequest{}
+			convey.So(waitCheckpointDeleted(c, fakeJobID), convey.ShouldBeTrue)
+			convey.So(restored.state.GetState(), convey.ShouldEqual, common.InitState)
+		}
+	})
+}
+
+func TestRestoreReportTimeout(t *testing.T) {
+	convey.Convey("Test the report timeout is re-armed by the saved deadline", t, func() {
+		c := newFakeCheckpointCluster()
+		defer c.patches.Reset()
+		c.patches.ApplyPrivateMethod(reflect.TypeOf(&EventController{}), "getBaseRules",
+			func(ctl *EventController) []common.TransRule { return getTestRules(ctl) })
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		cp := &controllerCheckpoint{JobInfo: common.JobBaseInfo{JobId: fakeJobID}, State: testWaitState,
+			Src: testNotifyState, Event: common.NotifySuccessEvent, Handling: true,
+			ReportDeadline: time.Now().Add(-time.Second).UnixMilli()}
+		recoverCheckpoints.save(cp, func() bool { return true })
+		c.restart(c.savedData())
+
+		ctl := restoreEventController(recoverCheckpoints.load()[fakeJobID], keepAliveSecond, ctx)
+		convey.So(waitCheckpointDeleted(c, fakeJobID), convey.ShouldBeTrue)
+		convey.So(ctl.state.GetPathGraph(), convey.ShouldContainSubstring, testTimeoutEvent)
+		// only the resumed wait uses the saved deadline
+		convey.So(ctl.remainReportTimeout(), convey.ShouldEqual, time.Duration(reportTimeoutMinutes)*time.Minute)
+	})
+	convey.Convey("Test the waits not resumed use the whole report timeout", t, func() {
+		ctl := &EventController{reportDeadline: time.Now().Add(-time.Second)}
+		convey.So(ctl.remainReportTimeout(), convey.ShouldEqual, time.Duration(reportTimeoutMinutes)*time.Minute)
+	})
+}
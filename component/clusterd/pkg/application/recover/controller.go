@@ -10,6 +10,7 @@ import (
 	"slices"
 	"strconv"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	corev1 "k8s.io/api/core/v1"
@@ -28,6 +29,7 @@ import (
 	"clusterd/pkg/domain/superpod"
 	"clusterd/pkg/interface/grpc/recover"
 	"clusterd/pkg/interface/kube"
+	"clusterd/pkg/interface/metrics"
 )
 
 const (
@@ -110,6 +112,24 @@ type EventController struct {
 	newPodStatusMonitorChan     chan corev1.PodPhase
 	currentHotSwitchFaultPodId  string
 	currentHotSwitchBackupPodId string
+	// checkpointSrc and checkpointEvent the last transition, handling whether its handler has not returned
+	checkpointSrc   string
+	checkpointEvent string
+	handling        bool
+	reportDeadline  time.Time
+	// resumingWait the handler resumed after clusterd restarts has not started waiting for the report yet, the
+	// wait continues with the persisted reportDeadline instead of the whole timeout
+	resumingWait bool
+	// maintenanceHandOff the job is on the devices of a draining maintenance window, it exits gracefully even if
+	// its sub-healthy strategy is ignore
+	maintenanceHandOff bool
+	// queuedEvents the events in the events chan, guarded by eventLock
+	queuedEvents    []string
+	eventLock       sync.Mutex
+	restored        bool
+	checkpointEpoch atomic.Int64
+	// stateEnterTime the time of the current state entered, used by the state duration metrics
+	stateEnterTime time.Time
 }
 
 func catchException() {
@@ -159,10 +179,12 @@ func NewEventController(jobInfo common.JobBaseInfo, keepAlive int, serviceCtx co
 		newPodStatusMonitorChan:     make(chan corev1.PodPhase, 1),
 		currentHotSwitchFaultPodId:  "",
 		currentHotSwitchBackupPodId: "",
+		queuedEvents:                []string{},
 	}
 	ctl.updatePodInfo()
 	var rules []common.TransRule = ctl.getBaseRules()
 	ctl.state = common.NewStateMachine(common.InitState, rules)
+	ctl.state.SetTransitionHook(ctl.onTransition)
 	ctl.controllerContext, ctl.ctxCancelFunc = context.WithCancel(ctl.serviceContext)
 	return ctl
 }
@@ -191,6 +213,18 @@ func (ctl *EventController) GetFaultPod() map[string]string {
 	return faultMap
 }
 
+func (ctl *EventController) setMaintenanceHandOff(handOff bool) {
+	ctl.lock.Lock()
+	defer ctl.lock.Unlock()
+	ctl.maintenanceHandOff = handOff
+}
+
+func (ctl *EventController) isMaintenanceHandOff() bool {
+	ctl.lock.RLock()
+	defer ctl.lock.RUnlock()
+	return ctl.maintenanceHandOff
+}
+
 func (ctl *EventController) mergeFaultPod(faultPod map[string]string) {
 	ctl.lock.Lock()
 	defer ctl.lock.Unlock()
@@ -220,6 +254,7 @@ func (ctl *EventController) saveCacheFault(faults []*pb.FaultRank) {
 
 func (ctl *EventController) reset(stop bool) {
 	hwlog.RunLog.Infof("jobId=%s enter reset function", ctl.jobInfo.JobId)
+	ctl.dropCheckpoint()
 	ctl.lock.Lock()
 	defer ctl.lock.Unlock()
 	hwlog.RunLog.Infof("jobId=%s's action path = {%s}", ctl.jobInfo.JobId, ctl.state.GetPathGraph())
@@ -237,8 +272,19 @@ func (ctl *EventController) reset(stop bool) {
 	ctl.restartFaultProcess = false
 	ctl.recoverInPlacePodFaults = make(map[string]*constant.PodFaultInfo)
 	ctl.uuid = ""
+	if ctl.state.GetState() != common.InitState {
+		ctl.observeOutcome(ctl.lastStrategy(), metrics.RecoverResultAborted)
+	}
+	ctl.stateEnterTime = time.Time{}
 	ctl.latestStrategy = ctl.latestStrategy[:0]
 	ctl.faultPod = make(map[string]string)
+	ctl.checkpointSrc = ""
+	ctl.checkpointEvent = ""
+	ctl.handling = false
+	ctl.reportDeadline = time.Time{}
+	ctl.resumingWait = false
+	ctl.maintenanceHandOff = false
+	ctl.restored = false
 	ctl.updatePodInfo()
 	if !ctl.isChanClosed {
 		ctl.closeControllerChan()
@@ -268,7 +314,10 @@ func (ctl *EventController) cleanControllerMapAndSet() {
 }
 
 func (ctl *EventController) initControllerChan() {
+	ctl.eventLock.Lock()
 	ctl.events = make(chan string, eventChanLength)
+	ctl.queuedEvents = ctl.queuedEvents[:0]
+	ctl.eventLock.Unlock()
 	ctl.signalChan = make(chan *pb.ProcessManageSignal, 1)
 	ctl.reportStopCompleteChan = make(chan *pb.StopCompleteRequest, 1)
 	ctl.reportRecoverStrategyChan = make(chan *pb.RecoverStrategyRequest, 1)
@@ -463,7 +512,7 @@ func (ctl *EventController) shouldDumpWhenOccurFault() bool {
 		return false
 	}
 	if ctl.healthState == constant.UnHealthyState ||
-		(ctl.healthState == constant.SubHealthyState && ctl.jobInfo.GraceExit) {
+		(ctl.healthState == constant.SubHealthyState && (ctl.jobInfo.GraceExit || ctl.isMaintenanceHandOff())) {
 		return true
 	}
 	hwlog.RunLog.Infof("jobId=%s healthState=%v graceExit=%v, should not dump",
@@ -482,18 +531,31 @@ func (ctl *EventController) addEvent(event string) {
 		hwlog.RunLog.Errorf("jobId=%s, event chan is nil", ctl.jobInfo.JobId)
 		return
 	}
+	if ctl.enqueueEvent(ctx, ch, event) {
+		ctl.reset(false)
+		return
+	}
+	ctl.saveCheckpoint()
+}
+
+// enqueueEvent return true when the event chan is full and the state machine should be reset
+func (ctl *EventController) enqueueEvent(ctx context.Context, ch chan string, event string) bool {
 	defer catchException()
+	ctl.eventLock.Lock()
+	defer ctl.eventLock.Unlock()
 	select {
 	case <-ctx.Done():
 		hwlog.RunLog.Warnf("event add fail, controller context canceled, jobId=%s, uuid=%s, event=%s",
 			ctl.jobInfo.JobId, ctl.uuid, event)
 	case ch <- event:
+		ctl.queuedEvents = append(ctl.queuedEvents, event)
 		hwlog.RunLog.Infof("jobId=%s uuid=%s state is %s, event=%s enqueue success",
 			ctl.jobInfo.JobId, ctl.uuid, ctl.state.GetState(), event)
 	default:
 		hwlog.RunLog.Infof("add event=%s timeout, reset state machine", event)
-		ctl.reset(false)
+		return true
 	}
+	return false
 }
 
 func (ctl *EventController) getCtxAndEventChan() (context.Context, chan string) {
@@ -519,16 +581,7 @@ func (ctl *EventController) selectEventChan(ctx context.Context, eventChan chan
 	case event, ok := <-eventChan:
 		if ok {
 			nextEvent, code, err := ctl.trigger(event)
-			hwlog.RunLog.Infof("jobId=%s's action path = {%s}", ctl.jobInfo.JobId, ctl.state.GetPathGraph())
-			if err != nil {
-				hwlog.RunLog.Errorf("jobId=%s trigger error, code=%d, err=%v", ctl.jobInfo.JobId, code, err)
-				ctl.reset(false)
-				return true
-			}
-			if nextEvent != "" {
-				ctl.addEvent(nextEvent)
-			}
-			return false
+			return ctl.handleTriggerResult(nextEvent, code, err)
 		} else {
 			hwlog.RunLog.Infof("event channel closed, break listen event, jobId=%s", ctl.jobInfo.JobId)
 			return true
@@ -536,6 +589,22 @@ func (ctl *EventController) selectEventChan(ctx context.Context, eventChan chan
 	}
 }
 
+// handleTriggerResult enqueue the next event returned by the handler, return true when the state machine is reset
+func (ctl *EventController) handleTriggerResult(nextEvent string, code common.RespCode, err error) bool {
+	hwlog.RunLog.Infof("jobId=%s's action path = {%s}", ctl.jobInfo.JobId, ctl.state.GetPathGraph())
+	if err != nil {
+		hwlog.RunLog.Errorf("jobId=%s trigger error, code=%d, err=%v", ctl.jobInfo.JobId, code, err)
+		ctl.reset(false)
+		return true
+	}
+	ctl.setHandled()
+	if nextEvent != "" {
+		ctl.addEvent(nextEvent)
+	}
+	ctl.saveCheckpoint()
+	return false
+}
+
 func (ctl *EventController) listenEvent() {
 	hwlog.RunLog.Infof("start listen a new event, jobId=%s", ctl.jobInfo.JobId)
 	ctx, eventChan := ctl.getCtxAndEventChan()
@@ -616,7 +685,13 @@ func (ctl *EventController) selectSendChannel(ctx context.Context, sendChan chan
 }
 
 func (ctl *EventController) listenSendChannel(stream pb.Recover_SubscribeProcessManageSignalServer) {
-	ctl.reset(false)
+	if ctl.takeRestored() {
+		// the recover process restored from checkpoint continues with the reconnected client
+		hwlog.RunLog.Infof("jobId=%s is restored from checkpoint, continue the recover process", ctl.jobInfo.JobId)
+		go ctl.keepAlive()
+	} else {
+		ctl.reset(false)
+	}
 	ctx, sendChan := ctl.getCtxAndSignalChan()
 	hwlog.RunLog.Infof("start listen a new send channel, jobId=%s", ctl.jobInfo.JobId)
 	exit := false
@@ -768,7 +843,7 @@ func (ctl *EventController) handleWaitReportStopComplete() (string, common.RespC
 			return common.ProcessNotReadyEvent, common.ClientError, nil
 		}
 		return common.ReceiveReportEvent, common.OK, nil
-	case <-time.After(time.Duration(reportTimeoutMinutes) * time.Minute):
+	case <-time.After(ctl.remainReportTimeout()):
 		hwlog.RunLog.Errorf("wait report stop complete timeout, jobId=%s, uuid=%s", ctl.jobInfo.JobId, ctl.uuid)
 		return common.ReportTimeoutEvent, common.WaitReportTimeout, nil
 	}
@@ -1071,26 +1146,26 @@ func isNodeDeviceA5(nodeName string, clusterDevices []*api.SuperPodDevice) bool
 func (ctl *EventController) firstChooseStrategy() string {
 	hwlog.RunLog.Infof("first choose strategy, jobId=%s, configed: %v, reported: %v", ctl.jobInfo.JobId,
 		ctl.jobInfo.MindXConfigStrategies, ctl.agentReportStrategies)
-	if ctl.supportRetryStrategy() && len(ctl.cacheNormalFault) <= 0 {
-		return constant.ProcessRetryStrategyName
-	}
-	if ctl.supportRestartProcessStrategy() {
-		return constant.ProcessRecoverInPlaceStrategyName
-	}
-	return ctl.chooseForRestartProcessFail()
+	return common.ChooseFirstStrategy(len(ctl.cacheNormalFault) <= 0, ctl.strategySupported)
 }
 
-func (ctl *EventController) chooseForRestartProcessFail() string {
-	if ctl.supportRecoverStrategy() {
-		return constant.ProcessRecoverStrategyName
-	}
-	if ctl.canChooseScaleInStrategy() {
-		return constant.ScaleInStrategyName
-	}
-	if ctl.supportDumpStrategy() {
-		return constant.ProcessDumpStrategyName
+// strategySupported return whether the strategy can be chosen for the job, it is evaluated in the order of the
+// strategies, so the resources are checked only when the scale in strategy is considered
+func (ctl *EventController) strategySupported(strategy string) bool {
+	switch strategy {
+	case constant.ProcessRetryStrategyName:
+		return ctl.supportRetryStrategy()
+	case constant.ProcessRecoverInPlaceStrategyName:
+		return ctl.supportRestartProcessStrategy()
+	case constant.ProcessRecoverStrategyName:
+		return ctl.supportRecoverStrategy()
+	case constant.ScaleInStrategyName:
+		return ctl.canChooseScaleInStrategy()
+	case constant.ProcessDumpStrategyName:
+		return ctl.supportDumpStrategy()
+	default:
+		return false
 	}
-	return constant.ProcessExitStrategyName
 }
 
 func (ctl *EventController) canChooseScaleInStrategy() bool {
@@ -1110,19 +1185,7 @@ func (ctl *EventController) canChooseScaleInStrategy() bool {
 }
 
 func (ctl *EventController) chooseForRetryFail() string {
-	if ctl.supportRestartProcessStrategy() {
-		return constant.ProcessRecoverInPlaceStrategyName
-	}
-	if ctl.supportRecoverStrategy() {
-		return constant.ProcessRecoverStrategyName
-	}
-	if ctl.canChooseScaleInStrategy() {
-		return constant.ScaleInStrategyName
-	}
-	if ctl.supportDumpStrategy() {
-		return constant.ProcessDumpStrategyName
-	}
-	return constant.ProcessExitStrategyName
+	return common.ChooseFirstStrategy(false, ctl.strategySupported)
 }
 
 func (ctl *EventController) chooseForRecoverFail() string {
@@ -1596,7 +1659,7 @@ func (ctl *EventController) handleWaitReportRecoverStrategy() (string, common.Re
 	case <-ctx.Done():
 		hwlog.RunLog.Warnf("controller context canceled, jobId=%s, uuid=%s", ctl.jobInfo.JobId, ctl.uuid)
 		return "", common.ControllerEventCancel, nil
-	case <-time.After(time.Duration(reportTimeoutMinutes) * time.Minute):
+	case <-time.After(ctl.remainReportTimeout()):
 		hwlog.RunLog.Errorf("wait report recover strategy timeout, jobId=%s", ctl.jobInfo.JobId)
 		return common.ReportTimeoutEvent, common.WaitReportTimeout, nil
 	}
@@ -1718,7 +1781,7 @@ func (ctl *EventController) handleDecideRetryStrategy() (string, common.RespCode
 	case <-ctx.Done():
 		hwlog.RunLog.Warnf("controller context canceled, jobId=%s, uuid=%s", ctl.jobInfo.JobId, ctl.uuid)
 		return "", common.ControllerEventCancel, nil
-	case <-time.After(time.Duration(reportTimeoutMinutes) * time.Minute):
+	case <-time.After(ctl.remainReportTimeout()):
 		hwlog.RunLog.Errorf("wait report recover status timeout, jobId=%s", ctl.jobInfo.JobId)
 		return common.ReportTimeoutEvent, common.WaitReportTimeout, nil
 	}
@@ -1732,7 +1795,7 @@ func (ctl *EventController) handleDecideRecoverStrategy() (string, common.RespCo
 		hwlog.RunLog.Errorf("jobId=%s, resultCh or scheduleCh is nil", ctl.jobInfo.JobId)
 		return "", common.OK, fmt.Errorf("jobId=%s, resultCh or scheduleCh is nil", ctl.jobInfo.JobId)
 	}
-	timer := time.NewTimer(time.Duration(reportTimeoutMinutes) * time.Minute)
+	timer := time.NewTimer(ctl.remainReportTimeout())
 	defer timer.Stop()
 	for {
 		select {
@@ -1935,7 +1998,7 @@ func (ctl *EventController) waitReportStatus() (string, common.RespCode, error)
 	case <-ctx.Done():
 		hwlog.RunLog.Warnf("controller context canceled, jobId=%s, uuid=%s", ctl.jobInfo.JobId, ctl.uuid)
 		return "", common.ControllerEventCancel, nil
-	case <-time.After(time.Duration(reportTimeoutMinutes) * time.Minute):
+	case <-time.After(ctl.remainReportTimeout()):
 		hwlog.RunLog.Errorf("%s timeout, jobId=%s", ctl.state.GetState(), ctl.jobInfo.JobId)
 		return common.ReportTimeoutEvent, common.WaitReportTimeout, nil
 	}
@@ -326,6 +326,11 @@ func TestShouldDumpWhenOccurFault(t *testing.T) {
 				ctl.jobInfo.GraceExit = true
 				convey.So(ctl.shouldDumpWhenOccurFault(), convey.ShouldBeTrue)
 			})
+			convey.Convey("08-healthState is subHealthy and handed off by maintenance, should return true", func() {
+				ctl.jobInfo.GraceExit = false
+				ctl.maintenanceHandOff = true
+				convey.So(ctl.shouldDumpWhenOccurFault(), convey.ShouldBeTrue)
+			})
 		})
 	})
 }
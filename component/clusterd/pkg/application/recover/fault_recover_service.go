@@ -75,6 +75,7 @@ func NewFaultRecoverService(keepAlive int, ctx context.Context) *FaultRecoverSer
 	kube.AddPodFunc(constant.FaultRecover, func(oldPodInfo *v1.Pod, newPodInfo *v1.Pod, op string) {
 		s.updateOriginPodInfo(oldPodInfo, newPodInfo, op)
 	})
+	s.restoreControllers()
 	go s.startUpdateOriginPodInfo(s.serviceCtx)
 	go s.checkFaultFromFaultCenter()
 	go s.podStatusMonitor()
@@ -340,6 +341,13 @@ func (s *FaultRecoverService) skipHandleSubHealthyFaults(ctl *EventController, f
 		}
 		return skipHandleSubHealthyHotSwitch(ctl, faultInfo)
 	}
+	// the devices are drained by a maintenance window, the job must leave them whatever its sub-healthy strategy
+	if isMaintenanceFault(faultInfo) {
+		hwlog.RunLog.Infof("jobId=%s is on the devices of a draining maintenance window, hand off it by grace exit",
+			ctl.jobInfo.JobId)
+		ctl.setMaintenanceHandOff(true)
+		return false
+	}
 	// sub health and not hotswitch scene , if graceExit is false, skip
 	if !ctl.jobInfo.GraceExit {
 		return true
@@ -351,6 +359,15 @@ func (s *FaultRecoverService) skipHandleSubHealthyFaults(ctl *EventController, f
 	return false
 }
 
+func isMaintenanceFault(faultInfo *constant.JobFaultInfo) bool {
+	for _, info := range faultInfo.FaultList {
+		if info.FaultCode == constant.MaintenanceFaultCode {
+			return true
+		}
+	}
+	return false
+}
+
 func skipHandleSubHealthyHotSwitch(ctl *EventController, faultInfo *constant.JobFaultInfo) bool {
 	if ctl.jobInfo.Framework != constant.PtFramework && ctl.jobInfo.Framework != constant.MsFramework {
 		hwlog.RunLog.Warnf("subhealthy hotswitch only support pytorch and mindspore framework,current is:%v ",
@@ -828,6 +845,32 @@ func (s *FaultRecoverService) HealthCheck(ctx context.Context, request *pb.Clien
 	}, nil
 }
 
+// GetStateMachineGraph render the recover state machine, the path traversed by the job and its current state are
+// highlighted when jobId is given
+func (s *FaultRecoverService) GetStateMachineGraph(ctx context.Context,
+	request *pb.StateMachineGraphRequest) (*pb.StateMachineGraphResponse, error) {
+	hwlog.RunLog.Infof("receive GetStateMachineGraph, jobId: %s, format: %s", request.JobId, request.Format)
+	resp := &pb.StateMachineGraphResponse{Status: &pb.Status{Code: int32(common.OK)},
+		SpecVersion: getRuleSpec().Version}
+	var err error
+	if request.JobId == "" {
+		resp.Graph, err = RenderRuleSpec(request.Format)
+	} else {
+		ctl, exist := s.getController(request.JobId)
+		if !exist || ctl == nil {
+			resp.Status = &pb.Status{Code: int32(common.JobNotExist),
+				Info: fmt.Sprintf("jobId=%s not exist", request.JobId)}
+			return resp, nil
+		}
+		resp.State = ctl.state.GetState()
+		resp.Graph, err = ctl.state.RenderGraph(request.JobId, request.Format)
+	}
+	if err != nil {
+		resp.Status = &pb.Status{Code: int32(common.InvalidReqParam), Info: err.Error()}
+	}
+	return resp, nil
+}
+
 func (s *FaultRecoverService) updateOriginPodInfo(oldPodInfo, newPodInfo *v1.Pod, operator string) {
 	if newPodInfo == nil {
 		hwlog.RunLog.Error("updateOriginPodInfo: newPodInfo is nil")
@@ -770,6 +770,20 @@ func buildTestCases1() []testSubHealthyCase {
 			expectedResult:          false,
 			expectHotSwitchDisabled: false,
 		},
+		{
+			name: "should return false when the devices are drained by a maintenance window and strategy is ignore",
+			controller: &EventController{
+				jobInfo: common.JobBaseInfo{
+					RecoverConfig: common.RecoverConfig{SubHealthyStrategy: constant.SubHealthyIngore},
+					Framework:     constant.PtFramework,
+				},
+			},
+			faultInfo: constant.JobFaultInfo{HealthyState: constant.SubHealthyState,
+				FaultList: []constant.FaultRank{{PodUid: "0", PodRank: "0",
+					FaultCode: constant.MaintenanceFaultCode}}},
+			expectedResult:          false,
+			expectHotSwitchDisabled: false,
+		},
 	}
 }
 
@@ -868,6 +882,7 @@ func TestSkipHandleSubHealthyFaults(t *testing.T) {
 			}
 			result := (&FaultRecoverService{}).skipHandleSubHealthyFaults(tt.controller, &tt.faultInfo)
 			convey.So(result, convey.ShouldEqual, tt.expectedResult)
+			convey.So(tt.controller.maintenanceHandOff, convey.ShouldEqual, isMaintenanceFault(&tt.faultInfo))
 			if tt.expectHotSwitchDisabled {
 				convey.So(tt.controller.jobInfo.HotSwitch, convey.ShouldBeFalse)
 				convey.So(tt.controller.jobInfo.SubHealthyStrategy, convey.ShouldEqual, constant.SubHealthyIngore)
@@ -1196,3 +1211,40 @@ func TestUpdateOriginPodInfo(t *testing.T) {
 		})
 	})
 }
+
+func TestGetStateMachineGraph(t *testing.T) {
+	convey.Convey("Test GetStateMachineGraph", t, func() {
+		s := fakeService()
+		ctx := context.Background()
+		convey.Convey("case render the rules without job", func() {
+			res, err := s.GetStateMachineGraph(ctx, &pb.StateMachineGraphRequest{Format: common.GraphFormatDot})
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(res.Status.Code, convey.ShouldEqual, int32(common.OK))
+			convey.So(res.SpecVersion, convey.ShouldEqual, common.RuleSpecVersionV1)
+			convey.So(res.Graph, convey.ShouldStartWith, "digraph")
+		})
+		convey.Convey("case render the path of the job", func() {
+			ctl := NewEventController(fakeCommonBaseInfo(), keepAliveSecond, ctx)
+			ctl.state.Restore(common.WaitReportStopCompleteState)
+			s.eventCtl[fakeJobID] = ctl
+			res, err := s.GetStateMachineGraph(ctx, &pb.StateMachineGraphRequest{JobId: fakeJobID,
+				Format: common.GraphFormatMermaid})
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(res.Status.Code, convey.ShouldEqual, int32(common.OK))
+			convey.So(res.State, convey.ShouldEqual, common.WaitReportStopCompleteState)
+			convey.So(res.Graph, convey.ShouldContainSubstring, "class "+common.WaitReportStopCompleteState+
+				" current")
+		})
+		convey.Convey("case job not exist", func() {
+			res, err := s.GetStateMachineGraph(ctx, &pb.StateMachineGraphRequest{JobId: fakeJobID1,
+				Format: common.GraphFormatDot})
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(res.Status.Code, convey.ShouldEqual, int32(common.JobNotExist))
+		})
+		convey.Convey("case format not supported", func() {
+			res, err := s.GetStateMachineGraph(ctx, &pb.StateMachineGraphRequest{Format: "svg"})
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(res.Status.Code, convey.ShouldEqual, int32(common.InvalidReqParam))
+		})
+	})
+}
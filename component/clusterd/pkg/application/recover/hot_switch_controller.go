@@ -180,7 +180,7 @@ func (ctl *EventController) waitReportPauseTrainResult() (string, common.RespCod
 	case <-ctx.Done():
 		hwlog.RunLog.Warnf("controller context canceled, jobId=%s, uuid=%s", ctl.jobInfo.JobId, ctl.uuid)
 		return "", common.ControllerEventCancel, nil
-	case <-time.After(reportTimeoutMinutes * time.Minute):
+	case <-time.After(ctl.remainReportTimeout()):
 		hwlog.RunLog.Errorf("wait report recover strategy timeout, jobId=%s", ctl.jobInfo.JobId)
 		return common.ReportTimeoutEvent, common.WaitReportTimeout, nil
 	}
@@ -207,7 +207,7 @@ func (ctl *EventController) handleWaitReportRestartTrainStatus() (string, common
 		} else {
 			hwlog.RunLog.Warnf("responce code from mindio is not expected,code: %v", req.Status.Code)
 		}
-	case <-time.After(reportTimeoutMinutes * time.Minute):
+	case <-time.After(ctl.remainReportTimeout()):
 		hwlog.RunLog.Errorf("wait report restart train complete timeout, jobId=%s, uuid=%s", ctl.jobInfo.JobId, ctl.uuid)
 		return common.ReportTimeoutEvent, common.WaitReportTimeout, nil
 	}
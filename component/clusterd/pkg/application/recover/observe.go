@@ -0,0 +1,123 @@
+// Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+
+// Package recover a series of service function
+package recover
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	"k8s.io/api/core/v1"
+
+	"clusterd/pkg/domain/common"
+	"clusterd/pkg/domain/podgroup"
+	"clusterd/pkg/domain/statistics"
+	"clusterd/pkg/interface/kube"
+	"clusterd/pkg/interface/metrics"
+)
+
+// the reasons of the kubernetes events reported on the job during the recovery
+const (
+	reasonRecoverStateChanged    = "RecoverStateChanged"
+	reasonRecoverStrategyDecided = "RecoverStrategyDecided"
+	reasonRecoverSucceeded       = "RecoverSucceeded"
+	reasonRecoverFailed          = "RecoverFailed"
+	reasonJobRescheduled         = "JobRescheduled"
+	reasonJobKilled              = "JobKilled"
+	reasonRecoverAborted         = "RecoverAborted"
+)
+
+var (
+	// successFinishEvents the events ending the recovery with the training recovered
+	successFinishEvents = []string{common.RecoverSuccessEvent, common.ScaleOutSuccessEvent,
+		common.RestartSuccessEvent, common.SwitchNicRecvContinueEvent, common.StressTestRecvContinueEvent}
+	recoverResultReasons = map[string]string{
+		metrics.RecoverResultSuccess:     reasonRecoverSucceeded,
+		metrics.RecoverResultFailed:      reasonRecoverFailed,
+		metrics.RecoverResultRescheduled: reasonJobRescheduled,
+		metrics.RecoverResultKilled:      reasonJobKilled,
+		metrics.RecoverResultAborted:     reasonRecoverAborted,
+	}
+)
+
+// observeTransition report the kubernetes events, the metrics and the job statistic of the transition
+func (ctl *EventController) observeTransition(src, event, dst string) {
+	now := time.Now()
+	ctl.lock.Lock()
+	enterTime := ctl.stateEnterTime
+	ctl.stateEnterTime = now
+	strategy := ctl.lastStrategy()
+	ctl.lock.Unlock()
+	// the time of the state entered before clusterd restarts is unknown
+	if src != common.InitState && !enterTime.IsZero() {
+		metrics.ObserveRecoverState(src, now.Sub(enterTime))
+	}
+	if src == common.InitState {
+		statistics.JobStcMgrInst.RecoverStart(ctl.jobInfo.JobId)
+	}
+	ref := podgroup.GetJobObjectReference(ctl.jobInfo.JobId)
+	kube.RecordEvent(ref, v1.EventTypeNormal, reasonRecoverStateChanged,
+		fmt.Sprintf("recover state %s(%s)-->%s", src, event, dst))
+	if src == common.NotifyDecidedStrategyState {
+		if decided, ok := decidedStrategy(event); ok {
+			kube.RecordEvent(ref, v1.EventTypeNormal, reasonRecoverStrategyDecided,
+				fmt.Sprintf("recover strategy %s is decided", decided))
+		}
+	}
+	if dst == common.InitState {
+		ctl.observeOutcome(strategy, recoverResult(src, event))
+	}
+}
+
+// observeOutcome report the kubernetes event and the metrics of the finished recovery
+func (ctl *EventController) observeOutcome(strategy, result string) {
+	metrics.ObserveRecover(strategy, result)
+	// the rescheduled job is recovered once its pods run again, which is accounted by the reschedule of the job
+	// statistic, so the interruption is kept open instead of being counted as recovered here
+	if result == metrics.RecoverResultRescheduled {
+		statistics.JobStcMgrInst.RecoverRescheduled(ctl.jobInfo.JobId)
+	} else {
+		statistics.JobStcMgrInst.RecoverFinish(ctl.jobInfo.JobId, result == metrics.RecoverResultSuccess)
+	}
+	eventType := v1.EventTypeWarning
+	if result == metrics.RecoverResultSuccess {
+		eventType = v1.EventTypeNormal
+	}
+	if strategy == "" {
+		strategy = metrics.NoneStrategy
+	}
+	kube.RecordEvent(podgroup.GetJobObjectReference(ctl.jobInfo.JobId), eventType, recoverResultReasons[result],
+		fmt.Sprintf("recover finished with result %s, the last strategy is %s", result, strategy))
+}
+
+// lastStrategy return the last decided strategy of the recovery, must be called with ctl.lock held
+func (ctl *EventController) lastStrategy() string {
+	if len(ctl.latestStrategy) == 0 {
+		return ""
+	}
+	return ctl.latestStrategy[len(ctl.latestStrategy)-1]
+}
+
+func decidedStrategy(event string) (string, bool) {
+	for strategy, successEvent := range notifyStrategySuccessEventMap {
+		if successEvent == event {
+			return strategy, true
+		}
+	}
+	return "", false
+}
+
+// recoverResult return the result of the recovery by the transition back to the init state
+func recoverResult(src, event string) string {
+	switch {
+	case slices.Contains(successFinishEvents, event) || src == common.ScaleInRunningState:
+		return metrics.RecoverResultSuccess
+	case src == common.NotifyKillJobState:
+		return metrics.RecoverResultKilled
+	case src == common.FaultRetryState:
+		return metrics.RecoverResultRescheduled
+	default:
+		return metrics.RecoverResultFailed
+	}
+}
@@ -0,0 +1,86 @@
+// Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+
+// Package recover a series of observe test function
+package recover
+
+import (
+	"testing"
+
+	"github.com/agiledragon/gomonkey/v2"
+	"github.com/smartystreets/goconvey/convey"
+	v1 "k8s.io/api/core/v1"
+
+	"clusterd/pkg/common/constant"
+	"clusterd/pkg/domain/common"
+	"clusterd/pkg/domain/podgroup"
+	"clusterd/pkg/domain/statistics"
+	"clusterd/pkg/interface/kube"
+	"clusterd/pkg/interface/metrics"
+)
+
+func TestRecoverResult(t *testing.T) {
+	convey.Convey("Test recoverResult", t, func() {
+		convey.So(recoverResult(common.CheckRecoverResultState, common.RecoverSuccessEvent),
+			convey.ShouldEqual, metrics.RecoverResultSuccess)
+		convey.So(recoverResult(common.ScaleInRunningState, common.FinishEvent),
+			convey.ShouldEqual, metrics.RecoverResultSuccess)
+		convey.So(recoverResult(common.NotifyKillJobState, common.FinishEvent),
+			convey.ShouldEqual, metrics.RecoverResultKilled)
+		convey.So(recoverResult(common.FaultRetryState, common.FinishEvent),
+			convey.ShouldEqual, metrics.RecoverResultRescheduled)
+		convey.So(recoverResult(testNotifyState, common.NotifyFailEvent),
+			convey.ShouldEqual, metrics.RecoverResultFailed)
+	})
+}
+
+func TestObserveTransition(t *testing.T) {
+	convey.Convey("Test observeTransition", t, func() {
+		var reasons []string
+		var recovers [][]string
+		var recoverStarted bool
+		var recovered []bool
+		var rescheduled bool
+		patches := gomonkey.ApplyFuncReturn(podgroup.GetJobObjectReference, &v1.ObjectReference{Name: fakeJobID}).
+			ApplyFunc(kube.RecordEvent, func(_ *v1.ObjectReference, _, reason, _ string) {
+				reasons = append(reasons, reason)
+			}).
+			ApplyFunc(metrics.ObserveRecover, func(strategy, result string) {
+				recovers = append(recovers, []string{strategy, result})
+			}).
+			ApplyMethod(statistics.JobStcMgrInst, "RecoverStart", func(_ *statistics.JobStcMgr, _ string) {
+				recoverStarted = true
+			}).
+			ApplyMethod(statistics.JobStcMgrInst, "RecoverFinish", func(_ *statistics.JobStcMgr, _ string, ok bool) {
+				recovered = append(recovered, ok)
+			}).
+			ApplyMethod(statistics.JobStcMgrInst, "RecoverRescheduled", func(_ *statistics.JobStcMgr, _ string) {
+				rescheduled = true
+			})
+		defer patches.Reset()
+		ctl := newTestEventController(fakeJobID)
+		convey.Convey("01-strategy decided, should report the decided strategy", func() {
+			ctl.observeTransition(common.NotifyDecidedStrategyState, common.NotifyRecoverSuccessEvent, testWaitState)
+			convey.So(reasons, convey.ShouldResemble,
+				[]string{reasonRecoverStateChanged, reasonRecoverStrategyDecided})
+			convey.So(ctl.stateEnterTime.IsZero(), convey.ShouldBeFalse)
+		})
+		convey.Convey("02-back to init state, should report the outcome with the last strategy", func() {
+			ctl.latestStrategy = []string{constant.ProcessRetryStrategyName, constant.ProcessRecoverStrategyName}
+			ctl.observeTransition(common.CheckRecoverResultState, common.RecoverSuccessEvent, common.InitState)
+			convey.So(reasons, convey.ShouldResemble, []string{reasonRecoverStateChanged, reasonRecoverSucceeded})
+			convey.So(recovers, convey.ShouldResemble,
+				[][]string{{constant.ProcessRecoverStrategyName, metrics.RecoverResultSuccess}})
+			convey.So(recovered, convey.ShouldResemble, []bool{true})
+		})
+		convey.Convey("03-leave init state, should record the recovery start of the job", func() {
+			ctl.observeTransition(common.InitState, common.FaultOccurEvent, testWaitState)
+			convey.So(recoverStarted, convey.ShouldBeTrue)
+		})
+		convey.Convey("04-rescheduled, should keep the interruption for the reschedule", func() {
+			ctl.observeTransition(common.FaultRetryState, common.FinishEvent, common.InitState)
+			convey.So(recovers, convey.ShouldResemble, [][]string{{"", metrics.RecoverResultRescheduled}})
+			convey.So(rescheduled, convey.ShouldBeTrue)
+			convey.So(len(recovered), convey.ShouldEqual, 0)
+		})
+	})
+}
@@ -1,70 +1,90 @@
-// Copyright (c) Huawei Technologies Co., Ltd. 2024-2025. All rights reserved.
+// Copyright (c) Huawei Technologies Co., Ltd. 2024-2026. All rights reserved.
 
 // Package recover a series of state machine rules test function
 package recover
 
 import (
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/smartystreets/goconvey/convey"
-	"github.com/stretchr/testify/assert"
+
+	"clusterd/pkg/domain/common"
 )
 
 func TestGetRules(t *testing.T) {
 	convey.Convey("Test getRules", t, func() {
 		ctl := &EventController{}
-		convey.Convey("01-test getPreRules, should return slice", func() {
-			preRules := ctl.getPreRules()
-			convey.So(len(preRules) > 0, convey.ShouldBeTrue)
-		})
-		convey.Convey("02-test getFixRules, should return slice", func() {
-			fixRules := ctl.getFixRules()
-			convey.So(len(fixRules) > 0, convey.ShouldBeTrue)
-		})
-		convey.Convey("03-test getAfterRules, should return slice", func() {
-			afterRules := ctl.getAfterRules()
-			convey.So(len(afterRules) > 0, convey.ShouldBeTrue)
+		convey.Convey("01-test default rule spec, should be valid", func() {
+			spec, err := parseRuleSpec(defaultRuleSpec)
+			convey.So(err, convey.ShouldBeNil)
+			var groups []string
+			for _, group := range spec.Groups {
+				convey.So(len(group.Rules) > 0, convey.ShouldBeTrue)
+				groups = append(groups, group.Name)
+			}
+			convey.So(groups, convey.ShouldResemble, []string{"pre", "extendPre", "fix", "after", "om", "switchNic",
+				"stressTest", "dpScale", "hotSwitch"})
 		})
-		convey.Convey("04-test getBaseRules, should return slice", func() {
+		convey.Convey("02-test getBaseRules, every rule should have handler", func() {
 			baseRules := ctl.getBaseRules()
-			convey.So(len(baseRules) > 0, convey.ShouldBeTrue)
+			convey.So(len(baseRules), convey.ShouldEqual, len(getRuleSpec().Rules()))
+			for _, rule := range baseRules {
+				convey.So(rule.Handler, convey.ShouldNotBeNil)
+			}
 		})
-		convey.Convey("05-test getExtendPreRules, should return slice", func() {
-			baseRules := ctl.getExtendPreRules()
-			convey.So(len(baseRules) > 0, convey.ShouldBeTrue)
+		convey.Convey("03-test getRuleHandlers, should not register the same handler twice", func() {
+			reports, notifies := ctl.getReportRuleHandlers(), ctl.getNotifyRuleHandlers()
+			convey.So(len(ctl.getRuleHandlers()), convey.ShouldEqual, len(reports)+len(notifies)+
+				len([]string{"handleNotifyWaitFaultFlushing", "handleNotifyGlobalFault", "handleFaultRetry",
+					"handleListenScheduleResult", "handleDecideRecoverStrategy"}))
 		})
-		convey.Convey("06-test getOMRules, should return slice", func() {
-			baseRules := ctl.getOMRules()
-			convey.So(len(baseRules) > 0, convey.ShouldBeTrue)
-		})
-		convey.Convey("06-test getSwitchNicRules, should return slice", func() {
-			baseRules := ctl.getSwitchNicRules()
-			convey.So(len(baseRules) > 0, convey.ShouldBeTrue)
+	})
+}
+
+func TestLoadRuleSpec(t *testing.T) {
+	convey.Convey("Test LoadRuleSpec", t, func() {
+		defer func() { ruleSpec = nil }()
+		dir := t.TempDir()
+		convey.Convey("01-empty file path, should load the default spec", func() {
+			convey.So(LoadRuleSpec(""), convey.ShouldBeNil)
+			convey.So(getRuleSpec().Version, convey.ShouldEqual, common.RuleSpecVersionV1)
 		})
-		convey.Convey("07-test getStressTestRules, should return slice", func() {
-			baseRules := ctl.getStressTestRules()
-			convey.So(len(baseRules) > 0, convey.ShouldBeTrue)
+		convey.Convey("02-spec missing the timeout rule, should return error and keep the loaded spec", func() {
+			convey.So(LoadRuleSpec(""), convey.ShouldBeNil)
+			loaded := getRuleSpec()
+			data := strings.Replace(string(defaultRuleSpec), "      - {src: WaitReportStopCompleteState, "+
+				"event: receiveTimeout, dst: FaultClearState, handler: handleFaultClear}\n", "", 1)
+			specFile := filepath.Join(dir, "rules.yaml")
+			convey.So(os.WriteFile(specFile, []byte(data), 0600), convey.ShouldBeNil)
+			err := LoadRuleSpec(specFile)
+			convey.So(err, convey.ShouldNotBeNil)
+			convey.So(err.Error(), convey.ShouldContainSubstring, "no rule for timeout event receiveTimeout")
+			convey.So(getRuleSpec(), convey.ShouldEqual, loaded)
 		})
-		convey.Convey("08-test getHotSwitchRules, should return slice", func() {
-			baseRules := ctl.getHotSwitchRules()
-			convey.So(len(baseRules) > 0, convey.ShouldBeTrue)
+		convey.Convey("03-spec file not exist, should return error", func() {
+			convey.So(LoadRuleSpec(filepath.Join(dir, "not-exist.yaml")), convey.ShouldNotBeNil)
 		})
 	})
 }
 
-func TestEventControllerGetDPScaleStrategyRules(t *testing.T) {
-	tests := []struct {
-		name string
-		want int
-	}{
-		{
-			name: "get dp rules success",
-		},
-	}
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			ctl := &EventController{}
-			assert.Greaterf(t, len(ctl.getDPScaleStrategyRules()), 0, "getDPScaleStrategyRules()")
+func TestRenderRuleSpec(t *testing.T) {
+	convey.Convey("Test RenderRuleSpec", t, func() {
+		convey.Convey("01-render dot, should contain every rule", func() {
+			graph, err := RenderRuleSpec(common.GraphFormatDot)
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(strings.Count(graph, " -> "), convey.ShouldEqual, len(getRuleSpec().Rules()))
+		})
+		convey.Convey("02-render mermaid, should contain every rule", func() {
+			graph, err := RenderRuleSpec(common.GraphFormatMermaid)
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(strings.Count(graph, " --> "), convey.ShouldEqual, len(getRuleSpec().Rules())+1)
 		})
-	}
+		convey.Convey("03-render unknown format, should return error", func() {
+			_, err := RenderRuleSpec("svg")
+			convey.So(err, convey.ShouldNotBeNil)
+		})
+	})
 }
@@ -19,6 +19,7 @@ package schedulingexception
 import (
 	"context"
 	"fmt"
+	"sort"
 	"strings"
 	"sync"
 	"time"
@@ -41,6 +42,9 @@ import (
 var (
 	collector    *Collector
 	newCollector sync.Once
+
+	publishedMutex     sync.RWMutex
+	publishedException = map[string]JobException{}
 )
 
 type Config struct {
@@ -110,6 +114,7 @@ func (c *Collector) Start(ctx context.Context) {
 func (c *Collector) checkJobs() {
 	allJobs := job.GetAllJobCache()
 	exceptionJobs := make(map[string]*jobExceptionInfo, 0)
+	loader := &nodeNPUsLoader{}
 
 	for jobKey, jobInfo := range allJobs {
 		cond := c.processPodGroup(jobKey, jobInfo)
@@ -123,12 +128,15 @@ func (c *Collector) checkJobs() {
 				JobType:   jobInfo.JobType,
 				NameSpace: jobInfo.NameSpace,
 				Condition: *cond,
+				jobKey:    jobKey,
 			}
 		}
 
 		hwlog.RunLog.Infof("pgname: %v, cond: %v, pre cond: %v", jobInfo.PgName, cond, c.jobExceptions[jobKey].Condition)
 		key := jobInfo.Name + "." + jobKey
 		if cond.Equal(c.jobExceptions[jobKey].Condition) {
+			c.jobExceptions[jobKey].Diagnosis = diagnose(getPgFromCache(jobInfo.NameSpace, jobInfo.PgName),
+				pod.GetPodByJobId(jobKey), loader)
 			exceptionJobs[key] = c.jobExceptions[jobKey]
 		}
 		c.jobExceptions[jobKey].Condition = *cond
@@ -136,6 +144,7 @@ func (c *Collector) checkJobs() {
 
 	allMetaObjs := c.processJobs(exceptionJobs, allJobs)
 	c.cleanupJobs(allJobs, allMetaObjs)
+	publish(exceptionJobs)
 
 	report := exceptionReport{
 		JobExceptions: exceptionJobs,
@@ -402,6 +411,7 @@ func (c *Collector) processJobs(exceptionJobs map[string]*jobExceptionInfo, pgIn
 					JobName:   metaObj.GetName(),
 					JobType:   gvk.String(),
 					Condition: *cond,
+					jobKey:    jobKey,
 				}
 			}
 
@@ -509,10 +519,51 @@ func (c *Collector) cleanupJobs(allJobs map[string]constant.JobInfo, allMetaObjs
 	}
 }
 
+func publish(exceptionJobs map[string]*jobExceptionInfo) {
+	published := make(map[string]JobException, len(exceptionJobs))
+	for _, jobInfo := range exceptionJobs {
+		published[jobInfo.jobKey] = JobException{
+			JobId:     jobInfo.jobKey,
+			JobName:   jobInfo.JobName,
+			JobType:   jobInfo.JobType,
+			NameSpace: jobInfo.NameSpace,
+			Status:    string(jobInfo.Condition.Status),
+			Reason:    jobInfo.Condition.Reason,
+			Message:   jobInfo.Condition.Message,
+			Diagnosis: jobInfo.Diagnosis,
+		}
+	}
+	publishedMutex.Lock()
+	publishedException = published
+	publishedMutex.Unlock()
+}
+
+// GetJobExceptions return the scheduling exceptions published by the last check ordered by the job id,
+// all the jobs are returned when the job id is empty
+func GetJobExceptions(jobId string) []JobException {
+	publishedMutex.RLock()
+	defer publishedMutex.RUnlock()
+	if jobId != "" {
+		if exception, ok := publishedException[jobId]; ok {
+			return []JobException{exception}
+		}
+		return []JobException{}
+	}
+	exceptions := make([]JobException, 0, len(publishedException))
+	for _, exception := range publishedException {
+		exceptions = append(exceptions, exception)
+	}
+	sort.Slice(exceptions, func(i, j int) bool {
+		return exceptions[i].JobId < exceptions[j].JobId
+	})
+	return exceptions
+}
+
 func updateConfigMap(report exceptionReport) error {
 	data := make(map[string]string)
 	for key, jobInfo := range report.JobExceptions {
 		// If jobInfo contains pointer objects, please implement the DeepCopy method and modify this code accordingly.
+		// Diagnosis is replaced rather than modified by every check, so it can be shared by the copy.
 		newJobInfo := *jobInfo
 		newJobInfo.Condition.Message = strings.Replace(newJobInfo.Condition.Message, "<", " ", -1)
 		newJobInfo.Condition.Message = strings.Replace(newJobInfo.Condition.Message, ">", "", -1)
@@ -43,3 +43,27 @@ const (
 	podGroupUnknown      jobStatus = "PodGroupUnknown"
 	podGroupRunning      jobStatus = "PodGroupRunning"
 )
+
+const (
+	spBlockAnnoKey = "sp-block"
+	// maxCauseDevices is the max number of devices recorded in one cause
+	maxCauseDevices = 16
+	// maxSummaryDevices is the max number of devices written in the summary of the diagnosis
+	maxSummaryDevices = 4
+	vNPUNameMinParts  = 3
+	spBlockName       = "spBlock"
+	raBlockName       = "raBlock"
+)
+
+// the categories of the diagnosis and the reasons of the causes
+const (
+	reasonQueueQuota   = "QueueQuotaExceeded"
+	reasonUnhealthy    = "NPUUnhealthy"
+	reasonSeparated    = "NPUSeparated"
+	reasonMaintenance  = "NPUUnderMaintenance"
+	reasonOccupied     = "NPUOccupied"
+	reasonFragmented   = "VNPUFragmented"
+	reasonScattered    = "NPUScattered"
+	reasonInsufficient = "NPUInsufficient"
+	reasonSufficient   = "NPUSufficient"
+)
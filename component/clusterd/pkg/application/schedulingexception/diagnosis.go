@@ -0,0 +1,460 @@
+/* Copyright(C) 2026. Huawei Technologies Co.,Ltd. All rights reserved.
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+// Package schedulingexception is for collecting scheduling exception
+package schedulingexception
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+
+	"ascend-common/api"
+	"ascend-common/common-utils/hwlog"
+	"clusterd/pkg/application/faultmanager/cmprocess"
+	"clusterd/pkg/common/constant"
+	"clusterd/pkg/domain/pod"
+	"clusterd/pkg/interface/kube"
+)
+
+// causeOrder is the order of the causes when they have the same number of npus
+var causeOrder = []string{reasonQueueQuota, reasonSeparated, reasonMaintenance, reasonUnhealthy, reasonOccupied,
+	reasonFragmented, reasonScattered}
+
+var causeDescriptions = map[string]string{
+	reasonSeparated:   "manually separated",
+	reasonMaintenance: "under maintenance",
+	reasonUnhealthy:   "unhealthy",
+	reasonOccupied:    "used by other jobs",
+	reasonFragmented:  "split into vNPUs",
+	reasonScattered:   "left on nodes with less free NPUs than one pod needs",
+}
+
+// npuRequirement is the npus the pod group needs
+type npuRequirement struct {
+	resourceName string
+	deviceType   string
+	total        int
+	perPod       int
+	blockSize    int
+	blockName    string
+}
+
+// nodeNPUs is the npus of one node, the unavailable npus are grouped by the reasons
+type nodeNPUs struct {
+	nodeName    string
+	superPodId  int32
+	rackId      int32
+	free        []string
+	unavailable map[string][]string
+}
+
+type npuGroup struct {
+	name        string
+	allocatable int
+	causes      map[string][]string
+}
+
+// queueResult is the queue got from the api server with the error
+type queueResult struct {
+	queue *v1beta1.Queue
+	err   error
+}
+
+// nodeNPUsLoader load the npus of the nodes and the queues once in one check, it is shared by all the jobs of the
+// check, so the queue shared by the pending jobs is got from the api server once
+type nodeNPUsLoader struct {
+	loaded map[string][]nodeNPUs
+	queues map[string]queueResult
+}
+
+func (l *nodeNPUsLoader) queue(name string) (*v1beta1.Queue, error) {
+	if l.queues == nil {
+		l.queues = make(map[string]queueResult)
+	}
+	if result, ok := l.queues[name]; ok {
+		return result.queue, result.err
+	}
+	queue, err := kube.GetQueue(name)
+	l.queues[name] = queueResult{queue: queue, err: err}
+	return queue, err
+}
+
+func (l *nodeNPUsLoader) load(req npuRequirement) []nodeNPUs {
+	if l.loaded == nil {
+		l.loaded = make(map[string][]nodeNPUs)
+	}
+	if nodes, ok := l.loaded[req.resourceName]; ok {
+		return nodes
+	}
+	nodes := collectNodeNPUs(req.publishedBy, cmprocess.DeviceCenter.GetProcessedCm(),
+		cmprocess.NodeCenter.GetProcessedCm(), pod.GetUsedDevicesByNodeName)
+	l.loaded[req.resourceName] = nodes
+	return nodes
+}
+
+// diagnose correlate the pending pod group with the queue quota and the npus of the cluster,
+// return nil when the pod group does not need npu or is not waiting for the resources
+func diagnose(pg *v1beta1.PodGroup, pods map[string]corev1.Pod, loader *nodeNPUsLoader) *Diagnosis {
+	if pg == nil || (pg.Status.Phase != v1beta1.PodGroupPending && pg.Status.Phase != v1beta1.PodGroupInqueue) {
+		return nil
+	}
+	req, ok := requirementOf(pg, pods)
+	if !ok {
+		return nil
+	}
+	if pg.Status.Phase == v1beta1.PodGroupPending && pg.Spec.Queue != "" {
+		queue, err := loader.queue(pg.Spec.Queue)
+		if err != nil {
+			hwlog.RunLog.Warnf("get queue %s of podgroup %s/%s failed: %v", pg.Spec.Queue, pg.Namespace, pg.Name, err)
+		} else if d := diagnoseQuota(queue, req); d != nil {
+			return d
+		}
+	}
+	return diagnoseNPU(req, loader.load(req))
+}
+
+func requirementOf(pg *v1beta1.PodGroup, pods map[string]corev1.Pod) (npuRequirement, bool) {
+	req := npuRequirement{}
+	if pg.Spec.MinResources == nil {
+		return req, false
+	}
+	names := make([]string, 0, len(*pg.Spec.MinResources))
+	for name := range *pg.Spec.MinResources {
+		names = append(names, string(name))
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		deviceType := strings.TrimPrefix(name, api.ResourceNamePrefix)
+		// the resources of vNPU templates such as huawei.com/Ascend910-2c are not diagnosed
+		if !strings.HasPrefix(name, api.ResourceNamePrefix) || strings.Contains(deviceType, "-") ||
+			(!strings.HasPrefix(deviceType, api.Ascend) && name != api.HuaweiNPU) {
+			continue
+		}
+		quantity := (*pg.Spec.MinResources)[corev1.ResourceName(name)]
+		if quantity.Value() <= 0 {
+			continue
+		}
+		req.resourceName, req.deviceType, req.total = name, deviceType, int(quantity.Value())
+		break
+	}
+	if req.resourceName == "" {
+		return req, false
+	}
+	req.perPod = npuPerPod(req, pods, pg.Spec.MinMember)
+	if size, err := strconv.Atoi(pg.Annotations[spBlockAnnoKey]); err == nil && size > 0 {
+		req.blockSize, req.blockName = size, spBlockName
+	} else if size, err = strconv.Atoi(pg.Annotations[constant.TpBlock]); err == nil && size > 0 {
+		req.blockSize, req.blockName = size, raBlockName
+	}
+	return req, true
+}
+
+// publishedBy return whether the npus of the node are the resource of the requirement. the generic resource
+// huawei.com/npu is published by the nodes of the different device types, so it is resolved by the allocatable
+// resources of the node instead of the device type
+func (req npuRequirement) publishedBy(nodeName string, devInfo *constant.AdvanceDeviceFaultCm) bool {
+	if req.resourceName != api.HuaweiNPU {
+		return devInfo.DeviceType == req.deviceType
+	}
+	node, err := kube.GetNodeFromIndexer(nodeName)
+	if err != nil || node == nil {
+		return devInfo.DeviceType == api.NPULowerCase
+	}
+	_, ok := node.Status.Allocatable[api.HuaweiNPU]
+	return ok
+}
+
+func npuPerPod(req npuRequirement, pods map[string]corev1.Pod, minMember int32) int {
+	for _, p := range pods {
+		num := int64(0)
+		for _, container := range p.Spec.Containers {
+			quantity, ok := container.Resources.Requests[corev1.ResourceName(req.resourceName)]
+			if !ok {
+				quantity = container.Resources.Limits[corev1.ResourceName(req.resourceName)]
+			}
+			num += quantity.Value()
+		}
+		if num > 0 {
+			return int(num)
+		}
+	}
+	if minMember > 0 && req.total%int(minMember) == 0 {
+		return req.total / int(minMember)
+	}
+	return 1
+}
+
+// collectNodeNPUs classify the npus of the nodes into the free npus and the unavailable npus with the reasons
+func collectNodeNPUs(published func(string, *constant.AdvanceDeviceFaultCm) bool,
+	deviceCms map[string]*constant.AdvanceDeviceFaultCm, nodeCms map[string]*constant.NodeInfo,
+	usedDevicesOf func(string) sets.String) []nodeNPUs {
+	nodeNames := make([]string, 0, len(deviceCms))
+	for nodeName, devInfo := range deviceCms {
+		if devInfo != nil && published(nodeName, devInfo) {
+			nodeNames = append(nodeNames, nodeName)
+		}
+	}
+	sort.Strings(nodeNames)
+	nodes := make([]nodeNPUs, 0, len(nodeNames))
+	for _, nodeName := range nodeNames {
+		devInfo := deviceCms[nodeName]
+		nodeInfo, ok := nodeCms[constant.NodeInfoPrefix+nodeName]
+		nodeUnhealthy := ok && nodeInfo != nil && nodeInfo.NodeStatus == constant.UnHealthyState
+		occupied, fragmented := splitUsedDevices(usedDevicesOf(nodeName))
+		available := sets.NewString(devInfo.AvailableDeviceList...)
+		unhealthy := sets.NewString(devInfo.CardUnHealthy...).Insert(devInfo.NetworkUnhealthy...).
+			Insert(devInfo.DPUUnhealthy...)
+		node := nodeNPUs{nodeName: nodeName, superPodId: devInfo.SuperPodID, rackId: devInfo.RackID,
+			unavailable: make(map[string][]string)}
+		for _, deviceName := range deviceNamesOf(devInfo) {
+			reason := faultReason(devInfo.FaultDeviceList[deviceName])
+			switch {
+			case reason != "":
+			case nodeUnhealthy || unhealthy.Has(deviceName) || !available.Has(deviceName):
+				reason = reasonUnhealthy
+			case fragmented.Has(deviceName):
+				reason = reasonFragmented
+			case occupied.Has(deviceName):
+				reason = reasonOccupied
+			default:
+				node.free = append(node.free, deviceName)
+				continue
+			}
+			node.unavailable[reason] = append(node.unavailable[reason], deviceName)
+		}
+		nodes = append(nodes, node)
+	}
+	return nodes
+}
+
+// splitUsedDevices split the used devices into the whole devices and the physical devices of the used vNPUs,
+// the name of vNPU such as Ascend910-2c-100-3 ends with the physical id
+func splitUsedDevices(used sets.String) (sets.String, sets.String) {
+	occupied, fragmented := sets.NewString(), sets.NewString()
+	for name := range used {
+		parts := strings.Split(name, "-")
+		if len(parts) < vNPUNameMinParts {
+			occupied.Insert(name)
+			continue
+		}
+		fragmented.Insert(parts[0] + "-" + parts[len(parts)-1])
+	}
+	return occupied, fragmented
+}
+
+func faultReason(faults []constant.DeviceFault) string {
+	reason := ""
+	for _, fault := range faults {
+		if fault.FaultLevel != constant.ManuallySeparateNPU {
+			continue
+		}
+		if fault.FaultCode == constant.MaintenanceFaultCode {
+			return reasonMaintenance
+		}
+		reason = reasonSeparated
+	}
+	return reason
+}
+
+func deviceNamesOf(devInfo *constant.AdvanceDeviceFaultCm) []string {
+	names := sets.NewString(devInfo.AvailableDeviceList...).Insert(devInfo.Recovering...).
+		Insert(devInfo.CardUnHealthy...).Insert(devInfo.NetworkUnhealthy...).Insert(devInfo.DPUUnhealthy...)
+	for name := range devInfo.FaultDeviceList {
+		names.Insert(name)
+	}
+	return names.List()
+}
+
+func diagnoseQuota(queue *v1beta1.Queue, req npuRequirement) *Diagnosis {
+	if queue == nil {
+		return nil
+	}
+	capability, ok := queue.Spec.Capability[corev1.ResourceName(req.resourceName)]
+	if !ok {
+		return nil
+	}
+	allocated := queue.Status.Allocated[corev1.ResourceName(req.resourceName)]
+	if int(allocated.Value())+req.total <= int(capability.Value()) {
+		return nil
+	}
+	return &Diagnosis{
+		Category:    reasonQueueQuota,
+		RequiredNPU: req.total,
+		NPUPerPod:   req.perPod,
+		Summary: fmt.Sprintf("needs %d NPUs, but queue %s has allocated %d NPUs of its capability %d, "+
+			"you can check it by executing command: kubectl describe q %s", req.total, queue.Name,
+			allocated.Value(), capability.Value(), queue.Name),
+		Causes: []DiagnosisCause{{Reason: reasonQueueQuota, NPUNum: int(allocated.Value())}},
+	}
+}
+
+// diagnoseNPU check whether the free npus can hold the pods of the job. a pod is bound to one node, so only the
+// whole multiples of the npus per pod are allocatable on a node. when the job has a block size, the npus of one
+// block must be in one super pod (spBlock) or one rack (raBlock)
+func diagnoseNPU(req npuRequirement, nodes []nodeNPUs) *Diagnosis {
+	perPod := req.perPod
+	if perPod <= 0 {
+		perPod = 1
+	}
+	d := &Diagnosis{RequiredNPU: req.total, NPUPerPod: perPod, BlockSize: req.blockSize}
+	groups := make(map[string]*npuGroup)
+	total := 0
+	for _, node := range nodes {
+		name := req.groupOf(node)
+		group, ok := groups[name]
+		if !ok {
+			group = &npuGroup{name: name, causes: make(map[string][]string)}
+			groups[name] = group
+		}
+		group.allocatable += len(node.free) / perPod * perPod
+		for reason, devices := range node.unavailable {
+			group.causes[reason] = append(group.causes[reason], qualifiedNames(node.nodeName, devices)...)
+			total += len(devices)
+		}
+		if rest := len(node.free) % perPod; rest > 0 {
+			group.causes[reasonScattered] = append(group.causes[reasonScattered],
+				qualifiedNames(node.nodeName, node.free[len(node.free)-rest:])...)
+		}
+		d.FreeNPU += len(node.free)
+		d.AllocatableNPU += len(node.free) / perPod * perPod
+	}
+	total += d.FreeNPU
+	if req.blockSize <= 0 {
+		group := groups[""]
+		if d.AllocatableNPU >= req.total {
+			return sufficient(d)
+		}
+		summary := fmt.Sprintf("needs %d NPUs (%d per pod), only %d can be allocated", req.total, perPod,
+			d.AllocatableNPU)
+		return explain(d, group, summary, total)
+	}
+	return diagnoseBlocks(req, d, groups, total)
+}
+
+func diagnoseBlocks(req npuRequirement, d *Diagnosis, groups map[string]*npuGroup, total int) *Diagnosis {
+	names := make([]string, 0, len(groups))
+	for name := range groups {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	blocks := 0
+	var largest *npuGroup
+	for _, name := range names {
+		group := groups[name]
+		blocks += group.allocatable / req.blockSize
+		if largest == nil || group.allocatable > largest.allocatable {
+			largest = group
+		}
+	}
+	requiredBlocks := (req.total + req.blockSize - 1) / req.blockSize
+	if blocks >= requiredBlocks {
+		return sufficient(d)
+	}
+	summary := fmt.Sprintf("needs %d NPUs in one %s", req.blockSize, req.blockName)
+	if requiredBlocks > 1 {
+		summary = fmt.Sprintf("needs %d NPUs in each of %d %ss, only %d %ss can be allocated", req.blockSize,
+			requiredBlocks, req.blockName, blocks, req.blockName)
+	}
+	if largest == nil {
+		return explain(d, nil, summary, total)
+	}
+	d.LargestFreeBlock = largest.allocatable
+	summary += fmt.Sprintf("; largest free block is %d in %s", largest.allocatable, largest.name)
+	return explain(d, largest, summary, total)
+}
+
+func (req npuRequirement) groupOf(node nodeNPUs) string {
+	switch req.blockName {
+	case spBlockName:
+		return fmt.Sprintf("super pod %d", node.superPodId)
+	case raBlockName:
+		return fmt.Sprintf("super pod %d rack %d", node.superPodId, node.rackId)
+	default:
+		return ""
+	}
+}
+
+func sufficient(d *Diagnosis) *Diagnosis {
+	d.Category = reasonSufficient
+	d.Summary = fmt.Sprintf("needs %d NPUs and they can be allocated, the job may be waiting for other resources "+
+		"such as cpu and memory, please check the message of the conditions", d.RequiredNPU)
+	return d
+}
+
+// explain write the causes of the group into the diagnosis, the category is the cause with the most npus
+func explain(d *Diagnosis, group *npuGroup, summary string, total int) *Diagnosis {
+	if group != nil {
+		d.Causes = toCauses(group.causes)
+	}
+	if len(d.Causes) == 0 {
+		d.Category = reasonInsufficient
+		d.Summary = fmt.Sprintf("%s because the cluster has only %d NPUs", summary, total)
+		return d
+	}
+	d.Category = d.Causes[0].Reason
+	reasons := make([]string, 0, len(d.Causes))
+	for _, cause := range d.Causes {
+		reasons = append(reasons, describeCause(cause))
+	}
+	d.Summary = fmt.Sprintf("%s because %s", summary, strings.Join(reasons, ", "))
+	return d
+}
+
+func toCauses(causes map[string][]string) []DiagnosisCause {
+	result := make([]DiagnosisCause, 0, len(causes))
+	for _, reason := range causeOrder {
+		devices, ok := causes[reason]
+		if !ok || len(devices) == 0 {
+			continue
+		}
+		sort.Strings(devices)
+		cause := DiagnosisCause{Reason: reason, NPUNum: len(devices), Devices: devices}
+		if len(devices) > maxCauseDevices {
+			cause.Devices = devices[:maxCauseDevices]
+		}
+		result = append(result, cause)
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].NPUNum > result[j].NPUNum
+	})
+	return result
+}
+
+func describeCause(cause DiagnosisCause) string {
+	devices := cause.Devices
+	suffix := ""
+	if len(devices) > maxSummaryDevices {
+		devices, suffix = devices[:maxSummaryDevices], " ..."
+	}
+	verb := "are"
+	if cause.NPUNum == 1 {
+		verb = "is"
+	}
+	return fmt.Sprintf("%d NPUs %s %s (%s%s)", cause.NPUNum, verb, causeDescriptions[cause.Reason],
+		strings.Join(devices, ", "), suffix)
+}
+
+func qualifiedNames(nodeName string, devices []string) []string {
+	names := make([]string, 0, len(devices))
+	for _, device := range devices {
+		names = append(names, nodeName+"/"+device)
+	}
+	return names
+}
@@ -0,0 +1,261 @@
+/* Copyright(C) 2026. Huawei Technologies Co.,Ltd. All rights reserved.
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+// Package schedulingexception is for collecting scheduling exception
+package schedulingexception
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/agiledragon/gomonkey/v2"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"volcano.sh/apis/pkg/apis/scheduling/v1beta1"
+
+	"ascend-common/api"
+	"clusterd/pkg/common/constant"
+	"clusterd/pkg/interface/kube"
+)
+
+var testRequirement = npuRequirement{resourceName: api.HuaweiAscend910, deviceType: api.Ascend910}
+
+const (
+	testNpuNum   = 8
+	testPerPod   = 2
+	testSeparate = 2
+	testOccupied = 4
+)
+
+func testDeviceNames(num int) []string {
+	names := make([]string, 0, num)
+	for i := 0; i < num; i++ {
+		names = append(names, fmt.Sprintf("%s-%d", api.Ascend910, i))
+	}
+	return names
+}
+
+func testDeviceCm(superPodId int32, separated int) *constant.AdvanceDeviceFaultCm {
+	devInfo := &constant.AdvanceDeviceFaultCm{
+		DeviceType:          api.Ascend910,
+		SuperPodID:          superPodId,
+		AvailableDeviceList: testDeviceNames(testNpuNum),
+		FaultDeviceList:     map[string][]constant.DeviceFault{},
+	}
+	for _, name := range testDeviceNames(separated) {
+		devInfo.FaultDeviceList[name] = []constant.DeviceFault{{FaultLevel: constant.ManuallySeparateNPU}}
+	}
+	return devInfo
+}
+
+func TestCollectNodeNPUs(t *testing.T) {
+	devInfo := testDeviceCm(0, 1)
+	devInfo.FaultDeviceList["Ascend910-1"] = []constant.DeviceFault{{FaultLevel: constant.ManuallySeparateNPU,
+		FaultCode: constant.MaintenanceFaultCode}}
+	devInfo.CardUnHealthy = []string{"Ascend910-2"}
+	deviceCms := map[string]*constant.AdvanceDeviceFaultCm{
+		"node1": devInfo,
+		"node2": testDeviceCm(0, 0),
+		"node3": {DeviceType: api.Ascend310P, AvailableDeviceList: []string{"Ascend310P-0"}},
+	}
+	nodeCms := map[string]*constant.NodeInfo{constant.NodeInfoPrefix + "node2": {
+		NodeInfoNoName: constant.NodeInfoNoName{NodeStatus: constant.UnHealthyState}}}
+	used := func(nodeName string) sets.String {
+		if nodeName == "node1" {
+			return sets.NewString("Ascend910-3", "Ascend910-2c-100-4", "Ascend910-2c-101-4")
+		}
+		return sets.NewString()
+	}
+
+	nodes := collectNodeNPUs(testRequirement.publishedBy, deviceCms, nodeCms, used)
+	if len(nodes) != 2 {
+		t.Fatalf("expected 2 nodes, got %d", len(nodes))
+	}
+	expected := map[string][]string{
+		reasonSeparated:   {"Ascend910-0"},
+		reasonMaintenance: {"Ascend910-1"},
+		reasonUnhealthy:   {"Ascend910-2"},
+		reasonOccupied:    {"Ascend910-3"},
+		reasonFragmented:  {"Ascend910-4"},
+	}
+	if !reflect.DeepEqual(nodes[0].unavailable, expected) {
+		t.Errorf("unexpected unavailable npus of node1: %v", nodes[0].unavailable)
+	}
+	if !reflect.DeepEqual(nodes[0].free, []string{"Ascend910-5", "Ascend910-6", "Ascend910-7"}) {
+		t.Errorf("unexpected free npus of node1: %v", nodes[0].free)
+	}
+	if len(nodes[1].free) != 0 || len(nodes[1].unavailable[reasonUnhealthy]) != testNpuNum {
+		t.Errorf("expected all the npus of the unhealthy node2 are unhealthy, got %v", nodes[1].unavailable)
+	}
+}
+
+func TestPublishedByGenericResource(t *testing.T) {
+	req := npuRequirement{resourceName: api.HuaweiNPU, deviceType: api.NPULowerCase}
+	devInfo := testDeviceCm(0, 0)
+	patch := gomonkey.ApplyFunc(kube.GetNodeFromIndexer, func(name string) (*corev1.Node, error) {
+		if name != "node1" {
+			return nil, fmt.Errorf("node %s is not found", name)
+		}
+		return &corev1.Node{Status: corev1.NodeStatus{Allocatable: corev1.ResourceList{
+			api.HuaweiNPU: *resource.NewQuantity(testNpuNum, resource.DecimalSI)}}}, nil
+	})
+	defer patch.Reset()
+	if !req.publishedBy("node1", devInfo) {
+		t.Error("expected the node allocating huawei.com/npu is resolved whatever its device type is")
+	}
+	if req.publishedBy("node2", devInfo) {
+		t.Error("expected the node of other device type is not resolved without the node")
+	}
+	if !req.publishedBy("node2", &constant.AdvanceDeviceFaultCm{DeviceType: api.NPULowerCase}) {
+		t.Error("expected the node of npu device type is resolved without the node")
+	}
+}
+
+func testNodes() []nodeNPUs {
+	deviceCms := map[string]*constant.AdvanceDeviceFaultCm{
+		"node1": testDeviceCm(0, testSeparate),
+		"node2": testDeviceCm(1, 0),
+	}
+	used := func(nodeName string) sets.String {
+		if nodeName == "node2" {
+			return sets.NewString(testDeviceNames(testOccupied)...)
+		}
+		return sets.NewString()
+	}
+	return collectNodeNPUs(testRequirement.publishedBy, deviceCms, nil, used)
+}
+
+func TestDiagnoseNPUWithBlock(t *testing.T) {
+	req := npuRequirement{total: testNpuNum, perPod: testPerPod, blockSize: testNpuNum, blockName: spBlockName}
+	d := diagnoseNPU(req, testNodes())
+	if d.Category != reasonSeparated {
+		t.Errorf("expected category %s, got %s", reasonSeparated, d.Category)
+	}
+	const largest = testNpuNum - testSeparate
+	if d.LargestFreeBlock != largest || d.FreeNPU != largest+testNpuNum-testOccupied {
+		t.Errorf("unexpected largest free block %d and free npus %d", d.LargestFreeBlock, d.FreeNPU)
+	}
+	expected := "needs 8 NPUs in one spBlock; largest free block is 6 in super pod 0 because 2 NPUs are " +
+		"manually separated (node1/Ascend910-0, node1/Ascend910-1)"
+	if d.Summary != expected {
+		t.Errorf("unexpected summary: %s", d.Summary)
+	}
+}
+
+func TestDiagnoseNPUWithoutBlock(t *testing.T) {
+	const scatteredPerPod = 4
+	req := npuRequirement{total: testNpuNum * testPerPod, perPod: scatteredPerPod}
+	d := diagnoseNPU(req, testNodes())
+	if d.AllocatableNPU != testNpuNum || d.Category != reasonOccupied {
+		t.Errorf("unexpected allocatable npus %d and category %s", d.AllocatableNPU, d.Category)
+	}
+	if len(d.Causes) != 3 || d.Causes[2].Reason != reasonScattered {
+		t.Errorf("unexpected causes: %v", d.Causes)
+	}
+
+	req.total = testNpuNum
+	if d = diagnoseNPU(req, testNodes()); d.Category != reasonSufficient {
+		t.Errorf("expected category %s, got %s", reasonSufficient, d.Category)
+	}
+	if d = diagnoseNPU(req, nil); d.Category != reasonInsufficient ||
+		!strings.HasSuffix(d.Summary, "the cluster has only 0 NPUs") {
+		t.Errorf("unexpected diagnosis of the empty cluster: %v", d)
+	}
+}
+
+func TestDiagnoseQuota(t *testing.T) {
+	queue := &v1beta1.Queue{
+		ObjectMeta: metav1.ObjectMeta{Name: "default"},
+		Spec: v1beta1.QueueSpec{Capability: corev1.ResourceList{
+			api.HuaweiAscend910: *resource.NewQuantity(testNpuNum, resource.DecimalSI)}},
+		Status: v1beta1.QueueStatus{Allocated: corev1.ResourceList{
+			api.HuaweiAscend910: *resource.NewQuantity(testOccupied, resource.DecimalSI)}},
+	}
+	req := npuRequirement{resourceName: api.HuaweiAscend910, total: testOccupied}
+	if d := diagnoseQuota(queue, req); d != nil {
+		t.Errorf("expected no diagnosis, got %v", d)
+	}
+	req.total = testNpuNum
+	if d := diagnoseQuota(queue, req); d == nil || d.Category != reasonQueueQuota {
+		t.Errorf("expected category %s, got %v", reasonQueueQuota, d)
+	}
+	req.resourceName = api.HuaweiNPU
+	if d := diagnoseQuota(queue, req); d != nil {
+		t.Errorf("expected no diagnosis without the capability, got %v", d)
+	}
+}
+
+func TestNodeNPUsLoaderQueue(t *testing.T) {
+	loader := &nodeNPUsLoader{}
+	if _, err := loader.queue("default"); err == nil {
+		t.Error("expected the error without the volcano client")
+	}
+	cached := &v1beta1.Queue{ObjectMeta: metav1.ObjectMeta{Name: "cached"}}
+	loader.queues["cached"] = queueResult{queue: cached}
+	if queue, err := loader.queue("cached"); err != nil || queue != cached {
+		t.Errorf("expected the queue got in the check is reused, got %v, %v", queue, err)
+	}
+	if _, ok := loader.queues["default"]; !ok {
+		t.Error("expected the failure is kept until the check ends")
+	}
+}
+
+func TestRequirementOf(t *testing.T) {
+	minResources := corev1.ResourceList{
+		corev1.ResourceCPU:  *resource.NewQuantity(1, resource.DecimalSI),
+		api.HuaweiAscend910: *resource.NewQuantity(testNpuNum, resource.DecimalSI),
+	}
+	pg := &v1beta1.PodGroup{
+		ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{spBlockAnnoKey: "8"}},
+		Spec:       v1beta1.PodGroupSpec{MinMember: testNpuNum / testPerPod, MinResources: &minResources},
+	}
+	req, ok := requirementOf(pg, nil)
+	expected := npuRequirement{resourceName: api.HuaweiAscend910, deviceType: api.Ascend910, total: testNpuNum,
+		perPod: testPerPod, blockSize: testNpuNum, blockName: spBlockName}
+	if !ok || req != expected {
+		t.Errorf("unexpected requirement: %v", req)
+	}
+
+	vNPUResources := corev1.ResourceList{"huawei.com/Ascend910-2c": *resource.NewQuantity(1, resource.DecimalSI)}
+	pg.Spec.MinResources = &vNPUResources
+	if _, ok = requirementOf(pg, nil); ok {
+		t.Error("expected the vNPU requirement is not diagnosed")
+	}
+}
+
+func TestGetJobExceptions(t *testing.T) {
+	diagnosis := &Diagnosis{Category: reasonSeparated}
+	publish(map[string]*jobExceptionInfo{
+		"job2.uid2": {JobName: "job2", jobKey: "uid2"},
+		"job1.uid1": {JobName: "job1", jobKey: "uid1", Diagnosis: diagnosis,
+			Condition: conditionDetail{Status: podGroupInqueue}},
+	})
+	defer publish(nil)
+
+	exceptions := GetJobExceptions("")
+	if len(exceptions) != 2 || exceptions[0].JobId != "uid1" || exceptions[0].Diagnosis != diagnosis ||
+		exceptions[0].Status != string(podGroupInqueue) {
+		t.Errorf("unexpected exceptions: %v", exceptions)
+	}
+	if exceptions = GetJobExceptions("uid2"); len(exceptions) != 1 || exceptions[0].JobName != "job2" {
+		t.Errorf("unexpected exceptions of uid2: %v", exceptions)
+	}
+	if exceptions = GetJobExceptions("uid3"); len(exceptions) != 0 {
+		t.Errorf("expected no exception of uid3, got %v", exceptions)
+	}
+}
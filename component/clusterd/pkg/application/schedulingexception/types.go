@@ -20,6 +20,8 @@ type jobExceptionInfo struct {
 	JobType   string          `json:"jobType"`
 	NameSpace string          `json:"nameSpace"`
 	Condition conditionDetail `json:"conditions"`
+	Diagnosis *Diagnosis      `json:"diagnosis,omitempty"`
+	jobKey    string
 }
 
 type conditionDetail struct {
@@ -39,3 +41,35 @@ type conditionIndices struct {
 	batchOrderFailedIndex     int
 	notEnoughResourcesIndex   int
 }
+
+// Diagnosis is the root cause of the job can not be scheduled, correlated with the npus of the cluster
+type Diagnosis struct {
+	Category         string           `json:"category"`
+	Summary          string           `json:"summary"`
+	RequiredNPU      int              `json:"requiredNPU,omitempty"`
+	NPUPerPod        int              `json:"npuPerPod,omitempty"`
+	BlockSize        int              `json:"blockSize,omitempty"`
+	FreeNPU          int              `json:"freeNPU"`
+	AllocatableNPU   int              `json:"allocatableNPU"`
+	LargestFreeBlock int              `json:"largestFreeBlock,omitempty"`
+	Causes           []DiagnosisCause `json:"causes,omitempty"`
+}
+
+// DiagnosisCause is the npus which can not be allocated to the job for the same reason
+type DiagnosisCause struct {
+	Reason  string   `json:"reason"`
+	NPUNum  int      `json:"npuNum"`
+	Devices []string `json:"devices,omitempty"`
+}
+
+// JobException is the published scheduling exception of the job
+type JobException struct {
+	JobId     string
+	JobName   string
+	JobType   string
+	NameSpace string
+	Status    string
+	Reason    string
+	Message   string
+	Diagnosis *Diagnosis
+}
@@ -235,6 +235,8 @@ const (
 	PreSeparateNPU = "PreSeparateNPU"
 	// ManuallySeparateNPU Manually Separate NPU
 	ManuallySeparateNPU = "ManuallySeparateNPU"
+	// MaintenanceFaultCode the fault code of the devices under the maintenance window
+	MaintenanceFaultCode = "MaintenanceWindow"
 	// CardUnhealthy fault is caused by card unhealthy
 	CardUnhealthy = "CardUnhealthy"
 	// CardNetworkUnhealthy  fault is caused by card network unhealthy
@@ -445,8 +447,17 @@ const (
 	ConfigCmName = "clusterd-config-cm"
 	// ManualDevInfoCmName manual device info cm name
 	ManualDevInfoCmName = "clusterd-manual-info-cm"
+	// RecoverCheckpointCmName the name prefix of the cms saving the checkpoints of the fault recover state
+	// machines, each job in recovering has its own cm
+	RecoverCheckpointCmName = "clusterd-recover-checkpoint"
+	// RecoverCheckpointLabelKey the label of the cms saving the checkpoints of the fault recover state machines
+	RecoverCheckpointLabelKey = "clusterd-recover-checkpoint"
+	// MaintenanceCmName the name of cm saving the maintenance windows
+	MaintenanceCmName = "clusterd-maintenance-windows"
 	// ManuallySeparateNPUConfigKey the key of manually separate npu config in cm
 	ManuallySeparateNPUConfigKey = "manually_separate_policy.conf"
+	// FaultProcessorChainConfigKey the key of the fault processor chain config in cm
+	FaultProcessorChainConfigKey = "fault_processor_chain.conf"
 	// HoursToMilliseconds hours to milliseconds
 	HoursToMilliseconds = 60 * 60 * 1000
 	// SecondsToMilliseconds seconds to milliseconds
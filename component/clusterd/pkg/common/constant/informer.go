@@ -10,4 +10,6 @@ const (
 	AddOperator = "add"
 	// UpdateOperator informer operator
 	UpdateOperator = "update"
+	// LeaveFilterOperator the operator of the watched job event, the job no longer matches the filter of the watch
+	LeaveFilterOperator = "leave"
 )
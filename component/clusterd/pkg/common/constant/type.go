@@ -144,6 +144,20 @@ type JobStatistic struct {
 	PodLastRunningTime  int64  `json:"podLastRunTime,omitempty"`
 	PodLastFaultTime    int64  `json:"podLastFaultTime,omitempty"`
 	PodFaultTimes       int64  `json:"podFaultTimes,omitempty"`
+	// RecoverPausedTime the seconds the training paused in the recovery states
+	RecoverPausedTime int64 `json:"recoverPausedTime,omitempty"`
+	// RescheduleLostTime the seconds from the pods failed to running again, excluding the recovery paused time
+	RescheduleLostTime int64 `json:"rescheduleLostTime,omitempty"`
+	// RecoverTimes and RecoverTotalTime the number and seconds of the interruptions recovered
+	RecoverTimes     int64 `json:"recoverTimes,omitempty"`
+	RecoverTotalTime int64 `json:"recoverTotalTime,omitempty"`
+	// FaultNums the number of the faults of the job, key is the fault level
+	FaultNums map[string]int64 `json:"faultNums,omitempty"`
+	// the start time of the ongoing recovery, reschedule and interruption, 0 means none
+	RecoverStartTime    int64  `json:"recoverStartTime,omitempty"`
+	RescheduleStartTime int64  `json:"rescheduleStartTime,omitempty"`
+	InterruptStartTime  int64  `json:"interruptStartTime,omitempty"`
+	Rescheduling        bool   `json:"rescheduling,omitempty"`
 	ScheduleProcess     string `json:"-"`
 	ScheduleFailReason  string `json:"-"`
 	Status              string `json:"-"`
@@ -350,6 +364,8 @@ type FaultRank struct {
 	DoStepRetry      bool
 	DoRestartInPlace bool
 	DeviceId         string // This value will only be filled in when fault type is npu
+	NodeName         string
+	FaultTime        int64 // This value will be 0 when the fault is not reported with the time, such as node not ready
 }
 
 // JobFaultInfo job fault rank info
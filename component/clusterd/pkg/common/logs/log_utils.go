@@ -21,6 +21,12 @@ const (
 	// grpcEventMaxLogLineLength to support 256 server
 	grpcEventMaxLogLineLength = 524288
 	grpcEventMaxAge           = 30
+
+	pubFaultDeadLetterLog           = "/var/log/mindx-dl/clusterd/pub_fault_dead_letter.log"
+	pubFaultDeadLetterMaxBackupLogs = 5
+	// pubFaultDeadLetterMaxLogLineLength to keep the truncated payload
+	pubFaultDeadLetterMaxLogLineLength = 8192
+	pubFaultDeadLetterMaxAge           = 30
 )
 
 var (
@@ -31,7 +37,12 @@ var (
 	grpcEventLogConfig = &hwlog.LogConfig{LogFileName: grpcEventLog, MaxBackups: grpcEventMaxBackupLogs,
 		MaxLineLength: grpcEventMaxLogLineLength, MaxAge: grpcEventMaxAge, OnlyToFile: true}
 	// GrpcEventLogger is used to log grpc event
-	GrpcEventLogger *hwlog.CustomLogger
+	GrpcEventLogger          *hwlog.CustomLogger
+	pubFaultDeadLetterConfig = &hwlog.LogConfig{LogFileName: pubFaultDeadLetterLog,
+		MaxBackups: pubFaultDeadLetterMaxBackupLogs, MaxLineLength: pubFaultDeadLetterMaxLogLineLength,
+		MaxAge: pubFaultDeadLetterMaxAge, OnlyToFile: true}
+	// PubFaultDeadLetterLog is used to log the rejected public fault payloads
+	PubFaultDeadLetterLog *hwlog.CustomLogger
 )
 
 // InitJobEventLogger init JobEventLog
@@ -65,3 +76,13 @@ func InitGrpcEventLogger(ctx context.Context) error {
 	GrpcEventLogger = grpcLog
 	return nil
 }
+
+// InitPubFaultDeadLetterLogger init PubFaultDeadLetterLog
+func InitPubFaultDeadLetterLogger(ctx context.Context) error {
+	deadLetterLog, err := hwlog.NewCustomLogger(pubFaultDeadLetterConfig, ctx)
+	if err != nil {
+		return err
+	}
+	PubFaultDeadLetterLog = deadLetterLog
+	return nil
+}
@@ -141,3 +141,26 @@ func TestInitGrpcEventLogger(t *testing.T) {
 		convey.So(GrpcEventLogger, convey.ShouldResemble, mockCustomLog)
 	})
 }
+
+func TestInitPubFaultDeadLetterLogger(t *testing.T) {
+	convey.Convey("test InitPubFaultDeadLetterLogger failed, NewCustomLogger error", t, func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		p1 := gomonkey.ApplyFuncReturn(hwlog.NewCustomLogger, nil, errTest)
+		defer p1.Reset()
+		err := InitPubFaultDeadLetterLogger(ctx)
+		convey.So(err, convey.ShouldResemble, errTest)
+		convey.So(PubFaultDeadLetterLog, convey.ShouldBeNil)
+	})
+
+	convey.Convey("test InitPubFaultDeadLetterLogger success", t, func() {
+		mockCustomLog := &hwlog.CustomLogger{}
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		p2 := gomonkey.ApplyFuncReturn(hwlog.NewCustomLogger, mockCustomLog, nil)
+		defer p2.Reset()
+		err := InitPubFaultDeadLetterLogger(ctx)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(PubFaultDeadLetterLog, convey.ShouldResemble, mockCustomLog)
+	})
+}
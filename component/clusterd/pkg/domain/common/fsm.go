@@ -9,8 +9,20 @@ import (
 	"sync"
 )
 
+const restoreEvent = "restore"
+
 type handleFunc func() (nextEvent string, code RespCode, err error)
 
+// TransitionHook is called after the state changed and before the handler of the rule is called
+type TransitionHook func(src, event, dst string)
+
+// Transition is a state change happened in the state machine
+type Transition struct {
+	Src   string
+	Event string
+	Dst   string
+}
+
 // TransRule is type of state change rules
 type TransRule struct {
 	Src     string
@@ -34,7 +46,10 @@ type StateMachine struct {
 	rules     []TransRule
 	path      []string
 	pathGraph string // src(event)-->dst
-	lock      sync.RWMutex
+	// transitions are the matched state changes since the last reset
+	transitions []Transition
+	hook        TransitionHook
+	lock        sync.RWMutex
 }
 
 // NewStateMachine return a new state machine
@@ -68,6 +83,20 @@ func (m *StateMachine) Reset() {
 	m.state = m.initState
 	m.path = []string{m.initState}
 	m.pathGraph = m.initState
+	m.transitions = nil
+}
+
+// SetTransitionHook set the hook called on every state change triggered by event
+func (m *StateMachine) SetTransitionHook(hook TransitionHook) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.hook = hook
+}
+
+func (m *StateMachine) getTransitionHook() TransitionHook {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+	return m.hook
 }
 
 // RuleMatching return rule for event when origin state is src
@@ -101,11 +130,26 @@ func (m *StateMachine) GetPathGraph() string {
 	return m.pathGraph
 }
 
-func (m *StateMachine) appendPath(match bool, event, dst string) {
+// GetTransitions return the state changes since the last reset
+func (m *StateMachine) GetTransitions() []Transition {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+	return append([]Transition(nil), m.transitions...)
+}
+
+// GetRules return the rules of the state machine
+func (m *StateMachine) GetRules() []TransRule {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+	return append([]TransRule(nil), m.rules...)
+}
+
+func (m *StateMachine) appendPath(match bool, src, event, dst string) {
 	m.lock.Lock()
 	defer m.lock.Unlock()
 	if match {
 		m.path = append(m.path, dst)
+		m.transitions = append(m.transitions, Transition{Src: src, Event: event, Dst: dst})
 		m.pathGraph = fmt.Sprintf("%s(%s)-->%s", m.pathGraph, event, dst)
 		return
 	}
@@ -119,10 +163,33 @@ func (m *StateMachine) Trigger(event string, args ...interface{}) (string, RespC
 	if matching {
 		dstState = rule.Dst
 	}
-	m.appendPath(matching, event, dstState)
+	m.appendPath(matching, m.state, event, dstState)
 	if !matching {
 		return "", OrderMix, errors.New("rule match error, change order may mixed")
 	}
+	src := m.GetState()
+	m.changeState(rule.Dst)
+	if hook := m.getTransitionHook(); hook != nil {
+		hook(src, event, rule.Dst)
+	}
+	return rule.Handler()
+}
+
+// Resume restore the state machine to the dst state of the rule matching src and event, and call the handler of
+// the rule again without calling the transition hook. it is used to continue the interrupted transition
+func (m *StateMachine) Resume(src, event string) (string, RespCode, error) {
+	matching, rule := m.ruleMatching(src, event)
+	if !matching {
+		return "", OrderMix, fmt.Errorf("no rule matches state %s and event %s", src, event)
+	}
 	m.changeState(rule.Dst)
+	m.appendPath(true, src, event, rule.Dst)
 	return rule.Handler()
 }
+
+// Restore restore the state machine to state without calling any handler
+func (m *StateMachine) Restore(state string) {
+	src := m.GetState()
+	m.changeState(state)
+	m.appendPath(true, src, restoreEvent, state)
+}
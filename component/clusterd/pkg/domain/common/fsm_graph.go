@@ -0,0 +1,139 @@
+// Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+
+// Package common is grpc common types and functions
+package common
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+const (
+	// GraphFormatDot render the state machine as graphviz dot
+	GraphFormatDot = "dot"
+	// GraphFormatMermaid render the state machine as mermaid state diagram
+	GraphFormatMermaid = "mermaid"
+)
+
+var mermaidIdReplacer = regexp.MustCompile(`[^A-Za-z0-9_]`)
+
+// graphData is the state machine to render, the edges traversed are marked with the step numbers
+type graphData struct {
+	name      string
+	initState string
+	current   string
+	states    []string
+	rules     []TransRule
+	visited   map[string]bool
+	steps     map[Transition][]string
+}
+
+// RenderGraph render the rules of the state machine with the path traversed since the last reset, the current
+// state is highlighted. name is the name of the graph, format is GraphFormatDot or GraphFormatMermaid
+func (m *StateMachine) RenderGraph(name, format string) (string, error) {
+	m.lock.RLock()
+	data := newGraphData(name, m.initState, m.state, m.rules, m.transitions)
+	m.lock.RUnlock()
+	switch format {
+	case GraphFormatDot:
+		return data.dot(), nil
+	case GraphFormatMermaid:
+		return data.mermaid(), nil
+	default:
+		return "", fmt.Errorf("graph format %q is not supported, supported formats are %s and %s",
+			format, GraphFormatDot, GraphFormatMermaid)
+	}
+}
+
+func newGraphData(name, initState, current string, rules []TransRule, transitions []Transition) *graphData {
+	data := &graphData{name: name, initState: initState, current: current, rules: rules,
+		visited: map[string]bool{initState: true}, steps: make(map[Transition][]string)}
+	seen := map[string]bool{initState: true}
+	data.states = []string{initState}
+	for _, rule := range rules {
+		for _, state := range []string{rule.Src, rule.Dst} {
+			if !seen[state] {
+				seen[state] = true
+				data.states = append(data.states, state)
+			}
+		}
+	}
+	for i, transition := range transitions {
+		data.visited[transition.Dst] = true
+		data.steps[transition] = append(data.steps[transition], strconv.Itoa(i+1))
+	}
+	return data
+}
+
+func (g *graphData) edgeLabel(rule TransRule) (string, bool) {
+	steps, ok := g.steps[Transition{Src: rule.Src, Event: rule.Event, Dst: rule.Dst}]
+	if !ok {
+		return rule.Event, false
+	}
+	return fmt.Sprintf("%s [%s]", rule.Event, strings.Join(steps, ",")), true
+}
+
+func (g *graphData) dot() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "digraph %s {\n", strconv.Quote(g.name))
+	b.WriteString("  rankdir=LR;\n  node [shape=box, style=rounded];\n")
+	for _, state := range g.states {
+		var attrs []string
+		if state == g.initState {
+			attrs = append(attrs, "peripheries=2")
+		}
+		switch {
+		case state == g.current:
+			attrs = append(attrs, `style="rounded,filled"`, "fillcolor=orange")
+		case g.visited[state]:
+			attrs = append(attrs, `style="rounded,filled"`, "fillcolor=lightblue")
+		default:
+		}
+		if len(attrs) == 0 {
+			fmt.Fprintf(&b, "  %s;\n", strconv.Quote(state))
+			continue
+		}
+		fmt.Fprintf(&b, "  %s [%s];\n", strconv.Quote(state), strings.Join(attrs, ", "))
+	}
+	for _, rule := range g.rules {
+		label, traversed := g.edgeLabel(rule)
+		attrs := "label=" + strconv.Quote(label)
+		if traversed {
+			attrs += ", color=red, fontcolor=red, penwidth=2"
+		}
+		fmt.Fprintf(&b, "  %s -> %s [%s];\n", strconv.Quote(rule.Src), strconv.Quote(rule.Dst), attrs)
+	}
+	b.WriteString("}\n")
+	return b.String()
+}
+
+func (g *graphData) mermaid() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "---\ntitle: %s\n---\nstateDiagram-v2\n", g.name)
+	var visited []string
+	for _, state := range g.states {
+		id := mermaidIdReplacer.ReplaceAllString(state, "_")
+		if id != state {
+			fmt.Fprintf(&b, "  state \"%s\" as %s\n", state, id)
+		}
+		if g.visited[state] && state != g.current {
+			visited = append(visited, id)
+		}
+	}
+	fmt.Fprintf(&b, "  [*] --> %s\n", mermaidIdReplacer.ReplaceAllString(g.initState, "_"))
+	for _, rule := range g.rules {
+		label, _ := g.edgeLabel(rule)
+		fmt.Fprintf(&b, "  %s --> %s : %s\n", mermaidIdReplacer.ReplaceAllString(rule.Src, "_"),
+			mermaidIdReplacer.ReplaceAllString(rule.Dst, "_"), label)
+	}
+	b.WriteString("  classDef visited fill:lightblue\n  classDef current fill:orange\n")
+	if len(visited) > 0 {
+		fmt.Fprintf(&b, "  class %s visited\n", strings.Join(visited, ","))
+	}
+	if g.current != "" {
+		fmt.Fprintf(&b, "  class %s current\n", mermaidIdReplacer.ReplaceAllString(g.current, "_"))
+	}
+	return b.String()
+}
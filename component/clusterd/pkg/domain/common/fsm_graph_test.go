@@ -0,0 +1,49 @@
+// Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+
+// Package common is grpc common types and functions
+package common
+
+import (
+	"testing"
+
+	"github.com/smartystreets/goconvey/convey"
+)
+
+func TestRenderGraph(t *testing.T) {
+	convey.Convey("Test RenderGraph", t, func() {
+		sm := NewStateMachine(InitState, append(getFakeRules(),
+			TransRule{Src: "state1", Event: "event3", Dst: "state-2", Handler: event2Handler}))
+		_, _, err := sm.Trigger("event1")
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(sm.GetTransitions(), convey.ShouldResemble, []Transition{{Src: InitState, Event: "event1",
+			Dst: "state1"}})
+		convey.Convey("01-render dot, should highlight the path and the current state", func() {
+			graph, err := sm.RenderGraph("job", GraphFormatDot)
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(graph, convey.ShouldContainSubstring, `"INIT" [peripheries=2, style="rounded,filled", `+
+				`fillcolor=lightblue];`)
+			convey.So(graph, convey.ShouldContainSubstring, `"state1" [style="rounded,filled", fillcolor=orange];`)
+			convey.So(graph, convey.ShouldContainSubstring, `"INIT" -> "state1" [label="event1 [1]", color=red, `+
+				`fontcolor=red, penwidth=2];`)
+			convey.So(graph, convey.ShouldContainSubstring, `"state1" -> "INIT" [label="event2"];`)
+		})
+		convey.Convey("02-render mermaid, should highlight the path and the current state", func() {
+			graph, err := sm.RenderGraph("job", GraphFormatMermaid)
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(graph, convey.ShouldContainSubstring, "  state \"state-2\" as state_2\n")
+			convey.So(graph, convey.ShouldContainSubstring, "  INIT --> state1 : event1 [1]\n")
+			convey.So(graph, convey.ShouldContainSubstring, "  class INIT visited\n  class state1 current\n")
+		})
+		convey.Convey("03-reset, should clear the path", func() {
+			sm.Reset()
+			convey.So(sm.GetTransitions(), convey.ShouldBeEmpty)
+			graph, err := sm.RenderGraph("job", GraphFormatMermaid)
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(graph, convey.ShouldNotContainSubstring, "[1]")
+		})
+		convey.Convey("04-unknown format, should return error", func() {
+			_, err := sm.RenderGraph("job", "svg")
+			convey.So(err, convey.ShouldNotBeNil)
+		})
+	})
+}
@@ -0,0 +1,176 @@
+// Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+
+// Package common is grpc common types and functions
+package common
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+)
+
+// RuleSpecVersionV1 the version of the rule spec supported
+const RuleSpecVersionV1 = "v1"
+
+// RuleSpec is the declarative definition of a state machine
+type RuleSpec struct {
+	Version   string          `yaml:"version"`
+	InitState string          `yaml:"initState"`
+	Groups    []RuleSpecGroup `yaml:"groups"`
+}
+
+// RuleSpecGroup is a group of rules of the same scene
+type RuleSpecGroup struct {
+	Name  string         `yaml:"name"`
+	Rules []RuleSpecRule `yaml:"rules"`
+}
+
+// RuleSpecRule is the declarative TransRule, Handler is the name of the handler registered by the user
+type RuleSpecRule struct {
+	Src     string `yaml:"src"`
+	Event   string `yaml:"event"`
+	Dst     string `yaml:"dst"`
+	Handler string `yaml:"handler"`
+}
+
+// RuleHandler is the handler referenced by the rule spec. TimeoutEvents are the events returned by Handle when
+// waiting for the report times out, the dst state of the rules using the handler must handle them
+type RuleHandler struct {
+	Handle        handleFunc
+	TimeoutEvents []string
+}
+
+// ParseRuleSpec parse the rule spec, unknown fields are rejected
+func ParseRuleSpec(data []byte) (*RuleSpec, error) {
+	spec := &RuleSpec{}
+	if err := yaml.UnmarshalStrict(data, spec); err != nil {
+		return nil, fmt.Errorf("unmarshal rule spec failed: %v", err)
+	}
+	if spec.Version != RuleSpecVersionV1 {
+		return nil, fmt.Errorf("rule spec version %q is not supported, supported version is %s",
+			spec.Version, RuleSpecVersionV1)
+	}
+	return spec, nil
+}
+
+// Rules return all rules in the order of the groups
+func (s *RuleSpec) Rules() []RuleSpecRule {
+	var rules []RuleSpecRule
+	for _, group := range s.Groups {
+		rules = append(rules, group.Rules...)
+	}
+	return rules
+}
+
+// Validate check the spec against the handlers: every handler is registered, no event is ambiguous, every state is
+// reachable from the init state and can leave unless it is the init state, and every timeout is handled
+func (s *RuleSpec) Validate(handlers map[string]RuleHandler) error {
+	if s.InitState == "" {
+		return errors.New("initState is empty")
+	}
+	var errs []string
+	transitions := make(map[string]map[string]string)
+	for _, group := range s.Groups {
+		for _, rule := range group.Rules {
+			if msg := s.checkRule(group.Name, rule, handlers, transitions); msg != "" {
+				errs = append(errs, msg)
+			}
+		}
+	}
+	errs = append(errs, s.checkStates(transitions)...)
+	errs = append(errs, s.checkTimeouts(handlers, transitions)...)
+	if len(errs) > 0 {
+		return fmt.Errorf("invalid rule spec: %s", strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+func (s *RuleSpec) checkRule(group string, rule RuleSpecRule, handlers map[string]RuleHandler,
+	transitions map[string]map[string]string) string {
+	if rule.Src == "" || rule.Event == "" || rule.Dst == "" {
+		return fmt.Sprintf("group %s has rule with empty src, event or dst: %+v", group, rule)
+	}
+	if _, ok := handlers[rule.Handler]; !ok {
+		return fmt.Sprintf("handler %q of rule %s(%s) is not registered", rule.Handler, rule.Src, rule.Event)
+	}
+	if transitions[rule.Src] == nil {
+		transitions[rule.Src] = make(map[string]string)
+	}
+	if dst, ok := transitions[rule.Src][rule.Event]; ok {
+		return fmt.Sprintf("event %s of state %s is ambiguous, defined to %s and %s", rule.Event, rule.Src,
+			dst, rule.Dst)
+	}
+	transitions[rule.Src][rule.Event] = rule.Dst
+	return ""
+}
+
+func (s *RuleSpec) checkStates(transitions map[string]map[string]string) []string {
+	reachable := map[string]bool{s.InitState: true}
+	queue := []string{s.InitState}
+	for len(queue) > 0 {
+		state := queue[0]
+		queue = queue[1:]
+		for _, dst := range transitions[state] {
+			if !reachable[dst] {
+				reachable[dst] = true
+				queue = append(queue, dst)
+			}
+		}
+	}
+	var errs []string
+	for _, state := range s.States() {
+		if !reachable[state] {
+			errs = append(errs, fmt.Sprintf("state %s is unreachable from %s", state, s.InitState))
+		}
+		if len(transitions[state]) == 0 && state != s.InitState {
+			errs = append(errs, fmt.Sprintf("state %s has no rule to leave", state))
+		}
+	}
+	return errs
+}
+
+func (s *RuleSpec) checkTimeouts(handlers map[string]RuleHandler, transitions map[string]map[string]string) []string {
+	var errs []string
+	reported := make(map[Transition]bool)
+	for _, rule := range s.Rules() {
+		for _, event := range handlers[rule.Handler].TimeoutEvents {
+			missing := Transition{Src: rule.Dst, Event: event}
+			if _, ok := transitions[rule.Dst][event]; !ok && !reported[missing] {
+				reported[missing] = true
+				errs = append(errs, fmt.Sprintf("state %s waits in %s but has no rule for timeout event %s",
+					rule.Dst, rule.Handler, event))
+			}
+		}
+	}
+	return errs
+}
+
+// States return all states of the spec, the init state is the first one and the others are sorted
+func (s *RuleSpec) States() []string {
+	states := make(map[string]bool)
+	for _, rule := range s.Rules() {
+		states[rule.Src] = true
+		states[rule.Dst] = true
+	}
+	delete(states, s.InitState)
+	res := make([]string, 0, len(states)+1)
+	for state := range states {
+		res = append(res, state)
+	}
+	sort.Strings(res)
+	return append([]string{s.InitState}, res...)
+}
+
+// BuildRules build the TransRules with the handlers, the spec should have been validated
+func (s *RuleSpec) BuildRules(handlers map[string]RuleHandler) []TransRule {
+	specRules := s.Rules()
+	rules := make([]TransRule, 0, len(specRules))
+	for _, rule := range specRules {
+		rules = append(rules, TransRule{Src: rule.Src, Event: rule.Event, Dst: rule.Dst,
+			Handler: handlers[rule.Handler].Handle})
+	}
+	return rules
+}
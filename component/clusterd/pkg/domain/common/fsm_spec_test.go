@@ -0,0 +1,95 @@
+// Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+
+// Package common is grpc common types and functions
+package common
+
+import (
+	"testing"
+
+	"github.com/smartystreets/goconvey/convey"
+)
+
+const fakeRuleSpec = `
+version: v1
+initState: INIT
+groups:
+  - name: fake
+    rules:
+      - {src: INIT, event: event1, dst: state1, handler: event1Handler}
+      - {src: state1, event: event2, dst: INIT, handler: event2Handler}
+      - {src: state1, event: timeout, dst: INIT, handler: event2Handler}
+`
+
+func getFakeRuleHandlers() map[string]RuleHandler {
+	return map[string]RuleHandler{
+		"event1Handler": {Handle: event1Handler, TimeoutEvents: []string{"timeout"}},
+		"event2Handler": {Handle: event2Handler},
+	}
+}
+
+func validateFakeSpec(rules ...RuleSpecRule) error {
+	spec, err := ParseRuleSpec([]byte(fakeRuleSpec))
+	if err != nil {
+		return err
+	}
+	spec.Groups[0].Rules = append(spec.Groups[0].Rules, rules...)
+	return spec.Validate(getFakeRuleHandlers())
+}
+
+func TestParseRuleSpec(t *testing.T) {
+	convey.Convey("Test ParseRuleSpec", t, func() {
+		convey.Convey("01-valid spec, should build the rules in order", func() {
+			spec, err := ParseRuleSpec([]byte(fakeRuleSpec))
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(spec.Validate(getFakeRuleHandlers()), convey.ShouldBeNil)
+			convey.So(spec.States(), convey.ShouldResemble, []string{InitState, "state1"})
+			rules := spec.BuildRules(getFakeRuleHandlers())
+			convey.So(len(rules), convey.ShouldEqual, len(spec.Rules()))
+			convey.So(rules[0].Dst, convey.ShouldEqual, "state1")
+			convey.So(rules[0].Handler, convey.ShouldNotBeNil)
+		})
+		convey.Convey("02-unsupported version, should return error", func() {
+			_, err := ParseRuleSpec([]byte("version: v2\ninitState: INIT\n"))
+			convey.So(err, convey.ShouldNotBeNil)
+		})
+		convey.Convey("03-unknown field, should return error", func() {
+			_, err := ParseRuleSpec([]byte("version: v1\ninitState: INIT\nstates: []\n"))
+			convey.So(err, convey.ShouldNotBeNil)
+		})
+	})
+}
+
+func TestRuleSpecValidate(t *testing.T) {
+	convey.Convey("Test RuleSpec Validate", t, func() {
+		convey.Convey("01-handler not registered, should return error", func() {
+			err := validateFakeSpec(RuleSpecRule{Src: "state1", Event: "event3", Dst: InitState, Handler: "unknown"})
+			convey.So(err.Error(), convey.ShouldContainSubstring, "is not registered")
+		})
+		convey.Convey("02-ambiguous event, should return error", func() {
+			err := validateFakeSpec(RuleSpecRule{Src: "state1", Event: "event2", Dst: "state1",
+				Handler: "event2Handler"})
+			convey.So(err.Error(), convey.ShouldContainSubstring, "is ambiguous")
+		})
+		convey.Convey("03-unreachable state, should return error", func() {
+			err := validateFakeSpec(RuleSpecRule{Src: "state2", Event: "event2", Dst: InitState,
+				Handler: "event2Handler"})
+			convey.So(err.Error(), convey.ShouldContainSubstring, "state state2 is unreachable")
+		})
+		convey.Convey("04-state can not leave, should return error", func() {
+			err := validateFakeSpec(RuleSpecRule{Src: InitState, Event: "event3", Dst: "state2",
+				Handler: "event2Handler"})
+			convey.So(err.Error(), convey.ShouldContainSubstring, "state state2 has no rule to leave")
+		})
+		convey.Convey("05-missing timeout, should return error", func() {
+			err := validateFakeSpec(RuleSpecRule{Src: "state1", Event: "event3", Dst: "state2",
+				Handler: "event1Handler"}, RuleSpecRule{Src: "state2", Event: "event2", Dst: InitState,
+				Handler: "event2Handler"})
+			convey.So(err.Error(), convey.ShouldContainSubstring, "state state2 waits in event1Handler but has no "+
+				"rule for timeout event timeout")
+		})
+		convey.Convey("06-empty init state, should return error", func() {
+			spec := &RuleSpec{Version: RuleSpecVersionV1}
+			convey.So(spec.Validate(getFakeRuleHandlers()), convey.ShouldNotBeNil)
+		})
+	})
+}
@@ -98,3 +98,45 @@ func TestTrigger(t *testing.T) {
 		})
 	})
 }
+
+func TestTransitionHook(t *testing.T) {
+	convey.Convey("Test transition hook", t, func() {
+		sm := NewStateMachine(InitState, getFakeRules())
+		var transitions []string
+		sm.SetTransitionHook(func(src, event, dst string) {
+			transitions = append(transitions, fmt.Sprintf("%s(%s)-->%s", src, event, dst))
+		})
+		_, _, err := sm.Trigger("event1")
+		convey.So(err, convey.ShouldBeNil)
+		_, _, err = sm.Trigger("event1")
+		convey.So(err, convey.ShouldNotBeNil)
+		convey.So(transitions, convey.ShouldResemble, []string{fmt.Sprintf("%s(event1)-->state1", InitState)})
+	})
+}
+
+func TestResumeAndRestore(t *testing.T) {
+	convey.Convey("Test resume and restore", t, func() {
+		convey.Convey("resume should call the handler of the rule without hook", func() {
+			sm := NewStateMachine(InitState, getFakeRules())
+			sm.SetTransitionHook(func(_, _, _ string) { panic("hook should not be called") })
+			nextEvent, code, err := sm.Resume(InitState, "event1")
+			convey.So(nextEvent, convey.ShouldEqual, "event2")
+			convey.So(code, convey.ShouldEqual, OK)
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(sm.GetState(), convey.ShouldEqual, "state1")
+		})
+		convey.Convey("resume should fail when no rule matches", func() {
+			sm := NewStateMachine(InitState, getFakeRules())
+			_, code, err := sm.Resume(InitState, "event2")
+			convey.So(code, convey.ShouldEqual, OrderMix)
+			convey.So(err, convey.ShouldNotBeNil)
+			convey.So(sm.GetState(), convey.ShouldEqual, InitState)
+		})
+		convey.Convey("restore should only change the state", func() {
+			sm := NewStateMachine(InitState, getFakeRules())
+			sm.Restore("state1")
+			convey.So(sm.GetState(), convey.ShouldEqual, "state1")
+			convey.So(sm.RuleCheck(sm.GetState(), "event2"), convey.ShouldBeTrue)
+		})
+	})
+}
@@ -98,11 +98,16 @@ func StrategySupported(strategy string) bool {
 
 // GetRecoverBaseInfo get recover config
 func GetRecoverBaseInfo(name, namespace string) (RecoverConfig, RespCode, error) {
-	config := RecoverConfig{}
 	pg, err := kube.RetryGetPodGroup(name, namespace, constant.GetPodGroupTimes)
 	if err != nil {
-		return config, OperatePodGroupError, err
+		return RecoverConfig{}, OperatePodGroupError, err
 	}
+	return GetRecoverConfigByPG(pg), OK, nil
+}
+
+// GetRecoverConfigByPG get recover config from the labels and annotations of the pod group
+func GetRecoverConfigByPG(pg *v1beta1.PodGroup) RecoverConfig {
+	config := RecoverConfig{}
 	_, config.PlatFormMode = pg.Annotations[constant.ProcessRecoverStrategy]
 	mindXConfig, ok := pg.Annotations[constant.RecoverStrategies]
 	strategyList := strings.Split(mindXConfig, ",")
@@ -136,7 +141,7 @@ func GetRecoverBaseInfo(name, namespace string) (RecoverConfig, RespCode, error)
 	config.SubHealthyStrategy = strategy
 	config.GraceExit = strategy == constant.SubHealthyGraceExit
 	config.HotSwitch = strategy == constant.SubHealthyHotSwitch
-	return config, OK, nil
+	return config
 }
 
 // SendRetry send signal util send success or retry times upper retryTimes
@@ -452,6 +457,29 @@ func SortRecoverStrategies(strSlice []string) {
 	})
 }
 
+// ChooseFirstStrategy choose the first strategy of the recovery, retryOnly is whether all the faults can be retried
+// by the step, supported tells whether the strategy can be chosen for the job
+func ChooseFirstStrategy(retryOnly bool, supported func(strategy string) bool) string {
+	if retryOnly && supported(constant.ProcessRetryStrategyName) {
+		return constant.ProcessRetryStrategyName
+	}
+	if supported(constant.ProcessRecoverInPlaceStrategyName) {
+		return constant.ProcessRecoverInPlaceStrategyName
+	}
+	return ChooseForRestartProcessFail(supported)
+}
+
+// ChooseForRestartProcessFail choose the strategy when the faulty processes can not be restarted in place
+func ChooseForRestartProcessFail(supported func(strategy string) bool) string {
+	for _, strategy := range []string{constant.ProcessRecoverStrategyName, constant.ScaleInStrategyName,
+		constant.ProcessDumpStrategyName} {
+		if supported(strategy) {
+			return strategy
+		}
+	}
+	return constant.ProcessExitStrategyName
+}
+
 type StreamSender[T any] interface {
 	Send(*T) error
 }
@@ -349,6 +349,31 @@ func addTestCaseForLabelNotExist(name, namespace string) {
 	})
 }
 
+func TestChooseFirstStrategy(t *testing.T) {
+	convey.Convey("Test ChooseFirstStrategy", t, func() {
+		supportedOf := func(strategies ...string) func(string) bool {
+			return func(strategy string) bool {
+				return util.IsSliceContain(strategy, strategies)
+			}
+		}
+		convey.Convey("01-retry supported and all faults can be retried, should choose retry", func() {
+			convey.So(ChooseFirstStrategy(true, supportedOf(constant.ProcessRetryStrategyName,
+				constant.ProcessRecoverStrategyName)), convey.ShouldEqual, constant.ProcessRetryStrategyName)
+		})
+		convey.Convey("02-normal faults, should skip retry and choose in the order", func() {
+			convey.So(ChooseFirstStrategy(false, supportedOf(constant.ProcessRetryStrategyName,
+				constant.ProcessRecoverStrategyName)), convey.ShouldEqual, constant.ProcessRecoverStrategyName)
+			convey.So(ChooseFirstStrategy(false, supportedOf(constant.ProcessRecoverInPlaceStrategyName,
+				constant.ProcessRecoverStrategyName)), convey.ShouldEqual, constant.ProcessRecoverInPlaceStrategyName)
+			convey.So(ChooseFirstStrategy(false, supportedOf(constant.ScaleInStrategyName,
+				constant.ProcessDumpStrategyName)), convey.ShouldEqual, constant.ScaleInStrategyName)
+		})
+		convey.Convey("03-nothing supported, should exit", func() {
+			convey.So(ChooseFirstStrategy(true, supportedOf()), convey.ShouldEqual, constant.ProcessExitStrategyName)
+		})
+	})
+}
+
 func TestIsUceFault(t *testing.T) {
 	convey.Convey("Test IsRetryFault", t, func() {
 		convey.Convey("case uce fault", func() {
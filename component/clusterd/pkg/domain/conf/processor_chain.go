@@ -0,0 +1,95 @@
+/* Copyright(C) 2026. Huawei Technologies Co.,Ltd. All rights reserved.
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+// Package conf global config base func
+package conf
+
+import (
+	"fmt"
+	"net/url"
+)
+
+const (
+	// DefaultWebhookTimeoutSeconds the timeout of the webhook processor when not configured
+	DefaultWebhookTimeoutSeconds = 3
+	// MaxWebhookTimeoutSeconds the max timeout of the webhook processor, the fault center is blocked during the call
+	MaxWebhookTimeoutSeconds = 10
+)
+
+// ProcessorChain the processors of each fault center in order. the built-in chain is used for the center not
+// configured, and the empty list disables all the processors of the center
+type ProcessorChain struct {
+	Device []ProcessorConfig `yaml:"device"`
+	Node   []ProcessorConfig `yaml:"node"`
+	Switch []ProcessorConfig `yaml:"switch"`
+	Dpu    []ProcessorConfig `yaml:"dpu"`
+}
+
+// ProcessorConfig one processor of the chain, it is the built-in processor with the name when webhook is nil
+type ProcessorConfig struct {
+	Name    string         `yaml:"name"`
+	Webhook *WebhookConfig `yaml:"webhook"`
+}
+
+// WebhookConfig the out-of-process processor, which receives the configmap content and returns the filtered one
+type WebhookConfig struct {
+	URL            string `yaml:"url"`
+	TimeoutSeconds int    `yaml:"timeout_seconds"`
+	// CaFile verify the certificate of the https webhook, the system roots are used when empty. the file is reloaded
+	// when changed, at most once per 10 seconds
+	CaFile string `yaml:"ca_file"`
+}
+
+// CheckProcessorChain check the processor chain config, the timeout of the webhook is defaulted
+func CheckProcessorChain(chain *ProcessorChain) error {
+	centers := map[string][]ProcessorConfig{"device": chain.Device, "node": chain.Node, "switch": chain.Switch,
+		"dpu": chain.Dpu}
+	for center, processors := range centers {
+		names := make(map[string]struct{}, len(processors))
+		for i := range processors {
+			if err := checkProcessor(&processors[i]); err != nil {
+				return fmt.Errorf("%s processor %d is invalid: %v", center, i, err)
+			}
+			if _, ok := names[processors[i].Name]; ok {
+				return fmt.Errorf("%s processor %s is duplicated", center, processors[i].Name)
+			}
+			names[processors[i].Name] = struct{}{}
+		}
+	}
+	return nil
+}
+
+func checkProcessor(processor *ProcessorConfig) error {
+	if processor.Name == "" {
+		return fmt.Errorf("name is empty")
+	}
+	webhook := processor.Webhook
+	if webhook == nil {
+		return nil
+	}
+	webhookURL, err := url.Parse(webhook.URL)
+	if err != nil || (webhookURL.Scheme != "http" && webhookURL.Scheme != "https") || webhookURL.Host == "" {
+		return fmt.Errorf("webhook url %q should be an absolute http or https url", webhook.URL)
+	}
+	if webhook.CaFile != "" && webhookURL.Scheme != "https" {
+		return fmt.Errorf("ca_file is only used by the https webhook")
+	}
+	if webhook.TimeoutSeconds == 0 {
+		webhook.TimeoutSeconds = DefaultWebhookTimeoutSeconds
+	}
+	if webhook.TimeoutSeconds < 0 || webhook.TimeoutSeconds > MaxWebhookTimeoutSeconds {
+		return fmt.Errorf("timeout_seconds must be in [1, %d]", MaxWebhookTimeoutSeconds)
+	}
+	return nil
+}
@@ -0,0 +1,55 @@
+/* Copyright(C) 2026. Huawei Technologies Co.,Ltd. All rights reserved.
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+// Package conf test for the processor chain config
+package conf
+
+import (
+	"testing"
+
+	"github.com/smartystreets/goconvey/convey"
+)
+
+const testWebhookURL = "https://filter.default.svc:8443/filter"
+
+func TestCheckProcessorChain(t *testing.T) {
+	convey.Convey("Test CheckProcessorChain", t, func() {
+		convey.Convey("01-valid chain, should default the webhook timeout", func() {
+			chain := ProcessorChain{
+				Device: []ProcessorConfig{{Name: "retry"}, {Name: "filter", Webhook: &WebhookConfig{URL: testWebhookURL}}},
+				Dpu:    []ProcessorConfig{},
+			}
+			convey.So(CheckProcessorChain(&chain), convey.ShouldBeNil)
+			convey.So(chain.Device[1].Webhook.TimeoutSeconds, convey.ShouldEqual, DefaultWebhookTimeoutSeconds)
+		})
+		testCases := []struct {
+			name  string
+			chain ProcessorChain
+		}{
+			{"02-empty name", ProcessorChain{Node: []ProcessorConfig{{}}}},
+			{"03-duplicated name", ProcessorChain{Switch: []ProcessorConfig{{Name: "retry"}, {Name: "retry"}}}},
+			{"04-relative url", ProcessorChain{Device: []ProcessorConfig{{Name: "filter",
+				Webhook: &WebhookConfig{URL: "/filter"}}}}},
+			{"05-ca file of http url", ProcessorChain{Device: []ProcessorConfig{{Name: "filter",
+				Webhook: &WebhookConfig{URL: "http://filter/filter", CaFile: "/etc/ca.crt"}}}}},
+			{"06-timeout too large", ProcessorChain{Device: []ProcessorConfig{{Name: "filter",
+				Webhook: &WebhookConfig{URL: testWebhookURL, TimeoutSeconds: MaxWebhookTimeoutSeconds + 1}}}}},
+		}
+		for _, testCase := range testCases {
+			convey.Convey(testCase.name+", should return error", func() {
+				convey.So(CheckProcessorChain(&testCase.chain), convey.ShouldNotBeNil)
+			})
+		}
+	})
+}
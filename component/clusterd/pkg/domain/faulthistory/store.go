@@ -0,0 +1,328 @@
+/* Copyright(C) 2026. Huawei Technologies Co.,Ltd. All rights reserved.
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+// Package faulthistory persist the timeline of the fault events in the local bbolt file
+package faulthistory
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	bolt "go.etcd.io/bbolt"
+
+	"ascend-common/common-utils/hwlog"
+)
+
+const (
+	// EventOccur the fault occurs
+	EventOccur = "occur"
+	// EventRecover the fault recovers
+	EventRecover = "recover"
+
+	// DefaultQueryLimit the number of events returned by the query without limit
+	DefaultQueryLimit = 1000
+	// MaxQueryLimit the max number of events returned by one query
+	MaxQueryLimit = 10000
+
+	dbFileMode  = 0600
+	openTimeout = time.Second
+	seqKeyLen   = 8
+)
+
+var (
+	eventBucket  = []byte("events")
+	activeBucket = []byte("active")
+)
+
+// AffectedJob the job and ranks running on the faulty device when the fault occurs
+type AffectedJob struct {
+	JobId     string   `json:"jobId"`
+	JobName   string   `json:"jobName"`
+	Namespace string   `json:"namespace"`
+	RankIds   []string `json:"rankIds,omitempty"`
+}
+
+// FaultEvent one occurrence or recovery of the fault. the recover event copies the fields of the occur event, so
+// the duration of the fault can be got from any one of them
+type FaultEvent struct {
+	Seq         uint64        `json:"seq"`
+	Type        string        `json:"type"`
+	Source      string        `json:"source"`
+	NodeName    string        `json:"nodeName"`
+	DeviceId    string        `json:"deviceId"`
+	DeviceType  string        `json:"deviceType"`
+	FaultCode   string        `json:"faultCode"`
+	FaultLevel  string        `json:"faultLevel"`
+	OccurTime   int64         `json:"occurTime"`
+	RecoverTime int64         `json:"recoverTime,omitempty"`
+	Jobs        []AffectedJob `json:"jobs,omitempty"`
+}
+
+// Time return the time of the event in milliseconds
+func (e *FaultEvent) Time() int64 {
+	if e.Type == EventRecover {
+		return e.RecoverTime
+	}
+	return e.OccurTime
+}
+
+// Filter the conditions of the query, the empty field matches all. the time range is [StartTime, EndTime] in
+// milliseconds, EndTime 0 means now
+type Filter struct {
+	StartTime int64
+	EndTime   int64
+	NodeName  string
+	JobId     string
+	FaultCode string
+	Limit     int
+}
+
+func (f *Filter) match(event *FaultEvent) bool {
+	eventTime := event.Time()
+	if eventTime < f.StartTime || (f.EndTime > 0 && eventTime > f.EndTime) {
+		return false
+	}
+	if (f.NodeName != "" && f.NodeName != event.NodeName) || (f.FaultCode != "" && f.FaultCode != event.FaultCode) {
+		return false
+	}
+	if f.JobId == "" {
+		return true
+	}
+	for _, job := range event.Jobs {
+		if job.JobId == f.JobId {
+			return true
+		}
+	}
+	return false
+}
+
+// Store the append-only fault event timeline, the oldest events are dropped when the number exceeds the bound.
+// the active faults are persisted with the events, so the recovery during the restart of clusterd is not lost
+type Store struct {
+	mu        sync.Mutex
+	db        *bolt.DB
+	maxEvents int
+	count     int
+	// active fault key -> occur event
+	active map[string]FaultEvent
+}
+
+// Open open or create the store file, keeping at most maxEvents events
+func Open(path string, maxEvents int) (*Store, error) {
+	if maxEvents <= 0 {
+		return nil, fmt.Errorf("max events %d should be positive", maxEvents)
+	}
+	if fileInfo, err := os.Lstat(path); err == nil && fileInfo.Mode()&os.ModeSymlink != 0 {
+		return nil, fmt.Errorf("fault history file %s should not be a soft link", path)
+	}
+	db, err := bolt.Open(path, dbFileMode, &bolt.Options{Timeout: openTimeout})
+	if err != nil {
+		return nil, fmt.Errorf("open fault history file %s failed: %v", path, err)
+	}
+	s := &Store{db: db, maxEvents: maxEvents, active: make(map[string]FaultEvent)}
+	if err = db.Update(s.load); err != nil {
+		if closeErr := db.Close(); closeErr != nil {
+			hwlog.RunLog.Warnf("close fault history file failed: %v", closeErr)
+		}
+		return nil, fmt.Errorf("load fault history file %s failed: %v", path, err)
+	}
+	hwlog.RunLog.Infof("fault history loaded, events: %d, active faults: %d", s.count, len(s.active))
+	return s, nil
+}
+
+func (s *Store) load(tx *bolt.Tx) error {
+	events, err := tx.CreateBucketIfNotExists(eventBucket)
+	if err != nil {
+		return err
+	}
+	active, err := tx.CreateBucketIfNotExists(activeBucket)
+	if err != nil {
+		return err
+	}
+	s.count = events.Stats().KeyN
+	if err = active.ForEach(func(k, v []byte) error {
+		var event FaultEvent
+		if err := json.Unmarshal(v, &event); err != nil {
+			hwlog.RunLog.Warnf("drop the invalid active fault %s: %v", string(k), err)
+			return nil
+		}
+		s.active[string(k)] = event
+		return nil
+	}); err != nil {
+		return err
+	}
+	// the bound may be decreased after restart
+	return s.compact(events)
+}
+
+// Close close the store file
+func (s *Store) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.db.Close()
+}
+
+// Sync compare the current active faults with the previous ones, record the occur event for the new fault and the
+// recover event at recoverTime for the disappeared fault. nothing is written when the active faults are unchanged.
+// attach is called for the new faults only, to fill in the fields which are expensive to get, such as the jobs
+func (s *Store) Sync(current map[string]FaultEvent, recoverTime int64, attach func(*FaultEvent)) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var recovered []string
+	occurred := make(map[string]FaultEvent)
+	for key, event := range current {
+		if _, ok := s.active[key]; ok {
+			continue
+		}
+		if attach != nil {
+			attach(&event)
+		}
+		event.Type, event.RecoverTime = EventOccur, 0
+		occurred[key] = event
+	}
+	for key := range s.active {
+		if _, ok := current[key]; !ok {
+			recovered = append(recovered, key)
+		}
+	}
+	if len(occurred) == 0 && len(recovered) == 0 {
+		return nil
+	}
+	originCount := s.count
+	err := s.db.Update(func(tx *bolt.Tx) error {
+		events, active := tx.Bucket(eventBucket), tx.Bucket(activeBucket)
+		for _, key := range sortedKeys(occurred) {
+			event := occurred[key]
+			if err := s.put(events, active, key, &event); err != nil {
+				return err
+			}
+			occurred[key] = event
+		}
+		sort.Strings(recovered)
+		for _, key := range recovered {
+			event := s.active[key]
+			event.Type, event.RecoverTime = EventRecover, recoverTime
+			if err := s.put(events, nil, key, &event); err != nil {
+				return err
+			}
+			if err := active.Delete([]byte(key)); err != nil {
+				return err
+			}
+		}
+		s.count += len(occurred) + len(recovered)
+		return s.compact(events)
+	})
+	if err != nil {
+		// the transaction is rolled back
+		s.count = originCount
+		return fmt.Errorf("record fault events failed: %v", err)
+	}
+	for key, event := range occurred {
+		s.active[key] = event
+	}
+	for _, key := range recovered {
+		delete(s.active, key)
+	}
+	hwlog.RunLog.Infof("fault history recorded %d occur events and %d recover events", len(occurred),
+		len(recovered))
+	return nil
+}
+
+func (s *Store) put(events, active *bolt.Bucket, key string, event *FaultEvent) error {
+	seq, err := events.NextSequence()
+	if err != nil {
+		return err
+	}
+	event.Seq = seq
+	data, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	if err = events.Put(seqKey(seq), data); err != nil {
+		return err
+	}
+	if active == nil {
+		return nil
+	}
+	return active.Put([]byte(key), data)
+}
+
+// compact drop the oldest events exceeding the bound
+func (s *Store) compact(events *bolt.Bucket) error {
+	cursor := events.Cursor()
+	for k, _ := cursor.First(); k != nil && s.count > s.maxEvents; k, _ = cursor.First() {
+		if err := cursor.Delete(); err != nil {
+			return err
+		}
+		s.count--
+	}
+	return nil
+}
+
+// Query return the latest events matched the filter in the order of occurrence
+func (s *Store) Query(filter Filter) ([]FaultEvent, error) {
+	if filter.EndTime > 0 && filter.StartTime > filter.EndTime {
+		return nil, errors.New("start time should not be after end time")
+	}
+	limit := filter.Limit
+	if limit <= 0 {
+		limit = DefaultQueryLimit
+	}
+	if limit > MaxQueryLimit {
+		limit = MaxQueryLimit
+	}
+	result := make([]FaultEvent, 0)
+	err := s.db.View(func(tx *bolt.Tx) error {
+		cursor := tx.Bucket(eventBucket).Cursor()
+		for k, v := cursor.Last(); k != nil && len(result) < limit; k, v = cursor.Prev() {
+			var event FaultEvent
+			if err := json.Unmarshal(v, &event); err != nil {
+				hwlog.RunLog.Warnf("skip the invalid fault event %d: %v", binary.BigEndian.Uint64(k), err)
+				continue
+			}
+			if filter.match(&event) {
+				result = append(result, event)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	for i, j := 0, len(result)-1; i < j; i, j = i+1, j-1 {
+		result[i], result[j] = result[j], result[i]
+	}
+	return result, nil
+}
+
+func sortedKeys(events map[string]FaultEvent) []string {
+	keys := make([]string, 0, len(events))
+	for key := range events {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func seqKey(seq uint64) []byte {
+	key := make([]byte, seqKeyLen)
+	binary.BigEndian.PutUint64(key, seq)
+	return key
+}
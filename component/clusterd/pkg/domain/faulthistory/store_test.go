@@ -0,0 +1,186 @@
+/* Copyright(C) 2026. Huawei Technologies Co.,Ltd. All rights reserved.
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+// Package faulthistory test for the fault history store
+package faulthistory
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/smartystreets/goconvey/convey"
+
+	"ascend-common/common-utils/hwlog"
+)
+
+const (
+	testNode1   = "node1"
+	testNode2   = "node2"
+	testCode1   = "80E01801"
+	testCode2   = "80C98009"
+	testJob     = "job1"
+	testMaxSize = 10
+
+	occurTime1   = 1771059600000 // 2026-02-14 09:00:00
+	occurTime2   = 1771059610000 // 2026-02-14 09:00:10
+	recoverTime1 = 1771059620000 // 2026-02-14 09:00:20
+	recoverTime2 = 1771059630000 // 2026-02-14 09:00:30
+)
+
+func init() {
+	if err := hwlog.InitRunLogger(&hwlog.LogConfig{OnlyToStdout: true}, context.Background()); err != nil {
+		fmt.Printf("init hwlog failed, %v\n", err)
+	}
+}
+
+func openTestStore(t *testing.T, maxEvents int) (*Store, string) {
+	path := filepath.Join(t.TempDir(), "fault-history.db")
+	store, err := Open(path, maxEvents)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return store, path
+}
+
+func testFaults() map[string]FaultEvent {
+	return map[string]FaultEvent{
+		"device/node1/0/" + testCode1: {Source: "device", NodeName: testNode1, DeviceId: "0", FaultCode: testCode1,
+			OccurTime: occurTime1},
+		"node/node2/0/" + testCode2: {Source: "node", NodeName: testNode2, DeviceId: "0", FaultCode: testCode2,
+			OccurTime: occurTime2},
+	}
+}
+
+func attachTestJob(event *FaultEvent) {
+	if event.NodeName == testNode1 {
+		event.Jobs = []AffectedJob{{JobId: testJob, RankIds: []string{"0"}}}
+	}
+}
+
+func TestStoreSync(t *testing.T) {
+	convey.Convey("Test Store sync", t, func() {
+		store, _ := openTestStore(t, testMaxSize)
+		defer store.Close()
+		faults := testFaults()
+		convey.So(store.Sync(faults, occurTime2, attachTestJob), convey.ShouldBeNil)
+		convey.Convey("01-new faults, should record the occur events with the jobs", func() {
+			events, err := store.Query(Filter{})
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(len(events), convey.ShouldEqual, len(faults))
+			convey.So(events[0].Type, convey.ShouldEqual, EventOccur)
+			convey.So(events[0].Seq, convey.ShouldEqual, 1)
+			convey.So(events[0].Jobs[0].JobId, convey.ShouldEqual, testJob)
+		})
+		convey.Convey("02-faults unchanged, should record nothing", func() {
+			convey.So(store.Sync(faults, recoverTime1, attachTestJob), convey.ShouldBeNil)
+			convey.So(store.count, convey.ShouldEqual, len(faults))
+		})
+		convey.Convey("03-fault disappeared, should record the recover event copying the occur event", func() {
+			delete(faults, "device/node1/0/"+testCode1)
+			convey.So(store.Sync(faults, recoverTime1, attachTestJob), convey.ShouldBeNil)
+			events, err := store.Query(Filter{FaultCode: testCode1})
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(len(events), convey.ShouldEqual, len([]string{EventOccur, EventRecover}))
+			convey.So(events[1].Type, convey.ShouldEqual, EventRecover)
+			convey.So(events[1].OccurTime, convey.ShouldEqual, occurTime1)
+			convey.So(events[1].RecoverTime, convey.ShouldEqual, recoverTime1)
+			convey.So(events[1].Jobs, convey.ShouldResemble, events[0].Jobs)
+		})
+	})
+}
+
+func TestStoreReopen(t *testing.T) {
+	convey.Convey("Test Store reopen, the active faults should be restored", t, func() {
+		store, path := openTestStore(t, testMaxSize)
+		convey.So(store.Sync(testFaults(), occurTime2, nil), convey.ShouldBeNil)
+		convey.So(store.Close(), convey.ShouldBeNil)
+		store, err := Open(path, testMaxSize)
+		convey.So(err, convey.ShouldBeNil)
+		defer store.Close()
+		convey.So(len(store.active), convey.ShouldEqual, len(testFaults()))
+		convey.So(store.Sync(map[string]FaultEvent{}, recoverTime2, nil), convey.ShouldBeNil)
+		events, err := store.Query(Filter{StartTime: recoverTime2})
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(len(events), convey.ShouldEqual, len(testFaults()))
+		convey.So(events[0].Seq, convey.ShouldEqual, len(testFaults())+1)
+	})
+}
+
+func TestStoreCompact(t *testing.T) {
+	convey.Convey("Test Store compact, the oldest events should be dropped", t, func() {
+		const maxEvents = 3
+		store, path := openTestStore(t, maxEvents)
+		faults := testFaults()
+		convey.So(store.Sync(faults, occurTime2, nil), convey.ShouldBeNil)
+		convey.So(store.Sync(map[string]FaultEvent{}, recoverTime1, nil), convey.ShouldBeNil)
+		events, err := store.Query(Filter{})
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(len(events), convey.ShouldEqual, maxEvents)
+		convey.So(events[0].Seq, convey.ShouldEqual, len(faults))
+		convey.So(store.Close(), convey.ShouldBeNil)
+		store, err = Open(path, 1)
+		convey.So(err, convey.ShouldBeNil)
+		defer store.Close()
+		events, err = store.Query(Filter{})
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(len(events), convey.ShouldEqual, 1)
+	})
+}
+
+func TestStoreQuery(t *testing.T) {
+	convey.Convey("Test Store query", t, func() {
+		store, _ := openTestStore(t, testMaxSize)
+		defer store.Close()
+		convey.So(store.Sync(testFaults(), occurTime2, attachTestJob), convey.ShouldBeNil)
+		convey.So(store.Sync(map[string]FaultEvent{}, recoverTime2, nil), convey.ShouldBeNil)
+		testCases := []struct {
+			filter   Filter
+			expected int
+		}{
+			{Filter{NodeName: testNode2}, len([]string{EventOccur, EventRecover})},
+			{Filter{JobId: testJob}, len([]string{EventOccur, EventRecover})},
+			{Filter{StartTime: occurTime2, EndTime: recoverTime1}, 1},
+			{Filter{FaultCode: testCode1, EndTime: occurTime1}, 1},
+			{Filter{Limit: 1}, 1},
+			{Filter{NodeName: "unknown"}, 0},
+		}
+		for _, testCase := range testCases {
+			events, err := store.Query(testCase.filter)
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(len(events), convey.ShouldEqual, testCase.expected)
+		}
+		_, err := store.Query(Filter{StartTime: recoverTime1, EndTime: occurTime1})
+		convey.So(err, convey.ShouldNotBeNil)
+	})
+}
+
+func TestOpen(t *testing.T) {
+	convey.Convey("Test Open", t, func() {
+		convey.Convey("01-max events is not positive, should return error", func() {
+			_, err := Open(filepath.Join(t.TempDir(), "fault-history.db"), 0)
+			convey.So(err, convey.ShouldNotBeNil)
+		})
+		convey.Convey("02-file is soft link, should return error", func() {
+			store, path := openTestStore(t, testMaxSize)
+			convey.So(store.Close(), convey.ShouldBeNil)
+			link := filepath.Join(filepath.Dir(path), "link.db")
+			convey.So(os.Symlink(path, link), convey.ShouldBeNil)
+			_, err := Open(link, testMaxSize)
+			convey.So(err, convey.ShouldNotBeNil)
+		})
+	})
+}
@@ -0,0 +1,213 @@
+/* Copyright(C) 2026. Huawei Technologies Co.,Ltd. All rights reserved.
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+// Package maintenance the maintenance windows of the nodes and devices
+package maintenance
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"sync"
+	"time"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+
+	"ascend-common/api"
+	"ascend-common/common-utils/hwlog"
+	"clusterd/pkg/common/constant"
+	"clusterd/pkg/interface/kube"
+)
+
+// maxFinishedWindows the oldest finished windows beyond it are dropped, so the configmap stays small
+const maxFinishedWindows = 100
+
+var (
+	// ErrNotFound the window does not exist
+	ErrNotFound = errors.New("maintenance window is not found")
+	// ErrFinished the window is completed or cancelled
+	ErrFinished = errors.New("maintenance window is finished")
+)
+
+// Windows the maintenance windows, they are saved in the configmap on every change
+var Windows = NewStore()
+
+// Store the maintenance windows, key is the id of the window
+type Store struct {
+	mutex   sync.RWMutex
+	windows map[string]*Window
+	lastId  int64
+}
+
+// NewStore return an empty store
+func NewStore() *Store {
+	return &Store{windows: make(map[string]*Window)}
+}
+
+// Add save the new window and return its id. the window should not share the device with another unfinished
+// window in the overlapped time
+func (s *Store) Add(window Window) (string, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	for _, existed := range s.windows {
+		if !existed.IsFinished() && existed.overlaps(&window) {
+			return "", fmt.Errorf("maintenance window overlaps with window %s", existed.Id)
+		}
+	}
+	window.Id = s.newId()
+	window.Status = StatusScheduled
+	window.AddAudit(ActionCreate, window.Operator, window.Reason)
+	s.windows[window.Id] = &window
+	s.flush()
+	return window.Id, nil
+}
+
+func (s *Store) newId() string {
+	id := time.Now().UnixMilli()
+	if id <= s.lastId {
+		id = s.lastId + 1
+	}
+	s.lastId = id
+	return fmt.Sprintf("mw-%d", id)
+}
+
+// Get return the copy of the window
+func (s *Store) Get(id string) (Window, bool) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	window, ok := s.windows[id]
+	if !ok {
+		return Window{}, false
+	}
+	return window.clone(), true
+}
+
+// List return the copies of the windows in the statuses ordered by the start time, all the windows when the
+// statuses are empty
+func (s *Store) List(statuses ...string) []Window {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	windows := make([]Window, 0, len(s.windows))
+	for _, window := range s.windows {
+		if len(statuses) > 0 && !slices.Contains(statuses, window.Status) {
+			continue
+		}
+		windows = append(windows, window.clone())
+	}
+	sort.Slice(windows, func(i, j int) bool {
+		if windows[i].StartTime != windows[j].StartTime {
+			return windows[i].StartTime < windows[j].StartTime
+		}
+		return windows[i].Id < windows[j].Id
+	})
+	return windows
+}
+
+// Update change the unfinished window by modify, the window is saved when modify returns true
+func (s *Store) Update(id string, modify func(window *Window) bool) (Window, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	window, ok := s.windows[id]
+	if !ok {
+		return Window{}, ErrNotFound
+	}
+	if window.IsFinished() {
+		return window.clone(), ErrFinished
+	}
+	updated := window.clone()
+	if modify(&updated) {
+		s.windows[id] = &updated
+		s.prune()
+		s.flush()
+	}
+	return updated.clone(), nil
+}
+
+// Cancel cancel the unfinished window, the devices are released
+func (s *Store) Cancel(id, operator, reason string) (Window, error) {
+	return s.Update(id, func(window *Window) bool {
+		window.Status = StatusCancelled
+		window.AffectedJobs = nil
+		window.AddAudit(ActionCancel, operator, reason)
+		return true
+	})
+}
+
+// prune drop the oldest finished windows beyond maxFinishedWindows
+func (s *Store) prune() {
+	finished := make([]*Window, 0)
+	for _, window := range s.windows {
+		if window.IsFinished() {
+			finished = append(finished, window)
+		}
+	}
+	if len(finished) <= maxFinishedWindows {
+		return
+	}
+	sort.Slice(finished, func(i, j int) bool { return finished[i].Id < finished[j].Id })
+	for _, window := range finished[:len(finished)-maxFinishedWindows] {
+		delete(s.windows, window.Id)
+	}
+}
+
+func persistEnabled() bool {
+	client := kube.GetClientK8s()
+	return client != nil && client.ClientSet != nil
+}
+
+// flush write all windows to the configmap, the failed write is retried by the next change
+func (s *Store) flush() {
+	if !persistEnabled() {
+		return
+	}
+	data := make(map[string]string, len(s.windows))
+	for id, window := range s.windows {
+		content, err := json.Marshal(window)
+		if err != nil {
+			hwlog.RunLog.Errorf("marshal maintenance window %s failed, err: %v", id, err)
+			continue
+		}
+		data[id] = string(content)
+	}
+	if err := kube.UpdateOrCreateConfigMap(constant.MaintenanceCmName, api.ClusterNS, data, nil); err != nil {
+		hwlog.RunLog.Errorf("write maintenance window configmap failed, err: %v", err)
+	}
+}
+
+// Load read the windows saved before clusterd restarted
+func (s *Store) Load() {
+	if !persistEnabled() {
+		return
+	}
+	cm, err := kube.GetConfigMap(constant.MaintenanceCmName, api.ClusterNS)
+	if err != nil {
+		if !k8serrors.IsNotFound(err) {
+			hwlog.RunLog.Errorf("get maintenance window configmap failed, err: %v", err)
+		}
+		return
+	}
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	for id, data := range cm.Data {
+		window := &Window{}
+		if err = json.Unmarshal([]byte(data), window); err != nil || window.Id != id {
+			hwlog.RunLog.Errorf("drop invalid maintenance window %s, err: %v", id, err)
+			continue
+		}
+		s.windows[id] = window
+	}
+	hwlog.RunLog.Infof("load %d maintenance windows", len(s.windows))
+}
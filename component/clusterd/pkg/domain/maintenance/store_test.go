@@ -0,0 +1,144 @@
+/* Copyright(C) 2026. Huawei Technologies Co.,Ltd. All rights reserved.
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+// Package maintenance test for the maintenance window store
+package maintenance
+
+import (
+	"context"
+	"testing"
+
+	"github.com/agiledragon/gomonkey/v2"
+	"github.com/smartystreets/goconvey/convey"
+	"k8s.io/api/core/v1"
+
+	"ascend-common/common-utils/hwlog"
+	"clusterd/pkg/interface/kube"
+)
+
+const (
+	testNode  = "node1"
+	testDev0  = "Ascend910-0"
+	testDev1  = "Ascend910-1"
+	testStart = 1000
+	testEnd   = 2000
+)
+
+func init() {
+	if err := hwlog.InitRunLogger(&hwlog.LogConfig{OnlyToStdout: true}, context.Background()); err != nil {
+		panic(err)
+	}
+}
+
+func testWindow(deviceNames ...string) Window {
+	return Window{Targets: []Target{{NodeName: testNode, DeviceNames: deviceNames}}, StartTime: testStart,
+		EndTime: testEnd, Operator: "ops"}
+}
+
+func TestStoreAdd(t *testing.T) {
+	convey.Convey("Test Store Add", t, func() {
+		store := NewStore()
+		id, err := store.Add(testWindow(testDev0))
+		convey.So(err, convey.ShouldBeNil)
+		window, ok := store.Get(id)
+		convey.So(ok, convey.ShouldBeTrue)
+		convey.So(window.Status, convey.ShouldEqual, StatusScheduled)
+		convey.So(window.Audits[0].Action, convey.ShouldEqual, ActionCreate)
+		convey.Convey("01-another device in the same time, should be added", func() {
+			_, err = store.Add(testWindow(testDev1))
+			convey.So(err, convey.ShouldBeNil)
+		})
+		convey.Convey("02-the whole node in the same time, should be rejected", func() {
+			_, err = store.Add(testWindow())
+			convey.So(err, convey.ShouldNotBeNil)
+		})
+		convey.Convey("03-the same device after the window, should be added", func() {
+			window := testWindow(testDev0)
+			window.StartTime, window.EndTime = testEnd, testEnd+testEnd
+			_, err = store.Add(window)
+			convey.So(err, convey.ShouldBeNil)
+		})
+	})
+}
+
+func TestStoreUpdate(t *testing.T) {
+	convey.Convey("Test Store Update and Cancel", t, func() {
+		store := NewStore()
+		id, err := store.Add(testWindow(testDev0))
+		convey.So(err, convey.ShouldBeNil)
+		convey.Convey("01-modify returns false, should not change the window", func() {
+			_, err = store.Update(id, func(w *Window) bool {
+				w.Status = StatusDraining
+				return false
+			})
+			convey.So(err, convey.ShouldBeNil)
+			window, _ := store.Get(id)
+			convey.So(window.Status, convey.ShouldEqual, StatusScheduled)
+		})
+		convey.Convey("02-cancel, should record the audit and reject the later changes", func() {
+			window, err := store.Cancel(id, "ops", "firmware ready")
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(window.Status, convey.ShouldEqual, StatusCancelled)
+			convey.So(window.Audits[len(window.Audits)-1].Action, convey.ShouldEqual, ActionCancel)
+			_, err = store.Cancel(id, "ops", "")
+			convey.So(err, convey.ShouldEqual, ErrFinished)
+			convey.So(len(store.List(StatusCancelled)), convey.ShouldEqual, 1)
+			convey.So(len(store.List(StatusScheduled)), convey.ShouldEqual, 0)
+		})
+		convey.Convey("03-window not exist, should return not found", func() {
+			_, err = store.Cancel("mw-0", "ops", "")
+			convey.So(err, convey.ShouldEqual, ErrNotFound)
+		})
+	})
+}
+
+func TestStorePersist(t *testing.T) {
+	convey.Convey("Test Store flush and Load, should restore the saved windows", t, func() {
+		var saved map[string]string
+		patches := gomonkey.ApplyFuncReturn(persistEnabled, true).
+			ApplyFunc(kube.UpdateOrCreateConfigMap, func(_, _ string, data, _ map[string]string) error {
+				saved = data
+				return nil
+			}).
+			ApplyFunc(kube.GetConfigMap, func(_, _ string) (*v1.ConfigMap, error) {
+				return &v1.ConfigMap{Data: saved}, nil
+			})
+		defer patches.Reset()
+		store := NewStore()
+		id, err := store.Add(testWindow(testDev0))
+		convey.So(err, convey.ShouldBeNil)
+		saved["invalid"] = "{"
+		restored := NewStore()
+		restored.Load()
+		window, ok := restored.Get(id)
+		convey.So(ok, convey.ShouldBeTrue)
+		convey.So(window.Targets[0].DeviceNames, convey.ShouldResemble, []string{testDev0})
+		convey.So(len(restored.List()), convey.ShouldEqual, 1)
+	})
+}
+
+func TestStorePrune(t *testing.T) {
+	convey.Convey("Test Store prune, should keep the latest finished windows", t, func() {
+		store := NewStore()
+		for i := 0; i <= maxFinishedWindows; i++ {
+			window := testWindow(testDev0)
+			window.StartTime, window.EndTime = int64(i*testEnd), int64((i+1)*testEnd)
+			id, err := store.Add(window)
+			convey.So(err, convey.ShouldBeNil)
+			_, err = store.Cancel(id, "ops", "")
+			convey.So(err, convey.ShouldBeNil)
+		}
+		convey.So(len(store.List()), convey.ShouldEqual, maxFinishedWindows)
+	})
+}
@@ -0,0 +1,143 @@
+/* Copyright(C) 2026. Huawei Technologies Co.,Ltd. All rights reserved.
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+// Package maintenance the maintenance windows of the nodes and devices
+package maintenance
+
+import (
+	"slices"
+	"time"
+)
+
+// the status of the maintenance window, the window goes through Scheduled, Draining, InProgress and Completed in
+// order, and it can be Cancelled before Completed
+const (
+	// StatusScheduled the window has not started
+	StatusScheduled = "Scheduled"
+	// StatusDraining the devices are sub-healthy, the jobs on them are being handed off
+	StatusDraining = "Draining"
+	// StatusInProgress the devices are separated for the maintenance
+	StatusInProgress = "InProgress"
+	// StatusCompleted the window ended and the devices are released
+	StatusCompleted = "Completed"
+	// StatusCancelled the window is cancelled and the devices are released
+	StatusCancelled = "Cancelled"
+)
+
+// the actions of the audit records
+const (
+	ActionCreate   = "create"
+	ActionDrain    = "drain"
+	ActionSeparate = "separate"
+	ActionRelease  = "release"
+	ActionCancel   = "cancel"
+	// SystemOperator the operator of the actions taken by clusterd
+	SystemOperator = "clusterd"
+)
+
+// DefaultDrainTimeout seconds to wait for the jobs to be handed off when the drain timeout is not given
+const DefaultDrainTimeout int64 = 600
+
+// Target the node under maintenance, all the devices of the node when DeviceNames is empty
+type Target struct {
+	NodeName string `json:"nodeName"`
+	// DeviceNames the names of the devices, e.g. Ascend910-0
+	DeviceNames []string `json:"deviceNames,omitempty"`
+}
+
+// Audit the record of an action on the window
+type Audit struct {
+	// Time unix milliseconds
+	Time     int64  `json:"time"`
+	Action   string `json:"action"`
+	Operator string `json:"operator"`
+	Message  string `json:"message"`
+}
+
+// Window the maintenance window of the nodes and devices
+type Window struct {
+	Id      string   `json:"id"`
+	Targets []Target `json:"targets"`
+	// StartTime and EndTime unix milliseconds
+	StartTime int64 `json:"startTime"`
+	EndTime   int64 `json:"endTime"`
+	// DrainTimeout seconds to wait for the jobs to be handed off before the devices are separated,
+	// DefaultDrainTimeout is used when it is 0
+	DrainTimeout int64  `json:"drainTimeout"`
+	Operator     string `json:"operator"`
+	Reason       string `json:"reason"`
+	Status       string `json:"status"`
+	// AffectedJobs the jobs still running on the devices when the window is draining
+	AffectedJobs []string `json:"affectedJobs,omitempty"`
+	Audits       []Audit  `json:"audits"`
+}
+
+// IsFinished the devices of the completed or cancelled window are released
+func (w *Window) IsFinished() bool {
+	return w.Status == StatusCompleted || w.Status == StatusCancelled
+}
+
+// AddAudit append the audit record of the action
+func (w *Window) AddAudit(action, operator, message string) {
+	w.Audits = append(w.Audits, Audit{Time: time.Now().UnixMilli(), Action: action, Operator: operator,
+		Message: message})
+}
+
+// Covers the device of the node is under the window
+func (w *Window) Covers(nodeName, deviceName string) bool {
+	for _, target := range w.Targets {
+		if target.NodeName != nodeName {
+			continue
+		}
+		if len(target.DeviceNames) == 0 || slices.Contains(target.DeviceNames, deviceName) {
+			return true
+		}
+	}
+	return false
+}
+
+// overlaps the windows share the device in the overlapped time
+func (w *Window) overlaps(another *Window) bool {
+	if w.StartTime >= another.EndTime || another.StartTime >= w.EndTime {
+		return false
+	}
+	for _, target := range w.Targets {
+		for _, anotherTarget := range another.Targets {
+			if target.NodeName != anotherTarget.NodeName {
+				continue
+			}
+			if len(target.DeviceNames) == 0 || len(anotherTarget.DeviceNames) == 0 {
+				return true
+			}
+			for _, deviceName := range target.DeviceNames {
+				if slices.Contains(anotherTarget.DeviceNames, deviceName) {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+func (w *Window) clone() Window {
+	window := *w
+	window.Targets = make([]Target, 0, len(w.Targets))
+	for _, target := range w.Targets {
+		window.Targets = append(window.Targets, Target{NodeName: target.NodeName,
+			DeviceNames: slices.Clone(target.DeviceNames)})
+	}
+	window.AffectedJobs = slices.Clone(w.AffectedJobs)
+	window.Audits = slices.Clone(w.Audits)
+	return window
+}
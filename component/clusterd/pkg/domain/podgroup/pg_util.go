@@ -7,6 +7,7 @@ import (
 	"errors"
 	"strings"
 
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/strings/slices"
 	"volcano.sh/apis/pkg/apis/scheduling/v1beta1"
@@ -166,3 +167,30 @@ func GetSubHealthStrategyByJobKey(jobKey string) string {
 	}
 	return strategy
 }
+
+// GetJobObjectReference get the reference of the job object by jobKey, which is the controller of the podGroup.
+// the podGroup itself is referenced when it has no controller, and nil is returned when the podGroup is not cached
+func GetJobObjectReference(jobKey string) *corev1.ObjectReference {
+	pg := GetPodGroup(jobKey)
+	if pg.Name == "" {
+		return nil
+	}
+	for _, owner := range pg.GetOwnerReferences() {
+		if owner.Controller != nil && *owner.Controller {
+			return &corev1.ObjectReference{
+				APIVersion: owner.APIVersion,
+				Kind:       owner.Kind,
+				Name:       owner.Name,
+				Namespace:  pg.Namespace,
+				UID:        owner.UID,
+			}
+		}
+	}
+	return &corev1.ObjectReference{
+		APIVersion: v1beta1.SchemeGroupVersion.String(),
+		Kind:       "PodGroup",
+		Name:       pg.Name,
+		Namespace:  pg.Namespace,
+		UID:        pg.UID,
+	}
+}
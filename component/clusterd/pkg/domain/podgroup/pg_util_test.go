@@ -236,3 +236,21 @@ func TestGetSubHealthStrategyByJobKey(t *testing.T) {
 		})
 	})
 }
+
+func TestGetJobObjectReference(t *testing.T) {
+	convey.Convey("test GetJobObjectReference", t, func() {
+		pgDemo1 := getDemoPodGroup(pgName1, pgNameSpace, jobUid1)
+		convey.Convey("when pg is not cached, should return nil", func() {
+			convey.So(GetJobObjectReference(jobUid1), convey.ShouldBeNil)
+		})
+		convey.Convey("when pg has controller, should reference the controller", func() {
+			SavePodGroup(pgDemo1)
+			defer DeletePodGroup(pgDemo1)
+			ref := GetJobObjectReference(jobUid1)
+			convey.So(ref, convey.ShouldNotBeNil)
+			convey.So(ref.Kind, convey.ShouldEqual, vcJobKey)
+			convey.So(string(ref.UID), convey.ShouldEqual, jobUid1)
+			convey.So(ref.Namespace, convey.ShouldEqual, pgNameSpace)
+		})
+	})
+}
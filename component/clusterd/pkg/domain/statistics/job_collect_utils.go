@@ -504,10 +504,12 @@ func updateStatistic(jobStc constant.JobStatistic, jobInfo constant.JobInfo) con
 		// job recover success
 		if jobStc.PodLastFaultTime > jobStc.PodLastRunningTime {
 			jobStc.PodLastRunningTime = nowTime
+			endReschedule(&jobStc, nowTime)
 			return jobStc
 		}
 	case job.StatusJobCompleted:
 		jobStc.StopTime = time.Now().Unix()
+		stopInterrupt(&jobStc, jobStc.StopTime)
 		return jobStc
 
 	case job.StatusJobFail:
@@ -516,7 +518,10 @@ func updateStatistic(jobStc constant.JobStatistic, jobInfo constant.JobInfo) con
 			jobStc.PodFaultTimes += 1
 			if jobInfo.IsPreDelete {
 				jobStc.StopTime = jobStc.PodLastFaultTime
+				stopInterrupt(&jobStc, jobStc.StopTime)
+				return jobStc
 			}
+			startReschedule(&jobStc, jobStc.PodLastFaultTime)
 			return jobStc
 		}
 	default:
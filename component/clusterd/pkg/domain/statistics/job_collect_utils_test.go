@@ -250,6 +250,15 @@ func TestUpdateStatistic(t *testing.T) {
 		result := updateStatistic(baseJobStc, jobInfo)
 		assert.Equal(t, result.PodLastFaultTime, result.StopTime)
 	})
+	t.Run("Failed and running again - account the reschedule", func(t *testing.T) {
+		jobStc := baseJobStc
+		jobStc.PodFirstRunningTime = now - 1
+		result := updateStatistic(jobStc, constant.JobInfo{Status: job.StatusJobFail})
+		assert.True(t, result.Rescheduling)
+		result = updateStatistic(result, constant.JobInfo{Status: job.StatusJobRunning})
+		assert.False(t, result.Rescheduling)
+		assert.Equal(t, int64(1), result.RecoverTimes)
+	})
 	t.Run("Unknown status - no change", func(t *testing.T) {
 		jobInfo := constant.JobInfo{Status: "Unknown"}
 		result := updateStatistic(baseJobStc, jobInfo)
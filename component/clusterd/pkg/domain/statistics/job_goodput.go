@@ -0,0 +1,279 @@
+// Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+
+// Package statistics a series of statistic function
+package statistics
+
+import (
+	"sort"
+	"time"
+
+	"ascend-common/common-utils/hwlog"
+	"clusterd/pkg/common/constant"
+)
+
+// JobGoodput the useful training time and the time lost to the faults of the job, the times are in seconds
+type JobGoodput struct {
+	JobId              string
+	CustomJobId        string
+	Name               string
+	Namespace          string
+	CardNum            int64
+	WallTime           int64
+	RecoverPausedTime  int64
+	RescheduleLostTime int64
+	ProductiveTime     int64
+	// Goodput the ratio of the productive time to the wall time
+	Goodput      float64
+	FaultNums    map[string]int64
+	FaultTimes   int64
+	RecoverTimes int64
+	// MeanTimeToRecover and MeanTimeBetweenFaults are 0 when there is no recovery or fault
+	MeanTimeToRecover     float64
+	MeanTimeBetweenFaults float64
+}
+
+// ClusterGoodput the goodput of all the jobs, the wall time and productive time are in card seconds
+type ClusterGoodput struct {
+	JobNum                int64
+	CardWallTime          int64
+	CardProductiveTime    int64
+	Goodput               float64
+	FaultTimes            int64
+	RecoverTimes          int64
+	MeanTimeToRecover     float64
+	MeanTimeBetweenFaults float64
+}
+
+// RecoverStart record the training of the job is paused by the recovery
+func (j *JobStcMgr) RecoverStart(jobKey string) {
+	j.modifyJobStatistic(jobKey, func(jobStc *constant.JobStatistic) bool {
+		return startRecover(jobStc, time.Now().Unix())
+	})
+}
+
+// RecoverFinish record the recovery of the job is finished, recovered is false when the job is not recovered by it
+func (j *JobStcMgr) RecoverFinish(jobKey string, recovered bool) {
+	j.modifyJobStatistic(jobKey, func(jobStc *constant.JobStatistic) bool {
+		return endRecover(jobStc, time.Now().Unix(), recovered)
+	})
+}
+
+// RecoverRescheduled record the recovery of the job is finished by rescheduling the job, it is not recovered until
+// the pods run again, so the interruption is kept and ended by the reschedule
+func (j *JobStcMgr) RecoverRescheduled(jobKey string) {
+	j.modifyJobStatistic(jobKey, func(jobStc *constant.JobStatistic) bool {
+		return handOffRecover(jobStc, time.Now().Unix())
+	})
+}
+
+// AddJobFault count the new fault of the job by the fault level
+func (j *JobStcMgr) AddJobFault(jobKey, faultLevel string) {
+	j.modifyJobStatistic(jobKey, func(jobStc *constant.JobStatistic) bool {
+		if jobStc.FaultNums == nil {
+			jobStc.FaultNums = make(map[string]int64)
+		}
+		jobStc.FaultNums[faultLevel]++
+		return true
+	})
+}
+
+func (j *JobStcMgr) modifyJobStatistic(jobKey string, modify func(jobStc *constant.JobStatistic) bool) {
+	j.mutex.Lock()
+	defer j.mutex.Unlock()
+	jobStc, ok := j.data.JobStatistic[jobKey]
+	if !ok {
+		hwlog.RunLog.Debugf("jobStc key: %s is not in jobStatistic cache", jobKey)
+		return
+	}
+	if !modify(&jobStc) {
+		return
+	}
+	j.data.JobStatistic[jobKey] = jobStc
+	j.version++
+}
+
+// GetJobGoodputs return the goodput of the jobs ordered by the job id, all the jobs when jobKeys are empty
+func (j *JobStcMgr) GetJobGoodputs(jobKeys ...string) []JobGoodput {
+	now := time.Now().Unix()
+	j.mutex.RLock()
+	defer j.mutex.RUnlock()
+	goodputs := make([]JobGoodput, 0, len(j.data.JobStatistic))
+	if len(jobKeys) == 0 {
+		for _, jobStc := range j.data.JobStatistic {
+			goodputs = append(goodputs, goodputOf(jobStc, now))
+		}
+	}
+	for _, jobKey := range jobKeys {
+		if jobStc, ok := j.data.JobStatistic[jobKey]; ok {
+			goodputs = append(goodputs, goodputOf(jobStc, now))
+		}
+	}
+	sort.Slice(goodputs, func(a, b int) bool {
+		return goodputs[a].JobId < goodputs[b].JobId
+	})
+	return goodputs
+}
+
+// SumGoodput sum up the goodput of the jobs, the time of the jobs is weighted by the card number
+func SumGoodput(goodputs []JobGoodput) ClusterGoodput {
+	var cluster ClusterGoodput
+	var productiveTime, recoverTotalTime float64
+	for _, goodput := range goodputs {
+		cardNum := goodput.CardNum
+		if cardNum <= 0 {
+			cardNum = 1
+		}
+		cluster.JobNum++
+		cluster.CardWallTime += goodput.WallTime * cardNum
+		cluster.CardProductiveTime += goodput.ProductiveTime * cardNum
+		cluster.FaultTimes += goodput.FaultTimes
+		cluster.RecoverTimes += goodput.RecoverTimes
+		productiveTime += float64(goodput.ProductiveTime)
+		recoverTotalTime += goodput.MeanTimeToRecover * float64(goodput.RecoverTimes)
+	}
+	if cluster.CardWallTime > 0 {
+		cluster.Goodput = float64(cluster.CardProductiveTime) / float64(cluster.CardWallTime)
+	}
+	if cluster.RecoverTimes > 0 {
+		cluster.MeanTimeToRecover = recoverTotalTime / float64(cluster.RecoverTimes)
+	}
+	if cluster.FaultTimes > 0 {
+		cluster.MeanTimeBetweenFaults = productiveTime / float64(cluster.FaultTimes)
+	}
+	return cluster
+}
+
+// goodputOf calculate the goodput of the job until it stops, the ongoing recovery and reschedule are counted as lost
+func goodputOf(jobStc constant.JobStatistic, now int64) JobGoodput {
+	goodput := JobGoodput{
+		JobId:              jobStc.K8sJobID,
+		CustomJobId:        jobStc.CustomJobID,
+		Name:               jobStc.Name,
+		Namespace:          jobStc.Namespace,
+		CardNum:            jobStc.CardNums,
+		RecoverPausedTime:  jobStc.RecoverPausedTime,
+		RescheduleLostTime: jobStc.RescheduleLostTime,
+		FaultNums:          make(map[string]int64, len(jobStc.FaultNums)),
+		RecoverTimes:       jobStc.RecoverTimes,
+	}
+	for level, num := range jobStc.FaultNums {
+		goodput.FaultNums[level] = num
+		goodput.FaultTimes += num
+	}
+	if jobStc.RecoverTimes > 0 {
+		goodput.MeanTimeToRecover = float64(jobStc.RecoverTotalTime) / float64(jobStc.RecoverTimes)
+	}
+	if jobStc.PodFirstRunningTime == 0 {
+		return goodput
+	}
+	end := now
+	if jobStc.StopTime != 0 {
+		end = jobStc.StopTime
+	}
+	goodput.RecoverPausedTime += elapsed(jobStc.RecoverStartTime, end)
+	goodput.RescheduleLostTime += elapsed(jobStc.RescheduleStartTime, end)
+	goodput.WallTime = elapsed(jobStc.PodFirstRunningTime, end)
+	goodput.ProductiveTime = max(goodput.WallTime-goodput.RecoverPausedTime-goodput.RescheduleLostTime, 0)
+	if goodput.WallTime > 0 {
+		goodput.Goodput = float64(goodput.ProductiveTime) / float64(goodput.WallTime)
+	}
+	if goodput.FaultTimes > 0 {
+		goodput.MeanTimeBetweenFaults = float64(goodput.ProductiveTime) / float64(goodput.FaultTimes)
+	}
+	return goodput
+}
+
+func elapsed(start, end int64) int64 {
+	if start == 0 || end <= start {
+		return 0
+	}
+	return end - start
+}
+
+// the recovery and the reschedule may overlap, e.g. the pods are rescheduled by the recovery. the reschedule lost
+// time is suspended during the recovery so the overlapped time is counted once, and the interruption lasts until
+// both of them end
+
+func startRecover(jobStc *constant.JobStatistic, now int64) bool {
+	if jobStc.RecoverStartTime != 0 || jobStc.StopTime != 0 {
+		return false
+	}
+	jobStc.RecoverStartTime = now
+	if jobStc.InterruptStartTime == 0 {
+		jobStc.InterruptStartTime = now
+	}
+	jobStc.RescheduleLostTime += elapsed(jobStc.RescheduleStartTime, now)
+	jobStc.RescheduleStartTime = 0
+	return true
+}
+
+func endRecover(jobStc *constant.JobStatistic, now int64, recovered bool) bool {
+	if jobStc.RecoverStartTime == 0 {
+		return false
+	}
+	jobStc.RecoverPausedTime += elapsed(jobStc.RecoverStartTime, now)
+	jobStc.RecoverStartTime = 0
+	if jobStc.Rescheduling {
+		jobStc.RescheduleStartTime = now
+		return true
+	}
+	endInterrupt(jobStc, now, recovered)
+	return true
+}
+
+// handOffRecover end the recovery without ending the interruption, which is ended by the coming reschedule
+func handOffRecover(jobStc *constant.JobStatistic, now int64) bool {
+	if jobStc.RecoverStartTime == 0 {
+		return false
+	}
+	jobStc.RecoverPausedTime += elapsed(jobStc.RecoverStartTime, now)
+	jobStc.RecoverStartTime = 0
+	if jobStc.Rescheduling {
+		jobStc.RescheduleStartTime = now
+	}
+	return true
+}
+
+func startReschedule(jobStc *constant.JobStatistic, now int64) {
+	// the job never ran is still scheduling, no training time is lost
+	if jobStc.PodFirstRunningTime == 0 || jobStc.Rescheduling {
+		return
+	}
+	jobStc.Rescheduling = true
+	if jobStc.InterruptStartTime == 0 {
+		jobStc.InterruptStartTime = now
+	}
+	if jobStc.RecoverStartTime == 0 {
+		jobStc.RescheduleStartTime = now
+	}
+}
+
+func endReschedule(jobStc *constant.JobStatistic, now int64) {
+	if !jobStc.Rescheduling {
+		return
+	}
+	jobStc.RescheduleLostTime += elapsed(jobStc.RescheduleStartTime, now)
+	jobStc.RescheduleStartTime = 0
+	jobStc.Rescheduling = false
+	if jobStc.RecoverStartTime == 0 {
+		endInterrupt(jobStc, now, true)
+	}
+}
+
+func endInterrupt(jobStc *constant.JobStatistic, now int64, recovered bool) {
+	if recovered && jobStc.InterruptStartTime != 0 {
+		jobStc.RecoverTimes++
+		jobStc.RecoverTotalTime += elapsed(jobStc.InterruptStartTime, now)
+	}
+	jobStc.InterruptStartTime = 0
+}
+
+// stopInterrupt close the ongoing recovery and reschedule when the job stops, the interruption is not recovered
+func stopInterrupt(jobStc *constant.JobStatistic, now int64) {
+	jobStc.RecoverPausedTime += elapsed(jobStc.RecoverStartTime, now)
+	jobStc.RescheduleLostTime += elapsed(jobStc.RescheduleStartTime, now)
+	jobStc.RecoverStartTime = 0
+	jobStc.RescheduleStartTime = 0
+	jobStc.Rescheduling = false
+	jobStc.InterruptStartTime = 0
+}
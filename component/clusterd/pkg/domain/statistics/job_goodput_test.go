@@ -0,0 +1,158 @@
+// Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+
+// Package statistics test for the goodput of the jobs
+package statistics
+
+import (
+	"testing"
+
+	"github.com/smartystreets/goconvey/convey"
+
+	"clusterd/pkg/common/constant"
+)
+
+const (
+	goodputJobId = "job-goodput"
+	firstRunTime = 1000
+)
+
+func runningJobStc() constant.JobStatistic {
+	return constant.JobStatistic{K8sJobID: goodputJobId, CardNums: 8, PodFirstRunningTime: firstRunTime}
+}
+
+func TestRecoverAccounting(t *testing.T) {
+	convey.Convey("Test the recovery accounting", t, func() {
+		jobStc := runningJobStc()
+		convey.Convey("01-recovered in place, should count the paused time and the recovery", func() {
+			convey.So(startRecover(&jobStc, firstRunTime+100), convey.ShouldBeTrue)
+			convey.So(startRecover(&jobStc, firstRunTime+110), convey.ShouldBeFalse)
+			convey.So(endRecover(&jobStc, firstRunTime+130, true), convey.ShouldBeTrue)
+			convey.So(jobStc.RecoverPausedTime, convey.ShouldEqual, 30)
+			convey.So(jobStc.RecoverTimes, convey.ShouldEqual, 1)
+			convey.So(jobStc.RecoverTotalTime, convey.ShouldEqual, 30)
+			goodput := goodputOf(jobStc, firstRunTime+300)
+			convey.So(goodput.WallTime, convey.ShouldEqual, 300)
+			convey.So(goodput.ProductiveTime, convey.ShouldEqual, 270)
+			convey.So(goodput.Goodput, convey.ShouldAlmostEqual, 0.9)
+			convey.So(goodput.MeanTimeToRecover, convey.ShouldEqual, 30)
+		})
+		convey.Convey("02-recovery failed, should count the paused time only", func() {
+			startRecover(&jobStc, firstRunTime+100)
+			endRecover(&jobStc, firstRunTime+120, false)
+			convey.So(jobStc.RecoverPausedTime, convey.ShouldEqual, 20)
+			convey.So(jobStc.RecoverTimes, convey.ShouldEqual, 0)
+			convey.So(jobStc.InterruptStartTime, convey.ShouldEqual, 0)
+		})
+		convey.Convey("03-ongoing recovery, should be counted as lost until now", func() {
+			startRecover(&jobStc, firstRunTime+100)
+			goodput := goodputOf(jobStc, firstRunTime+200)
+			convey.So(goodput.RecoverPausedTime, convey.ShouldEqual, 100)
+			convey.So(goodput.ProductiveTime, convey.ShouldEqual, 100)
+		})
+	})
+}
+
+func TestRescheduleAccounting(t *testing.T) {
+	convey.Convey("Test the reschedule accounting", t, func() {
+		jobStc := runningJobStc()
+		convey.Convey("01-pods failed and running again, should count the lost time and the recovery", func() {
+			startReschedule(&jobStc, firstRunTime+100)
+			endReschedule(&jobStc, firstRunTime+160)
+			convey.So(jobStc.RescheduleLostTime, convey.ShouldEqual, 60)
+			convey.So(jobStc.RecoverTimes, convey.ShouldEqual, 1)
+			convey.So(jobStc.RecoverTotalTime, convey.ShouldEqual, 60)
+		})
+		convey.Convey("02-rescheduled during the recovery, should count the overlapped time once", func() {
+			startRecover(&jobStc, firstRunTime+100)
+			startReschedule(&jobStc, firstRunTime+110)
+			endRecover(&jobStc, firstRunTime+130, true)
+			convey.So(jobStc.RecoverTimes, convey.ShouldEqual, 0)
+			endReschedule(&jobStc, firstRunTime+150)
+			convey.So(jobStc.RecoverPausedTime, convey.ShouldEqual, 30)
+			convey.So(jobStc.RescheduleLostTime, convey.ShouldEqual, 20)
+			convey.So(jobStc.RecoverTimes, convey.ShouldEqual, 1)
+			convey.So(jobStc.RecoverTotalTime, convey.ShouldEqual, 50)
+		})
+		convey.Convey("03-recovery started during the reschedule, should suspend the reschedule lost time", func() {
+			startReschedule(&jobStc, firstRunTime+100)
+			startRecover(&jobStc, firstRunTime+120)
+			endReschedule(&jobStc, firstRunTime+130)
+			endRecover(&jobStc, firstRunTime+140, true)
+			convey.So(jobStc.RescheduleLostTime, convey.ShouldEqual, 20)
+			convey.So(jobStc.RecoverPausedTime, convey.ShouldEqual, 20)
+			convey.So(jobStc.RecoverTotalTime, convey.ShouldEqual, 40)
+		})
+		convey.Convey("04-recovery ended by the reschedule before the pods fail, should count the recovery once",
+			func() {
+				startRecover(&jobStc, firstRunTime+100)
+				convey.So(handOffRecover(&jobStc, firstRunTime+120), convey.ShouldBeTrue)
+				convey.So(handOffRecover(&jobStc, firstRunTime+125), convey.ShouldBeFalse)
+				convey.So(jobStc.RecoverTimes, convey.ShouldEqual, 0)
+				convey.So(jobStc.InterruptStartTime, convey.ShouldEqual, firstRunTime+100)
+				startReschedule(&jobStc, firstRunTime+130)
+				endReschedule(&jobStc, firstRunTime+160)
+				convey.So(jobStc.RecoverPausedTime, convey.ShouldEqual, 20)
+				convey.So(jobStc.RescheduleLostTime, convey.ShouldEqual, 30)
+				convey.So(jobStc.RecoverTimes, convey.ShouldEqual, 1)
+				convey.So(jobStc.RecoverTotalTime, convey.ShouldEqual, 60)
+			})
+		convey.Convey("05-recovery ended by the reschedule after the pods fail, should resume the lost time",
+			func() {
+				startRecover(&jobStc, firstRunTime+100)
+				startReschedule(&jobStc, firstRunTime+110)
+				handOffRecover(&jobStc, firstRunTime+130)
+				convey.So(jobStc.RescheduleStartTime, convey.ShouldEqual, firstRunTime+130)
+				endReschedule(&jobStc, firstRunTime+150)
+				convey.So(jobStc.RecoverTimes, convey.ShouldEqual, 1)
+				convey.So(jobStc.RecoverTotalTime, convey.ShouldEqual, 50)
+			})
+		convey.Convey("06-job never ran, should not count the reschedule", func() {
+			jobStc.PodFirstRunningTime = 0
+			startReschedule(&jobStc, firstRunTime+100)
+			convey.So(jobStc.Rescheduling, convey.ShouldBeFalse)
+		})
+		convey.Convey("07-job stopped during the reschedule, should close the lost time", func() {
+			startReschedule(&jobStc, firstRunTime+100)
+			jobStc.StopTime = firstRunTime + 150
+			stopInterrupt(&jobStc, jobStc.StopTime)
+			goodput := goodputOf(jobStc, firstRunTime+1000)
+			convey.So(goodput.WallTime, convey.ShouldEqual, 150)
+			convey.So(goodput.RescheduleLostTime, convey.ShouldEqual, 50)
+			convey.So(goodput.RecoverTimes, convey.ShouldEqual, 0)
+		})
+	})
+}
+
+func TestJobStcMgrGoodput(t *testing.T) {
+	convey.Convey("Test JobStcMgr goodput", t, func() {
+		mgr := &JobStcMgr{data: constant.CurrJobStatistic{JobStatistic: map[string]constant.JobStatistic{
+			goodputJobId: runningJobStc()}}}
+		mgr.AddJobFault(goodputJobId, constant.RestartBusiness)
+		mgr.AddJobFault(goodputJobId, constant.RestartBusiness)
+		mgr.AddJobFault("unknown", constant.RestartBusiness)
+		mgr.RecoverStart(goodputJobId)
+		mgr.RecoverFinish(goodputJobId, true)
+		convey.So(mgr.version, convey.ShouldEqual, 4)
+		goodputs := mgr.GetJobGoodputs()
+		convey.So(len(goodputs), convey.ShouldEqual, 1)
+		convey.So(goodputs[0].FaultNums[constant.RestartBusiness], convey.ShouldEqual, 2)
+		convey.So(goodputs[0].FaultTimes, convey.ShouldEqual, 2)
+		convey.So(goodputs[0].RecoverTimes, convey.ShouldEqual, 1)
+		convey.So(len(mgr.GetJobGoodputs("unknown")), convey.ShouldEqual, 0)
+	})
+}
+
+func TestSumGoodput(t *testing.T) {
+	convey.Convey("Test SumGoodput, should weight the time by the card number", t, func() {
+		cluster := SumGoodput([]JobGoodput{
+			{CardNum: 8, WallTime: 100, ProductiveTime: 50, FaultTimes: 1, RecoverTimes: 1, MeanTimeToRecover: 40},
+			{WallTime: 100, ProductiveTime: 100},
+		})
+		convey.So(cluster.JobNum, convey.ShouldEqual, 2)
+		convey.So(cluster.CardWallTime, convey.ShouldEqual, 900)
+		convey.So(cluster.CardProductiveTime, convey.ShouldEqual, 500)
+		convey.So(cluster.Goodput, convey.ShouldAlmostEqual, 500.0/900.0)
+		convey.So(cluster.MeanTimeToRecover, convey.ShouldEqual, 40)
+		convey.So(cluster.MeanTimeBetweenFaults, convey.ShouldEqual, 150)
+	})
+}
@@ -0,0 +1,263 @@
+// Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+
+// Package auth a series of grpc authentication and authorization function
+package auth
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"ascend-common/common-utils/hwlog"
+	"ascend-common/common-utils/tlsutils"
+	"ascend-common/common-utils/utils"
+	"clusterd/pkg/interface/kube"
+)
+
+const (
+	policyReloadInterval = 10 * time.Second
+	tokenCacheTTL        = time.Minute
+	maxTokenCacheSize    = 1024
+	maxPolicyFileSize    = 1024 * 1024
+	serviceAccountUser   = "system:serviceaccount:"
+)
+
+type tokenCacheEntry struct {
+	identity string
+	expire   time.Time
+}
+
+// Authorizer authenticate the client by the verified client certificate or the bearer token, and authorize the
+// call by the policy. the policy file is reloaded when changed, and the previous policy is kept when it is invalid
+type Authorizer struct {
+	policyFile string
+	lock       sync.Mutex
+	policy     *Policy
+	raw        []byte
+	lastCheck  time.Time
+	tokenCache map[[sha256.Size]byte]tokenCacheEntry
+	now        func() time.Time
+}
+
+// NewAuthorizer load the policy file and return the authorizer
+func NewAuthorizer(policyFile string) (*Authorizer, error) {
+	a := &Authorizer{policyFile: policyFile, now: time.Now,
+		tokenCache: make(map[[sha256.Size]byte]tokenCacheEntry)}
+	if _, err := a.reload(); err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+func (a *Authorizer) reload() (bool, error) {
+	a.lastCheck = a.now()
+	data, err := readPolicyFile(a.policyFile)
+	if err != nil {
+		return false, fmt.Errorf("load authorization policy from <%s> failed: %v", a.policyFile, err)
+	}
+	if a.policy != nil && bytes.Equal(data, a.raw) {
+		return false, nil
+	}
+	policy, err := ParsePolicy(data)
+	if err != nil {
+		return false, err
+	}
+	a.policy, a.raw = policy, data
+	return true, nil
+}
+
+// readPolicyFile read the policy file, which is a symlink to the data directory when mounted from the configmap
+func readPolicyFile(policyFile string) ([]byte, error) {
+	if !utils.IsExist(policyFile) {
+		return nil, errors.New("file not exist")
+	}
+	dir, err := filepath.EvalSymlinks(filepath.Dir(policyFile))
+	if err != nil {
+		return nil, err
+	}
+	return utils.ReadLimitBytesWithSymlink(policyFile, maxPolicyFileSize, func(realPath string) bool {
+		return strings.HasPrefix(realPath, dir+string(filepath.Separator))
+	})
+}
+
+func (a *Authorizer) currentPolicy() *Policy {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	if a.now().Sub(a.lastCheck) >= policyReloadInterval {
+		if changed, err := a.reload(); err != nil {
+			hwlog.RunLog.Errorf("reload authorization policy failed, the previous one is used, err: %v", err)
+		} else if changed {
+			hwlog.RunLog.Infof("authorization policy <%s> is reloaded", a.policyFile)
+		}
+	}
+	return a.policy
+}
+
+// UnaryInterceptor authorize the unary call
+func (a *Authorizer) UnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler) (interface{}, error) {
+	identities, err := a.authorize(ctx, info.FullMethod)
+	if err != nil {
+		return nil, err
+	}
+	return handler(withCaller(ctx, identities), req)
+}
+
+// StreamInterceptor authorize the stream call
+func (a *Authorizer) StreamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler) error {
+	identities, err := a.authorize(ss.Context(), info.FullMethod)
+	if err != nil {
+		return err
+	}
+	return handler(srv, &callerStream{ServerStream: ss, ctx: withCaller(ss.Context(), identities)})
+}
+
+// HttpHandler authorize the http requests as the calls to fullMethod, so the grants of the grpc method in the
+// policy apply to its http endpoint as well
+func (a *Authorizer) HttpHandler(fullMethod string, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		identities := a.identitiesOf(r.TLS, r.Header.Values(tlsutils.AuthorizationKey))
+		if len(identities) == 0 {
+			hwlog.RunLog.Warnf("reject unauthenticated request %s from %s", r.URL.Path, r.RemoteAddr)
+			http.Error(w, "client certificate or token is required", http.StatusUnauthorized)
+			return
+		}
+		if !a.currentPolicy().Allowed(identities, fullMethod) {
+			hwlog.RunLog.Warnf("reject request %s of %v from %s", r.URL.Path, identities, r.RemoteAddr)
+			http.Error(w, fmt.Sprintf("%s is not allowed to call %s", strings.Join(identities, ","), fullMethod),
+				http.StatusForbidden)
+			return
+		}
+		next.ServeHTTP(w, r.WithContext(withCaller(r.Context(), identities)))
+	})
+}
+
+// authorize return the identities of the client when it is allowed to call fullMethod
+func (a *Authorizer) authorize(ctx context.Context, fullMethod string) ([]string, error) {
+	identities := a.identities(ctx)
+	if len(identities) == 0 {
+		hwlog.RunLog.Warnf("reject unauthenticated call %s from %s", fullMethod, peerAddr(ctx))
+		return nil, status.Error(codes.Unauthenticated, "client certificate or token is required")
+	}
+	if !a.currentPolicy().Allowed(identities, fullMethod) {
+		hwlog.RunLog.Warnf("reject call %s of %v from %s", fullMethod, identities, peerAddr(ctx))
+		return nil, status.Errorf(codes.PermissionDenied, "%s is not allowed to call %s",
+			strings.Join(identities, ","), fullMethod)
+	}
+	return identities, nil
+}
+
+type callerKey struct{}
+
+// callerStream the server stream carrying the identities of the authorized client in its context
+type callerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+// Context return the context with the identities of the client
+func (s *callerStream) Context() context.Context {
+	return s.ctx
+}
+
+func withCaller(ctx context.Context, identities []string) context.Context {
+	return context.WithValue(ctx, callerKey{}, identities)
+}
+
+// Caller return the identities of the authorized client joined by comma, such as cn:taskd or
+// system:serviceaccount:ns:name, it is empty when the authorization is disabled
+func Caller(ctx context.Context) string {
+	identities, ok := ctx.Value(callerKey{}).([]string)
+	if !ok {
+		return ""
+	}
+	return strings.Join(identities, ",")
+}
+
+func peerAddr(ctx context.Context) string {
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		return p.Addr.String()
+	}
+	return "unknown"
+}
+
+// identities return the identities of the grpc client
+func (a *Authorizer) identities(ctx context.Context) []string {
+	var state *tls.ConnectionState
+	if p, ok := peer.FromContext(ctx); ok {
+		if tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo); ok {
+			state = &tlsInfo.State
+		}
+	}
+	var authorizations []string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		authorizations = md.Get(tlsutils.AuthorizationKey)
+	}
+	return a.identitiesOf(state, authorizations)
+}
+
+// identitiesOf return the identities of the client, which are the common name of the verified client certificate
+// and the user of the valid bearer token
+func (a *Authorizer) identitiesOf(state *tls.ConnectionState, authorizations []string) []string {
+	var identities []string
+	if state != nil && len(state.VerifiedChains) > 0 && len(state.VerifiedChains[0]) > 0 {
+		identities = append(identities, CommonNamePrefix+state.VerifiedChains[0][0].Subject.CommonName)
+	}
+	for _, value := range authorizations {
+		if !strings.HasPrefix(value, tlsutils.BearerPrefix) {
+			continue
+		}
+		if identity := a.reviewToken(strings.TrimPrefix(value, tlsutils.BearerPrefix)); identity != "" {
+			identities = append(identities, identity)
+		}
+	}
+	return identities
+}
+
+// reviewToken return the identity of the token, or empty when the token is invalid. the results are cached for
+// tokenCacheTTL to reduce the requests to kube-apiserver
+func (a *Authorizer) reviewToken(token string) string {
+	key := sha256.Sum256([]byte(token))
+	a.lock.Lock()
+	entry, ok := a.tokenCache[key]
+	a.lock.Unlock()
+	if ok && a.now().Before(entry.expire) {
+		return entry.identity
+	}
+	identity := ""
+	reviewStatus, err := kube.ReviewToken(token)
+	if err != nil {
+		// the failure of kube-apiserver is not cached
+		hwlog.RunLog.Errorf("review token failed, err: %v", err)
+		return ""
+	}
+	if reviewStatus.Authenticated {
+		identity = UserPrefix + reviewStatus.User.Username
+		if sa := strings.TrimPrefix(reviewStatus.User.Username, serviceAccountUser); sa != reviewStatus.User.Username {
+			identity = ServiceAccountPrefix + strings.Replace(sa, ":", "/", 1)
+		}
+	}
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	if len(a.tokenCache) >= maxTokenCacheSize {
+		a.tokenCache = make(map[[sha256.Size]byte]tokenCacheEntry)
+	}
+	a.tokenCache[key] = tokenCacheEntry{identity: identity, expire: a.now().Add(tokenCacheTTL)}
+	return identity
+}
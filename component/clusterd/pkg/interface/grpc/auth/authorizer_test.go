@@ -0,0 +1,276 @@
+// Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+
+// Package auth test for the authorizer
+package auth
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/agiledragon/gomonkey/v2"
+	"github.com/smartystreets/goconvey/convey"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	authenticationv1 "k8s.io/api/authentication/v1"
+
+	"ascend-common/common-utils/hwlog"
+	"ascend-common/common-utils/tlsutils"
+	"clusterd/pkg/interface/kube"
+)
+
+const (
+	testFileMode   = 0600
+	testMethod     = "/Recover/Init"
+	testValidToken = "valid-token"
+)
+
+func init() {
+	logConfig := &hwlog.LogConfig{
+		OnlyToStdout: true,
+	}
+	if err := hwlog.InitRunLogger(logConfig, context.Background()); err != nil {
+		fmt.Printf("init hwlog failed, %v\n", err)
+		return
+	}
+}
+
+func writePolicy(t *testing.T, content string) string {
+	file := filepath.Join(t.TempDir(), "policy.yaml")
+	if err := os.WriteFile(file, []byte(content), testFileMode); err != nil {
+		t.Fatal(err)
+	}
+	return file
+}
+
+func certContext(commonName string) context.Context {
+	cert := &x509.Certificate{Subject: pkix.Name{CommonName: commonName}}
+	return peer.NewContext(context.Background(), &peer.Peer{AuthInfo: credentials.TLSInfo{
+		State: tls.ConnectionState{VerifiedChains: [][]*x509.Certificate{{cert}}}}})
+}
+
+func tokenContext(token string) context.Context {
+	return metadata.NewIncomingContext(context.Background(),
+		metadata.Pairs(tlsutils.AuthorizationKey, tlsutils.BearerPrefix+token))
+}
+
+func fakeReviewToken(calls *int) func(string) (*authenticationv1.TokenReviewStatus, error) {
+	return func(token string) (*authenticationv1.TokenReviewStatus, error) {
+		*calls++
+		if token != testValidToken {
+			return &authenticationv1.TokenReviewStatus{Authenticated: false}, nil
+		}
+		return &authenticationv1.TokenReviewStatus{Authenticated: true,
+			User: authenticationv1.UserInfo{Username: "system:serviceaccount:ns1:default"}}, nil
+	}
+}
+
+func TestAuthorizerAuthorize(t *testing.T) {
+	convey.Convey("Test Authorizer authorize", t, func() {
+		authorizer, err := NewAuthorizer(writePolicy(t, testPolicy))
+		convey.So(err, convey.ShouldBeNil)
+		calls := 0
+		patches := gomonkey.ApplyFunc(kube.ReviewToken, fakeReviewToken(&calls))
+		defer patches.Reset()
+		convey.Convey("01-no certificate and token, should return unauthenticated", func() {
+			_, err = authorizer.authorize(context.Background(), testMethod)
+			convey.So(status.Code(err), convey.ShouldEqual, codes.Unauthenticated)
+		})
+		convey.Convey("02-allowed common name, should return nil", func() {
+			_, err = authorizer.authorize(certContext("taskd"), testMethod)
+			convey.So(err, convey.ShouldBeNil)
+		})
+		convey.Convey("03-common name not allowed, should return permission denied", func() {
+			_, err = authorizer.authorize(certContext("noded"), testMethod)
+			convey.So(status.Code(err), convey.ShouldEqual, codes.PermissionDenied)
+		})
+		convey.Convey("04-valid service account token, should be cached", func() {
+			_, err = authorizer.authorize(tokenContext(testValidToken), testMethod)
+			convey.So(err, convey.ShouldBeNil)
+			_, err = authorizer.authorize(tokenContext(testValidToken), testMethod)
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(calls, convey.ShouldEqual, 1)
+		})
+		convey.Convey("05-invalid token, should return unauthenticated", func() {
+			_, err = authorizer.authorize(tokenContext("invalid-token"), testMethod)
+			convey.So(status.Code(err), convey.ShouldEqual, codes.Unauthenticated)
+		})
+		convey.Convey("06-review token failed, should not be cached", func() {
+			patches.Reset()
+			failedPatches := gomonkey.ApplyFunc(kube.ReviewToken,
+				func(string) (*authenticationv1.TokenReviewStatus, error) {
+					calls++
+					return nil, errors.New("apiserver unavailable")
+				})
+			defer failedPatches.Reset()
+			authorizer.authorize(tokenContext(testValidToken), testMethod)
+			authorizer.authorize(tokenContext(testValidToken), testMethod)
+			convey.So(calls, convey.ShouldEqual, len([]string{"first", "second"}))
+		})
+	})
+}
+
+func TestAuthorizerReload(t *testing.T) {
+	convey.Convey("Test Authorizer reload policy", t, func() {
+		file := writePolicy(t, testPolicy)
+		authorizer, err := NewAuthorizer(file)
+		convey.So(err, convey.ShouldBeNil)
+		now := time.Now()
+		authorizer.now = func() time.Time { return now }
+		_, err = authorizer.authorize(certContext("noded"), testMethod)
+		convey.So(err, convey.ShouldNotBeNil)
+		convey.Convey("01-policy changed, should use the new policy after reload interval", func() {
+			newPolicy := "services:\n  Recover:\n    - identities: [\"cn:noded\"]\n      methods: [\"*\"]\n"
+			convey.So(os.WriteFile(file, []byte(newPolicy), testFileMode), convey.ShouldBeNil)
+			_, err = authorizer.authorize(certContext("noded"), testMethod)
+			convey.So(err, convey.ShouldNotBeNil)
+			now = now.Add(policyReloadInterval)
+			_, err = authorizer.authorize(certContext("noded"), testMethod)
+			convey.So(err, convey.ShouldBeNil)
+		})
+		convey.Convey("02-policy invalid, should keep the previous policy", func() {
+			convey.So(os.WriteFile(file, []byte("invalid"), testFileMode), convey.ShouldBeNil)
+			now = now.Add(policyReloadInterval)
+			_, err = authorizer.authorize(certContext("taskd"), testMethod)
+			convey.So(err, convey.ShouldBeNil)
+		})
+		convey.Convey("03-policy mounted from configmap, should follow the symlink", func() {
+			dir := filepath.Dir(file)
+			link := filepath.Join(dir, "link.yaml")
+			convey.So(os.Symlink(file, link), convey.ShouldBeNil)
+			_, err = NewAuthorizer(link)
+			convey.So(err, convey.ShouldBeNil)
+		})
+	})
+}
+
+func TestInterceptors(t *testing.T) {
+	convey.Convey("Test Authorizer interceptors", t, func() {
+		authorizer, err := NewAuthorizer(writePolicy(t, testPolicy))
+		convey.So(err, convey.ShouldBeNil)
+		handled := false
+		convey.Convey("01-unary call allowed, should call the handler with the caller", func() {
+			caller := ""
+			_, err = authorizer.UnaryInterceptor(certContext("taskd"), nil,
+				&grpc.UnaryServerInfo{FullMethod: testMethod}, func(ctx context.Context, _ interface{}) (interface{},
+					error) {
+					handled = true
+					caller = Caller(ctx)
+					return nil, nil
+				})
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(handled, convey.ShouldBeTrue)
+			convey.So(caller, convey.ShouldEqual, CommonNamePrefix+"taskd")
+			convey.So(Caller(context.Background()), convey.ShouldBeEmpty)
+		})
+		convey.Convey("02-stream call denied, should not call the handler", func() {
+			err = authorizer.StreamInterceptor(nil, &fakeServerStream{ctx: certContext("noded")},
+				&grpc.StreamServerInfo{FullMethod: "/Recover/Register"}, func(interface{}, grpc.ServerStream) error {
+					handled = true
+					return nil
+				})
+			convey.So(status.Code(err), convey.ShouldEqual, codes.PermissionDenied)
+			convey.So(handled, convey.ShouldBeFalse)
+		})
+	})
+}
+
+func TestHttpHandler(t *testing.T) {
+	convey.Convey("Test Authorizer HttpHandler", t, func() {
+		authorizer, err := NewAuthorizer(writePolicy(t, testPolicy))
+		convey.So(err, convey.ShouldBeNil)
+		calls := 0
+		patches := gomonkey.ApplyFunc(kube.ReviewToken, fakeReviewToken(&calls))
+		defer patches.Reset()
+		handled := false
+		handler := authorizer.HttpHandler("/PubFault/SendPublicFault", http.HandlerFunc(
+			func(http.ResponseWriter, *http.Request) { handled = true }))
+		serve := func(r *http.Request) int {
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, r)
+			return recorder.Code
+		}
+		convey.Convey("01-no certificate and token, should return unauthorized", func() {
+			convey.So(serve(httptest.NewRequest(http.MethodPost, "/", nil)), convey.ShouldEqual,
+				http.StatusUnauthorized)
+			convey.So(handled, convey.ShouldBeFalse)
+		})
+		convey.Convey("02-allowed common name, should call the handler", func() {
+			r := httptest.NewRequest(http.MethodPost, "/", nil)
+			r.TLS = &tls.ConnectionState{VerifiedChains: [][]*x509.Certificate{
+				{{Subject: pkix.Name{CommonName: "noded"}}}}}
+			convey.So(serve(r), convey.ShouldEqual, http.StatusOK)
+			convey.So(handled, convey.ShouldBeTrue)
+		})
+		convey.Convey("03-token not allowed, should return forbidden", func() {
+			r := httptest.NewRequest(http.MethodPost, "/", nil)
+			r.Header.Set(tlsutils.AuthorizationKey, tlsutils.BearerPrefix+testValidToken)
+			convey.So(serve(r), convey.ShouldEqual, http.StatusForbidden)
+			convey.So(handled, convey.ShouldBeFalse)
+		})
+	})
+}
+
+type fakeServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *fakeServerStream) Context() context.Context {
+	return s.ctx
+}
+
+func TestServerOptions(t *testing.T) {
+	convey.Convey("Test Config ServerOptions", t, func() {
+		convey.Convey("01-empty config, should return no option", func() {
+			opts, err := Config{}.ServerOptions()
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(opts, convey.ShouldBeEmpty)
+		})
+		convey.Convey("02-require client certificate without certificates, should return error", func() {
+			_, err := Config{RequireClientCert: true}.ServerOptions()
+			convey.So(err, convey.ShouldNotBeNil)
+		})
+		convey.Convey("03-certificates dir is empty, should return error", func() {
+			_, err := Config{CertDir: t.TempDir()}.ServerOptions()
+			convey.So(err, convey.ShouldNotBeNil)
+		})
+		convey.Convey("04-policy file not exist, should return error", func() {
+			_, err := Config{PolicyFile: filepath.Join(t.TempDir(), "policy.yaml")}.ServerOptions()
+			convey.So(err, convey.ShouldNotBeNil)
+		})
+		convey.Convey("05-valid policy, should return the interceptors", func() {
+			opts, err := Config{PolicyFile: writePolicy(t, testPolicy)}.ServerOptions()
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(len(opts), convey.ShouldEqual, len([]string{"unary", "stream"}))
+		})
+	})
+}
+
+func TestConfigHttpHandler(t *testing.T) {
+	convey.Convey("Test Config HttpHandler", t, func() {
+		next := http.NotFoundHandler()
+		convey.Convey("01-empty policy file, should return the handler", func() {
+			handler, err := Config{}.HttpHandler(testMethod, next)
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(handler, convey.ShouldEqual, next)
+		})
+		convey.Convey("02-policy file not exist, should return error", func() {
+			_, err := Config{PolicyFile: filepath.Join(t.TempDir(), "policy.yaml")}.HttpHandler(testMethod, next)
+			convey.So(err, convey.ShouldNotBeNil)
+		})
+	})
+}
@@ -0,0 +1,81 @@
+// Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+
+// Package auth a series of grpc authentication and authorization function
+package auth
+
+import (
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+
+	"ascend-common/common-utils/hwlog"
+	"ascend-common/common-utils/tlsutils"
+)
+
+// Config is the security config of the grpc server
+type Config struct {
+	// CertDir is the directory of tls.crt, tls.key and ca.crt mounted from the secret, the server is insecure
+	// when it is empty
+	CertDir string
+	// RequireClientCert reject the connection without the client certificate verified by ca.crt
+	RequireClientCert bool
+	// PolicyFile is the authorization policy, all calls are allowed when it is empty
+	PolicyFile string
+}
+
+// TLSConfig return the tls config of the server certificates in CertDir, nil when CertDir is empty
+func (c Config) TLSConfig() (*tls.Config, error) {
+	if c.CertDir == "" {
+		if c.RequireClientCert {
+			return nil, errors.New("client certificate is required but the server certificates are not set")
+		}
+		return nil, nil
+	}
+	reloader, err := tlsutils.NewCertReloader(c.CertDir)
+	if err != nil {
+		return nil, fmt.Errorf("load server certificates failed: %v", err)
+	}
+	return reloader.ServerConfig(c.RequireClientCert)
+}
+
+// ServerOptions return the options of the tls credentials and the authorization interceptors
+func (c Config) ServerOptions() ([]grpc.ServerOption, error) {
+	var opts []grpc.ServerOption
+	tlsConfig, err := c.TLSConfig()
+	if err != nil {
+		return nil, err
+	}
+	if tlsConfig != nil {
+		opts = append(opts, grpc.Creds(credentials.NewTLS(tlsConfig)))
+		hwlog.RunLog.Infof("grpc server enables tls, certificates dir: %s, require client certificate: %v",
+			c.CertDir, c.RequireClientCert)
+	}
+	if c.PolicyFile != "" {
+		authorizer, err := NewAuthorizer(c.PolicyFile)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, grpc.ChainUnaryInterceptor(authorizer.UnaryInterceptor),
+			grpc.ChainStreamInterceptor(authorizer.StreamInterceptor))
+		hwlog.RunLog.Infof("grpc server enables authorization, policy file: %s", c.PolicyFile)
+	}
+	return opts, nil
+}
+
+// HttpHandler return the handler authorizing the requests to next as the calls to fullMethod, next is returned
+// when PolicyFile is empty
+func (c Config) HttpHandler(fullMethod string, next http.Handler) (http.Handler, error) {
+	if c.PolicyFile == "" {
+		return next, nil
+	}
+	authorizer, err := NewAuthorizer(c.PolicyFile)
+	if err != nil {
+		return nil, err
+	}
+	hwlog.RunLog.Infof("http server of %s enables authorization, policy file: %s", fullMethod, c.PolicyFile)
+	return authorizer.HttpHandler(fullMethod, next), nil
+}
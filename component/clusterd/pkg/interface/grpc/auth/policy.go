@@ -0,0 +1,102 @@
+// Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+
+// Package auth a series of grpc authentication and authorization function
+package auth
+
+import (
+	"fmt"
+	"path"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	// CommonNamePrefix is the prefix of the identity authenticated by the client certificate
+	CommonNamePrefix = "cn:"
+	// ServiceAccountPrefix is the prefix of the identity authenticated by the service account token,
+	// the identity is sa:<namespace>/<name>
+	ServiceAccountPrefix = "sa:"
+	// UserPrefix is the prefix of the identity authenticated by the token of the other users
+	UserPrefix = "user:"
+
+	matchAll = "*"
+	// patternMeta is the special characters of path.Match
+	patternMeta = `*?[\`
+)
+
+// Policy is the authorization policy of the grpc services. the key of Services is the full name of the grpc service,
+// such as Recover and job.Job, the calls to the service not in the policy are denied
+type Policy struct {
+	Services map[string][]Grant `yaml:"services"`
+}
+
+// Grant allow the identities to call the methods, the identity and the method are matched by path.Match, in which
+// * does not match the / of sa:<namespace>/<name>. the pattern ending with the only * matches the prefix instead, so
+// sa:* matches all the service accounts, and sa:ns1/* or sa:*/default matches them in the namespace or by the name
+type Grant struct {
+	Identities []string `yaml:"identities"`
+	Methods    []string `yaml:"methods"`
+}
+
+// ParsePolicy parse and validate the authorization policy
+func ParsePolicy(data []byte) (*Policy, error) {
+	policy := &Policy{}
+	if err := yaml.UnmarshalStrict(data, policy); err != nil {
+		return nil, fmt.Errorf("unmarshal authorization policy failed: %v", err)
+	}
+	for service, grants := range policy.Services {
+		for _, grant := range grants {
+			if len(grant.Identities) == 0 || len(grant.Methods) == 0 {
+				return nil, fmt.Errorf("grant of service %s should have identities and methods", service)
+			}
+			for _, pattern := range append(append([]string{}, grant.Identities...), grant.Methods...) {
+				if _, err := path.Match(pattern, ""); err != nil {
+					return nil, fmt.Errorf("pattern %q of service %s is invalid: %v", pattern, service, err)
+				}
+			}
+		}
+	}
+	return policy, nil
+}
+
+// Allowed return whether one of the identities is allowed to call the method, fullMethod is /service/method
+func (p *Policy) Allowed(identities []string, fullMethod string) bool {
+	service, method, ok := strings.Cut(strings.TrimPrefix(fullMethod, "/"), "/")
+	if !ok {
+		return false
+	}
+	for _, grant := range p.Services[service] {
+		if matchAny(grant.Methods, method) && anyMatched(grant.Identities, identities) {
+			return true
+		}
+	}
+	return false
+}
+
+func anyMatched(patterns, values []string) bool {
+	for _, value := range values {
+		if matchAny(patterns, value) {
+			return true
+		}
+	}
+	return false
+}
+
+func matchAny(patterns []string, value string) bool {
+	for _, pattern := range patterns {
+		if pattern == matchAll {
+			return true
+		}
+		if prefix, ok := strings.CutSuffix(pattern, matchAll); ok && !strings.ContainsAny(prefix, patternMeta) {
+			if strings.HasPrefix(value, prefix) {
+				return true
+			}
+			continue
+		}
+		if matched, err := path.Match(pattern, value); err == nil && matched {
+			return true
+		}
+	}
+	return false
+}
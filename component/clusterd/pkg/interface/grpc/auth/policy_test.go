@@ -0,0 +1,74 @@
+// Copyright (c) Huawei Technologies Co., Ltd. 2026-2026. All rights reserved.
+
+// Package auth test for the authorization policy
+package auth
+
+import (
+	"testing"
+
+	"github.com/smartystreets/goconvey/convey"
+)
+
+const testPolicy = `
+services:
+  Recover:
+    - identities: ["cn:taskd", "sa:*/default"]
+      methods: ["*"]
+  PubFault:
+    - identities: ["cn:noded"]
+      methods: ["SendPublicFault"]
+  job.Job:
+    - identities: ["*"]
+      methods: ["Get*", "List*"]
+    - identities: ["sa:*"]
+      methods: ["Watch*"]
+`
+
+func TestParsePolicy(t *testing.T) {
+	convey.Convey("Test ParsePolicy", t, func() {
+		convey.Convey("01-valid policy, should parse success", func() {
+			policy, err := ParsePolicy([]byte(testPolicy))
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(len(policy.Services), convey.ShouldEqual, len([]string{"Recover", "PubFault", "job.Job"}))
+		})
+		convey.Convey("02-grant without methods, should return error", func() {
+			_, err := ParsePolicy([]byte("services:\n  Recover:\n    - identities: [\"cn:taskd\"]\n"))
+			convey.So(err, convey.ShouldNotBeNil)
+		})
+		convey.Convey("03-invalid pattern, should return error", func() {
+			_, err := ParsePolicy([]byte("services:\n  Recover:\n    - identities: [\"cn:[\"]\n      methods: [\"*\"]\n"))
+			convey.So(err, convey.ShouldNotBeNil)
+		})
+		convey.Convey("04-unknown field, should return error", func() {
+			_, err := ParsePolicy([]byte("rules: []\n"))
+			convey.So(err, convey.ShouldNotBeNil)
+		})
+	})
+}
+
+func TestPolicyAllowed(t *testing.T) {
+	convey.Convey("Test Policy Allowed", t, func() {
+		policy, err := ParsePolicy([]byte(testPolicy))
+		convey.So(err, convey.ShouldBeNil)
+		tests := []struct {
+			identities []string
+			method     string
+			allowed    bool
+		}{
+			{[]string{"cn:taskd"}, "/Recover/ReportProcessFault", true},
+			{[]string{"sa:ns1/default"}, "/Recover/Init", true},
+			{[]string{"sa:ns1/other"}, "/Recover/Init", false},
+			{[]string{"cn:taskd"}, "/PubFault/SendPublicFault", false},
+			{[]string{"cn:taskd", "cn:noded"}, "/PubFault/SendPublicFault", true},
+			{[]string{"user:admin"}, "/job.Job/GetJob", true},
+			{[]string{"user:admin"}, "/job.Job/DeleteJob", false},
+			{[]string{"sa:ns1/other"}, "/job.Job/WatchJobs", true},
+			{[]string{"user:admin"}, "/job.Job/WatchJobs", false},
+			{[]string{"cn:taskd"}, "/fault.Fault/GetFault", false},
+			{[]string{"cn:taskd"}, "invalid", false},
+		}
+		for _, tt := range tests {
+			convey.So(policy.Allowed(tt.identities, tt.method), convey.ShouldEqual, tt.allowed)
+		}
+	})
+}
@@ -469,6 +469,1078 @@ func (m *DeviceFaultInfo) GetFaultLevels() []string {
 	return nil
 }
 
+type QueryFaultHistoryRequest struct {
+	StartTime            int64    `protobuf:"varint,1,opt,name=startTime,proto3" json:"startTime,omitempty"`
+	EndTime              int64    `protobuf:"varint,2,opt,name=endTime,proto3" json:"endTime,omitempty"`
+	NodeName             string   `protobuf:"bytes,3,opt,name=nodeName,proto3" json:"nodeName,omitempty"`
+	JobId                string   `protobuf:"bytes,4,opt,name=jobId,proto3" json:"jobId,omitempty"`
+	FaultCode            string   `protobuf:"bytes,5,opt,name=faultCode,proto3" json:"faultCode,omitempty"`
+	Limit                int32    `protobuf:"varint,6,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *QueryFaultHistoryRequest) Reset()         { *m = QueryFaultHistoryRequest{} }
+func (m *QueryFaultHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFaultHistoryRequest) ProtoMessage()    {}
+func (*QueryFaultHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f6b57b59ad5d7d5, []int{7}
+}
+
+func (m *QueryFaultHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryFaultHistoryRequest.Unmarshal(m, b)
+}
+func (m *QueryFaultHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryFaultHistoryRequest.Marshal(b, m, deterministic)
+}
+func (m *QueryFaultHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFaultHistoryRequest.Merge(m, src)
+}
+func (m *QueryFaultHistoryRequest) XXX_Size() int {
+	return xxx_messageInfo_QueryFaultHistoryRequest.Size(m)
+}
+func (m *QueryFaultHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFaultHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFaultHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryFaultHistoryRequest) GetStartTime() int64 {
+	if m != nil {
+		return m.StartTime
+	}
+	return 0
+}
+
+func (m *QueryFaultHistoryRequest) GetEndTime() int64 {
+	if m != nil {
+		return m.EndTime
+	}
+	return 0
+}
+
+func (m *QueryFaultHistoryRequest) GetNodeName() string {
+	if m != nil {
+		return m.NodeName
+	}
+	return ""
+}
+
+func (m *QueryFaultHistoryRequest) GetJobId() string {
+	if m != nil {
+		return m.JobId
+	}
+	return ""
+}
+
+func (m *QueryFaultHistoryRequest) GetFaultCode() string {
+	if m != nil {
+		return m.FaultCode
+	}
+	return ""
+}
+
+func (m *QueryFaultHistoryRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type AffectedJob struct {
+	JobId                string   `protobuf:"bytes,1,opt,name=jobId,proto3" json:"jobId,omitempty"`
+	JobName              string   `protobuf:"bytes,2,opt,name=jobName,proto3" json:"jobName,omitempty"`
+	Namespace            string   `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	RankIds              []string `protobuf:"bytes,4,rep,name=rankIds,proto3" json:"rankIds,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AffectedJob) Reset()         { *m = AffectedJob{} }
+func (m *AffectedJob) String() string { return proto.CompactTextString(m) }
+func (*AffectedJob) ProtoMessage()    {}
+func (*AffectedJob) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f6b57b59ad5d7d5, []int{8}
+}
+
+func (m *AffectedJob) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AffectedJob.Unmarshal(m, b)
+}
+func (m *AffectedJob) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AffectedJob.Marshal(b, m, deterministic)
+}
+func (m *AffectedJob) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AffectedJob.Merge(m, src)
+}
+func (m *AffectedJob) XXX_Size() int {
+	return xxx_messageInfo_AffectedJob.Size(m)
+}
+func (m *AffectedJob) XXX_DiscardUnknown() {
+	xxx_messageInfo_AffectedJob.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AffectedJob proto.InternalMessageInfo
+
+func (m *AffectedJob) GetJobId() string {
+	if m != nil {
+		return m.JobId
+	}
+	return ""
+}
+
+func (m *AffectedJob) GetJobName() string {
+	if m != nil {
+		return m.JobName
+	}
+	return ""
+}
+
+func (m *AffectedJob) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *AffectedJob) GetRankIds() []string {
+	if m != nil {
+		return m.RankIds
+	}
+	return nil
+}
+
+type FaultEvent struct {
+	Seq                  uint64         `protobuf:"varint,1,opt,name=seq,proto3" json:"seq,omitempty"`
+	EventType            string         `protobuf:"bytes,2,opt,name=eventType,proto3" json:"eventType,omitempty"`
+	Source               string         `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`
+	NodeName             string         `protobuf:"bytes,4,opt,name=nodeName,proto3" json:"nodeName,omitempty"`
+	DeviceId             string         `protobuf:"bytes,5,opt,name=deviceId,proto3" json:"deviceId,omitempty"`
+	DeviceType           string         `protobuf:"bytes,6,opt,name=deviceType,proto3" json:"deviceType,omitempty"`
+	FaultCode            string         `protobuf:"bytes,7,opt,name=faultCode,proto3" json:"faultCode,omitempty"`
+	FaultLevel           string         `protobuf:"bytes,8,opt,name=faultLevel,proto3" json:"faultLevel,omitempty"`
+	OccurTime            int64          `protobuf:"varint,9,opt,name=occurTime,proto3" json:"occurTime,omitempty"`
+	RecoverTime          int64          `protobuf:"varint,10,opt,name=recoverTime,proto3" json:"recoverTime,omitempty"`
+	Jobs                 []*AffectedJob `protobuf:"bytes,11,rep,name=jobs,proto3" json:"jobs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *FaultEvent) Reset()         { *m = FaultEvent{} }
+func (m *FaultEvent) String() string { return proto.CompactTextString(m) }
+func (*FaultEvent) ProtoMessage()    {}
+func (*FaultEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f6b57b59ad5d7d5, []int{9}
+}
+
+func (m *FaultEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FaultEvent.Unmarshal(m, b)
+}
+func (m *FaultEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FaultEvent.Marshal(b, m, deterministic)
+}
+func (m *FaultEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FaultEvent.Merge(m, src)
+}
+func (m *FaultEvent) XXX_Size() int {
+	return xxx_messageInfo_FaultEvent.Size(m)
+}
+func (m *FaultEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_FaultEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FaultEvent proto.InternalMessageInfo
+
+func (m *FaultEvent) GetSeq() uint64 {
+	if m != nil {
+		return m.Seq
+	}
+	return 0
+}
+
+func (m *FaultEvent) GetEventType() string {
+	if m != nil {
+		return m.EventType
+	}
+	return ""
+}
+
+func (m *FaultEvent) GetSource() string {
+	if m != nil {
+		return m.Source
+	}
+	return ""
+}
+
+func (m *FaultEvent) GetNodeName() string {
+	if m != nil {
+		return m.NodeName
+	}
+	return ""
+}
+
+func (m *FaultEvent) GetDeviceId() string {
+	if m != nil {
+		return m.DeviceId
+	}
+	return ""
+}
+
+func (m *FaultEvent) GetDeviceType() string {
+	if m != nil {
+		return m.DeviceType
+	}
+	return ""
+}
+
+func (m *FaultEvent) GetFaultCode() string {
+	if m != nil {
+		return m.FaultCode
+	}
+	return ""
+}
+
+func (m *FaultEvent) GetFaultLevel() string {
+	if m != nil {
+		return m.FaultLevel
+	}
+	return ""
+}
+
+func (m *FaultEvent) GetOccurTime() int64 {
+	if m != nil {
+		return m.OccurTime
+	}
+	return 0
+}
+
+func (m *FaultEvent) GetRecoverTime() int64 {
+	if m != nil {
+		return m.RecoverTime
+	}
+	return 0
+}
+
+func (m *FaultEvent) GetJobs() []*AffectedJob {
+	if m != nil {
+		return m.Jobs
+	}
+	return nil
+}
+
+type QueryFaultHistoryResponse struct {
+	Status               *Status       `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Events               []*FaultEvent `protobuf:"bytes,2,rep,name=events,proto3" json:"events,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *QueryFaultHistoryResponse) Reset()         { *m = QueryFaultHistoryResponse{} }
+func (m *QueryFaultHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFaultHistoryResponse) ProtoMessage()    {}
+func (*QueryFaultHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f6b57b59ad5d7d5, []int{10}
+}
+
+func (m *QueryFaultHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryFaultHistoryResponse.Unmarshal(m, b)
+}
+func (m *QueryFaultHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryFaultHistoryResponse.Marshal(b, m, deterministic)
+}
+func (m *QueryFaultHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFaultHistoryResponse.Merge(m, src)
+}
+func (m *QueryFaultHistoryResponse) XXX_Size() int {
+	return xxx_messageInfo_QueryFaultHistoryResponse.Size(m)
+}
+func (m *QueryFaultHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFaultHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFaultHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryFaultHistoryResponse) GetStatus() *Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *QueryFaultHistoryResponse) GetEvents() []*FaultEvent {
+	if m != nil {
+		return m.Events
+	}
+	return nil
+}
+
+type SimulateFaultRequest struct {
+	ConfigMaps           []string `protobuf:"bytes,1,rep,name=configMaps,proto3" json:"configMaps,omitempty"`
+	ReturnSnapshot       bool     `protobuf:"varint,2,opt,name=returnSnapshot,proto3" json:"returnSnapshot,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SimulateFaultRequest) Reset()         { *m = SimulateFaultRequest{} }
+func (m *SimulateFaultRequest) String() string { return proto.CompactTextString(m) }
+func (*SimulateFaultRequest) ProtoMessage()    {}
+func (*SimulateFaultRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f6b57b59ad5d7d5, []int{11}
+}
+
+func (m *SimulateFaultRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SimulateFaultRequest.Unmarshal(m, b)
+}
+func (m *SimulateFaultRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SimulateFaultRequest.Marshal(b, m, deterministic)
+}
+func (m *SimulateFaultRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SimulateFaultRequest.Merge(m, src)
+}
+func (m *SimulateFaultRequest) XXX_Size() int {
+	return xxx_messageInfo_SimulateFaultRequest.Size(m)
+}
+func (m *SimulateFaultRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SimulateFaultRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SimulateFaultRequest proto.InternalMessageInfo
+
+func (m *SimulateFaultRequest) GetConfigMaps() []string {
+	if m != nil {
+		return m.ConfigMaps
+	}
+	return nil
+}
+
+func (m *SimulateFaultRequest) GetReturnSnapshot() bool {
+	if m != nil {
+		return m.ReturnSnapshot
+	}
+	return false
+}
+
+type SimulateFaultRank struct {
+	RankId               string   `protobuf:"bytes,1,opt,name=rankId,proto3" json:"rankId,omitempty"`
+	PodRank              string   `protobuf:"bytes,2,opt,name=podRank,proto3" json:"podRank,omitempty"`
+	FaultCode            string   `protobuf:"bytes,3,opt,name=faultCode,proto3" json:"faultCode,omitempty"`
+	FaultLevel           string   `protobuf:"bytes,4,opt,name=faultLevel,proto3" json:"faultLevel,omitempty"`
+	DeviceId             string   `protobuf:"bytes,5,opt,name=deviceId,proto3" json:"deviceId,omitempty"`
+	DoStepRetry          bool     `protobuf:"varint,6,opt,name=doStepRetry,proto3" json:"doStepRetry,omitempty"`
+	DoRestartInPlace     bool     `protobuf:"varint,7,opt,name=doRestartInPlace,proto3" json:"doRestartInPlace,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SimulateFaultRank) Reset()         { *m = SimulateFaultRank{} }
+func (m *SimulateFaultRank) String() string { return proto.CompactTextString(m) }
+func (*SimulateFaultRank) ProtoMessage()    {}
+func (*SimulateFaultRank) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f6b57b59ad5d7d5, []int{12}
+}
+
+func (m *SimulateFaultRank) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SimulateFaultRank.Unmarshal(m, b)
+}
+func (m *SimulateFaultRank) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SimulateFaultRank.Marshal(b, m, deterministic)
+}
+func (m *SimulateFaultRank) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SimulateFaultRank.Merge(m, src)
+}
+func (m *SimulateFaultRank) XXX_Size() int {
+	return xxx_messageInfo_SimulateFaultRank.Size(m)
+}
+func (m *SimulateFaultRank) XXX_DiscardUnknown() {
+	xxx_messageInfo_SimulateFaultRank.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SimulateFaultRank proto.InternalMessageInfo
+
+func (m *SimulateFaultRank) GetRankId() string {
+	if m != nil {
+		return m.RankId
+	}
+	return ""
+}
+
+func (m *SimulateFaultRank) GetPodRank() string {
+	if m != nil {
+		return m.PodRank
+	}
+	return ""
+}
+
+func (m *SimulateFaultRank) GetFaultCode() string {
+	if m != nil {
+		return m.FaultCode
+	}
+	return ""
+}
+
+func (m *SimulateFaultRank) GetFaultLevel() string {
+	if m != nil {
+		return m.FaultLevel
+	}
+	return ""
+}
+
+func (m *SimulateFaultRank) GetDeviceId() string {
+	if m != nil {
+		return m.DeviceId
+	}
+	return ""
+}
+
+func (m *SimulateFaultRank) GetDoStepRetry() bool {
+	if m != nil {
+		return m.DoStepRetry
+	}
+	return false
+}
+
+func (m *SimulateFaultRank) GetDoRestartInPlace() bool {
+	if m != nil {
+		return m.DoRestartInPlace
+	}
+	return false
+}
+
+type SimulateJobResult struct {
+	JobId                string               `protobuf:"bytes,1,opt,name=jobId,proto3" json:"jobId,omitempty"`
+	JobName              string               `protobuf:"bytes,2,opt,name=jobName,proto3" json:"jobName,omitempty"`
+	Namespace            string               `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	HealthyState         string               `protobuf:"bytes,4,opt,name=healthyState,proto3" json:"healthyState,omitempty"`
+	Strategy             string               `protobuf:"bytes,5,opt,name=strategy,proto3" json:"strategy,omitempty"`
+	FaultRanks           []*SimulateFaultRank `protobuf:"bytes,6,rep,name=faultRanks,proto3" json:"faultRanks,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *SimulateJobResult) Reset()         { *m = SimulateJobResult{} }
+func (m *SimulateJobResult) String() string { return proto.CompactTextString(m) }
+func (*SimulateJobResult) ProtoMessage()    {}
+func (*SimulateJobResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f6b57b59ad5d7d5, []int{13}
+}
+
+func (m *SimulateJobResult) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SimulateJobResult.Unmarshal(m, b)
+}
+func (m *SimulateJobResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SimulateJobResult.Marshal(b, m, deterministic)
+}
+func (m *SimulateJobResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SimulateJobResult.Merge(m, src)
+}
+func (m *SimulateJobResult) XXX_Size() int {
+	return xxx_messageInfo_SimulateJobResult.Size(m)
+}
+func (m *SimulateJobResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_SimulateJobResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SimulateJobResult proto.InternalMessageInfo
+
+func (m *SimulateJobResult) GetJobId() string {
+	if m != nil {
+		return m.JobId
+	}
+	return ""
+}
+
+func (m *SimulateJobResult) GetJobName() string {
+	if m != nil {
+		return m.JobName
+	}
+	return ""
+}
+
+func (m *SimulateJobResult) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *SimulateJobResult) GetHealthyState() string {
+	if m != nil {
+		return m.HealthyState
+	}
+	return ""
+}
+
+func (m *SimulateJobResult) GetStrategy() string {
+	if m != nil {
+		return m.Strategy
+	}
+	return ""
+}
+
+func (m *SimulateJobResult) GetFaultRanks() []*SimulateFaultRank {
+	if m != nil {
+		return m.FaultRanks
+	}
+	return nil
+}
+
+type SimulateFaultResponse struct {
+	Status               *Status              `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Jobs                 []*SimulateJobResult `protobuf:"bytes,2,rep,name=jobs,proto3" json:"jobs,omitempty"`
+	Snapshot             string               `protobuf:"bytes,3,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *SimulateFaultResponse) Reset()         { *m = SimulateFaultResponse{} }
+func (m *SimulateFaultResponse) String() string { return proto.CompactTextString(m) }
+func (*SimulateFaultResponse) ProtoMessage()    {}
+func (*SimulateFaultResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f6b57b59ad5d7d5, []int{14}
+}
+
+func (m *SimulateFaultResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SimulateFaultResponse.Unmarshal(m, b)
+}
+func (m *SimulateFaultResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SimulateFaultResponse.Marshal(b, m, deterministic)
+}
+func (m *SimulateFaultResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SimulateFaultResponse.Merge(m, src)
+}
+func (m *SimulateFaultResponse) XXX_Size() int {
+	return xxx_messageInfo_SimulateFaultResponse.Size(m)
+}
+func (m *SimulateFaultResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SimulateFaultResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SimulateFaultResponse proto.InternalMessageInfo
+
+func (m *SimulateFaultResponse) GetStatus() *Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *SimulateFaultResponse) GetJobs() []*SimulateJobResult {
+	if m != nil {
+		return m.Jobs
+	}
+	return nil
+}
+
+func (m *SimulateFaultResponse) GetSnapshot() string {
+	if m != nil {
+		return m.Snapshot
+	}
+	return ""
+}
+
+type MaintenanceTarget struct {
+	NodeName             string   `protobuf:"bytes,1,opt,name=nodeName,proto3" json:"nodeName,omitempty"`
+	DeviceNames          []string `protobuf:"bytes,2,rep,name=deviceNames,proto3" json:"deviceNames,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MaintenanceTarget) Reset()         { *m = MaintenanceTarget{} }
+func (m *MaintenanceTarget) String() string { return proto.CompactTextString(m) }
+func (*MaintenanceTarget) ProtoMessage()    {}
+func (*MaintenanceTarget) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f6b57b59ad5d7d5, []int{15}
+}
+
+func (m *MaintenanceTarget) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MaintenanceTarget.Unmarshal(m, b)
+}
+func (m *MaintenanceTarget) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MaintenanceTarget.Marshal(b, m, deterministic)
+}
+func (m *MaintenanceTarget) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MaintenanceTarget.Merge(m, src)
+}
+func (m *MaintenanceTarget) XXX_Size() int {
+	return xxx_messageInfo_MaintenanceTarget.Size(m)
+}
+func (m *MaintenanceTarget) XXX_DiscardUnknown() {
+	xxx_messageInfo_MaintenanceTarget.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MaintenanceTarget proto.InternalMessageInfo
+
+func (m *MaintenanceTarget) GetNodeName() string {
+	if m != nil {
+		return m.NodeName
+	}
+	return ""
+}
+
+func (m *MaintenanceTarget) GetDeviceNames() []string {
+	if m != nil {
+		return m.DeviceNames
+	}
+	return nil
+}
+
+type MaintenanceAudit struct {
+	Time                 int64    `protobuf:"varint,1,opt,name=time,proto3" json:"time,omitempty"`
+	Action               string   `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"`
+	Operator             string   `protobuf:"bytes,3,opt,name=operator,proto3" json:"operator,omitempty"`
+	Message              string   `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MaintenanceAudit) Reset()         { *m = MaintenanceAudit{} }
+func (m *MaintenanceAudit) String() string { return proto.CompactTextString(m) }
+func (*MaintenanceAudit) ProtoMessage()    {}
+func (*MaintenanceAudit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f6b57b59ad5d7d5, []int{16}
+}
+
+func (m *MaintenanceAudit) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MaintenanceAudit.Unmarshal(m, b)
+}
+func (m *MaintenanceAudit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MaintenanceAudit.Marshal(b, m, deterministic)
+}
+func (m *MaintenanceAudit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MaintenanceAudit.Merge(m, src)
+}
+func (m *MaintenanceAudit) XXX_Size() int {
+	return xxx_messageInfo_MaintenanceAudit.Size(m)
+}
+func (m *MaintenanceAudit) XXX_DiscardUnknown() {
+	xxx_messageInfo_MaintenanceAudit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MaintenanceAudit proto.InternalMessageInfo
+
+func (m *MaintenanceAudit) GetTime() int64 {
+	if m != nil {
+		return m.Time
+	}
+	return 0
+}
+
+func (m *MaintenanceAudit) GetAction() string {
+	if m != nil {
+		return m.Action
+	}
+	return ""
+}
+
+func (m *MaintenanceAudit) GetOperator() string {
+	if m != nil {
+		return m.Operator
+	}
+	return ""
+}
+
+func (m *MaintenanceAudit) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+type MaintenanceWindow struct {
+	Id                   string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Targets              []*MaintenanceTarget `protobuf:"bytes,2,rep,name=targets,proto3" json:"targets,omitempty"`
+	StartTime            int64                `protobuf:"varint,3,opt,name=startTime,proto3" json:"startTime,omitempty"`
+	EndTime              int64                `protobuf:"varint,4,opt,name=endTime,proto3" json:"endTime,omitempty"`
+	DrainTimeout         int64                `protobuf:"varint,5,opt,name=drainTimeout,proto3" json:"drainTimeout,omitempty"`
+	Operator             string               `protobuf:"bytes,6,opt,name=operator,proto3" json:"operator,omitempty"`
+	Reason               string               `protobuf:"bytes,7,opt,name=reason,proto3" json:"reason,omitempty"`
+	Status               string               `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"`
+	AffectedJobs         []string             `protobuf:"bytes,9,rep,name=affectedJobs,proto3" json:"affectedJobs,omitempty"`
+	Audits               []*MaintenanceAudit  `protobuf:"bytes,10,rep,name=audits,proto3" json:"audits,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *MaintenanceWindow) Reset()         { *m = MaintenanceWindow{} }
+func (m *MaintenanceWindow) String() string { return proto.CompactTextString(m) }
+func (*MaintenanceWindow) ProtoMessage()    {}
+func (*MaintenanceWindow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f6b57b59ad5d7d5, []int{17}
+}
+
+func (m *MaintenanceWindow) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MaintenanceWindow.Unmarshal(m, b)
+}
+func (m *MaintenanceWindow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MaintenanceWindow.Marshal(b, m, deterministic)
+}
+func (m *MaintenanceWindow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MaintenanceWindow.Merge(m, src)
+}
+func (m *MaintenanceWindow) XXX_Size() int {
+	return xxx_messageInfo_MaintenanceWindow.Size(m)
+}
+func (m *MaintenanceWindow) XXX_DiscardUnknown() {
+	xxx_messageInfo_MaintenanceWindow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MaintenanceWindow proto.InternalMessageInfo
+
+func (m *MaintenanceWindow) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *MaintenanceWindow) GetTargets() []*MaintenanceTarget {
+	if m != nil {
+		return m.Targets
+	}
+	return nil
+}
+
+func (m *MaintenanceWindow) GetStartTime() int64 {
+	if m != nil {
+		return m.StartTime
+	}
+	return 0
+}
+
+func (m *MaintenanceWindow) GetEndTime() int64 {
+	if m != nil {
+		return m.EndTime
+	}
+	return 0
+}
+
+func (m *MaintenanceWindow) GetDrainTimeout() int64 {
+	if m != nil {
+		return m.DrainTimeout
+	}
+	return 0
+}
+
+func (m *MaintenanceWindow) GetOperator() string {
+	if m != nil {
+		return m.Operator
+	}
+	return ""
+}
+
+func (m *MaintenanceWindow) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *MaintenanceWindow) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *MaintenanceWindow) GetAffectedJobs() []string {
+	if m != nil {
+		return m.AffectedJobs
+	}
+	return nil
+}
+
+func (m *MaintenanceWindow) GetAudits() []*MaintenanceAudit {
+	if m != nil {
+		return m.Audits
+	}
+	return nil
+}
+
+type CreateMaintenanceWindowRequest struct {
+	Targets              []*MaintenanceTarget `protobuf:"bytes,1,rep,name=targets,proto3" json:"targets,omitempty"`
+	StartTime            int64                `protobuf:"varint,2,opt,name=startTime,proto3" json:"startTime,omitempty"`
+	EndTime              int64                `protobuf:"varint,3,opt,name=endTime,proto3" json:"endTime,omitempty"`
+	DrainTimeout         int64                `protobuf:"varint,4,opt,name=drainTimeout,proto3" json:"drainTimeout,omitempty"`
+	Operator             string               `protobuf:"bytes,5,opt,name=operator,proto3" json:"operator,omitempty"`
+	Reason               string               `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *CreateMaintenanceWindowRequest) Reset()         { *m = CreateMaintenanceWindowRequest{} }
+func (m *CreateMaintenanceWindowRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateMaintenanceWindowRequest) ProtoMessage()    {}
+func (*CreateMaintenanceWindowRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f6b57b59ad5d7d5, []int{18}
+}
+
+func (m *CreateMaintenanceWindowRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateMaintenanceWindowRequest.Unmarshal(m, b)
+}
+func (m *CreateMaintenanceWindowRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateMaintenanceWindowRequest.Marshal(b, m, deterministic)
+}
+func (m *CreateMaintenanceWindowRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateMaintenanceWindowRequest.Merge(m, src)
+}
+func (m *CreateMaintenanceWindowRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateMaintenanceWindowRequest.Size(m)
+}
+func (m *CreateMaintenanceWindowRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateMaintenanceWindowRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateMaintenanceWindowRequest proto.InternalMessageInfo
+
+func (m *CreateMaintenanceWindowRequest) GetTargets() []*MaintenanceTarget {
+	if m != nil {
+		return m.Targets
+	}
+	return nil
+}
+
+func (m *CreateMaintenanceWindowRequest) GetStartTime() int64 {
+	if m != nil {
+		return m.StartTime
+	}
+	return 0
+}
+
+func (m *CreateMaintenanceWindowRequest) GetEndTime() int64 {
+	if m != nil {
+		return m.EndTime
+	}
+	return 0
+}
+
+func (m *CreateMaintenanceWindowRequest) GetDrainTimeout() int64 {
+	if m != nil {
+		return m.DrainTimeout
+	}
+	return 0
+}
+
+func (m *CreateMaintenanceWindowRequest) GetOperator() string {
+	if m != nil {
+		return m.Operator
+	}
+	return ""
+}
+
+func (m *CreateMaintenanceWindowRequest) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+type CancelMaintenanceWindowRequest struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Operator             string   `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"`
+	Reason               string   `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CancelMaintenanceWindowRequest) Reset()         { *m = CancelMaintenanceWindowRequest{} }
+func (m *CancelMaintenanceWindowRequest) String() string { return proto.CompactTextString(m) }
+func (*CancelMaintenanceWindowRequest) ProtoMessage()    {}
+func (*CancelMaintenanceWindowRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f6b57b59ad5d7d5, []int{19}
+}
+
+func (m *CancelMaintenanceWindowRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CancelMaintenanceWindowRequest.Unmarshal(m, b)
+}
+func (m *CancelMaintenanceWindowRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CancelMaintenanceWindowRequest.Marshal(b, m, deterministic)
+}
+func (m *CancelMaintenanceWindowRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelMaintenanceWindowRequest.Merge(m, src)
+}
+func (m *CancelMaintenanceWindowRequest) XXX_Size() int {
+	return xxx_messageInfo_CancelMaintenanceWindowRequest.Size(m)
+}
+func (m *CancelMaintenanceWindowRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelMaintenanceWindowRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CancelMaintenanceWindowRequest proto.InternalMessageInfo
+
+func (m *CancelMaintenanceWindowRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *CancelMaintenanceWindowRequest) GetOperator() string {
+	if m != nil {
+		return m.Operator
+	}
+	return ""
+}
+
+func (m *CancelMaintenanceWindowRequest) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+type MaintenanceWindowResponse struct {
+	Status               *Status            `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Window               *MaintenanceWindow `protobuf:"bytes,2,opt,name=window,proto3" json:"window,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *MaintenanceWindowResponse) Reset()         { *m = MaintenanceWindowResponse{} }
+func (m *MaintenanceWindowResponse) String() string { return proto.CompactTextString(m) }
+func (*MaintenanceWindowResponse) ProtoMessage()    {}
+func (*MaintenanceWindowResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f6b57b59ad5d7d5, []int{20}
+}
+
+func (m *MaintenanceWindowResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MaintenanceWindowResponse.Unmarshal(m, b)
+}
+func (m *MaintenanceWindowResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MaintenanceWindowResponse.Marshal(b, m, deterministic)
+}
+func (m *MaintenanceWindowResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MaintenanceWindowResponse.Merge(m, src)
+}
+func (m *MaintenanceWindowResponse) XXX_Size() int {
+	return xxx_messageInfo_MaintenanceWindowResponse.Size(m)
+}
+func (m *MaintenanceWindowResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MaintenanceWindowResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MaintenanceWindowResponse proto.InternalMessageInfo
+
+func (m *MaintenanceWindowResponse) GetStatus() *Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *MaintenanceWindowResponse) GetWindow() *MaintenanceWindow {
+	if m != nil {
+		return m.Window
+	}
+	return nil
+}
+
+type ListMaintenanceWindowsRequest struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status               string   `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListMaintenanceWindowsRequest) Reset()         { *m = ListMaintenanceWindowsRequest{} }
+func (m *ListMaintenanceWindowsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListMaintenanceWindowsRequest) ProtoMessage()    {}
+func (*ListMaintenanceWindowsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f6b57b59ad5d7d5, []int{21}
+}
+
+func (m *ListMaintenanceWindowsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListMaintenanceWindowsRequest.Unmarshal(m, b)
+}
+func (m *ListMaintenanceWindowsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListMaintenanceWindowsRequest.Marshal(b, m, deterministic)
+}
+func (m *ListMaintenanceWindowsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListMaintenanceWindowsRequest.Merge(m, src)
+}
+func (m *ListMaintenanceWindowsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListMaintenanceWindowsRequest.Size(m)
+}
+func (m *ListMaintenanceWindowsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListMaintenanceWindowsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListMaintenanceWindowsRequest proto.InternalMessageInfo
+
+func (m *ListMaintenanceWindowsRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *ListMaintenanceWindowsRequest) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+type ListMaintenanceWindowsResponse struct {
+	Status               *Status              `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Windows              []*MaintenanceWindow `protobuf:"bytes,2,rep,name=windows,proto3" json:"windows,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *ListMaintenanceWindowsResponse) Reset()         { *m = ListMaintenanceWindowsResponse{} }
+func (m *ListMaintenanceWindowsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListMaintenanceWindowsResponse) ProtoMessage()    {}
+func (*ListMaintenanceWindowsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f6b57b59ad5d7d5, []int{22}
+}
+
+func (m *ListMaintenanceWindowsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListMaintenanceWindowsResponse.Unmarshal(m, b)
+}
+func (m *ListMaintenanceWindowsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListMaintenanceWindowsResponse.Marshal(b, m, deterministic)
+}
+func (m *ListMaintenanceWindowsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListMaintenanceWindowsResponse.Merge(m, src)
+}
+func (m *ListMaintenanceWindowsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListMaintenanceWindowsResponse.Size(m)
+}
+func (m *ListMaintenanceWindowsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListMaintenanceWindowsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListMaintenanceWindowsResponse proto.InternalMessageInfo
+
+func (m *ListMaintenanceWindowsResponse) GetStatus() *Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *ListMaintenanceWindowsResponse) GetWindows() []*MaintenanceWindow {
+	if m != nil {
+		return m.Windows
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*FaultQueryResult)(nil), "fault.FaultQueryResult")
 	proto.RegisterType((*Status)(nil), "fault.Status")
@@ -477,6 +1549,22 @@ func init() {
 	proto.RegisterType((*NodeFaultInfo)(nil), "fault.NodeFaultInfo")
 	proto.RegisterType((*SwitchFaultInfo)(nil), "fault.SwitchFaultInfo")
 	proto.RegisterType((*DeviceFaultInfo)(nil), "fault.DeviceFaultInfo")
+	proto.RegisterType((*QueryFaultHistoryRequest)(nil), "fault.QueryFaultHistoryRequest")
+	proto.RegisterType((*AffectedJob)(nil), "fault.AffectedJob")
+	proto.RegisterType((*FaultEvent)(nil), "fault.FaultEvent")
+	proto.RegisterType((*QueryFaultHistoryResponse)(nil), "fault.QueryFaultHistoryResponse")
+	proto.RegisterType((*SimulateFaultRequest)(nil), "fault.SimulateFaultRequest")
+	proto.RegisterType((*SimulateFaultRank)(nil), "fault.SimulateFaultRank")
+	proto.RegisterType((*SimulateJobResult)(nil), "fault.SimulateJobResult")
+	proto.RegisterType((*SimulateFaultResponse)(nil), "fault.SimulateFaultResponse")
+	proto.RegisterType((*MaintenanceTarget)(nil), "fault.MaintenanceTarget")
+	proto.RegisterType((*MaintenanceAudit)(nil), "fault.MaintenanceAudit")
+	proto.RegisterType((*MaintenanceWindow)(nil), "fault.MaintenanceWindow")
+	proto.RegisterType((*CreateMaintenanceWindowRequest)(nil), "fault.CreateMaintenanceWindowRequest")
+	proto.RegisterType((*CancelMaintenanceWindowRequest)(nil), "fault.CancelMaintenanceWindowRequest")
+	proto.RegisterType((*MaintenanceWindowResponse)(nil), "fault.MaintenanceWindowResponse")
+	proto.RegisterType((*ListMaintenanceWindowsRequest)(nil), "fault.ListMaintenanceWindowsRequest")
+	proto.RegisterType((*ListMaintenanceWindowsResponse)(nil), "fault.ListMaintenanceWindowsResponse")
 }
 
 func init() {
@@ -484,39 +1572,92 @@ func init() {
 }
 
 var fileDescriptor_1f6b57b59ad5d7d5 = []byte{
-	// 534 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0xdd, 0x6e, 0xd3, 0x30,
-	0x14, 0x6e, 0xda, 0xa6, 0x6b, 0x4e, 0x29, 0xdb, 0xac, 0xb1, 0x59, 0x13, 0x42, 0x91, 0xaf, 0x7a,
-	0x55, 0x4d, 0x45, 0x02, 0x04, 0x57, 0xac, 0x88, 0xa9, 0x12, 0x54, 0xc3, 0xdd, 0x15, 0x77, 0xfd,
-	0xf1, 0x3a, 0xa3, 0x2c, 0xa9, 0x62, 0x67, 0x68, 0x2f, 0xc2, 0x8b, 0x20, 0x9e, 0x80, 0x17, 0x43,
-	0x3e, 0x71, 0x12, 0x27, 0x4c, 0xda, 0xee, 0xce, 0xf9, 0x7c, 0xce, 0xf7, 0x7d, 0x27, 0x27, 0x36,
-	0x0c, 0xae, 0x97, 0x59, 0xa4, 0xc7, 0xbb, 0x34, 0xd1, 0x09, 0xf1, 0x31, 0x61, 0x0a, 0x0e, 0x3e,
-	0x9b, 0xe0, 0x5b, 0x26, 0xd2, 0x7b, 0x2e, 0x54, 0x16, 0x69, 0x42, 0xa0, 0xbb, 0x4e, 0x36, 0x82,
-	0x7a, 0xa1, 0x37, 0xf2, 0x39, 0xc6, 0x06, 0x93, 0xf1, 0x75, 0x42, 0xdb, 0xa1, 0x37, 0x0a, 0x38,
-	0xc6, 0xe4, 0xad, 0x65, 0x5c, 0xc8, 0x6d, 0xbc, 0x8c, 0x68, 0x27, 0xf4, 0x46, 0x83, 0xc9, 0x8b,
-	0x71, 0xae, 0x82, 0xac, 0x5f, 0xd5, 0x36, 0x3f, 0xe4, 0x6e, 0x25, 0x3b, 0x83, 0xde, 0x42, 0x2f,
-	0x75, 0xa6, 0x9e, 0x2a, 0xc5, 0xde, 0x00, 0x4c, 0x23, 0x29, 0x62, 0x3d, 0x33, 0xc2, 0x47, 0xe0,
-	0xff, 0x48, 0x56, 0xb3, 0x0d, 0xb6, 0x05, 0x3c, 0x4f, 0x4c, 0x5f, 0x9a, 0x44, 0xa2, 0xe8, 0x33,
-	0x31, 0xfb, 0xe5, 0xc1, 0xf3, 0xba, 0x13, 0x53, 0x96, 0x65, 0xb2, 0xe8, 0xc5, 0xb8, 0x22, 0x6c,
-	0xbb, 0x84, 0xaf, 0x00, 0x14, 0xf6, 0x5c, 0xdd, 0xef, 0x04, 0x8e, 0x17, 0x70, 0x07, 0x21, 0xef,
-	0x61, 0x18, 0x27, 0x1b, 0x81, 0xfc, 0xc6, 0x17, 0xed, 0x86, 0x9d, 0xd1, 0x60, 0x72, 0x64, 0xbf,
-	0xc0, 0xdc, 0x3d, 0xe3, 0xf5, 0x52, 0xf6, 0xc7, 0x83, 0x61, 0xad, 0x80, 0x9c, 0x42, 0xdf, 0x94,
-	0xcc, 0x97, 0xb7, 0xc2, 0x7a, 0x2b, 0x73, 0x72, 0x0c, 0x3d, 0x13, 0xcf, 0x2e, 0xad, 0x41, 0x9b,
-	0x15, 0xf8, 0x62, 0x6e, 0xdd, 0xd9, 0xcc, 0x38, 0x47, 0x0f, 0x5f, 0xc4, 0x9d, 0x88, 0x68, 0x37,
-	0x77, 0x5e, 0x21, 0xe4, 0x9d, 0xdd, 0xdc, 0x27, 0x71, 0x27, 0xd7, 0x82, 0xfa, 0xe8, 0xfb, 0xd8,
-	0xfa, 0xce, 0xc1, 0xca, 0xb9, 0x5b, 0x6a, 0x7c, 0xef, 0x2f, 0x7e, 0x4a, 0xbd, 0xbe, 0xa9, 0x9c,
-	0xbf, 0x84, 0x00, 0x4b, 0xa6, 0xc5, 0x26, 0x03, 0x5e, 0x01, 0x84, 0xc1, 0x33, 0x85, 0x0d, 0xd3,
-	0x1b, 0xb9, 0x2b, 0x3f, 0x71, 0x0d, 0xab, 0x6a, 0x2e, 0x93, 0x54, 0xcf, 0x36, 0x76, 0x9a, 0x1a,
-	0x56, 0xaa, 0x5c, 0xc9, 0x5b, 0x61, 0x47, 0xaa, 0x80, 0xc6, 0xc4, 0x7e, 0x73, 0x62, 0xf6, 0xbb,
-	0x0d, 0xfb, 0x8d, 0xc1, 0xcc, 0x17, 0xdf, 0x20, 0x54, 0xfe, 0x49, 0x65, 0x6e, 0xf8, 0xf2, 0x18,
-	0x77, 0x9f, 0x7b, 0x76, 0x90, 0x52, 0xcf, 0x8c, 0xa8, 0x68, 0x27, 0xec, 0x94, 0x7a, 0x88, 0x3c,
-	0xba, 0x81, 0x72, 0x1a, 0x43, 0xef, 0x63, 0x7b, 0x05, 0x90, 0xd0, 0xee, 0x87, 0x8b, 0xa5, 0x4a,
-	0x62, 0xda, 0xc3, 0x73, 0x17, 0x22, 0xe7, 0x70, 0xa0, 0xea, 0x6b, 0x50, 0x74, 0xaf, 0xb6, 0xc6,
-	0xc6, 0x96, 0xf8, 0x7f, 0xf5, 0xa5, 0x0a, 0x3a, 0x52, 0xb4, 0xef, 0xa8, 0xe4, 0xd0, 0xe4, 0xaf,
-	0x07, 0x3e, 0x36, 0x90, 0x31, 0xf4, 0xb9, 0xd8, 0x4a, 0xa5, 0x45, 0x4a, 0x0e, 0xad, 0x42, 0x75,
-	0x23, 0x4f, 0x87, 0x85, 0x28, 0x5e, 0x6b, 0xd6, 0x22, 0x17, 0x70, 0xb2, 0xc8, 0x56, 0x6a, 0x9d,
-	0xca, 0x95, 0x68, 0x5c, 0xc0, 0x07, 0xda, 0x1f, 0x7e, 0x34, 0x58, 0xeb, 0xcc, 0x23, 0x1f, 0xe1,
-	0xf0, 0x42, 0xe8, 0xc7, 0x29, 0x4e, 0x5c, 0x0a, 0xe7, 0x35, 0x63, 0xad, 0xf3, 0xe0, 0xfb, 0xde,
-	0xf8, 0x03, 0x9e, 0xae, 0x7a, 0xf8, 0xf8, 0xbd, 0xfe, 0x17, 0x00, 0x00, 0xff, 0xff, 0xf0, 0xa9,
-	0xf5, 0x7f, 0x0b, 0x05, 0x00, 0x00,
+	// 1392 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0xdd, 0x6e, 0x1c, 0xb5,
+	0x17, 0xcf, 0x7e, 0xef, 0x9e, 0x6d, 0xda, 0xc4, 0x4a, 0x9b, 0x69, 0xfe, 0xfd, 0x87, 0x95, 0x45,
+	0xab, 0x80, 0x50, 0xa8, 0x16, 0x09, 0x2a, 0xb8, 0x6a, 0x03, 0x94, 0xa0, 0x36, 0x6a, 0xbd, 0x95,
+	0x40, 0x5c, 0x20, 0x79, 0x67, 0x9c, 0xcd, 0xb4, 0xbb, 0xe3, 0xed, 0xd8, 0x93, 0x2a, 0xe2, 0x05,
+	0x78, 0x02, 0xae, 0x79, 0x07, 0x84, 0xc4, 0x73, 0x20, 0xee, 0xb8, 0xe5, 0x96, 0x77, 0x40, 0x3e,
+	0x63, 0xcf, 0xd7, 0x7e, 0xa4, 0x41, 0xdc, 0xf9, 0xfc, 0x7c, 0x7c, 0x3e, 0x7f, 0xc7, 0x9e, 0x5d,
+	0xe8, 0x9f, 0xf2, 0x64, 0xaa, 0x0f, 0xe7, 0xb1, 0xd4, 0x92, 0xb4, 0x50, 0xa0, 0x0a, 0xb6, 0xbe,
+	0x34, 0x8b, 0xe7, 0x89, 0x88, 0x2f, 0x98, 0x50, 0xc9, 0x54, 0x13, 0x02, 0x4d, 0x5f, 0x06, 0xc2,
+	0xab, 0x0d, 0x6a, 0x07, 0x2d, 0x86, 0x6b, 0x83, 0x85, 0xd1, 0xa9, 0xf4, 0xea, 0x83, 0xda, 0x41,
+	0x8f, 0xe1, 0x9a, 0x7c, 0x62, 0x2d, 0x8e, 0xc2, 0x49, 0xc4, 0xa7, 0x5e, 0x63, 0x50, 0x3b, 0xe8,
+	0x0f, 0x6f, 0x1e, 0xa6, 0x5e, 0xd0, 0xea, 0x53, 0x35, 0x49, 0x37, 0x59, 0x51, 0x93, 0xde, 0x87,
+	0xf6, 0x48, 0x73, 0x9d, 0xa8, 0xb7, 0x75, 0x45, 0x3f, 0x06, 0x38, 0x9a, 0x86, 0x22, 0xd2, 0xc7,
+	0xc6, 0xf1, 0x0e, 0xb4, 0x5e, 0xca, 0xf1, 0x71, 0x80, 0xc7, 0x7a, 0x2c, 0x15, 0xcc, 0xb9, 0x58,
+	0x4e, 0x85, 0x3b, 0x67, 0xd6, 0xf4, 0xa7, 0x1a, 0x5c, 0x2f, 0x47, 0x62, 0xd4, 0x92, 0x24, 0x74,
+	0x67, 0x71, 0x9d, 0x1b, 0xac, 0x17, 0x0d, 0xee, 0x03, 0x28, 0x3c, 0xf3, 0xe2, 0x62, 0x2e, 0x30,
+	0xbd, 0x1e, 0x2b, 0x20, 0xe4, 0x53, 0xd8, 0x8c, 0x64, 0x20, 0xd0, 0xbe, 0x89, 0xcb, 0x6b, 0x0e,
+	0x1a, 0x07, 0xfd, 0xe1, 0x8e, 0xad, 0xc0, 0x49, 0x71, 0x8f, 0x95, 0x55, 0xe9, 0xaf, 0x35, 0xd8,
+	0x2c, 0x29, 0x90, 0x3d, 0xe8, 0x1a, 0x95, 0x13, 0x3e, 0x13, 0x36, 0xb6, 0x4c, 0x26, 0xb7, 0xa0,
+	0x6d, 0xd6, 0xc7, 0xcf, 0x6c, 0x80, 0x56, 0x72, 0xf8, 0xe8, 0xc4, 0x46, 0x67, 0x25, 0x13, 0x39,
+	0xc6, 0xf0, 0x44, 0x9c, 0x8b, 0xa9, 0xd7, 0x4c, 0x23, 0xcf, 0x11, 0xf2, 0xc0, 0x76, 0xee, 0x73,
+	0x71, 0x1e, 0xfa, 0xc2, 0x6b, 0x61, 0xdc, 0xb7, 0x6c, 0xdc, 0x29, 0x98, 0x47, 0x5e, 0x54, 0x35,
+	0x71, 0xdf, 0x18, 0xbd, 0x09, 0xb5, 0x7f, 0x96, 0x47, 0x7e, 0x07, 0x7a, 0xa8, 0x72, 0xe4, 0x3a,
+	0xd9, 0x63, 0x39, 0x40, 0x28, 0x5c, 0x53, 0x78, 0xe0, 0xe8, 0x2c, 0x9c, 0x67, 0x25, 0x2e, 0x61,
+	0xb9, 0xce, 0x33, 0x19, 0xeb, 0xe3, 0xc0, 0x66, 0x53, 0xc2, 0x32, 0x2f, 0x2f, 0xc2, 0x99, 0xb0,
+	0x29, 0xe5, 0x40, 0x25, 0xe3, 0x56, 0x35, 0x63, 0xfa, 0x4b, 0x1d, 0x6e, 0x54, 0x12, 0x33, 0x15,
+	0x0f, 0x10, 0xca, 0x98, 0x94, 0xc9, 0xc6, 0x5e, 0xba, 0xc6, 0xde, 0xa7, 0x31, 0x17, 0x90, 0xcc,
+	0x9f, 0x49, 0x51, 0x79, 0x8d, 0x41, 0x23, 0xf3, 0x87, 0xc8, 0xa5, 0x1d, 0xc8, 0xb2, 0xb9, 0x98,
+	0xa7, 0xf5, 0xcf, 0xb2, 0x31, 0xd6, 0x07, 0xb6, 0x3f, 0x4c, 0x70, 0x25, 0x23, 0xaf, 0x8d, 0xfb,
+	0x45, 0x88, 0x3c, 0x82, 0x2d, 0x55, 0x6e, 0x83, 0xf2, 0x3a, 0xa5, 0x36, 0x56, 0xba, 0xc4, 0x16,
+	0xf4, 0x33, 0x2f, 0x18, 0x91, 0xf2, 0xba, 0x05, 0x2f, 0x29, 0x44, 0x7f, 0xab, 0x81, 0x87, 0x37,
+	0x03, 0x9e, 0xfa, 0x2a, 0x54, 0x5a, 0x9a, 0x5b, 0xe2, 0x75, 0x22, 0x94, 0x36, 0x29, 0x28, 0xcd,
+	0xe3, 0xb4, 0x21, 0xa6, 0x7e, 0x0d, 0x96, 0x03, 0xc4, 0x83, 0x8e, 0x88, 0x02, 0xdc, 0xab, 0xe3,
+	0x9e, 0x13, 0x4b, 0x44, 0x6f, 0x54, 0x88, 0x9e, 0x0d, 0x62, 0xb3, 0x38, 0x88, 0x25, 0x82, 0xb5,
+	0xaa, 0x04, 0xdb, 0x81, 0xd6, 0x34, 0x9c, 0x85, 0xda, 0x6b, 0xe3, 0x25, 0x92, 0x0a, 0x34, 0x81,
+	0xfe, 0xc3, 0xd3, 0x53, 0xe1, 0x6b, 0x11, 0x7c, 0x2d, 0xc7, 0x2b, 0xae, 0x0c, 0x0f, 0x3a, 0x2f,
+	0xe5, 0xf8, 0x84, 0xdb, 0x20, 0x7b, 0xcc, 0x89, 0xc6, 0x65, 0xc4, 0x67, 0x42, 0xcd, 0xb9, 0xef,
+	0xa2, 0xcc, 0x01, 0x73, 0x2e, 0xe6, 0xd1, 0xab, 0xe3, 0x40, 0xe1, 0xcc, 0xf7, 0x98, 0x13, 0xe9,
+	0xef, 0x75, 0x00, 0x2c, 0xd6, 0x17, 0xe7, 0x22, 0xd2, 0x64, 0x0b, 0x1a, 0x4a, 0xbc, 0x46, 0xa7,
+	0x4d, 0x66, 0x96, 0xc6, 0xb0, 0x30, 0x5b, 0x05, 0x5e, 0xe5, 0x80, 0x19, 0x68, 0x25, 0x93, 0x38,
+	0xf3, 0x69, 0xa5, 0x52, 0xcd, 0x9a, 0x95, 0x9a, 0x15, 0x69, 0xdc, 0x5a, 0x4b, 0xe3, 0xf6, 0x02,
+	0x8d, 0x4b, 0x95, 0xed, 0x54, 0x2b, 0x5b, 0x26, 0x71, 0x77, 0x19, 0x89, 0xa5, 0xef, 0x27, 0x31,
+	0x76, 0xb9, 0x97, 0x32, 0x20, 0x03, 0x0c, 0xbd, 0x62, 0xe1, 0xcb, 0x73, 0x91, 0xee, 0x03, 0xee,
+	0x17, 0x21, 0x72, 0x0f, 0x9a, 0x2f, 0xe5, 0x58, 0x79, 0x7d, 0x24, 0x2e, 0xb1, 0xc4, 0x2d, 0xb4,
+	0x8d, 0xe1, 0x3e, 0x9d, 0xc1, 0xed, 0x25, 0x2c, 0x54, 0x73, 0x19, 0x29, 0x41, 0xee, 0x42, 0x5b,
+	0xe1, 0x63, 0x82, 0x55, 0xee, 0x0f, 0x37, 0x1d, 0xff, 0x11, 0x64, 0x76, 0x93, 0xbc, 0x07, 0x6d,
+	0x2c, 0xb3, 0xf2, 0xea, 0xe8, 0x6d, 0xbb, 0xf8, 0x4e, 0x61, 0xb3, 0x98, 0x55, 0xa0, 0xdf, 0xc3,
+	0xce, 0x28, 0x9c, 0x25, 0x53, 0xae, 0xd3, 0xcb, 0xc2, 0x11, 0x7e, 0x1f, 0xc0, 0x97, 0xd1, 0x69,
+	0x38, 0x79, 0xca, 0xe7, 0xc6, 0x1b, 0xce, 0x7c, 0x8e, 0x90, 0x7b, 0x70, 0x3d, 0x16, 0x3a, 0x89,
+	0xa3, 0x51, 0xc4, 0xe7, 0xea, 0x4c, 0x6a, 0xec, 0x6f, 0x97, 0x55, 0x50, 0xfa, 0x77, 0x0d, 0xb6,
+	0xcb, 0x0e, 0x78, 0xf4, 0xca, 0xb4, 0x3e, 0x25, 0x91, 0xa5, 0xa8, 0x95, 0x0c, 0xd7, 0xe6, 0x32,
+	0x30, 0x2a, 0x8e, 0xa3, 0x56, 0x2c, 0x37, 0xaf, 0xb1, 0xbe, 0x79, 0x8b, 0x37, 0xd0, 0x3a, 0xda,
+	0x0c, 0xa0, 0x1f, 0xc8, 0x91, 0x16, 0x73, 0x26, 0x74, 0x7c, 0x81, 0xbc, 0xe9, 0xb2, 0x22, 0x44,
+	0xde, 0x87, 0xad, 0x40, 0x32, 0x81, 0xf3, 0x7e, 0x1c, 0x3d, 0x9b, 0x72, 0x3f, 0xe5, 0x4f, 0x97,
+	0x2d, 0xe0, 0xf4, 0xcf, 0x42, 0xbe, 0xa6, 0xa9, 0xe9, 0x57, 0xc6, 0x7f, 0x3b, 0x91, 0x14, 0xae,
+	0x9d, 0x09, 0x3e, 0xd5, 0x67, 0x17, 0xa6, 0xef, 0x6e, 0x48, 0x4a, 0x98, 0xc9, 0x58, 0xe9, 0x98,
+	0x6b, 0x31, 0xb9, 0x70, 0x19, 0x3b, 0x99, 0x3c, 0xb0, 0xd5, 0x32, 0x85, 0x55, 0x78, 0xe1, 0xf6,
+	0x87, 0x9e, 0x63, 0x52, 0xb5, 0x57, 0xac, 0xa0, 0x4b, 0x7f, 0xac, 0xc1, 0xcd, 0xb2, 0xc6, 0x15,
+	0x99, 0xf9, 0x81, 0x9d, 0x82, 0xfa, 0x52, 0xa7, 0x59, 0xc1, 0xd2, 0x59, 0xc0, 0x24, 0x1c, 0xbd,
+	0xec, 0xed, 0xe9, 0x64, 0xfa, 0x1c, 0xb6, 0x9f, 0xf2, 0x30, 0xd2, 0x22, 0xe2, 0x91, 0x2f, 0x5e,
+	0xf0, 0x78, 0x22, 0xf4, 0xda, 0xef, 0x0a, 0xd3, 0x67, 0xec, 0xb9, 0x91, 0xd2, 0x08, 0x7a, 0xac,
+	0x08, 0x51, 0x0d, 0x5b, 0x05, 0x93, 0x0f, 0x93, 0x20, 0xc4, 0xef, 0x43, 0x9d, 0xdf, 0xf9, 0xb8,
+	0x36, 0xec, 0xe5, 0xbe, 0x0e, 0x65, 0xe4, 0xbe, 0x50, 0x52, 0xc9, 0x78, 0x97, 0x73, 0x11, 0x73,
+	0x2d, 0x63, 0x17, 0xae, 0x93, 0x4d, 0xaf, 0x67, 0x42, 0x29, 0x3e, 0x71, 0xed, 0x72, 0x22, 0xfd,
+	0xa3, 0x5e, 0xca, 0xe4, 0x9b, 0x30, 0x0a, 0xe4, 0x1b, 0x72, 0x1d, 0xea, 0xd9, 0x77, 0x5b, 0x3d,
+	0x0c, 0xc8, 0x10, 0x3a, 0x1a, 0x73, 0xac, 0xd6, 0x6e, 0xa1, 0x08, 0xcc, 0x29, 0x96, 0x1f, 0xad,
+	0xc6, 0x9a, 0x47, 0xab, 0x59, 0x7e, 0xb4, 0x28, 0x5c, 0x0b, 0x62, 0x1e, 0x46, 0x46, 0x90, 0x89,
+	0x46, 0xfe, 0x34, 0x58, 0x09, 0x2b, 0xe5, 0xda, 0xae, 0xe4, 0x6a, 0xa6, 0x3b, 0x7d, 0xcc, 0x3b,
+	0x76, 0xba, 0x51, 0x32, 0xb8, 0xe5, 0x48, 0x7a, 0xbd, 0x5a, 0xc9, 0xf8, 0xe3, 0xf9, 0x3d, 0xa8,
+	0xbc, 0x1e, 0xb6, 0xa6, 0x84, 0x91, 0x0f, 0xa1, 0xcd, 0x4d, 0x43, 0x94, 0x07, 0x98, 0xfe, 0xee,
+	0x62, 0xfa, 0xd8, 0x30, 0x66, 0xd5, 0xe8, 0x5f, 0x35, 0xd8, 0x3f, 0x8a, 0x05, 0xd7, 0x62, 0xa1,
+	0xb8, 0xee, 0x8e, 0x2b, 0xd4, 0xb4, 0xf6, 0xaf, 0x6a, 0x5a, 0x5f, 0x53, 0xd3, 0xc6, 0xfa, 0x9a,
+	0x36, 0x2f, 0xa9, 0x69, 0x6b, 0x65, 0x4d, 0xdb, 0xc5, 0x9a, 0xd2, 0x00, 0xf6, 0x8f, 0x4c, 0x9c,
+	0xd3, 0x95, 0x59, 0x56, 0x99, 0x54, 0xf4, 0x52, 0x5f, 0xe9, 0xa5, 0x51, 0xf2, 0xa2, 0xe1, 0xf6,
+	0x12, 0xfb, 0x57, 0x1b, 0xfd, 0xfb, 0xd0, 0x7e, 0x83, 0x07, 0xd1, 0xeb, 0xd2, 0x62, 0x5b, 0xc3,
+	0x56, 0x8f, 0x3e, 0x86, 0xff, 0x3f, 0x09, 0x95, 0x5e, 0x50, 0x50, 0xab, 0x52, 0xcb, 0x09, 0x56,
+	0x2f, 0x12, 0x8c, 0xfe, 0x00, 0xfb, 0xab, 0x0c, 0x5d, 0x2d, 0x87, 0x21, 0x74, 0xd2, 0xd8, 0xd6,
+	0x4c, 0xa1, 0x4d, 0xc2, 0x29, 0x0e, 0x7f, 0x6e, 0x41, 0x0b, 0xef, 0x4a, 0x72, 0x08, 0x5d, 0x26,
+	0x26, 0xa1, 0xd2, 0x22, 0x26, 0xee, 0x49, 0xce, 0x7f, 0xe9, 0xed, 0x95, 0x7d, 0xd2, 0x0d, 0xf2,
+	0x18, 0x76, 0x47, 0xc9, 0x58, 0xf9, 0x71, 0x38, 0x16, 0x95, 0x1f, 0x76, 0x4b, 0x8e, 0x2f, 0xff,
+	0x31, 0x4a, 0x37, 0xee, 0xd7, 0xc8, 0x43, 0xd8, 0x7e, 0x2c, 0xf4, 0xe5, 0x26, 0x76, 0x8b, 0x26,
+	0x0a, 0xbf, 0x92, 0xe9, 0x06, 0xf9, 0x16, 0xb6, 0x17, 0x3e, 0x4b, 0xc8, 0x3b, 0x56, 0x7f, 0xd5,
+	0x67, 0xf3, 0xde, 0x60, 0xb5, 0x42, 0x5a, 0x78, 0xba, 0x41, 0x9e, 0xc0, 0x66, 0xe9, 0x49, 0x21,
+	0xff, 0x5b, 0xfa, 0x14, 0x59, 0x8b, 0x77, 0x96, 0x6f, 0x66, 0xd6, 0x02, 0xd8, 0x5d, 0x31, 0xf5,
+	0xe4, 0xae, 0x4b, 0x78, 0xed, 0xad, 0x90, 0xc5, 0xbc, 0x92, 0xf0, 0xd6, 0xcb, 0xf2, 0xa9, 0xcb,
+	0xbd, 0xac, 0x9d, 0xca, 0xb7, 0xf2, 0x32, 0x81, 0x5b, 0xcb, 0x69, 0x4b, 0xde, 0xb5, 0xa7, 0xd7,
+	0x8e, 0xc7, 0xde, 0xdd, 0x4b, 0xb4, 0x9c, 0xa3, 0x47, 0xbd, 0xef, 0x3a, 0x87, 0x9f, 0xa1, 0xee,
+	0xb8, 0x8d, 0xff, 0x98, 0x7c, 0xf4, 0xcf, 0x00, 0x0e, 0xaa, 0xa2, 0xa0, 0x40, 0x11, 0x00, 0x00,
 }
@@ -22,6 +22,11 @@ const (
 	Fault_Register_FullMethodName                = "/fault.Fault/Register"
 	Fault_SubscribeFaultMsgSignal_FullMethodName = "/fault.Fault/SubscribeFaultMsgSignal"
 	Fault_GetFaultMsgSignal_FullMethodName       = "/fault.Fault/GetFaultMsgSignal"
+	Fault_QueryFaultHistory_FullMethodName       = "/fault.Fault/QueryFaultHistory"
+	Fault_SimulateFault_FullMethodName           = "/fault.Fault/SimulateFault"
+	Fault_CreateMaintenanceWindow_FullMethodName = "/fault.Fault/CreateMaintenanceWindow"
+	Fault_CancelMaintenanceWindow_FullMethodName = "/fault.Fault/CancelMaintenanceWindow"
+	Fault_ListMaintenanceWindows_FullMethodName  = "/fault.Fault/ListMaintenanceWindows"
 )
 
 // FaultClient is the client API for Fault service.
@@ -31,6 +36,11 @@ type FaultClient interface {
 	Register(ctx context.Context, in *ClientInfo, opts ...grpc.CallOption) (*Status, error)
 	SubscribeFaultMsgSignal(ctx context.Context, in *ClientInfo, opts ...grpc.CallOption) (Fault_SubscribeFaultMsgSignalClient, error)
 	GetFaultMsgSignal(ctx context.Context, in *ClientInfo, opts ...grpc.CallOption) (*FaultQueryResult, error)
+	QueryFaultHistory(ctx context.Context, in *QueryFaultHistoryRequest, opts ...grpc.CallOption) (*QueryFaultHistoryResponse, error)
+	SimulateFault(ctx context.Context, in *SimulateFaultRequest, opts ...grpc.CallOption) (*SimulateFaultResponse, error)
+	CreateMaintenanceWindow(ctx context.Context, in *CreateMaintenanceWindowRequest, opts ...grpc.CallOption) (*MaintenanceWindowResponse, error)
+	CancelMaintenanceWindow(ctx context.Context, in *CancelMaintenanceWindowRequest, opts ...grpc.CallOption) (*MaintenanceWindowResponse, error)
+	ListMaintenanceWindows(ctx context.Context, in *ListMaintenanceWindowsRequest, opts ...grpc.CallOption) (*ListMaintenanceWindowsResponse, error)
 }
 
 type faultClient struct {
@@ -91,6 +101,51 @@ func (c *faultClient) GetFaultMsgSignal(ctx context.Context, in *ClientInfo, opt
 	return out, nil
 }
 
+func (c *faultClient) QueryFaultHistory(ctx context.Context, in *QueryFaultHistoryRequest, opts ...grpc.CallOption) (*QueryFaultHistoryResponse, error) {
+	out := new(QueryFaultHistoryResponse)
+	err := c.cc.Invoke(ctx, Fault_QueryFaultHistory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *faultClient) SimulateFault(ctx context.Context, in *SimulateFaultRequest, opts ...grpc.CallOption) (*SimulateFaultResponse, error) {
+	out := new(SimulateFaultResponse)
+	err := c.cc.Invoke(ctx, Fault_SimulateFault_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *faultClient) CreateMaintenanceWindow(ctx context.Context, in *CreateMaintenanceWindowRequest, opts ...grpc.CallOption) (*MaintenanceWindowResponse, error) {
+	out := new(MaintenanceWindowResponse)
+	err := c.cc.Invoke(ctx, Fault_CreateMaintenanceWindow_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *faultClient) CancelMaintenanceWindow(ctx context.Context, in *CancelMaintenanceWindowRequest, opts ...grpc.CallOption) (*MaintenanceWindowResponse, error) {
+	out := new(MaintenanceWindowResponse)
+	err := c.cc.Invoke(ctx, Fault_CancelMaintenanceWindow_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *faultClient) ListMaintenanceWindows(ctx context.Context, in *ListMaintenanceWindowsRequest, opts ...grpc.CallOption) (*ListMaintenanceWindowsResponse, error) {
+	out := new(ListMaintenanceWindowsResponse)
+	err := c.cc.Invoke(ctx, Fault_ListMaintenanceWindows_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // FaultServer is the server API for Fault service.
 // All implementations must embed UnimplementedFaultServer
 // for forward compatibility
@@ -98,6 +153,11 @@ type FaultServer interface {
 	Register(context.Context, *ClientInfo) (*Status, error)
 	SubscribeFaultMsgSignal(*ClientInfo, Fault_SubscribeFaultMsgSignalServer) error
 	GetFaultMsgSignal(context.Context, *ClientInfo) (*FaultQueryResult, error)
+	QueryFaultHistory(context.Context, *QueryFaultHistoryRequest) (*QueryFaultHistoryResponse, error)
+	SimulateFault(context.Context, *SimulateFaultRequest) (*SimulateFaultResponse, error)
+	CreateMaintenanceWindow(context.Context, *CreateMaintenanceWindowRequest) (*MaintenanceWindowResponse, error)
+	CancelMaintenanceWindow(context.Context, *CancelMaintenanceWindowRequest) (*MaintenanceWindowResponse, error)
+	ListMaintenanceWindows(context.Context, *ListMaintenanceWindowsRequest) (*ListMaintenanceWindowsResponse, error)
 	mustEmbedUnimplementedFaultServer()
 }
 
@@ -114,6 +174,21 @@ func (UnimplementedFaultServer) SubscribeFaultMsgSignal(*ClientInfo, Fault_Subsc
 func (UnimplementedFaultServer) GetFaultMsgSignal(context.Context, *ClientInfo) (*FaultQueryResult, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetFaultMsgSignal not implemented")
 }
+func (UnimplementedFaultServer) QueryFaultHistory(context.Context, *QueryFaultHistoryRequest) (*QueryFaultHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryFaultHistory not implemented")
+}
+func (UnimplementedFaultServer) SimulateFault(context.Context, *SimulateFaultRequest) (*SimulateFaultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateFault not implemented")
+}
+func (UnimplementedFaultServer) CreateMaintenanceWindow(context.Context, *CreateMaintenanceWindowRequest) (*MaintenanceWindowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateMaintenanceWindow not implemented")
+}
+func (UnimplementedFaultServer) CancelMaintenanceWindow(context.Context, *CancelMaintenanceWindowRequest) (*MaintenanceWindowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelMaintenanceWindow not implemented")
+}
+func (UnimplementedFaultServer) ListMaintenanceWindows(context.Context, *ListMaintenanceWindowsRequest) (*ListMaintenanceWindowsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMaintenanceWindows not implemented")
+}
 func (UnimplementedFaultServer) mustEmbedUnimplementedFaultServer() {}
 
 // UnsafeFaultServer may be embedded to opt out of forward compatibility for this service.
@@ -184,6 +259,96 @@ func _Fault_GetFaultMsgSignal_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Fault_QueryFaultHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFaultHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FaultServer).QueryFaultHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Fault_QueryFaultHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FaultServer).QueryFaultHistory(ctx, req.(*QueryFaultHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Fault_SimulateFault_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SimulateFaultRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FaultServer).SimulateFault(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Fault_SimulateFault_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FaultServer).SimulateFault(ctx, req.(*SimulateFaultRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Fault_CreateMaintenanceWindow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateMaintenanceWindowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FaultServer).CreateMaintenanceWindow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Fault_CreateMaintenanceWindow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FaultServer).CreateMaintenanceWindow(ctx, req.(*CreateMaintenanceWindowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Fault_CancelMaintenanceWindow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelMaintenanceWindowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FaultServer).CancelMaintenanceWindow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Fault_CancelMaintenanceWindow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FaultServer).CancelMaintenanceWindow(ctx, req.(*CancelMaintenanceWindowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Fault_ListMaintenanceWindows_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMaintenanceWindowsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FaultServer).ListMaintenanceWindows(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Fault_ListMaintenanceWindows_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FaultServer).ListMaintenanceWindows(ctx, req.(*ListMaintenanceWindowsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Fault_ServiceDesc is the grpc.ServiceDesc for Fault service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -199,6 +364,26 @@ var Fault_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetFaultMsgSignal",
 			Handler:    _Fault_GetFaultMsgSignal_Handler,
 		},
+		{
+			MethodName: "QueryFaultHistory",
+			Handler:    _Fault_QueryFaultHistory_Handler,
+		},
+		{
+			MethodName: "SimulateFault",
+			Handler:    _Fault_SimulateFault_Handler,
+		},
+		{
+			MethodName: "CreateMaintenanceWindow",
+			Handler:    _Fault_CreateMaintenanceWindow_Handler,
+		},
+		{
+			MethodName: "CancelMaintenanceWindow",
+			Handler:    _Fault_CancelMaintenanceWindow_Handler,
+		},
+		{
+			MethodName: "ListMaintenanceWindows",
+			Handler:    _Fault_ListMaintenanceWindows_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
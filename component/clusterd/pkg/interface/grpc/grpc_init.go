@@ -11,6 +11,8 @@ import (
 	"time"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	net2 "k8s.io/utils/net"
 
 	"ascend-common/common-utils/hwlog"
@@ -22,6 +24,7 @@ import (
 	"clusterd/pkg/application/publicfault"
 	"clusterd/pkg/application/recover"
 	"clusterd/pkg/common/constant"
+	"clusterd/pkg/interface/grpc/auth"
 	grpcconfig "clusterd/pkg/interface/grpc/config"
 	grpcfault "clusterd/pkg/interface/grpc/fault"
 	"clusterd/pkg/interface/grpc/job"
@@ -38,6 +41,7 @@ var (
 type ClusterInfoMgrServer struct {
 	grpcServer *grpc.Server
 	opts       []grpc.ServerOption
+	security   auth.Config
 }
 
 // NewClusterInfoMgrServer get a pointer of ClusterInfoMgrServer Object
@@ -47,6 +51,11 @@ func NewClusterInfoMgrServer(opts []grpc.ServerOption) *ClusterInfoMgrServer {
 	return server
 }
 
+// SetSecurityConfig set the tls and authorization config used by all services, it should be called before Start
+func (server *ClusterInfoMgrServer) SetSecurityConfig(config auth.Config) {
+	server.security = config
+}
+
 func isIPValid(ipStr string) (string, error) {
 	parsedIp := net.ParseIP(ipStr)
 	if parsedIp == nil {
@@ -70,35 +79,22 @@ func isIPValid(ipStr string) (string, error) {
 
 // Start the grpc server
 func (server *ClusterInfoMgrServer) Start(ctx context.Context, useProxy bool) error {
+	securityOpts, err := server.security.ServerOptions()
+	if err != nil {
+		hwlog.RunLog.Errorf("init grpc server security failed, err: %v", err)
+		return err
+	}
 	recoverSvc := recover.NewFaultRecoverService(keepAliveInterval, ctx)
 	pubFaultSvc := publicfault.NewPubFaultService(ctx)
 	dataTraceSvc := profiling.NewSwitchManager(ctx)
 	configSvc := config.NewBusinessConfigServer(ctx)
 	faultSvc := fault.NewFaultServer(ctx)
 	jobSvc := jobinfo.NewJobServer(ctx)
-	ipStr := os.Getenv("POD_IP")
-	if useProxy {
-		ipStr = "127.0.0.1"
-		hwlog.RunLog.Info("use local proxy")
-	}
-	ipStr, err := isIPValid(ipStr)
+	limitedListener, err := listen(useProxy)
 	if err != nil {
 		return err
 	}
-	listenAddress := ipStr + constant.GrpcPort
-	hwlog.RunLog.Infof("listen on: %s", listenAddress)
-	listen, err := net.Listen("tcp", listenAddress)
-	if err != nil {
-		hwlog.RunLog.Errorf("cluster info server listen failed, err: %#v", err)
-		return err
-	}
-	limitedListener, err := limiter.LimitListener(listen, constant.MaxConcurrentLimit,
-		constant.MaxIPConnectionLimit, constant.CacheSize)
-	if err != nil {
-		hwlog.RunLog.Errorf("create limit listener failed, err: %#v", err)
-		return err
-	}
-	server.grpcServer = grpc.NewServer(server.opts...)
+	server.grpcServer = grpc.NewServer(append(server.opts, securityOpts...)...)
 	pb.RegisterRecoverServer(server.grpcServer, recoverSvc)
 	pubfault.RegisterPubFaultServer(server.grpcServer, pubFaultSvc)
 	pbprofiling.RegisterTrainingDataTraceServer(server.grpcServer, dataTraceSvc)
@@ -120,6 +116,63 @@ func (server *ClusterInfoMgrServer) Start(ctx context.Context, useProxy bool) er
 	return nil
 }
 
+// StartStandby start the grpc server of the standby replica. the clients are routed to the leader only by the
+// clusterd-role: leader label selected by the grpc service, so only the calls routed before the label is updated
+// reach the standby replica, they are rejected as unavailable, and the clients reach the leader when they register
+// again through the service
+func (server *ClusterInfoMgrServer) StartStandby(useProxy bool, leaderAddress func() string) error {
+	securityOpts, err := server.security.ServerOptions()
+	if err != nil {
+		hwlog.RunLog.Errorf("init grpc server security failed, err: %v", err)
+		return err
+	}
+	limitedListener, err := listen(useProxy)
+	if err != nil {
+		return err
+	}
+	opts := append([]grpc.ServerOption{grpc.UnknownServiceHandler(rejectAsStandby(leaderAddress))}, server.opts...)
+	server.grpcServer = grpc.NewServer(append(opts, securityOpts...)...)
+	go func() {
+		if err := server.grpcServer.Serve(limitedListener); err != nil {
+			hwlog.RunLog.Errorf("standby cluster info server crashed, err: %#v", err)
+		}
+	}()
+	hwlog.RunLog.Infof("standby cluster info server start listen...")
+	return nil
+}
+
+func rejectAsStandby(leaderAddress func() string) grpc.StreamHandler {
+	return func(interface{}, grpc.ServerStream) error {
+		return status.Errorf(codes.Unavailable, "clusterd is standby, the leader is %q", leaderAddress())
+	}
+}
+
+func listen(useProxy bool) (net.Listener, error) {
+	ipStr := os.Getenv("POD_IP")
+	if useProxy {
+		ipStr = "127.0.0.1"
+		hwlog.RunLog.Info("use local proxy")
+	}
+	ipStr, err := isIPValid(ipStr)
+	if err != nil {
+		return nil, err
+	}
+	listenAddress := ipStr + constant.GrpcPort
+	hwlog.RunLog.Infof("listen on: %s", listenAddress)
+	tcpListener, err := net.Listen("tcp", listenAddress)
+	if err != nil {
+		hwlog.RunLog.Errorf("cluster info server listen failed, err: %#v", err)
+		return nil, err
+	}
+	limitedListener, err := limiter.LimitListener(tcpListener, constant.MaxConcurrentLimit,
+		constant.MaxIPConnectionLimit, constant.CacheSize)
+	if err != nil {
+		hwlog.RunLog.Errorf("create limit listener failed, err: %#v", err)
+		return nil, err
+	}
+	return limitedListener, nil
+}
+
 // Stop grpc server
 func (server *ClusterInfoMgrServer) Stop(grace bool) {
 	if server.grpcServer == nil {
@@ -328,11 +328,1076 @@ func (m *JobSummarySignalList) GetJobTotalNum() int32 {
 	return 0
 }
 
+type GetJobRequest struct {
+	JobId                string   `protobuf:"bytes,1,opt,name=jobId,proto3" json:"jobId,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetJobRequest) Reset()         { *m = GetJobRequest{} }
+func (m *GetJobRequest) String() string { return proto.CompactTextString(m) }
+func (*GetJobRequest) ProtoMessage()    {}
+func (*GetJobRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f32c477d91a04ead, []int{4}
+}
+
+func (m *GetJobRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetJobRequest.Unmarshal(m, b)
+}
+func (m *GetJobRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetJobRequest.Marshal(b, m, deterministic)
+}
+func (m *GetJobRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetJobRequest.Merge(m, src)
+}
+func (m *GetJobRequest) XXX_Size() int {
+	return xxx_messageInfo_GetJobRequest.Size(m)
+}
+func (m *GetJobRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetJobRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetJobRequest proto.InternalMessageInfo
+
+func (m *GetJobRequest) GetJobId() string {
+	if m != nil {
+		return m.JobId
+	}
+	return ""
+}
+
+type GetJobResponse struct {
+	Status               *Status           `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Job                  *JobSummarySignal `protobuf:"bytes,2,opt,name=job,proto3" json:"job,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *GetJobResponse) Reset()         { *m = GetJobResponse{} }
+func (m *GetJobResponse) String() string { return proto.CompactTextString(m) }
+func (*GetJobResponse) ProtoMessage()    {}
+func (*GetJobResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f32c477d91a04ead, []int{5}
+}
+
+func (m *GetJobResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetJobResponse.Unmarshal(m, b)
+}
+func (m *GetJobResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetJobResponse.Marshal(b, m, deterministic)
+}
+func (m *GetJobResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetJobResponse.Merge(m, src)
+}
+func (m *GetJobResponse) XXX_Size() int {
+	return xxx_messageInfo_GetJobResponse.Size(m)
+}
+func (m *GetJobResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetJobResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetJobResponse proto.InternalMessageInfo
+
+func (m *GetJobResponse) GetStatus() *Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *GetJobResponse) GetJob() *JobSummarySignal {
+	if m != nil {
+		return m.Job
+	}
+	return nil
+}
+
+type JobFilter struct {
+	Namespace            string   `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	JobStatus            string   `protobuf:"bytes,2,opt,name=jobStatus,proto3" json:"jobStatus,omitempty"`
+	FrameWork            string   `protobuf:"bytes,3,opt,name=frameWork,proto3" json:"frameWork,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *JobFilter) Reset()         { *m = JobFilter{} }
+func (m *JobFilter) String() string { return proto.CompactTextString(m) }
+func (*JobFilter) ProtoMessage()    {}
+func (*JobFilter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f32c477d91a04ead, []int{6}
+}
+
+func (m *JobFilter) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_JobFilter.Unmarshal(m, b)
+}
+func (m *JobFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_JobFilter.Marshal(b, m, deterministic)
+}
+func (m *JobFilter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobFilter.Merge(m, src)
+}
+func (m *JobFilter) XXX_Size() int {
+	return xxx_messageInfo_JobFilter.Size(m)
+}
+func (m *JobFilter) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobFilter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JobFilter proto.InternalMessageInfo
+
+func (m *JobFilter) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *JobFilter) GetJobStatus() string {
+	if m != nil {
+		return m.JobStatus
+	}
+	return ""
+}
+
+func (m *JobFilter) GetFrameWork() string {
+	if m != nil {
+		return m.FrameWork
+	}
+	return ""
+}
+
+type ListJobsRequest struct {
+	Filter               *JobFilter `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	PageSize             int32      `protobuf:"varint,2,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	PageToken            string     `protobuf:"bytes,3,opt,name=pageToken,proto3" json:"pageToken,omitempty"`
+	WithRankTable        bool       `protobuf:"varint,4,opt,name=withRankTable,proto3" json:"withRankTable,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *ListJobsRequest) Reset()         { *m = ListJobsRequest{} }
+func (m *ListJobsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListJobsRequest) ProtoMessage()    {}
+func (*ListJobsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f32c477d91a04ead, []int{7}
+}
+
+func (m *ListJobsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListJobsRequest.Unmarshal(m, b)
+}
+func (m *ListJobsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListJobsRequest.Marshal(b, m, deterministic)
+}
+func (m *ListJobsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListJobsRequest.Merge(m, src)
+}
+func (m *ListJobsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListJobsRequest.Size(m)
+}
+func (m *ListJobsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListJobsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListJobsRequest proto.InternalMessageInfo
+
+func (m *ListJobsRequest) GetFilter() *JobFilter {
+	if m != nil {
+		return m.Filter
+	}
+	return nil
+}
+
+func (m *ListJobsRequest) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *ListJobsRequest) GetPageToken() string {
+	if m != nil {
+		return m.PageToken
+	}
+	return ""
+}
+
+func (m *ListJobsRequest) GetWithRankTable() bool {
+	if m != nil {
+		return m.WithRankTable
+	}
+	return false
+}
+
+type ListJobsResponse struct {
+	Status               *Status             `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Jobs                 []*JobSummarySignal `protobuf:"bytes,2,rep,name=jobs,proto3" json:"jobs,omitempty"`
+	NextPageToken        string              `protobuf:"bytes,3,opt,name=nextPageToken,proto3" json:"nextPageToken,omitempty"`
+	Total                int32               `protobuf:"varint,4,opt,name=total,proto3" json:"total,omitempty"`
+	ResourceVersion      string              `protobuf:"bytes,5,opt,name=resourceVersion,proto3" json:"resourceVersion,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *ListJobsResponse) Reset()         { *m = ListJobsResponse{} }
+func (m *ListJobsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListJobsResponse) ProtoMessage()    {}
+func (*ListJobsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f32c477d91a04ead, []int{8}
+}
+
+func (m *ListJobsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListJobsResponse.Unmarshal(m, b)
+}
+func (m *ListJobsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListJobsResponse.Marshal(b, m, deterministic)
+}
+func (m *ListJobsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListJobsResponse.Merge(m, src)
+}
+func (m *ListJobsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListJobsResponse.Size(m)
+}
+func (m *ListJobsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListJobsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListJobsResponse proto.InternalMessageInfo
+
+func (m *ListJobsResponse) GetStatus() *Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *ListJobsResponse) GetJobs() []*JobSummarySignal {
+	if m != nil {
+		return m.Jobs
+	}
+	return nil
+}
+
+func (m *ListJobsResponse) GetNextPageToken() string {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return ""
+}
+
+func (m *ListJobsResponse) GetTotal() int32 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+func (m *ListJobsResponse) GetResourceVersion() string {
+	if m != nil {
+		return m.ResourceVersion
+	}
+	return ""
+}
+
+type WatchJobsRequest struct {
+	Filter               *JobFilter `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	ResourceVersion      string     `protobuf:"bytes,2,opt,name=resourceVersion,proto3" json:"resourceVersion,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *WatchJobsRequest) Reset()         { *m = WatchJobsRequest{} }
+func (m *WatchJobsRequest) String() string { return proto.CompactTextString(m) }
+func (*WatchJobsRequest) ProtoMessage()    {}
+func (*WatchJobsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f32c477d91a04ead, []int{9}
+}
+
+func (m *WatchJobsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WatchJobsRequest.Unmarshal(m, b)
+}
+func (m *WatchJobsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WatchJobsRequest.Marshal(b, m, deterministic)
+}
+func (m *WatchJobsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchJobsRequest.Merge(m, src)
+}
+func (m *WatchJobsRequest) XXX_Size() int {
+	return xxx_messageInfo_WatchJobsRequest.Size(m)
+}
+func (m *WatchJobsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchJobsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WatchJobsRequest proto.InternalMessageInfo
+
+func (m *WatchJobsRequest) GetFilter() *JobFilter {
+	if m != nil {
+		return m.Filter
+	}
+	return nil
+}
+
+func (m *WatchJobsRequest) GetResourceVersion() string {
+	if m != nil {
+		return m.ResourceVersion
+	}
+	return ""
+}
+
+type JobEvent struct {
+	ResourceVersion      string            `protobuf:"bytes,1,opt,name=resourceVersion,proto3" json:"resourceVersion,omitempty"`
+	Job                  *JobSummarySignal `protobuf:"bytes,2,opt,name=job,proto3" json:"job,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *JobEvent) Reset()         { *m = JobEvent{} }
+func (m *JobEvent) String() string { return proto.CompactTextString(m) }
+func (*JobEvent) ProtoMessage()    {}
+func (*JobEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f32c477d91a04ead, []int{10}
+}
+
+func (m *JobEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_JobEvent.Unmarshal(m, b)
+}
+func (m *JobEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_JobEvent.Marshal(b, m, deterministic)
+}
+func (m *JobEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobEvent.Merge(m, src)
+}
+func (m *JobEvent) XXX_Size() int {
+	return xxx_messageInfo_JobEvent.Size(m)
+}
+func (m *JobEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JobEvent proto.InternalMessageInfo
+
+func (m *JobEvent) GetResourceVersion() string {
+	if m != nil {
+		return m.ResourceVersion
+	}
+	return ""
+}
+
+func (m *JobEvent) GetJob() *JobSummarySignal {
+	if m != nil {
+		return m.Job
+	}
+	return nil
+}
+
+type GetJobStatisticsRequest struct {
+	JobIds               []string `protobuf:"bytes,1,rep,name=jobIds,proto3" json:"jobIds,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetJobStatisticsRequest) Reset()         { *m = GetJobStatisticsRequest{} }
+func (m *GetJobStatisticsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetJobStatisticsRequest) ProtoMessage()    {}
+func (*GetJobStatisticsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f32c477d91a04ead, []int{11}
+}
+
+func (m *GetJobStatisticsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetJobStatisticsRequest.Unmarshal(m, b)
+}
+func (m *GetJobStatisticsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetJobStatisticsRequest.Marshal(b, m, deterministic)
+}
+func (m *GetJobStatisticsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetJobStatisticsRequest.Merge(m, src)
+}
+func (m *GetJobStatisticsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetJobStatisticsRequest.Size(m)
+}
+func (m *GetJobStatisticsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetJobStatisticsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetJobStatisticsRequest proto.InternalMessageInfo
+
+func (m *GetJobStatisticsRequest) GetJobIds() []string {
+	if m != nil {
+		return m.JobIds
+	}
+	return nil
+}
+
+type JobGoodput struct {
+	JobId                 string           `protobuf:"bytes,1,opt,name=jobId,proto3" json:"jobId,omitempty"`
+	CustomJobId           string           `protobuf:"bytes,2,opt,name=customJobId,proto3" json:"customJobId,omitempty"`
+	Name                  string           `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Namespace             string           `protobuf:"bytes,4,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	CardNum               int64            `protobuf:"varint,5,opt,name=cardNum,proto3" json:"cardNum,omitempty"`
+	WallTime              int64            `protobuf:"varint,6,opt,name=wallTime,proto3" json:"wallTime,omitempty"`
+	RecoverPausedTime     int64            `protobuf:"varint,7,opt,name=recoverPausedTime,proto3" json:"recoverPausedTime,omitempty"`
+	RescheduleLostTime    int64            `protobuf:"varint,8,opt,name=rescheduleLostTime,proto3" json:"rescheduleLostTime,omitempty"`
+	ProductiveTime        int64            `protobuf:"varint,9,opt,name=productiveTime,proto3" json:"productiveTime,omitempty"`
+	Goodput               float64          `protobuf:"fixed64,10,opt,name=goodput,proto3" json:"goodput,omitempty"`
+	FaultNums             map[string]int64 `protobuf:"bytes,11,rep,name=faultNums,proto3" json:"faultNums,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	FaultTimes            int64            `protobuf:"varint,12,opt,name=faultTimes,proto3" json:"faultTimes,omitempty"`
+	RecoverTimes          int64            `protobuf:"varint,13,opt,name=recoverTimes,proto3" json:"recoverTimes,omitempty"`
+	MeanTimeToRecover     float64          `protobuf:"fixed64,14,opt,name=meanTimeToRecover,proto3" json:"meanTimeToRecover,omitempty"`
+	MeanTimeBetweenFaults float64          `protobuf:"fixed64,15,opt,name=meanTimeBetweenFaults,proto3" json:"meanTimeBetweenFaults,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{}         `json:"-"`
+	XXX_unrecognized      []byte           `json:"-"`
+	XXX_sizecache         int32            `json:"-"`
+}
+
+func (m *JobGoodput) Reset()         { *m = JobGoodput{} }
+func (m *JobGoodput) String() string { return proto.CompactTextString(m) }
+func (*JobGoodput) ProtoMessage()    {}
+func (*JobGoodput) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f32c477d91a04ead, []int{12}
+}
+
+func (m *JobGoodput) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_JobGoodput.Unmarshal(m, b)
+}
+func (m *JobGoodput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_JobGoodput.Marshal(b, m, deterministic)
+}
+func (m *JobGoodput) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobGoodput.Merge(m, src)
+}
+func (m *JobGoodput) XXX_Size() int {
+	return xxx_messageInfo_JobGoodput.Size(m)
+}
+func (m *JobGoodput) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobGoodput.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JobGoodput proto.InternalMessageInfo
+
+func (m *JobGoodput) GetJobId() string {
+	if m != nil {
+		return m.JobId
+	}
+	return ""
+}
+
+func (m *JobGoodput) GetCustomJobId() string {
+	if m != nil {
+		return m.CustomJobId
+	}
+	return ""
+}
+
+func (m *JobGoodput) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *JobGoodput) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *JobGoodput) GetCardNum() int64 {
+	if m != nil {
+		return m.CardNum
+	}
+	return 0
+}
+
+func (m *JobGoodput) GetWallTime() int64 {
+	if m != nil {
+		return m.WallTime
+	}
+	return 0
+}
+
+func (m *JobGoodput) GetRecoverPausedTime() int64 {
+	if m != nil {
+		return m.RecoverPausedTime
+	}
+	return 0
+}
+
+func (m *JobGoodput) GetRescheduleLostTime() int64 {
+	if m != nil {
+		return m.RescheduleLostTime
+	}
+	return 0
+}
+
+func (m *JobGoodput) GetProductiveTime() int64 {
+	if m != nil {
+		return m.ProductiveTime
+	}
+	return 0
+}
+
+func (m *JobGoodput) GetGoodput() float64 {
+	if m != nil {
+		return m.Goodput
+	}
+	return 0
+}
+
+func (m *JobGoodput) GetFaultNums() map[string]int64 {
+	if m != nil {
+		return m.FaultNums
+	}
+	return nil
+}
+
+func (m *JobGoodput) GetFaultTimes() int64 {
+	if m != nil {
+		return m.FaultTimes
+	}
+	return 0
+}
+
+func (m *JobGoodput) GetRecoverTimes() int64 {
+	if m != nil {
+		return m.RecoverTimes
+	}
+	return 0
+}
+
+func (m *JobGoodput) GetMeanTimeToRecover() float64 {
+	if m != nil {
+		return m.MeanTimeToRecover
+	}
+	return 0
+}
+
+func (m *JobGoodput) GetMeanTimeBetweenFaults() float64 {
+	if m != nil {
+		return m.MeanTimeBetweenFaults
+	}
+	return 0
+}
+
+type ClusterGoodput struct {
+	JobNum                int64    `protobuf:"varint,1,opt,name=jobNum,proto3" json:"jobNum,omitempty"`
+	CardWallTime          int64    `protobuf:"varint,2,opt,name=cardWallTime,proto3" json:"cardWallTime,omitempty"`
+	CardProductiveTime    int64    `protobuf:"varint,3,opt,name=cardProductiveTime,proto3" json:"cardProductiveTime,omitempty"`
+	Goodput               float64  `protobuf:"fixed64,4,opt,name=goodput,proto3" json:"goodput,omitempty"`
+	FaultTimes            int64    `protobuf:"varint,5,opt,name=faultTimes,proto3" json:"faultTimes,omitempty"`
+	RecoverTimes          int64    `protobuf:"varint,6,opt,name=recoverTimes,proto3" json:"recoverTimes,omitempty"`
+	MeanTimeToRecover     float64  `protobuf:"fixed64,7,opt,name=meanTimeToRecover,proto3" json:"meanTimeToRecover,omitempty"`
+	MeanTimeBetweenFaults float64  `protobuf:"fixed64,8,opt,name=meanTimeBetweenFaults,proto3" json:"meanTimeBetweenFaults,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{} `json:"-"`
+	XXX_unrecognized      []byte   `json:"-"`
+	XXX_sizecache         int32    `json:"-"`
+}
+
+func (m *ClusterGoodput) Reset()         { *m = ClusterGoodput{} }
+func (m *ClusterGoodput) String() string { return proto.CompactTextString(m) }
+func (*ClusterGoodput) ProtoMessage()    {}
+func (*ClusterGoodput) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f32c477d91a04ead, []int{13}
+}
+
+func (m *ClusterGoodput) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ClusterGoodput.Unmarshal(m, b)
+}
+func (m *ClusterGoodput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ClusterGoodput.Marshal(b, m, deterministic)
+}
+func (m *ClusterGoodput) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClusterGoodput.Merge(m, src)
+}
+func (m *ClusterGoodput) XXX_Size() int {
+	return xxx_messageInfo_ClusterGoodput.Size(m)
+}
+func (m *ClusterGoodput) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClusterGoodput.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClusterGoodput proto.InternalMessageInfo
+
+func (m *ClusterGoodput) GetJobNum() int64 {
+	if m != nil {
+		return m.JobNum
+	}
+	return 0
+}
+
+func (m *ClusterGoodput) GetCardWallTime() int64 {
+	if m != nil {
+		return m.CardWallTime
+	}
+	return 0
+}
+
+func (m *ClusterGoodput) GetCardProductiveTime() int64 {
+	if m != nil {
+		return m.CardProductiveTime
+	}
+	return 0
+}
+
+func (m *ClusterGoodput) GetGoodput() float64 {
+	if m != nil {
+		return m.Goodput
+	}
+	return 0
+}
+
+func (m *ClusterGoodput) GetFaultTimes() int64 {
+	if m != nil {
+		return m.FaultTimes
+	}
+	return 0
+}
+
+func (m *ClusterGoodput) GetRecoverTimes() int64 {
+	if m != nil {
+		return m.RecoverTimes
+	}
+	return 0
+}
+
+func (m *ClusterGoodput) GetMeanTimeToRecover() float64 {
+	if m != nil {
+		return m.MeanTimeToRecover
+	}
+	return 0
+}
+
+func (m *ClusterGoodput) GetMeanTimeBetweenFaults() float64 {
+	if m != nil {
+		return m.MeanTimeBetweenFaults
+	}
+	return 0
+}
+
+type GetJobStatisticsResponse struct {
+	Status               *Status         `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Jobs                 []*JobGoodput   `protobuf:"bytes,2,rep,name=jobs,proto3" json:"jobs,omitempty"`
+	Cluster              *ClusterGoodput `protobuf:"bytes,3,opt,name=cluster,proto3" json:"cluster,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *GetJobStatisticsResponse) Reset()         { *m = GetJobStatisticsResponse{} }
+func (m *GetJobStatisticsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetJobStatisticsResponse) ProtoMessage()    {}
+func (*GetJobStatisticsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f32c477d91a04ead, []int{14}
+}
+
+func (m *GetJobStatisticsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetJobStatisticsResponse.Unmarshal(m, b)
+}
+func (m *GetJobStatisticsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetJobStatisticsResponse.Marshal(b, m, deterministic)
+}
+func (m *GetJobStatisticsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetJobStatisticsResponse.Merge(m, src)
+}
+func (m *GetJobStatisticsResponse) XXX_Size() int {
+	return xxx_messageInfo_GetJobStatisticsResponse.Size(m)
+}
+func (m *GetJobStatisticsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetJobStatisticsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetJobStatisticsResponse proto.InternalMessageInfo
+
+func (m *GetJobStatisticsResponse) GetStatus() *Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *GetJobStatisticsResponse) GetJobs() []*JobGoodput {
+	if m != nil {
+		return m.Jobs
+	}
+	return nil
+}
+
+func (m *GetJobStatisticsResponse) GetCluster() *ClusterGoodput {
+	if m != nil {
+		return m.Cluster
+	}
+	return nil
+}
+
+type GetSchedulingExceptionsRequest struct {
+	JobId                string   `protobuf:"bytes,1,opt,name=jobId,proto3" json:"jobId,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetSchedulingExceptionsRequest) Reset()         { *m = GetSchedulingExceptionsRequest{} }
+func (m *GetSchedulingExceptionsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetSchedulingExceptionsRequest) ProtoMessage()    {}
+func (*GetSchedulingExceptionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f32c477d91a04ead, []int{15}
+}
+
+func (m *GetSchedulingExceptionsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetSchedulingExceptionsRequest.Unmarshal(m, b)
+}
+func (m *GetSchedulingExceptionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetSchedulingExceptionsRequest.Marshal(b, m, deterministic)
+}
+func (m *GetSchedulingExceptionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetSchedulingExceptionsRequest.Merge(m, src)
+}
+func (m *GetSchedulingExceptionsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetSchedulingExceptionsRequest.Size(m)
+}
+func (m *GetSchedulingExceptionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetSchedulingExceptionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetSchedulingExceptionsRequest proto.InternalMessageInfo
+
+func (m *GetSchedulingExceptionsRequest) GetJobId() string {
+	if m != nil {
+		return m.JobId
+	}
+	return ""
+}
+
+type SchedulingCause struct {
+	Reason               string   `protobuf:"bytes,1,opt,name=reason,proto3" json:"reason,omitempty"`
+	NpuNum               int64    `protobuf:"varint,2,opt,name=npuNum,proto3" json:"npuNum,omitempty"`
+	Devices              []string `protobuf:"bytes,3,rep,name=devices,proto3" json:"devices,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SchedulingCause) Reset()         { *m = SchedulingCause{} }
+func (m *SchedulingCause) String() string { return proto.CompactTextString(m) }
+func (*SchedulingCause) ProtoMessage()    {}
+func (*SchedulingCause) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f32c477d91a04ead, []int{16}
+}
+
+func (m *SchedulingCause) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SchedulingCause.Unmarshal(m, b)
+}
+func (m *SchedulingCause) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SchedulingCause.Marshal(b, m, deterministic)
+}
+func (m *SchedulingCause) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SchedulingCause.Merge(m, src)
+}
+func (m *SchedulingCause) XXX_Size() int {
+	return xxx_messageInfo_SchedulingCause.Size(m)
+}
+func (m *SchedulingCause) XXX_DiscardUnknown() {
+	xxx_messageInfo_SchedulingCause.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SchedulingCause proto.InternalMessageInfo
+
+func (m *SchedulingCause) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *SchedulingCause) GetNpuNum() int64 {
+	if m != nil {
+		return m.NpuNum
+	}
+	return 0
+}
+
+func (m *SchedulingCause) GetDevices() []string {
+	if m != nil {
+		return m.Devices
+	}
+	return nil
+}
+
+type SchedulingDiagnosis struct {
+	Category             string             `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	Summary              string             `protobuf:"bytes,2,opt,name=summary,proto3" json:"summary,omitempty"`
+	RequiredNPU          int64              `protobuf:"varint,3,opt,name=requiredNPU,proto3" json:"requiredNPU,omitempty"`
+	NpuPerPod            int64              `protobuf:"varint,4,opt,name=npuPerPod,proto3" json:"npuPerPod,omitempty"`
+	BlockSize            int64              `protobuf:"varint,5,opt,name=blockSize,proto3" json:"blockSize,omitempty"`
+	FreeNPU              int64              `protobuf:"varint,6,opt,name=freeNPU,proto3" json:"freeNPU,omitempty"`
+	AllocatableNPU       int64              `protobuf:"varint,7,opt,name=allocatableNPU,proto3" json:"allocatableNPU,omitempty"`
+	LargestFreeBlock     int64              `protobuf:"varint,8,opt,name=largestFreeBlock,proto3" json:"largestFreeBlock,omitempty"`
+	Causes               []*SchedulingCause `protobuf:"bytes,9,rep,name=causes,proto3" json:"causes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *SchedulingDiagnosis) Reset()         { *m = SchedulingDiagnosis{} }
+func (m *SchedulingDiagnosis) String() string { return proto.CompactTextString(m) }
+func (*SchedulingDiagnosis) ProtoMessage()    {}
+func (*SchedulingDiagnosis) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f32c477d91a04ead, []int{17}
+}
+
+func (m *SchedulingDiagnosis) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SchedulingDiagnosis.Unmarshal(m, b)
+}
+func (m *SchedulingDiagnosis) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SchedulingDiagnosis.Marshal(b, m, deterministic)
+}
+func (m *SchedulingDiagnosis) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SchedulingDiagnosis.Merge(m, src)
+}
+func (m *SchedulingDiagnosis) XXX_Size() int {
+	return xxx_messageInfo_SchedulingDiagnosis.Size(m)
+}
+func (m *SchedulingDiagnosis) XXX_DiscardUnknown() {
+	xxx_messageInfo_SchedulingDiagnosis.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SchedulingDiagnosis proto.InternalMessageInfo
+
+func (m *SchedulingDiagnosis) GetCategory() string {
+	if m != nil {
+		return m.Category
+	}
+	return ""
+}
+
+func (m *SchedulingDiagnosis) GetSummary() string {
+	if m != nil {
+		return m.Summary
+	}
+	return ""
+}
+
+func (m *SchedulingDiagnosis) GetRequiredNPU() int64 {
+	if m != nil {
+		return m.RequiredNPU
+	}
+	return 0
+}
+
+func (m *SchedulingDiagnosis) GetNpuPerPod() int64 {
+	if m != nil {
+		return m.NpuPerPod
+	}
+	return 0
+}
+
+func (m *SchedulingDiagnosis) GetBlockSize() int64 {
+	if m != nil {
+		return m.BlockSize
+	}
+	return 0
+}
+
+func (m *SchedulingDiagnosis) GetFreeNPU() int64 {
+	if m != nil {
+		return m.FreeNPU
+	}
+	return 0
+}
+
+func (m *SchedulingDiagnosis) GetAllocatableNPU() int64 {
+	if m != nil {
+		return m.AllocatableNPU
+	}
+	return 0
+}
+
+func (m *SchedulingDiagnosis) GetLargestFreeBlock() int64 {
+	if m != nil {
+		return m.LargestFreeBlock
+	}
+	return 0
+}
+
+func (m *SchedulingDiagnosis) GetCauses() []*SchedulingCause {
+	if m != nil {
+		return m.Causes
+	}
+	return nil
+}
+
+type JobSchedulingException struct {
+	JobId                string               `protobuf:"bytes,1,opt,name=jobId,proto3" json:"jobId,omitempty"`
+	JobName              string               `protobuf:"bytes,2,opt,name=jobName,proto3" json:"jobName,omitempty"`
+	Namespace            string               `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	JobType              string               `protobuf:"bytes,4,opt,name=jobType,proto3" json:"jobType,omitempty"`
+	Status               string               `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	Reason               string               `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	Message              string               `protobuf:"bytes,7,opt,name=message,proto3" json:"message,omitempty"`
+	Diagnosis            *SchedulingDiagnosis `protobuf:"bytes,8,opt,name=diagnosis,proto3" json:"diagnosis,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *JobSchedulingException) Reset()         { *m = JobSchedulingException{} }
+func (m *JobSchedulingException) String() string { return proto.CompactTextString(m) }
+func (*JobSchedulingException) ProtoMessage()    {}
+func (*JobSchedulingException) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f32c477d91a04ead, []int{18}
+}
+
+func (m *JobSchedulingException) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_JobSchedulingException.Unmarshal(m, b)
+}
+func (m *JobSchedulingException) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_JobSchedulingException.Marshal(b, m, deterministic)
+}
+func (m *JobSchedulingException) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobSchedulingException.Merge(m, src)
+}
+func (m *JobSchedulingException) XXX_Size() int {
+	return xxx_messageInfo_JobSchedulingException.Size(m)
+}
+func (m *JobSchedulingException) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobSchedulingException.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JobSchedulingException proto.InternalMessageInfo
+
+func (m *JobSchedulingException) GetJobId() string {
+	if m != nil {
+		return m.JobId
+	}
+	return ""
+}
+
+func (m *JobSchedulingException) GetJobName() string {
+	if m != nil {
+		return m.JobName
+	}
+	return ""
+}
+
+func (m *JobSchedulingException) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *JobSchedulingException) GetJobType() string {
+	if m != nil {
+		return m.JobType
+	}
+	return ""
+}
+
+func (m *JobSchedulingException) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *JobSchedulingException) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *JobSchedulingException) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *JobSchedulingException) GetDiagnosis() *SchedulingDiagnosis {
+	if m != nil {
+		return m.Diagnosis
+	}
+	return nil
+}
+
+type GetSchedulingExceptionsResponse struct {
+	Status               *Status                   `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Jobs                 []*JobSchedulingException `protobuf:"bytes,2,rep,name=jobs,proto3" json:"jobs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
+}
+
+func (m *GetSchedulingExceptionsResponse) Reset()         { *m = GetSchedulingExceptionsResponse{} }
+func (m *GetSchedulingExceptionsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetSchedulingExceptionsResponse) ProtoMessage()    {}
+func (*GetSchedulingExceptionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f32c477d91a04ead, []int{19}
+}
+
+func (m *GetSchedulingExceptionsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetSchedulingExceptionsResponse.Unmarshal(m, b)
+}
+func (m *GetSchedulingExceptionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetSchedulingExceptionsResponse.Marshal(b, m, deterministic)
+}
+func (m *GetSchedulingExceptionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetSchedulingExceptionsResponse.Merge(m, src)
+}
+func (m *GetSchedulingExceptionsResponse) XXX_Size() int {
+	return xxx_messageInfo_GetSchedulingExceptionsResponse.Size(m)
+}
+func (m *GetSchedulingExceptionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetSchedulingExceptionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetSchedulingExceptionsResponse proto.InternalMessageInfo
+
+func (m *GetSchedulingExceptionsResponse) GetStatus() *Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *GetSchedulingExceptionsResponse) GetJobs() []*JobSchedulingException {
+	if m != nil {
+		return m.Jobs
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*ClientInfo)(nil), "job.ClientInfo")
 	proto.RegisterType((*Status)(nil), "job.Status")
 	proto.RegisterType((*JobSummarySignal)(nil), "job.JobSummarySignal")
 	proto.RegisterType((*JobSummarySignalList)(nil), "job.JobSummarySignalList")
+	proto.RegisterType((*GetJobRequest)(nil), "job.GetJobRequest")
+	proto.RegisterType((*GetJobResponse)(nil), "job.GetJobResponse")
+	proto.RegisterType((*JobFilter)(nil), "job.JobFilter")
+	proto.RegisterType((*ListJobsRequest)(nil), "job.ListJobsRequest")
+	proto.RegisterType((*ListJobsResponse)(nil), "job.ListJobsResponse")
+	proto.RegisterType((*WatchJobsRequest)(nil), "job.WatchJobsRequest")
+	proto.RegisterType((*JobEvent)(nil), "job.JobEvent")
+	proto.RegisterType((*GetJobStatisticsRequest)(nil), "job.GetJobStatisticsRequest")
+	proto.RegisterType((*JobGoodput)(nil), "job.JobGoodput")
+	proto.RegisterMapType((map[string]int64)(nil), "job.JobGoodput.FaultNumsEntry")
+	proto.RegisterType((*ClusterGoodput)(nil), "job.ClusterGoodput")
+	proto.RegisterType((*GetJobStatisticsResponse)(nil), "job.GetJobStatisticsResponse")
+	proto.RegisterType((*GetSchedulingExceptionsRequest)(nil), "job.GetSchedulingExceptionsRequest")
+	proto.RegisterType((*SchedulingCause)(nil), "job.SchedulingCause")
+	proto.RegisterType((*SchedulingDiagnosis)(nil), "job.SchedulingDiagnosis")
+	proto.RegisterType((*JobSchedulingException)(nil), "job.JobSchedulingException")
+	proto.RegisterType((*GetSchedulingExceptionsResponse)(nil), "job.GetSchedulingExceptionsResponse")
 }
 
 func init() {
@@ -340,35 +1405,98 @@ func init() {
 }
 
 var fileDescriptor_f32c477d91a04ead = []byte{
-	// 478 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x53, 0xcd, 0x6e, 0xd4, 0x30,
-	0x10, 0x6e, 0x48, 0xb3, 0x3f, 0xb3, 0x40, 0x8b, 0x55, 0x24, 0xb7, 0x02, 0xb4, 0xca, 0xa9, 0xe2,
-	0xb0, 0x42, 0xe5, 0x08, 0x17, 0xe8, 0x01, 0x76, 0x55, 0x15, 0x29, 0xbb, 0x12, 0x12, 0x37, 0x3b,
-	0xf6, 0x96, 0x84, 0x24, 0x13, 0xd9, 0x8e, 0x04, 0x0f, 0x83, 0xc4, 0xfb, 0xf0, 0x52, 0x68, 0xec,
-	0xfd, 0xd3, 0x6e, 0xe9, 0x6d, 0xe6, 0x9b, 0x99, 0x6f, 0xbe, 0x99, 0xb1, 0x61, 0x58, 0xa2, 0x9c,
-	0xb4, 0x06, 0x1d, 0xb2, 0xb8, 0x44, 0x99, 0xbe, 0x07, 0xb8, 0xae, 0x0a, 0xdd, 0xb8, 0x69, 0xb3,
-	0x44, 0xc6, 0xe0, 0xd8, 0x60, 0xa5, 0x79, 0x34, 0x8e, 0x2e, 0x87, 0x99, 0xb7, 0xd9, 0x05, 0x0c,
-	0xf2, 0x90, 0xa1, 0x78, 0xec, 0xf1, 0x8d, 0x9f, 0xde, 0x40, 0x6f, 0xee, 0x84, 0xeb, 0x2c, 0x55,
-	0xe6, 0xa8, 0x42, 0x65, 0x92, 0x79, 0x9b, 0xb0, 0xa2, 0x59, 0x22, 0x7f, 0x14, 0xd8, 0xc8, 0x7e,
-	0x90, 0xed, 0x4f, 0x0c, 0xa7, 0x33, 0x94, 0xf3, 0xae, 0xae, 0x85, 0xf9, 0x35, 0x2f, 0xee, 0x1a,
-	0x51, 0x11, 0x49, 0xd7, 0x15, 0x6a, 0x2d, 0x89, 0x6c, 0x76, 0x06, 0x49, 0x89, 0x72, 0xaa, 0x56,
-	0xcc, 0xc1, 0x61, 0x1c, 0xfa, 0x25, 0xca, 0x5b, 0x51, 0xeb, 0x15, 0xf3, 0xda, 0x65, 0x2f, 0x60,
-	0xd8, 0x88, 0x5a, 0xdb, 0x56, 0xe4, 0x9a, 0x1f, 0xfb, 0xd8, 0x16, 0xa0, 0xe8, 0xd2, 0x88, 0x5a,
-	0x7f, 0x45, 0xf3, 0x83, 0x27, 0x21, 0xba, 0x01, 0x28, 0x5a, 0xa2, 0x0c, 0x53, 0xf2, 0x5e, 0x88,
-	0x6e, 0x00, 0x52, 0xe7, 0x8a, 0x5a, 0xf3, 0x7e, 0x50, 0x47, 0x36, 0xe9, 0xc8, 0xeb, 0x69, 0xa3,
-	0xf4, 0x4f, 0x3e, 0x08, 0x3a, 0x56, 0x2e, 0xe9, 0x76, 0xe8, 0x44, 0xc5, 0x87, 0x41, 0xb7, 0x77,
-	0x68, 0x25, 0x9f, 0xf3, 0xbc, 0x9a, 0x59, 0x6c, 0x38, 0x84, 0x95, 0xac, 0x7d, 0xf6, 0x0a, 0x40,
-	0xe9, 0x4a, 0x3b, 0xbd, 0xa0, 0x2e, 0x23, 0x1f, 0xdd, 0x41, 0xd8, 0x18, 0x46, 0xf6, 0xbb, 0x30,
-	0x5a, 0x2d, 0xd0, 0x4c, 0x5b, 0xfe, 0xd8, 0x27, 0xec, 0x42, 0xc4, 0x50, 0x0b, 0xeb, 0xb4, 0xf9,
-	0xa0, 0x94, 0xe1, 0x4f, 0x02, 0xc3, 0x16, 0xa1, 0xee, 0xd8, 0x6a, 0x23, 0x1c, 0x1a, 0xfe, 0x34,
-	0x74, 0x5f, 0xfb, 0xec, 0x14, 0x62, 0x5b, 0x28, 0x7e, 0xe2, 0x61, 0x32, 0xd3, 0xdf, 0x11, 0x9c,
-	0xed, 0x9f, 0xe8, 0xa6, 0xb0, 0x8e, 0x5d, 0xc3, 0xb3, 0x72, 0x0f, 0xb7, 0x3c, 0x1a, 0xc7, 0x97,
-	0xa3, 0xab, 0xe7, 0x13, 0x7a, 0x73, 0xfb, 0x55, 0xd9, 0x61, 0x3e, 0x69, 0xcd, 0x74, 0x8b, 0xc6,
-	0xf9, 0x69, 0xc3, 0x71, 0x77, 0x10, 0x9a, 0x76, 0x86, 0x72, 0x41, 0x5b, 0xbb, 0xed, 0x6a, 0x7f,
-	0xe5, 0x24, 0xdb, 0x85, 0xae, 0xfe, 0x46, 0x10, 0xcf, 0x50, 0xb2, 0xd7, 0x30, 0xc8, 0xf4, 0x5d,
-	0x41, 0x53, 0xb2, 0x13, 0xdf, 0x7f, 0xfb, 0xca, 0x2f, 0x46, 0x1e, 0x08, 0x17, 0x4c, 0x8f, 0xd8,
-	0x27, 0x38, 0x9f, 0x77, 0xd2, 0xe6, 0xa6, 0x90, 0xfa, 0xe0, 0xf9, 0x1d, 0x14, 0xdf, 0x3f, 0x4d,
-	0x7a, 0xf4, 0x26, 0x62, 0x5f, 0xe0, 0xe5, 0x7f, 0x89, 0xfc, 0x92, 0x0e, 0xc8, 0xce, 0xef, 0x25,
-	0xa3, 0x5c, 0x22, 0xfc, 0xd8, 0xff, 0x96, 0x4c, 0xde, 0x95, 0x28, 0x65, 0xcf, 0xff, 0xd8, 0xb7,
-	0xff, 0x02, 0x00, 0x00, 0xff, 0xff, 0x8e, 0xf0, 0x8e, 0xba, 0xbe, 0x03, 0x00, 0x00,
+	// 1481 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x58, 0x5f, 0x73, 0xdb, 0x44,
+	0x10, 0xaf, 0xac, 0xc4, 0xb1, 0xd7, 0xcd, 0x9f, 0x5e, 0xff, 0xa0, 0x9a, 0xb6, 0x74, 0xd4, 0x52,
+	0x4a, 0xa7, 0x04, 0x48, 0xa1, 0xc3, 0x9f, 0xbe, 0xd0, 0xd0, 0x86, 0x78, 0x3a, 0x21, 0x28, 0x86,
+	0xce, 0xc0, 0xc0, 0xcc, 0x49, 0xba, 0x38, 0x72, 0x64, 0x9d, 0x7b, 0x77, 0x4a, 0x1a, 0x1e, 0xf9,
+	0x0a, 0x3c, 0xf1, 0xc0, 0x0c, 0x5f, 0x80, 0xaf, 0xc0, 0x1b, 0x33, 0x7c, 0x1c, 0x3e, 0x02, 0xb3,
+	0x77, 0x3a, 0xdb, 0x92, 0x1d, 0xb7, 0xe1, 0x4d, 0xfb, 0xdb, 0xbd, 0xbd, 0xbd, 0xdd, 0xdf, 0xee,
+	0x9d, 0x0d, 0xcd, 0x3e, 0x0f, 0xd7, 0x87, 0x82, 0x2b, 0x4e, 0xdc, 0x3e, 0x0f, 0xfd, 0x47, 0x00,
+	0x9b, 0x69, 0xc2, 0x32, 0xb5, 0x9d, 0xed, 0x73, 0x42, 0x60, 0x41, 0xf0, 0x94, 0x79, 0xce, 0x4d,
+	0xe7, 0x6e, 0x33, 0xd0, 0xdf, 0xa4, 0x0d, 0x8d, 0xc8, 0x58, 0xc4, 0x9e, 0xab, 0xf1, 0x91, 0xec,
+	0x3f, 0x83, 0xfa, 0x9e, 0xa2, 0x2a, 0x97, 0xb8, 0x32, 0xe2, 0xb1, 0x59, 0xb9, 0x18, 0xe8, 0x6f,
+	0xc4, 0x92, 0x6c, 0x9f, 0x7b, 0x35, 0xe3, 0x0d, 0xbf, 0xe7, 0x7a, 0xfb, 0xc3, 0x85, 0xb5, 0x0e,
+	0x0f, 0xf7, 0xf2, 0xc1, 0x80, 0x8a, 0x93, 0xbd, 0xa4, 0x97, 0xd1, 0x14, 0x9d, 0xe4, 0x79, 0x12,
+	0xdb, 0x90, 0xf0, 0x9b, 0x5c, 0x82, 0xc5, 0x3e, 0x0f, 0xb7, 0xe3, 0xc2, 0xb3, 0x11, 0x88, 0x07,
+	0x4b, 0x7d, 0x1e, 0xee, 0xd0, 0x01, 0x2b, 0x3c, 0x5b, 0x91, 0x5c, 0x83, 0x66, 0x46, 0x07, 0x4c,
+	0x0e, 0x69, 0xc4, 0xbc, 0x05, 0xad, 0x1b, 0x03, 0xa8, 0xdd, 0x17, 0x74, 0xc0, 0x9e, 0x73, 0x71,
+	0xe8, 0x2d, 0x1a, 0xed, 0x08, 0x40, 0x6d, 0x9f, 0x87, 0xe6, 0x94, 0x5e, 0xdd, 0x68, 0x47, 0x00,
+	0x46, 0xa7, 0x92, 0x01, 0xf3, 0x96, 0x4c, 0x74, 0xf8, 0x8d, 0x71, 0x44, 0x83, 0xed, 0x2c, 0x66,
+	0x2f, 0xbd, 0x86, 0x89, 0xa3, 0x10, 0x31, 0x6e, 0xc5, 0x15, 0x4d, 0xbd, 0xa6, 0x89, 0x5b, 0x0b,
+	0x98, 0x92, 0xaf, 0xa2, 0x28, 0xed, 0x48, 0x9e, 0x79, 0x60, 0x52, 0x62, 0x65, 0x72, 0x03, 0x20,
+	0x66, 0x29, 0x53, 0xac, 0x8b, 0xbb, 0xb4, 0xb4, 0x76, 0x02, 0x21, 0x37, 0xa1, 0x25, 0x0f, 0xa8,
+	0x60, 0x71, 0x97, 0x8b, 0xed, 0xa1, 0x77, 0x5e, 0x1b, 0x4c, 0x42, 0xe8, 0x61, 0x40, 0xa5, 0x62,
+	0xe2, 0x8b, 0x38, 0x16, 0xde, 0xb2, 0xf1, 0x30, 0x46, 0x70, 0x77, 0x3e, 0x64, 0x82, 0x2a, 0x2e,
+	0xbc, 0x15, 0xb3, 0xbb, 0x95, 0xc9, 0x1a, 0xb8, 0x32, 0x89, 0xbd, 0x55, 0x0d, 0xe3, 0xa7, 0xff,
+	0xbb, 0x03, 0x97, 0xaa, 0x25, 0x7a, 0x96, 0x48, 0x45, 0x36, 0xe1, 0x42, 0xbf, 0x82, 0x4b, 0xcf,
+	0xb9, 0xe9, 0xde, 0x6d, 0x6d, 0x5c, 0x5e, 0x47, 0xce, 0x55, 0x57, 0x05, 0xd3, 0xf6, 0x18, 0x6b,
+	0xc0, 0x86, 0x5c, 0x28, 0x7d, 0x5a, 0x53, 0xdc, 0x09, 0x04, 0x4f, 0xdb, 0xe1, 0x61, 0x17, 0xb3,
+	0xb6, 0x93, 0x0f, 0x74, 0x95, 0x17, 0x83, 0x49, 0xc8, 0x7f, 0x1b, 0x96, 0xb7, 0x98, 0xea, 0xf0,
+	0x30, 0x60, 0x2f, 0x72, 0x26, 0xd5, 0x98, 0x2a, 0xce, 0x04, 0x55, 0xfc, 0x9f, 0x60, 0xc5, 0x9a,
+	0xc9, 0x21, 0xcf, 0x24, 0x23, 0xb7, 0xa0, 0x2e, 0x4d, 0x8d, 0xd1, 0xb0, 0xb5, 0xd1, 0xd2, 0x41,
+	0x9b, 0x2a, 0x07, 0x85, 0x8a, 0xbc, 0x03, 0xd8, 0x33, 0x3a, 0xb0, 0x53, 0x8f, 0xa5, 0xbb, 0x8a,
+	0x41, 0xb3, 0xc3, 0xc3, 0xa7, 0x49, 0xaa, 0x98, 0x28, 0xb3, 0xcf, 0x99, 0xc1, 0xbe, 0x31, 0xbf,
+	0x6a, 0x55, 0x7e, 0x95, 0xb8, 0xe9, 0x56, 0xb8, 0xe9, 0xff, 0xe6, 0xc0, 0x2a, 0x66, 0xbf, 0xc3,
+	0x43, 0x69, 0x0f, 0x7c, 0x07, 0xea, 0xfb, 0x7a, 0xdf, 0xe2, 0x20, 0x2b, 0x36, 0x4c, 0x13, 0x4d,
+	0x50, 0x68, 0xb1, 0xee, 0x43, 0xda, 0x63, 0x7b, 0xc9, 0xcf, 0x26, 0xd3, 0x8b, 0xc1, 0x48, 0xc6,
+	0x5d, 0xf1, 0xbb, 0xcb, 0x0f, 0x59, 0x66, 0x77, 0x1d, 0x01, 0xe4, 0x36, 0x2c, 0x1f, 0x27, 0xea,
+	0x20, 0xa0, 0xd9, 0x61, 0x97, 0x86, 0xa9, 0xe9, 0xa8, 0x46, 0x50, 0x06, 0xfd, 0x7f, 0x1c, 0x58,
+	0x1b, 0xc7, 0x76, 0x96, 0x2c, 0xbf, 0x0b, 0x0b, 0x7d, 0x1e, 0x62, 0x32, 0xe6, 0xb0, 0x47, 0x9b,
+	0x60, 0x28, 0x19, 0x7b, 0xa9, 0x76, 0x2b, 0xc1, 0x96, 0xc1, 0x71, 0xdb, 0x2d, 0xe8, 0x73, 0x1a,
+	0x81, 0xdc, 0x85, 0x55, 0xc1, 0x24, 0xcf, 0x45, 0xc4, 0xbe, 0x63, 0x42, 0x26, 0x3c, 0x2b, 0x9a,
+	0xbf, 0x0a, 0xfb, 0x31, 0xac, 0x3d, 0xa7, 0x2a, 0x3a, 0xf8, 0x3f, 0x69, 0x9e, 0xb1, 0x4b, 0x6d,
+	0xf6, 0x2e, 0x3f, 0x42, 0xa3, 0xc3, 0xc3, 0x27, 0x47, 0x2c, 0x53, 0xb3, 0x56, 0x39, 0x33, 0x57,
+	0xbd, 0x3e, 0x25, 0x3f, 0x84, 0x37, 0x0c, 0xe5, 0x31, 0xdb, 0x89, 0x54, 0x49, 0x34, 0x3a, 0xcb,
+	0x15, 0xa8, 0xeb, 0xb6, 0x30, 0x0d, 0xdb, 0x0c, 0x0a, 0xc9, 0xff, 0x77, 0x01, 0xa0, 0xc3, 0xc3,
+	0x2d, 0xce, 0xe3, 0x61, 0x7e, 0x4a, 0x2b, 0x61, 0x4f, 0x46, 0xb9, 0x54, 0x7c, 0xd0, 0x99, 0x98,
+	0xc8, 0x93, 0x10, 0xce, 0xc8, 0x6c, 0x3c, 0x94, 0xf5, 0xf7, 0x2b, 0x26, 0x32, 0x4e, 0x50, 0x2a,
+	0x62, 0xec, 0x71, 0x2c, 0x89, 0x1b, 0x58, 0x11, 0x59, 0x7b, 0x4c, 0xd3, 0x54, 0xcf, 0x87, 0xba,
+	0x56, 0x8d, 0x64, 0x72, 0x1f, 0x2e, 0x08, 0x16, 0xf1, 0x23, 0x26, 0x76, 0x69, 0x2e, 0x59, 0xdc,
+	0xb5, 0x83, 0xd9, 0x0d, 0xa6, 0x15, 0x64, 0x1d, 0x88, 0x60, 0x32, 0x3a, 0x60, 0x71, 0x9e, 0xb2,
+	0x67, 0x5c, 0x9a, 0x99, 0xd3, 0xd0, 0xe6, 0x33, 0x34, 0xe4, 0x0e, 0xac, 0x0c, 0x05, 0x8f, 0xf3,
+	0x48, 0x25, 0x47, 0x66, 0x1a, 0x37, 0xb5, 0x6d, 0x05, 0xc5, 0xd8, 0x7b, 0x26, 0x61, 0x7a, 0x98,
+	0x3b, 0x81, 0x15, 0xc9, 0x23, 0x68, 0xee, 0xd3, 0x3c, 0x55, 0x3b, 0xf9, 0x40, 0x7a, 0x2d, 0x4d,
+	0xee, 0x1b, 0xb6, 0x60, 0x45, 0x8e, 0xd7, 0x9f, 0x5a, 0x83, 0x27, 0x99, 0x12, 0x27, 0xc1, 0x78,
+	0x01, 0xce, 0x46, 0x2d, 0xe0, 0x26, 0x52, 0x0f, 0x7a, 0x37, 0x98, 0x40, 0x88, 0x0f, 0xe7, 0x8b,
+	0x43, 0x1a, 0x8b, 0x65, 0x6d, 0x51, 0xc2, 0x30, 0x43, 0x03, 0x46, 0x33, 0x14, 0xba, 0x3c, 0x30,
+	0x1a, 0x3d, 0xf4, 0x9d, 0x60, 0x5a, 0x41, 0x3e, 0x82, 0xcb, 0x16, 0x7c, 0xcc, 0xd4, 0x31, 0x63,
+	0x99, 0x8e, 0x4e, 0xea, 0xfb, 0xc0, 0x09, 0x66, 0x2b, 0xdb, 0x8f, 0x60, 0xa5, 0x7c, 0x08, 0xbc,
+	0x45, 0x0e, 0xd9, 0x49, 0xc1, 0x1a, 0xfc, 0x44, 0x26, 0x1d, 0xd1, 0x34, 0x37, 0x83, 0xc7, 0x0d,
+	0x8c, 0xf0, 0x59, 0xed, 0x13, 0xc7, 0xff, 0xab, 0x06, 0x2b, 0x9b, 0x69, 0x8e, 0xb7, 0x93, 0xa5,
+	0x9d, 0x61, 0x27, 0x72, 0xc1, 0xd1, 0xd6, 0x85, 0x84, 0x07, 0x46, 0x56, 0x3c, 0xb7, 0x74, 0x30,
+	0xbe, 0x4a, 0x18, 0x16, 0x19, 0xe5, 0xdd, 0x72, 0xe1, 0x5c, 0x53, 0xe4, 0x69, 0xcd, 0x64, 0xf1,
+	0x16, 0xca, 0xc5, 0x2b, 0xa7, 0x7f, 0xf1, 0x95, 0xe9, 0xaf, 0xbf, 0x6e, 0xfa, 0x97, 0xce, 0x9c,
+	0xfe, 0xc6, 0x9c, 0xf4, 0xfb, 0xbf, 0x3a, 0xe0, 0x4d, 0xf7, 0xf9, 0x59, 0xc6, 0xef, 0xad, 0xd2,
+	0xf8, 0x5d, 0xad, 0x30, 0xb4, 0x18, 0xbc, 0xef, 0xc1, 0x52, 0x64, 0xca, 0xa4, 0xb3, 0xd9, 0xda,
+	0xb8, 0xa8, 0xed, 0xca, 0xa5, 0x0b, 0xac, 0x8d, 0xff, 0x10, 0x6e, 0x6c, 0x31, 0xb5, 0x67, 0x7a,
+	0x2a, 0xc9, 0x7a, 0x4f, 0x5e, 0x46, 0x6c, 0xa8, 0x12, 0x9e, 0xc9, 0xf9, 0xf7, 0xf4, 0x0f, 0xb0,
+	0x3a, 0x5e, 0xb4, 0x89, 0xcd, 0x8b, 0x74, 0x10, 0x8c, 0xca, 0xd1, 0x44, 0x2c, 0x24, 0xc4, 0xb3,
+	0x61, 0x8e, 0x34, 0x31, 0x44, 0x28, 0x24, 0x2c, 0x69, 0xcc, 0x8e, 0x92, 0x88, 0x49, 0xcf, 0xd5,
+	0xd3, 0xcd, 0x8a, 0xfe, 0xdf, 0x35, 0xb8, 0x38, 0xf6, 0xfe, 0x65, 0x42, 0x7b, 0x19, 0x97, 0x89,
+	0xd4, 0x4f, 0x54, 0xaa, 0x58, 0x8f, 0x0b, 0x4b, 0xda, 0x91, 0x8c, 0xde, 0xa4, 0x99, 0xad, 0xc5,
+	0xa4, 0xb3, 0x22, 0xce, 0x41, 0xc1, 0x5e, 0xe4, 0x89, 0x60, 0xf1, 0xce, 0xee, 0xb7, 0x05, 0xc7,
+	0x26, 0x21, 0x3d, 0xf3, 0x86, 0xf9, 0x2e, 0x13, 0xbb, 0x3c, 0xd6, 0xf4, 0x72, 0x83, 0x31, 0x80,
+	0xda, 0x30, 0xe5, 0xd1, 0xa1, 0xbe, 0x90, 0x0d, 0xbf, 0xc6, 0x00, 0xee, 0xbb, 0x2f, 0x18, 0x43,
+	0xcf, 0x86, 0x59, 0x56, 0xc4, 0xb9, 0x44, 0xd3, 0x94, 0x47, 0x54, 0xe1, 0xb5, 0x8b, 0x06, 0x66,
+	0xe4, 0x55, 0x50, 0x72, 0x0f, 0xd6, 0x52, 0x2a, 0x7a, 0x4c, 0xaa, 0xa7, 0x82, 0xb1, 0xc7, 0xe8,
+	0xb9, 0x98, 0x76, 0x53, 0x38, 0xb9, 0x0f, 0xf5, 0x08, 0x93, 0x2d, 0xbd, 0xa6, 0x26, 0xc1, 0x25,
+	0xc3, 0x93, 0x72, 0x25, 0x82, 0xc2, 0xc6, 0xff, 0xa5, 0x06, 0x57, 0x90, 0x6f, 0xd3, 0xd5, 0x3d,
+	0xe5, 0xca, 0x98, 0x78, 0xa8, 0xd7, 0xe6, 0x3c, 0xd4, 0xdd, 0x19, 0xd7, 0x42, 0x9f, 0x87, 0xdd,
+	0x93, 0xa1, 0xbd, 0x32, 0xac, 0x88, 0xc5, 0x2f, 0x88, 0x6d, 0xae, 0xf0, 0x42, 0x9a, 0x20, 0x4b,
+	0xbd, 0x44, 0x16, 0x0f, 0x96, 0x06, 0x4c, 0x4a, 0xda, 0xb3, 0x2f, 0x77, 0x2b, 0x92, 0x87, 0xd0,
+	0x8c, 0x2d, 0x13, 0x74, 0x7e, 0x5a, 0x1b, 0x5e, 0xe5, 0xf4, 0x23, 0xa6, 0x04, 0x63, 0x53, 0xff,
+	0x18, 0xde, 0x3a, 0x95, 0xe1, 0x67, 0xe9, 0xbe, 0xf7, 0x4b, 0xdd, 0xf7, 0xe6, 0xe8, 0x42, 0x9f,
+	0x76, 0x6c, 0x3a, 0x71, 0xe3, 0xcf, 0x05, 0x70, 0x3b, 0x3c, 0x24, 0xf7, 0xa0, 0x11, 0xb0, 0x5e,
+	0x82, 0xed, 0x46, 0x56, 0x8b, 0x66, 0xb4, 0xbf, 0xeb, 0xda, 0x93, 0x5b, 0xf9, 0xe7, 0xc8, 0x16,
+	0x5c, 0xdd, 0xcb, 0x43, 0x19, 0x89, 0x24, 0x64, 0x53, 0x3f, 0xb8, 0xa6, 0x16, 0xcf, 0x7e, 0x55,
+	0xf8, 0xe7, 0x3e, 0x70, 0xc8, 0xd7, 0x70, 0xfd, 0x54, 0x47, 0xfa, 0x67, 0xc1, 0x94, 0xb3, 0xab,
+	0x33, 0x9d, 0xa1, 0xad, 0x76, 0xf8, 0x00, 0xea, 0x66, 0x7a, 0x11, 0xa2, 0x0d, 0x4b, 0x8f, 0xf9,
+	0xf6, 0xc5, 0x12, 0x66, 0xd2, 0xea, 0x9f, 0x23, 0x9f, 0x42, 0xc3, 0xbe, 0x34, 0x89, 0xa1, 0x6a,
+	0xe5, 0x51, 0xdc, 0xbe, 0x5c, 0x41, 0x47, 0x4b, 0x3f, 0x86, 0xe6, 0xe8, 0x69, 0x47, 0x8c, 0x55,
+	0xf5, 0xa9, 0xd7, 0x5e, 0xb6, 0x21, 0xeb, 0xb7, 0x99, 0x0e, 0xf3, 0x1b, 0x58, 0xab, 0x0e, 0x59,
+	0x72, 0x6d, 0x22, 0xb8, 0xa9, 0x37, 0x56, 0xfb, 0xfa, 0x29, 0xda, 0x51, 0x24, 0xfb, 0xfa, 0x7d,
+	0x36, 0x8b, 0x40, 0xe4, 0x96, 0x5d, 0x3b, 0x67, 0x80, 0xb6, 0x6f, 0xcf, 0x37, 0xb2, 0xfb, 0x3c,
+	0x5e, 0xfa, 0x7e, 0x71, 0xfd, 0xf3, 0x3e, 0x0f, 0xc3, 0xba, 0xfe, 0x17, 0xe0, 0xc1, 0x7f, 0x03,
+	0x00, 0xbf, 0x72, 0x6c, 0x15, 0x12, 0x10, 0x00, 0x00,
 }
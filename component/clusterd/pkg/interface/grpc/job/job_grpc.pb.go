@@ -22,6 +22,11 @@ const (
 	Job_Register_FullMethodName                      = "/job.Job/Register"
 	Job_SubscribeJobSummarySignal_FullMethodName     = "/job.Job/SubscribeJobSummarySignal"
 	Job_SubscribeJobSummarySignalList_FullMethodName = "/job.Job/SubscribeJobSummarySignalList"
+	Job_GetJob_FullMethodName                        = "/job.Job/GetJob"
+	Job_ListJobs_FullMethodName                      = "/job.Job/ListJobs"
+	Job_WatchJobs_FullMethodName                     = "/job.Job/WatchJobs"
+	Job_GetJobStatistics_FullMethodName              = "/job.Job/GetJobStatistics"
+	Job_GetSchedulingExceptions_FullMethodName       = "/job.Job/GetSchedulingExceptions"
 )
 
 // JobClient is the client API for Job service.
@@ -31,6 +36,11 @@ type JobClient interface {
 	Register(ctx context.Context, in *ClientInfo, opts ...grpc.CallOption) (*Status, error)
 	SubscribeJobSummarySignal(ctx context.Context, in *ClientInfo, opts ...grpc.CallOption) (Job_SubscribeJobSummarySignalClient, error)
 	SubscribeJobSummarySignalList(ctx context.Context, in *ClientInfo, opts ...grpc.CallOption) (Job_SubscribeJobSummarySignalListClient, error)
+	GetJob(ctx context.Context, in *GetJobRequest, opts ...grpc.CallOption) (*GetJobResponse, error)
+	ListJobs(ctx context.Context, in *ListJobsRequest, opts ...grpc.CallOption) (*ListJobsResponse, error)
+	WatchJobs(ctx context.Context, in *WatchJobsRequest, opts ...grpc.CallOption) (Job_WatchJobsClient, error)
+	GetJobStatistics(ctx context.Context, in *GetJobStatisticsRequest, opts ...grpc.CallOption) (*GetJobStatisticsResponse, error)
+	GetSchedulingExceptions(ctx context.Context, in *GetSchedulingExceptionsRequest, opts ...grpc.CallOption) (*GetSchedulingExceptionsResponse, error)
 }
 
 type jobClient struct {
@@ -114,6 +124,74 @@ func (x *jobSubscribeJobSummarySignalListClient) Recv() (*JobSummarySignalList,
 	return m, nil
 }
 
+func (c *jobClient) GetJob(ctx context.Context, in *GetJobRequest, opts ...grpc.CallOption) (*GetJobResponse, error) {
+	out := new(GetJobResponse)
+	err := c.cc.Invoke(ctx, Job_GetJob_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobClient) ListJobs(ctx context.Context, in *ListJobsRequest, opts ...grpc.CallOption) (*ListJobsResponse, error) {
+	out := new(ListJobsResponse)
+	err := c.cc.Invoke(ctx, Job_ListJobs_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobClient) WatchJobs(ctx context.Context, in *WatchJobsRequest, opts ...grpc.CallOption) (Job_WatchJobsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Job_ServiceDesc.Streams[2], Job_WatchJobs_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &jobWatchJobsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Job_WatchJobsClient interface {
+	Recv() (*JobEvent, error)
+	grpc.ClientStream
+}
+
+type jobWatchJobsClient struct {
+	grpc.ClientStream
+}
+
+func (x *jobWatchJobsClient) Recv() (*JobEvent, error) {
+	m := new(JobEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *jobClient) GetJobStatistics(ctx context.Context, in *GetJobStatisticsRequest, opts ...grpc.CallOption) (*GetJobStatisticsResponse, error) {
+	out := new(GetJobStatisticsResponse)
+	err := c.cc.Invoke(ctx, Job_GetJobStatistics_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobClient) GetSchedulingExceptions(ctx context.Context, in *GetSchedulingExceptionsRequest, opts ...grpc.CallOption) (*GetSchedulingExceptionsResponse, error) {
+	out := new(GetSchedulingExceptionsResponse)
+	err := c.cc.Invoke(ctx, Job_GetSchedulingExceptions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // JobServer is the server API for Job service.
 // All implementations must embed UnimplementedJobServer
 // for forward compatibility
@@ -121,6 +199,11 @@ type JobServer interface {
 	Register(context.Context, *ClientInfo) (*Status, error)
 	SubscribeJobSummarySignal(*ClientInfo, Job_SubscribeJobSummarySignalServer) error
 	SubscribeJobSummarySignalList(*ClientInfo, Job_SubscribeJobSummarySignalListServer) error
+	GetJob(context.Context, *GetJobRequest) (*GetJobResponse, error)
+	ListJobs(context.Context, *ListJobsRequest) (*ListJobsResponse, error)
+	WatchJobs(*WatchJobsRequest, Job_WatchJobsServer) error
+	GetJobStatistics(context.Context, *GetJobStatisticsRequest) (*GetJobStatisticsResponse, error)
+	GetSchedulingExceptions(context.Context, *GetSchedulingExceptionsRequest) (*GetSchedulingExceptionsResponse, error)
 	mustEmbedUnimplementedJobServer()
 }
 
@@ -137,6 +220,21 @@ func (UnimplementedJobServer) SubscribeJobSummarySignal(*ClientInfo, Job_Subscri
 func (UnimplementedJobServer) SubscribeJobSummarySignalList(*ClientInfo, Job_SubscribeJobSummarySignalListServer) error {
 	return status.Errorf(codes.Unimplemented, "method SubscribeJobSummarySignalList not implemented")
 }
+func (UnimplementedJobServer) GetJob(context.Context, *GetJobRequest) (*GetJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetJob not implemented")
+}
+func (UnimplementedJobServer) ListJobs(context.Context, *ListJobsRequest) (*ListJobsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListJobs not implemented")
+}
+func (UnimplementedJobServer) WatchJobs(*WatchJobsRequest, Job_WatchJobsServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchJobs not implemented")
+}
+func (UnimplementedJobServer) GetJobStatistics(context.Context, *GetJobStatisticsRequest) (*GetJobStatisticsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetJobStatistics not implemented")
+}
+func (UnimplementedJobServer) GetSchedulingExceptions(context.Context, *GetSchedulingExceptionsRequest) (*GetSchedulingExceptionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSchedulingExceptions not implemented")
+}
 func (UnimplementedJobServer) mustEmbedUnimplementedJobServer() {}
 
 // UnsafeJobServer may be embedded to opt out of forward compatibility for this service.
@@ -210,6 +308,99 @@ func (x *jobSubscribeJobSummarySignalListServer) Send(m *JobSummarySignalList) e
 	return x.ServerStream.SendMsg(m)
 }
 
+func _Job_GetJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServer).GetJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Job_GetJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServer).GetJob(ctx, req.(*GetJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Job_ListJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListJobsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServer).ListJobs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Job_ListJobs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServer).ListJobs(ctx, req.(*ListJobsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Job_WatchJobs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchJobsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(JobServer).WatchJobs(m, &jobWatchJobsServer{stream})
+}
+
+type Job_WatchJobsServer interface {
+	Send(*JobEvent) error
+	grpc.ServerStream
+}
+
+type jobWatchJobsServer struct {
+	grpc.ServerStream
+}
+
+func (x *jobWatchJobsServer) Send(m *JobEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Job_GetJobStatistics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetJobStatisticsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServer).GetJobStatistics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Job_GetJobStatistics_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServer).GetJobStatistics(ctx, req.(*GetJobStatisticsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Job_GetSchedulingExceptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSchedulingExceptionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServer).GetSchedulingExceptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Job_GetSchedulingExceptions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServer).GetSchedulingExceptions(ctx, req.(*GetSchedulingExceptionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Job_ServiceDesc is the grpc.ServiceDesc for Job service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -221,6 +412,22 @@ var Job_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Register",
 			Handler:    _Job_Register_Handler,
 		},
+		{
+			MethodName: "GetJob",
+			Handler:    _Job_GetJob_Handler,
+		},
+		{
+			MethodName: "ListJobs",
+			Handler:    _Job_ListJobs_Handler,
+		},
+		{
+			MethodName: "GetJobStatistics",
+			Handler:    _Job_GetJobStatistics_Handler,
+		},
+		{
+			MethodName: "GetSchedulingExceptions",
+			Handler:    _Job_GetSchedulingExceptions_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -233,6 +440,11 @@ var Job_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _Job_SubscribeJobSummarySignalList_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "WatchJobs",
+			Handler:       _Job_WatchJobs_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "job.proto",
 }
@@ -1126,6 +1126,116 @@ func (m *StressTestOpResult) GetResult() string {
 	return ""
 }
 
+type StateMachineGraphRequest struct {
+	JobId                string   `protobuf:"bytes,1,opt,name=jobId,proto3" json:"jobId,omitempty"`
+	Format               string   `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StateMachineGraphRequest) Reset()         { *m = StateMachineGraphRequest{} }
+func (m *StateMachineGraphRequest) String() string { return proto.CompactTextString(m) }
+func (*StateMachineGraphRequest) ProtoMessage()    {}
+func (*StateMachineGraphRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e825e73050144430, []int{22}
+}
+
+func (m *StateMachineGraphRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StateMachineGraphRequest.Unmarshal(m, b)
+}
+func (m *StateMachineGraphRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StateMachineGraphRequest.Marshal(b, m, deterministic)
+}
+func (m *StateMachineGraphRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StateMachineGraphRequest.Merge(m, src)
+}
+func (m *StateMachineGraphRequest) XXX_Size() int {
+	return xxx_messageInfo_StateMachineGraphRequest.Size(m)
+}
+func (m *StateMachineGraphRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StateMachineGraphRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StateMachineGraphRequest proto.InternalMessageInfo
+
+func (m *StateMachineGraphRequest) GetJobId() string {
+	if m != nil {
+		return m.JobId
+	}
+	return ""
+}
+
+func (m *StateMachineGraphRequest) GetFormat() string {
+	if m != nil {
+		return m.Format
+	}
+	return ""
+}
+
+type StateMachineGraphResponse struct {
+	Status               *Status  `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Graph                string   `protobuf:"bytes,2,opt,name=graph,proto3" json:"graph,omitempty"`
+	SpecVersion          string   `protobuf:"bytes,3,opt,name=specVersion,proto3" json:"specVersion,omitempty"`
+	State                string   `protobuf:"bytes,4,opt,name=state,proto3" json:"state,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StateMachineGraphResponse) Reset()         { *m = StateMachineGraphResponse{} }
+func (m *StateMachineGraphResponse) String() string { return proto.CompactTextString(m) }
+func (*StateMachineGraphResponse) ProtoMessage()    {}
+func (*StateMachineGraphResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e825e73050144430, []int{23}
+}
+
+func (m *StateMachineGraphResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StateMachineGraphResponse.Unmarshal(m, b)
+}
+func (m *StateMachineGraphResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StateMachineGraphResponse.Marshal(b, m, deterministic)
+}
+func (m *StateMachineGraphResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StateMachineGraphResponse.Merge(m, src)
+}
+func (m *StateMachineGraphResponse) XXX_Size() int {
+	return xxx_messageInfo_StateMachineGraphResponse.Size(m)
+}
+func (m *StateMachineGraphResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_StateMachineGraphResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StateMachineGraphResponse proto.InternalMessageInfo
+
+func (m *StateMachineGraphResponse) GetStatus() *Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *StateMachineGraphResponse) GetGraph() string {
+	if m != nil {
+		return m.Graph
+	}
+	return ""
+}
+
+func (m *StateMachineGraphResponse) GetSpecVersion() string {
+	if m != nil {
+		return m.SpecVersion
+	}
+	return ""
+}
+
+func (m *StateMachineGraphResponse) GetState() string {
+	if m != nil {
+		return m.State
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Status)(nil), "Status")
 	proto.RegisterType((*ClientInfo)(nil), "ClientInfo")
@@ -1154,6 +1264,8 @@ func init() {
 	proto.RegisterType((*StressTestRankResult)(nil), "StressTestRankResult")
 	proto.RegisterMapType((map[string]*StressTestOpResult)(nil), "StressTestRankResult.RankResultEntry")
 	proto.RegisterType((*StressTestOpResult)(nil), "StressTestOpResult")
+	proto.RegisterType((*StateMachineGraphRequest)(nil), "StateMachineGraphRequest")
+	proto.RegisterType((*StateMachineGraphResponse)(nil), "StateMachineGraphResponse")
 }
 
 func init() {
@@ -1161,75 +1273,81 @@ func init() {
 }
 
 var fileDescriptor_e825e73050144430 = []byte{
-	// 1119 bytes of a gzipped FileDescriptorProto
+	// 1208 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0xdd, 0x72, 0xdb, 0xc4,
-	0x17, 0xaf, 0xec, 0xc4, 0x89, 0x8f, 0xf3, 0xb9, 0x89, 0x5b, 0x8d, 0xff, 0xfd, 0x17, 0x57, 0x9d,
-	0x76, 0x1c, 0x98, 0x59, 0x32, 0x61, 0xf8, 0x4c, 0x2f, 0x80, 0x24, 0x6d, 0xc3, 0x50, 0xa7, 0xb3,
-	0xce, 0xc0, 0x0c, 0x77, 0x8a, 0xb2, 0x71, 0x44, 0x14, 0xad, 0xd0, 0xae, 0x43, 0xcc, 0x5b, 0x70,
-	0xcd, 0x23, 0x70, 0xc5, 0x43, 0x70, 0xc1, 0x43, 0xf0, 0x16, 0x3c, 0x00, 0xb3, 0x1f, 0x92, 0x56,
-	0xb2, 0x1c, 0x86, 0xa1, 0x77, 0xbb, 0x67, 0xcf, 0xef, 0x9c, 0xdf, 0xf9, 0xd0, 0x9e, 0x15, 0xac,
-	0xa6, 0x34, 0x60, 0x37, 0x34, 0xc5, 0x49, 0xca, 0x04, 0xf3, 0x76, 0xa1, 0x35, 0x12, 0xbe, 0x98,
-	0x70, 0x84, 0x60, 0x21, 0x60, 0xe7, 0xd4, 0x75, 0xfa, 0xce, 0x60, 0x91, 0xa8, 0xb5, 0x94, 0x85,
-	0xf1, 0x05, 0x73, 0x1b, 0x7d, 0x67, 0xd0, 0x26, 0x6a, 0xed, 0x7d, 0x04, 0x70, 0x10, 0x85, 0x34,
-	0x16, 0xc7, 0xf1, 0x05, 0x43, 0xdb, 0xb0, 0xf8, 0x3d, 0x3b, 0x3b, 0x3e, 0x57, 0xb0, 0x36, 0xd1,
-	0x1b, 0x89, 0x4b, 0x59, 0x44, 0x33, 0x9c, 0x5c, 0x7b, 0x5f, 0x40, 0xfb, 0x85, 0x3f, 0x89, 0x04,
-	0xf1, 0xe3, 0x2b, 0x74, 0x1f, 0x5a, 0xa9, 0x1f, 0x5f, 0xe5, 0x38, 0xb3, 0x43, 0x0f, 0xa1, 0x7d,
-	0x21, 0x95, 0x4e, 0xa7, 0x49, 0x86, 0x2e, 0x04, 0xde, 0xaf, 0x0d, 0xd8, 0x7a, 0x93, 0xb2, 0x80,
-	0x72, 0xfe, 0xda, 0x8f, 0xfd, 0x31, 0x1d, 0x85, 0xe3, 0xd8, 0x8f, 0xa4, 0xbb, 0xc9, 0x24, 0xcc,
-	0x6c, 0xa9, 0x75, 0x41, 0xac, 0x61, 0x13, 0x7b, 0x04, 0xc0, 0x15, 0x46, 0x39, 0x68, 0xaa, 0x23,
-	0x4b, 0x82, 0x5c, 0x58, 0xf2, 0x03, 0x11, 0xb2, 0x98, 0xbb, 0x0b, 0xfd, 0xe6, 0xa0, 0x4d, 0xb2,
-	0x2d, 0x7a, 0x17, 0xe0, 0x22, 0xa3, 0xcf, 0xdd, 0xc5, 0x7e, 0x73, 0xd0, 0xd9, 0x03, 0x9c, 0x47,
-	0x44, 0xac, 0x53, 0xf4, 0x0c, 0xd6, 0x82, 0x4b, 0x3f, 0x1e, 0xd3, 0x91, 0x48, 0x7d, 0x41, 0xc7,
-	0x53, 0xb7, 0xa5, 0x3c, 0x55, 0xa4, 0xd2, 0x9b, 0x08, 0xaf, 0x29, 0x9b, 0x08, 0x77, 0xa9, 0xef,
-	0x0c, 0x9a, 0x24, 0xdb, 0xa2, 0x3e, 0x74, 0x62, 0x76, 0x4e, 0x89, 0xca, 0x0a, 0x77, 0x97, 0x15,
-	0x17, 0x5b, 0x24, 0x35, 0xe8, 0xad, 0x48, 0xfd, 0x37, 0x7e, 0xea, 0x5f, 0x73, 0xb7, 0xad, 0x1c,
-	0xd8, 0x22, 0xef, 0x16, 0xb6, 0x46, 0x82, 0x25, 0x07, 0xec, 0x3a, 0x89, 0xa8, 0xa0, 0x84, 0xfe,
-	0x30, 0xa1, 0x5c, 0xcc, 0xa9, 0xd8, 0x3b, 0xd0, 0xe2, 0xaa, 0x0f, 0x54, 0xbe, 0x3a, 0x7b, 0x4b,
-	0x58, 0xb7, 0x05, 0x31, 0xe2, 0x4a, 0xfc, 0xcd, 0xbb, 0xe2, 0xf7, 0x7e, 0x82, 0xfb, 0x44, 0x77,
-	0x59, 0x16, 0xea, 0xdd, 0xce, 0xcb, 0xb6, 0x1b, 0x77, 0xe6, 0x56, 0x56, 0x50, 0x1b, 0x0d, 0xa9,
-	0xe6, 0x21, 0x2b, 0x98, 0x4b, 0xbc, 0x9f, 0x1d, 0xd8, 0xce, 0x9d, 0xab, 0x08, 0xfe, 0x5b, 0xdc,
-	0x3d, 0x58, 0xe6, 0x59, 0x15, 0x75, 0xbf, 0xe4, 0x7b, 0x59, 0xe7, 0x90, 0xb3, 0xc8, 0x17, 0x79,
-	0xa1, 0x74, 0xd3, 0x54, 0xa4, 0xde, 0xb7, 0x79, 0xdb, 0xea, 0x98, 0xde, 0x56, 0x32, 0xbc, 0x7d,
-	0xd8, 0x1c, 0xfd, 0x18, 0x8a, 0xe0, 0x72, 0x18, 0x06, 0x84, 0xf2, 0x84, 0xc5, 0x9c, 0x66, 0x66,
-	0x0f, 0x6d, 0xb3, 0x87, 0x68, 0x03, 0x9a, 0xd7, 0x7c, 0x6c, 0xbe, 0x06, 0xb9, 0xf4, 0x06, 0xb0,
-	0x61, 0x81, 0x4b, 0x94, 0xca, 0x58, 0xef, 0x17, 0x07, 0x20, 0x57, 0xe5, 0x73, 0x1c, 0xbc, 0x0f,
-	0xad, 0x38, 0x0c, 0x4e, 0x92, 0x8c, 0xf3, 0x03, 0x5c, 0x40, 0xf0, 0x50, 0x9d, 0x1c, 0xc5, 0x22,
-	0x9d, 0x12, 0xa3, 0xd6, 0x7b, 0x01, 0x1d, 0x4b, 0x2c, 0x09, 0x5e, 0xd1, 0xa9, 0xb1, 0x29, 0x97,
-	0xe8, 0x31, 0x2c, 0xde, 0xf8, 0xd1, 0x84, 0x9a, 0xd2, 0x74, 0xf0, 0x21, 0xbd, 0x09, 0x03, 0xfa,
-	0x75, 0xc8, 0x05, 0xd1, 0x27, 0x9f, 0x35, 0x3e, 0x71, 0x3c, 0x0c, 0x50, 0x1c, 0x48, 0x33, 0xe7,
-	0xf4, 0xc6, 0x75, 0x54, 0x21, 0xe4, 0x12, 0xad, 0x41, 0x83, 0x25, 0x8a, 0xd4, 0x32, 0x69, 0xb0,
-	0xc4, 0x1b, 0xc2, 0x9a, 0x66, 0x26, 0x73, 0xa8, 0x30, 0xd9, 0x6d, 0x74, 0x68, 0x60, 0x66, 0x57,
-	0x45, 0x16, 0x05, 0x6b, 0x5a, 0x05, 0xf3, 0x9e, 0xc3, 0x8a, 0xb1, 0x47, 0xf9, 0x24, 0x9a, 0x57,
-	0x56, 0xe9, 0x43, 0x9d, 0xab, 0x68, 0x96, 0x89, 0xd9, 0x79, 0x7d, 0x58, 0x19, 0x89, 0x94, 0x72,
-	0x7e, 0x92, 0x64, 0xfc, 0x59, 0xc2, 0x15, 0x91, 0x26, 0x91, 0x4b, 0xef, 0x4f, 0x07, 0xd6, 0xb5,
-	0xca, 0x29, 0xe5, 0x42, 0x7d, 0xdc, 0x73, 0x4a, 0x70, 0x00, 0x1d, 0xae, 0x14, 0x95, 0x92, 0xa9,
-	0xc3, 0x63, 0x5c, 0x01, 0x9b, 0xbd, 0x5a, 0xeb, 0x8a, 0xd8, 0x28, 0x79, 0xb1, 0xf8, 0x51, 0x34,
-	0x64, 0xe7, 0x94, 0xcb, 0x62, 0x36, 0x15, 0x11, 0x5b, 0xd4, 0x7b, 0x0d, 0x1b, 0x55, 0x13, 0x35,
-	0xd5, 0x7b, 0x52, 0xae, 0xde, 0x2a, 0xb6, 0xc3, 0xb4, 0xeb, 0xb7, 0x03, 0x9b, 0x05, 0xc3, 0xbb,
-	0x1b, 0xf1, 0x39, 0x20, 0x5b, 0xf5, 0x5f, 0x36, 0xfc, 0xef, 0x0e, 0x6c, 0x5b, 0x70, 0x3f, 0xbe,
-	0xd2, 0x37, 0x25, 0x7a, 0x55, 0xce, 0x9b, 0xa3, 0xf2, 0xf6, 0x0c, 0xd7, 0xe9, 0xfe, 0x43, 0xf2,
-	0x6a, 0xa7, 0xce, 0xdb, 0x4e, 0xd8, 0x1f, 0x4e, 0x66, 0xcf, 0xa4, 0x61, 0x7e, 0xd7, 0xbd, 0x84,
-	0x15, 0x4d, 0x8f, 0x64, 0xbd, 0x27, 0x43, 0x7b, 0x82, 0xab, 0x70, 0x23, 0xd0, 0x1b, 0x1d, 0x57,
-	0x09, 0xd8, 0xfb, 0x26, 0x2b, 0x92, 0xa5, 0x52, 0x13, 0xc3, 0x7b, 0xe5, 0x18, 0xba, 0x95, 0x1c,
-	0x6a, 0xb0, 0x1d, 0xcb, 0x6f, 0x33, 0x35, 0x31, 0xf1, 0x1c, 0x01, 0xa4, 0xf9, 0xce, 0x94, 0xe4,
-	0x69, 0xad, 0x39, 0x5c, 0x2c, 0x35, 0x73, 0x0b, 0xd8, 0x23, 0xb0, 0x5e, 0x39, 0xae, 0x61, 0xbd,
-	0x53, 0x66, 0xbd, 0x65, 0xb9, 0x39, 0x49, 0x66, 0x39, 0x7f, 0x6e, 0x77, 0x61, 0xa6, 0x50, 0x7a,
-	0x3f, 0xb5, 0xcd, 0xfb, 0xa9, 0xfc, 0xd1, 0xb7, 0xb3, 0x8f, 0x7e, 0xef, 0xaf, 0x16, 0x2c, 0x99,
-	0x21, 0x85, 0x1e, 0xc1, 0xc2, 0x71, 0x1c, 0x0a, 0xd4, 0xc1, 0xc5, 0xb3, 0xaa, 0x97, 0x8d, 0x21,
-	0xef, 0x1e, 0xf2, 0x60, 0x99, 0xd0, 0x71, 0xc8, 0x05, 0x4d, 0xe7, 0xea, 0x1c, 0xc1, 0xc3, 0xd1,
-	0xe4, 0x8c, 0x07, 0x69, 0x78, 0x46, 0xeb, 0x1e, 0x48, 0x25, 0xdc, 0x36, 0xae, 0x51, 0xf1, 0xee,
-	0xed, 0x3a, 0xe8, 0x43, 0x40, 0x84, 0x26, 0x2c, 0x15, 0xf6, 0xbb, 0x01, 0x6d, 0xe3, 0x9a, 0x67,
-	0x84, 0xed, 0x7d, 0x1f, 0xba, 0x1a, 0x56, 0x19, 0xfa, 0xe8, 0x01, 0xae, 0x7f, 0x06, 0xd8, 0xe0,
-	0x8f, 0x61, 0xab, 0x02, 0x56, 0x63, 0xb7, 0x8b, 0xeb, 0x86, 0xb8, 0x0d, 0xcc, 0xc9, 0xda, 0xa3,
-	0x15, 0xe5, 0xc1, 0xd9, 0x93, 0xd6, 0x86, 0x0d, 0xb2, 0xdb, 0x7f, 0x18, 0x06, 0xa7, 0xa9, 0x1f,
-	0x5c, 0xa1, 0x8e, 0x35, 0xa8, 0xca, 0x49, 0x75, 0xf3, 0xa4, 0xe6, 0x1a, 0x26, 0xa1, 0x9b, 0xb8,
-	0x3a, 0x3a, 0x7b, 0x08, 0xcf, 0x8c, 0x62, 0x95, 0xd4, 0x4f, 0xa1, 0x9b, 0x9b, 0x19, 0x32, 0x11,
-	0x5e, 0x4c, 0xb5, 0x5e, 0xb9, 0x28, 0xeb, 0xb8, 0x3c, 0x93, 0x14, 0x74, 0x57, 0x3e, 0x65, 0x92,
-	0x68, 0x6a, 0x1b, 0x96, 0x41, 0xae, 0x62, 0x7b, 0xe0, 0xd8, 0x9c, 0x9f, 0x42, 0xe7, 0x15, 0xf5,
-	0x23, 0x71, 0x79, 0x70, 0x49, 0x55, 0x68, 0xf5, 0xfd, 0xb2, 0x03, 0x50, 0x74, 0x30, 0xda, 0xa8,
-	0x4e, 0x08, 0x5b, 0xf5, 0x2b, 0xf8, 0x5f, 0x91, 0x85, 0xd9, 0xbb, 0x17, 0xe1, 0x99, 0xbb, 0xbb,
-	0xb7, 0x85, 0x67, 0x15, 0x55, 0x3c, 0x2f, 0xe1, 0xff, 0x95, 0x54, 0x1c, 0xdd, 0xd2, 0xc0, 0x62,
-	0x52, 0xe2, 0xdb, 0xad, 0xbd, 0x80, 0x4d, 0xa3, 0x76, 0x75, 0x62, 0xaa, 0xb7, 0xe0, 0xe6, 0xcc,
-	0xcd, 0x66, 0xc5, 0xf2, 0x65, 0xeb, 0xbb, 0x05, 0xbc, 0x9f, 0x9c, 0x9d, 0xb5, 0xd4, 0xbf, 0xcf,
-	0x07, 0x7f, 0x07, 0x00, 0x00, 0xff, 0xff, 0x08, 0xd4, 0x17, 0x94, 0x0c, 0x0d, 0x00, 0x00,
+	0x17, 0xaf, 0xec, 0xc4, 0x89, 0x8f, 0xfb, 0x91, 0x6c, 0xec, 0x54, 0xf5, 0xbf, 0xff, 0xe2, 0xaa,
+	0xd3, 0x8e, 0x03, 0x33, 0x4b, 0x26, 0x0c, 0x9f, 0xe9, 0x05, 0x90, 0xa4, 0x49, 0x18, 0xe2, 0x74,
+	0xd6, 0x99, 0x32, 0xc3, 0x9d, 0xa2, 0xac, 0x6d, 0x61, 0x45, 0x2b, 0xb4, 0xeb, 0x10, 0xf3, 0x08,
+	0xdc, 0x71, 0xcd, 0x23, 0x70, 0xc5, 0x43, 0xf4, 0x82, 0x87, 0xe0, 0x5d, 0x98, 0xdd, 0x95, 0xe4,
+	0x95, 0x2c, 0x9b, 0x61, 0xe8, 0xdd, 0x9e, 0xa3, 0xf3, 0x3b, 0xdf, 0xbb, 0xe7, 0x08, 0xee, 0xc5,
+	0xd4, 0x63, 0x37, 0x34, 0xc6, 0x51, 0xcc, 0x04, 0x73, 0x76, 0xa1, 0xd6, 0x17, 0xae, 0x98, 0x70,
+	0x84, 0x60, 0xc5, 0x63, 0x57, 0xd4, 0xb6, 0x3a, 0x56, 0x77, 0x95, 0xa8, 0xb3, 0xe4, 0xf9, 0xe1,
+	0x80, 0xd9, 0x95, 0x8e, 0xd5, 0xad, 0x13, 0x75, 0x76, 0x3e, 0x01, 0x38, 0x08, 0x7c, 0x1a, 0x8a,
+	0xd3, 0x70, 0xc0, 0x50, 0x13, 0x56, 0x7f, 0x60, 0x97, 0xa7, 0x57, 0x0a, 0x56, 0x27, 0x9a, 0x90,
+	0xb8, 0x98, 0x05, 0x34, 0xc5, 0xc9, 0xb3, 0xf3, 0x15, 0xd4, 0x5f, 0xb9, 0x93, 0x40, 0x10, 0x37,
+	0x1c, 0xa3, 0x6d, 0xa8, 0xc5, 0x6e, 0x38, 0xce, 0x70, 0x09, 0x85, 0x1e, 0x43, 0x7d, 0x20, 0x85,
+	0x2e, 0xa6, 0x51, 0x8a, 0x9e, 0x31, 0x9c, 0xdf, 0x2b, 0xb0, 0xf5, 0x3a, 0x66, 0x1e, 0xe5, 0xfc,
+	0xcc, 0x0d, 0xdd, 0x21, 0xed, 0xfb, 0xc3, 0xd0, 0x0d, 0xa4, 0xb9, 0xc9, 0xc4, 0x4f, 0x75, 0xa9,
+	0xf3, 0xcc, 0xb1, 0x8a, 0xe9, 0xd8, 0x13, 0x00, 0xae, 0x30, 0xca, 0x40, 0x55, 0x7d, 0x32, 0x38,
+	0xc8, 0x86, 0x35, 0xd7, 0x13, 0x3e, 0x0b, 0xb9, 0xbd, 0xd2, 0xa9, 0x76, 0xeb, 0x24, 0x25, 0xd1,
+	0xfb, 0x00, 0x83, 0xd4, 0x7d, 0x6e, 0xaf, 0x76, 0xaa, 0xdd, 0xc6, 0x1e, 0xe0, 0x2c, 0x22, 0x62,
+	0x7c, 0x45, 0x2f, 0xe0, 0xbe, 0x37, 0x72, 0xc3, 0x21, 0xed, 0x8b, 0xd8, 0x15, 0x74, 0x38, 0xb5,
+	0x6b, 0xca, 0x52, 0x81, 0x2b, 0xad, 0x09, 0xff, 0x9a, 0xb2, 0x89, 0xb0, 0xd7, 0x3a, 0x56, 0xb7,
+	0x4a, 0x52, 0x12, 0x75, 0xa0, 0x11, 0xb2, 0x2b, 0x4a, 0x54, 0x56, 0xb8, 0xbd, 0xae, 0x7c, 0x31,
+	0x59, 0x52, 0x82, 0xde, 0x8a, 0xd8, 0x7d, 0xed, 0xc6, 0xee, 0x35, 0xb7, 0xeb, 0xca, 0x80, 0xc9,
+	0x72, 0x6e, 0x61, 0xab, 0x2f, 0x58, 0x74, 0xc0, 0xae, 0xa3, 0x80, 0x0a, 0x4a, 0xe8, 0x8f, 0x13,
+	0xca, 0xc5, 0x82, 0x8a, 0xbd, 0x07, 0x35, 0xae, 0xfa, 0x40, 0xe5, 0xab, 0xb1, 0xb7, 0x86, 0x75,
+	0x5b, 0x90, 0x84, 0x5d, 0x88, 0xbf, 0xba, 0x2c, 0x7e, 0xe7, 0x67, 0xd8, 0x26, 0xba, 0xcb, 0xd2,
+	0x50, 0x97, 0x1b, 0xcf, 0xeb, 0xae, 0x2c, 0xcd, 0xad, 0xac, 0xa0, 0x56, 0xea, 0x53, 0xed, 0x47,
+	0x9d, 0x18, 0x1c, 0xe7, 0x57, 0x0b, 0x9a, 0x99, 0x71, 0x15, 0xc1, 0x7f, 0x8b, 0xbb, 0x0d, 0xeb,
+	0x3c, 0xad, 0xa2, 0xee, 0x97, 0x8c, 0x96, 0x75, 0xf6, 0x39, 0x0b, 0x5c, 0x91, 0x15, 0x4a, 0x37,
+	0x4d, 0x81, 0xeb, 0x7c, 0x97, 0xb5, 0xad, 0x8e, 0xe9, 0x5d, 0x25, 0xc3, 0xd9, 0x87, 0xcd, 0xfe,
+	0x4f, 0xbe, 0xf0, 0x46, 0x3d, 0xdf, 0x23, 0x94, 0x47, 0x2c, 0xe4, 0x34, 0x55, 0x7b, 0x68, 0xaa,
+	0x3d, 0x44, 0x1b, 0x50, 0xbd, 0xe6, 0xc3, 0xe4, 0x36, 0xc8, 0xa3, 0xd3, 0x85, 0x0d, 0x03, 0x9c,
+	0x73, 0x29, 0x8f, 0x75, 0x7e, 0xb3, 0x00, 0x32, 0x51, 0xbe, 0xc0, 0xc0, 0x87, 0x50, 0x0b, 0x7d,
+	0xef, 0x3c, 0x4a, 0x7d, 0x7e, 0x88, 0x67, 0x10, 0xdc, 0x53, 0x5f, 0x8e, 0x42, 0x11, 0x4f, 0x49,
+	0x22, 0xd6, 0x7e, 0x05, 0x0d, 0x83, 0x2d, 0x1d, 0x1c, 0xd3, 0x69, 0xa2, 0x53, 0x1e, 0xd1, 0x53,
+	0x58, 0xbd, 0x71, 0x83, 0x09, 0x4d, 0x4a, 0xd3, 0xc0, 0x87, 0xf4, 0xc6, 0xf7, 0xe8, 0xb7, 0x3e,
+	0x17, 0x44, 0x7f, 0xf9, 0xa2, 0xf2, 0x99, 0xe5, 0x60, 0x80, 0xd9, 0x07, 0xa9, 0xe6, 0x8a, 0xde,
+	0xd8, 0x96, 0x2a, 0x84, 0x3c, 0xa2, 0xfb, 0x50, 0x61, 0x91, 0x72, 0x6a, 0x9d, 0x54, 0x58, 0xe4,
+	0xf4, 0xe0, 0xbe, 0xf6, 0x4c, 0xe6, 0x50, 0x61, 0xd2, 0xd7, 0xe8, 0x30, 0x81, 0x25, 0x54, 0x11,
+	0x39, 0x2b, 0x58, 0xd5, 0x28, 0x98, 0xf3, 0x12, 0xee, 0x26, 0xfa, 0x28, 0x9f, 0x04, 0x8b, 0xca,
+	0x2a, 0x6d, 0xa8, 0xef, 0x2a, 0x9a, 0x75, 0x92, 0x50, 0x4e, 0x07, 0xee, 0xf6, 0x45, 0x4c, 0x39,
+	0x3f, 0x8f, 0x52, 0xff, 0x59, 0xc4, 0x95, 0x23, 0x55, 0x22, 0x8f, 0xce, 0x5f, 0x16, 0x3c, 0xd0,
+	0x22, 0x17, 0x94, 0x0b, 0x75, 0xb9, 0x17, 0x94, 0xe0, 0x00, 0x1a, 0x5c, 0x09, 0x2a, 0xa1, 0xa4,
+	0x0e, 0x4f, 0x71, 0x01, 0x9c, 0xd0, 0xea, 0xac, 0x2b, 0x62, 0xa2, 0xe4, 0xc3, 0xe2, 0x06, 0x41,
+	0x8f, 0x5d, 0x51, 0x7e, 0x1e, 0xe9, 0x1b, 0x56, 0x25, 0x26, 0xab, 0x7d, 0x06, 0x1b, 0x45, 0x15,
+	0x25, 0xd5, 0x7b, 0x96, 0xaf, 0xde, 0x3d, 0x6c, 0x86, 0x69, 0xd6, 0x6f, 0x07, 0x36, 0x67, 0x1e,
+	0x2e, 0x6f, 0xc4, 0x97, 0x80, 0x4c, 0xd1, 0x7f, 0xd9, 0xf0, 0x6f, 0x2d, 0x68, 0x1a, 0x70, 0x37,
+	0x1c, 0xeb, 0x97, 0x12, 0x9d, 0xe4, 0xf3, 0x66, 0xa9, 0xbc, 0xbd, 0xc0, 0x65, 0xb2, 0xff, 0x90,
+	0xbc, 0xd2, 0xa9, 0xf3, 0xae, 0x13, 0xf6, 0xa7, 0x05, 0x1b, 0x86, 0x6f, 0xcb, 0xba, 0xee, 0x18,
+	0xee, 0x6a, 0xf7, 0x48, 0xda, 0x7b, 0x32, 0xb4, 0x67, 0xb8, 0x08, 0x4f, 0x18, 0x9a, 0xd0, 0x71,
+	0xe5, 0x80, 0xed, 0x37, 0x69, 0x91, 0x0c, 0x91, 0x92, 0x18, 0x3e, 0xc8, 0xc7, 0xd0, 0x2a, 0xe4,
+	0x50, 0x83, 0xcd, 0x58, 0xfe, 0x98, 0xab, 0x49, 0x12, 0xcf, 0x11, 0x40, 0x9c, 0x51, 0x49, 0x49,
+	0x9e, 0x97, 0xaa, 0xc3, 0xb3, 0xa3, 0xf6, 0xdc, 0x00, 0xb6, 0x09, 0x3c, 0x28, 0x7c, 0x2e, 0xf1,
+	0x7a, 0x27, 0xef, 0xf5, 0x96, 0x61, 0xe6, 0x3c, 0x9a, 0xf7, 0xf9, 0x4b, 0x40, 0xf3, 0x02, 0xb9,
+	0xfd, 0xa9, 0x9e, 0xec, 0x4f, 0xf9, 0x4b, 0x5f, 0xcf, 0x2e, 0xfd, 0x09, 0xd8, 0x72, 0xcc, 0xd0,
+	0x33, 0xd7, 0x1b, 0xf9, 0x21, 0x3d, 0x8e, 0xdd, 0x68, 0xb4, 0x7c, 0x2a, 0x6c, 0x43, 0x6d, 0xc0,
+	0xe2, 0x6b, 0x37, 0xd3, 0xa4, 0x29, 0xe7, 0x17, 0x0b, 0x1e, 0x95, 0xa8, 0x4a, 0x6e, 0xc6, 0x6c,
+	0xba, 0x59, 0xe5, 0xd3, 0xad, 0x09, 0xab, 0x43, 0x89, 0x48, 0xfb, 0x55, 0x11, 0xf2, 0x09, 0xe0,
+	0x11, 0xf5, 0xde, 0xd0, 0x98, 0xfb, 0x2c, 0x4c, 0x5e, 0x3b, 0x93, 0x25, 0x71, 0x52, 0x03, 0xb5,
+	0x57, 0x34, 0x4e, 0x11, 0x7b, 0x6f, 0xd7, 0x60, 0x2d, 0x99, 0xbd, 0xe8, 0x09, 0xac, 0x9c, 0x86,
+	0xbe, 0x40, 0x0d, 0x3c, 0xdb, 0x16, 0xdb, 0xa9, 0x7d, 0xe7, 0x0e, 0x72, 0x60, 0x9d, 0xd0, 0xa1,
+	0xcf, 0x05, 0x8d, 0x17, 0xca, 0x1c, 0xc1, 0xe3, 0xfe, 0xe4, 0x92, 0x7b, 0xb1, 0x7f, 0x49, 0xcb,
+	0xf6, 0xbe, 0x1c, 0xae, 0x89, 0x4b, 0x44, 0x9c, 0x3b, 0xbb, 0x16, 0xfa, 0x18, 0x10, 0xa1, 0x11,
+	0x8b, 0x85, 0xb9, 0x0e, 0xa1, 0x26, 0x2e, 0xd9, 0x8e, 0x4c, 0xeb, 0xfb, 0xd0, 0xd2, 0xb0, 0xc2,
+	0x2e, 0x83, 0x1e, 0xe2, 0xf2, 0xed, 0xc6, 0x04, 0x7f, 0x0a, 0x5b, 0x05, 0xb0, 0xca, 0x77, 0x0b,
+	0x97, 0xed, 0x26, 0x26, 0x30, 0x73, 0xd6, 0xdc, 0x18, 0x50, 0x13, 0x9b, 0x64, 0x09, 0xac, 0x9b,
+	0x0e, 0xb5, 0x9e, 0xef, 0x5d, 0xc4, 0xae, 0x37, 0x46, 0x0d, 0x63, 0xfe, 0xe6, 0x93, 0x6a, 0x67,
+	0x49, 0xcd, 0x24, 0x92, 0x84, 0x6e, 0xe2, 0xe2, 0x46, 0xd0, 0x46, 0x78, 0x6e, 0xc3, 0x50, 0x49,
+	0xfd, 0x1c, 0x5a, 0x99, 0x9a, 0x1e, 0x13, 0xfe, 0x60, 0xaa, 0xe5, 0xf2, 0x45, 0x79, 0x80, 0xf3,
+	0xa3, 0x56, 0x41, 0x77, 0xe5, 0x86, 0x16, 0x05, 0x53, 0x53, 0xb1, 0x0c, 0xf2, 0x1e, 0x36, 0xe7,
+	0xa8, 0xe9, 0xf3, 0x73, 0x68, 0x9c, 0x50, 0x37, 0x10, 0xa3, 0x83, 0x11, 0xf5, 0xc6, 0x79, 0x13,
+	0x86, 0xd8, 0x0e, 0xc0, 0xec, 0x62, 0xa2, 0x8d, 0xe2, 0xe0, 0x33, 0x45, 0xbf, 0x81, 0xff, 0xcd,
+	0xb2, 0x30, 0x3f, 0x52, 0x10, 0x9e, 0x1b, 0x49, 0xed, 0x2d, 0x3c, 0x2f, 0xa8, 0xe2, 0x39, 0x86,
+	0xff, 0x17, 0x52, 0x71, 0x74, 0x4b, 0x3d, 0xc3, 0x93, 0x9c, 0xbf, 0xad, 0xd2, 0xb9, 0x92, 0x34,
+	0x6a, 0x4b, 0x27, 0xa6, 0xf8, 0xb8, 0x6f, 0xce, 0x3d, 0xd8, 0x66, 0x2c, 0xe7, 0xd0, 0x3c, 0xa6,
+	0x62, 0xee, 0x15, 0x40, 0x8f, 0xf0, 0xa2, 0x47, 0xa6, 0xdd, 0xc6, 0x0b, 0x1f, 0x0d, 0xe7, 0xce,
+	0xd7, 0xb5, 0xef, 0x57, 0xf0, 0x7e, 0x74, 0x79, 0x59, 0x53, 0xff, 0x88, 0x1f, 0xfd, 0x3d, 0x00,
+	0xbc, 0x1f, 0xd7, 0x3a, 0x34, 0x0e, 0x00, 0x00,
 }
@@ -35,6 +35,7 @@ const (
 	Recover_SubscribeStressTestResponse_FullMethodName   = "/Recover/SubscribeStressTestResponse"
 	Recover_SubscribeNotifyExecStressTest_FullMethodName = "/Recover/SubscribeNotifyExecStressTest"
 	Recover_ReplyStressTestResult_FullMethodName         = "/Recover/ReplyStressTestResult"
+	Recover_GetStateMachineGraph_FullMethodName          = "/Recover/GetStateMachineGraph"
 )
 
 // RecoverClient is the client API for Recover service.
@@ -57,6 +58,7 @@ type RecoverClient interface {
 	SubscribeStressTestResponse(ctx context.Context, in *StressTestRequest, opts ...grpc.CallOption) (Recover_SubscribeStressTestResponseClient, error)
 	SubscribeNotifyExecStressTest(ctx context.Context, in *ClientInfo, opts ...grpc.CallOption) (Recover_SubscribeNotifyExecStressTestClient, error)
 	ReplyStressTestResult(ctx context.Context, in *StressTestResult, opts ...grpc.CallOption) (*Status, error)
+	GetStateMachineGraph(ctx context.Context, in *StateMachineGraphRequest, opts ...grpc.CallOption) (*StateMachineGraphResponse, error)
 }
 
 type recoverClient struct {
@@ -326,6 +328,15 @@ func (c *recoverClient) ReplyStressTestResult(ctx context.Context, in *StressTes
 	return out, nil
 }
 
+func (c *recoverClient) GetStateMachineGraph(ctx context.Context, in *StateMachineGraphRequest, opts ...grpc.CallOption) (*StateMachineGraphResponse, error) {
+	out := new(StateMachineGraphResponse)
+	err := c.cc.Invoke(ctx, Recover_GetStateMachineGraph_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // RecoverServer is the server API for Recover service.
 // All implementations must embed UnimplementedRecoverServer
 // for forward compatibility
@@ -346,6 +357,7 @@ type RecoverServer interface {
 	SubscribeStressTestResponse(*StressTestRequest, Recover_SubscribeStressTestResponseServer) error
 	SubscribeNotifyExecStressTest(*ClientInfo, Recover_SubscribeNotifyExecStressTestServer) error
 	ReplyStressTestResult(context.Context, *StressTestResult) (*Status, error)
+	GetStateMachineGraph(context.Context, *StateMachineGraphRequest) (*StateMachineGraphResponse, error)
 	mustEmbedUnimplementedRecoverServer()
 }
 
@@ -401,6 +413,9 @@ func (UnimplementedRecoverServer) SubscribeNotifyExecStressTest(*ClientInfo, Rec
 func (UnimplementedRecoverServer) ReplyStressTestResult(context.Context, *StressTestResult) (*Status, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReplyStressTestResult not implemented")
 }
+func (UnimplementedRecoverServer) GetStateMachineGraph(context.Context, *StateMachineGraphRequest) (*StateMachineGraphResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStateMachineGraph not implemented")
+}
 func (UnimplementedRecoverServer) mustEmbedUnimplementedRecoverServer() {}
 
 // UnsafeRecoverServer may be embedded to opt out of forward compatibility for this service.
@@ -717,6 +732,24 @@ func _Recover_ReplyStressTestResult_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Recover_GetStateMachineGraph_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StateMachineGraphRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecoverServer).GetStateMachineGraph(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Recover_GetStateMachineGraph_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecoverServer).GetStateMachineGraph(ctx, req.(*StateMachineGraphRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Recover_ServiceDesc is the grpc.ServiceDesc for Recover service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -768,6 +801,10 @@ var Recover_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ReplyStressTestResult",
 			Handler:    _Recover_ReplyStressTestResult_Handler,
 		},
+		{
+			MethodName: "GetStateMachineGraph",
+			Handler:    _Recover_GetStateMachineGraph_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
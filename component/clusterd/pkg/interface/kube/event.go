@@ -0,0 +1,51 @@
+/* Copyright(C) 2026. Huawei Technologies Co.,Ltd. All rights reserved.
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package kube
+
+import (
+	"k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	typedcorev1 "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/tools/record"
+
+	"ascend-common/common-utils/hwlog"
+)
+
+const eventComponent = "clusterd"
+
+var eventRecorder record.EventRecorder
+
+// InitEventRecorder init the recorder which reports the kubernetes events of clusterd, must be called after the
+// k8s client is initialized
+func InitEventRecorder() {
+	if k8sClient == nil || k8sClient.ClientSet == nil {
+		hwlog.RunLog.Warn("k8s client is not initialized, kubernetes events will not be reported")
+		return
+	}
+	broadcaster := record.NewBroadcaster()
+	broadcaster.StartRecordingToSink(&typedcorev1.EventSinkImpl{
+		Interface: k8sClient.ClientSet.CoreV1().Events(""),
+	})
+	eventRecorder = broadcaster.NewRecorder(scheme.Scheme, v1.EventSource{Component: eventComponent})
+}
+
+// RecordEvent report the kubernetes event of the object asynchronously, nothing is done when the recorder is not
+// initialized or the object is nil
+func RecordEvent(ref *v1.ObjectReference, eventType, reason, message string) {
+	if eventRecorder == nil || ref == nil {
+		return
+	}
+	eventRecorder.Event(ref, eventType, reason, message)
+}
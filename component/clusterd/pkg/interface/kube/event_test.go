@@ -0,0 +1,43 @@
+/* Copyright(C) 2026. Huawei Technologies Co.,Ltd. All rights reserved.
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+// Package kube test for the kubernetes events
+package kube
+
+import (
+	"testing"
+
+	"github.com/smartystreets/goconvey/convey"
+	"k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/record"
+)
+
+func TestRecordEvent(t *testing.T) {
+	convey.Convey("Test RecordEvent", t, func() {
+		ref := &v1.ObjectReference{Kind: "AscendJob", Name: "job1", Namespace: "default"}
+		convey.Convey("01-recorder not initialized, should do nothing", func() {
+			eventRecorder = nil
+			convey.So(func() { RecordEvent(ref, v1.EventTypeNormal, "Test", "test") }, convey.ShouldNotPanic)
+		})
+		convey.Convey("02-recorder initialized, should record the event of the object", func() {
+			fakeRecorder := record.NewFakeRecorder(1)
+			eventRecorder = fakeRecorder
+			defer func() { eventRecorder = nil }()
+			RecordEvent(ref, v1.EventTypeWarning, "Test", "test")
+			RecordEvent(nil, v1.EventTypeWarning, "Test", "nil object")
+			convey.So(len(fakeRecorder.Events), convey.ShouldEqual, 1)
+			convey.So(<-fakeRecorder.Events, convey.ShouldEqual, "Warning Test test")
+		})
+	})
+}
@@ -238,6 +238,9 @@ func AddCmRankTableFunc(business string, func1 ...func(interface{}, interface{},
 
 // GetNodeFromIndexer get node from informer indexer
 func GetNodeFromIndexer(name string) (*v1.Node, error) {
+	if nodeInformer == nil {
+		return nil, fmt.Errorf("node informer is not initialized")
+	}
 	item, exist, err := nodeInformer.GetIndexer().GetByKey(name)
 	if err != nil || !exist {
 		return nil, fmt.Errorf("get node %s from informer failed, err: %v, exist: %v", name, err, exist)
@@ -11,6 +11,7 @@ import (
 	"sync"
 	"time"
 
+	authenticationv1 "k8s.io/api/authentication/v1"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -108,6 +109,23 @@ func GetConfigMap(cmName, cmNamespace string) (*v1.ConfigMap, error) {
 		cmName, metav1.GetOptions{})
 }
 
+// ListConfigMaps list the configMaps with the labels in the namespace
+func ListConfigMaps(namespace, labelSelector string) (*v1.ConfigMapList, error) {
+	return k8sClient.ClientSet.CoreV1().ConfigMaps(namespace).List(context.TODO(),
+		metav1.ListOptions{LabelSelector: labelSelector})
+}
+
+// ReviewToken review the bearer token by the kube-apiserver, return the status of the token
+func ReviewToken(token string) (*authenticationv1.TokenReviewStatus, error) {
+	review := &authenticationv1.TokenReview{Spec: authenticationv1.TokenReviewSpec{Token: token}}
+	res, err := k8sClient.ClientSet.AuthenticationV1().TokenReviews().Create(context.TODO(), review,
+		metav1.CreateOptions{})
+	if err != nil {
+		return nil, err
+	}
+	return &res.Status, nil
+}
+
 // DeleteConfigMap delete configMap
 func DeleteConfigMap(cmName, cmNamespace string) error {
 	return k8sClient.ClientSet.CoreV1().ConfigMaps(cmNamespace).Delete(context.TODO(), cmName, metav1.DeleteOptions{})
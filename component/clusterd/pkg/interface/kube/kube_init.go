@@ -28,6 +28,11 @@ func InitClientK8s() error {
 	return nil
 }
 
+// InitClientK8sWith init k8s client with the client set, such as the in-memory one of the offline fault simulation
+func InitClientK8sWith(clientSet kubernetes.Interface) {
+	k8sClient = &K8sClient{ClientSet: clientSet}
+}
+
 // GetClientK8s get client k8s
 func GetClientK8s() *K8sClient {
 	return k8sClient
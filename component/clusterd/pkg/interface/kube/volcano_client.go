@@ -77,6 +77,14 @@ func GetPodGroup(name, namespace string) (*v1beta1.PodGroup, error) {
 	return nil, fmt.Errorf("vcK8sClient is nil")
 }
 
+// GetQueue return the volcano queue according the queue name
+func GetQueue(name string) (*v1beta1.Queue, error) {
+	if vcK8sClient != nil && vcK8sClient.ClientSet != nil {
+		return vcK8sClient.ClientSet.SchedulingV1beta1().Queues().Get(context.TODO(), name, v1.GetOptions{})
+	}
+	return nil, fmt.Errorf("vcK8sClient is nil")
+}
+
 // RetryPatchPodGroupAnnotations retry patch pod group annotations
 func RetryPatchPodGroupAnnotations(pgName, pgNamespace string, retryTimes int,
 	annotations map[string]interface{}) (*v1beta1.PodGroup, error) {
@@ -0,0 +1,95 @@
+/* Copyright(C) 2026. Huawei Technologies Co.,Ltd. All rights reserved.
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+// Package metrics the prometheus metrics of clusterd and the http endpoint serving them
+package metrics
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+)
+
+const (
+	namespace = "clusterd"
+
+	// RecoverResultSuccess the training recovered by the strategy
+	RecoverResultSuccess = "success"
+	// RecoverResultRescheduled the process recovery failed and the job is rescheduled
+	RecoverResultRescheduled = "rescheduled"
+	// RecoverResultKilled the job is killed
+	RecoverResultKilled = "killed"
+	// RecoverResultFailed the recovery failed
+	RecoverResultFailed = "failed"
+	// RecoverResultAborted the recovery is aborted by the reset of the state machine
+	RecoverResultAborted = "aborted"
+	// NoneStrategy the label of the recovery ended before any strategy is decided
+	NoneStrategy = "none"
+)
+
+var (
+	registry = prometheus.NewRegistry()
+
+	recoverTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "recover_total",
+		Help:      "Number of the finished fault recoveries by the last strategy and the result",
+	}, []string{"strategy", "result"})
+	recoverStateDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "recover_state_duration_seconds",
+		Help:      "Time spent in each state of the recover state machine",
+		Buckets:   []float64{0.1, 0.5, 1, 5, 10, 30, 60, 120, 300, 600, 1800},
+	}, []string{"state"})
+	jobFaultTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "job_fault_total",
+		Help:      "Number of the faults occurred on the ranks of the jobs by the fault code and level",
+	}, []string{"fault_code", "fault_level"})
+	subscriberStreams = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "grpc_subscriber_streams",
+		Help:      "Number of the open grpc streams by the method",
+	}, []string{"method"})
+	grpcDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "grpc_request_duration_seconds",
+		Help:      "Latency of the unary grpc requests by the method and the status code",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method", "code"})
+)
+
+func init() {
+	registry.MustRegister(recoverTotal, recoverStateDuration, jobFaultTotal, subscriberStreams, grpcDuration,
+		collectors.NewGoCollector(), collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}))
+}
+
+// ObserveRecover count the finished recovery
+func ObserveRecover(strategy, result string) {
+	if strategy == "" {
+		strategy = NoneStrategy
+	}
+	recoverTotal.WithLabelValues(strategy, result).Inc()
+}
+
+// ObserveRecoverState record the time spent in the state of the recover state machine
+func ObserveRecoverState(state string, duration time.Duration) {
+	recoverStateDuration.WithLabelValues(state).Observe(duration.Seconds())
+}
+
+// ObserveJobFault count the fault occurred on the rank of the job
+func ObserveJobFault(faultCode, faultLevel string) {
+	jobFaultTotal.WithLabelValues(faultCode, faultLevel).Inc()
+}
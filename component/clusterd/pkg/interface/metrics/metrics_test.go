@@ -0,0 +1,95 @@
+/* Copyright(C) 2026. Huawei Technologies Co.,Ltd. All rights reserved.
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+// Package metrics test for the metrics
+package metrics
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/smartystreets/goconvey/convey"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"ascend-common/common-utils/hwlog"
+)
+
+const testMethod = "/test.Service/Method"
+
+func init() {
+	if err := hwlog.InitRunLogger(&hwlog.LogConfig{OnlyToStdout: true}, context.Background()); err != nil {
+		panic(err)
+	}
+}
+
+func TestObserveRecover(t *testing.T) {
+	convey.Convey("Test ObserveRecover, empty strategy should be counted as none", t, func() {
+		before := testutil.ToFloat64(recoverTotal.WithLabelValues(NoneStrategy, RecoverResultAborted))
+		ObserveRecover("", RecoverResultAborted)
+		after := testutil.ToFloat64(recoverTotal.WithLabelValues(NoneStrategy, RecoverResultAborted))
+		convey.So(after-before, convey.ShouldEqual, 1)
+	})
+}
+
+func TestObserveJobFault(t *testing.T) {
+	convey.Convey("Test ObserveJobFault, should count the fault by code and level", t, func() {
+		ObserveJobFault("80E01801", "RestartRequest")
+		convey.So(testutil.ToFloat64(jobFaultTotal.WithLabelValues("80E01801", "RestartRequest")),
+			convey.ShouldEqual, 1)
+	})
+}
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	convey.Convey("Test UnaryServerInterceptor, should observe the latency with the status code", t, func() {
+		info := &grpc.UnaryServerInfo{FullMethod: testMethod}
+		_, err := UnaryServerInterceptor(context.Background(), nil, info,
+			func(context.Context, interface{}) (interface{}, error) {
+				return nil, status.Error(codes.PermissionDenied, "denied")
+			})
+		convey.So(err, convey.ShouldNotBeNil)
+		convey.So(testutil.CollectAndCount(grpcDuration), convey.ShouldEqual, 1)
+	})
+}
+
+func TestStreamServerInterceptor(t *testing.T) {
+	convey.Convey("Test StreamServerInterceptor, should count the stream until it is closed", t, func() {
+		info := &grpc.StreamServerInfo{FullMethod: testMethod}
+		var opened float64
+		err := StreamServerInterceptor(nil, nil, info, func(interface{}, grpc.ServerStream) error {
+			opened = testutil.ToFloat64(subscriberStreams.WithLabelValues(testMethod))
+			return errors.New("stream closed")
+		})
+		convey.So(err, convey.ShouldNotBeNil)
+		convey.So(opened, convey.ShouldEqual, 1)
+		convey.So(testutil.ToFloat64(subscriberStreams.WithLabelValues(testMethod)), convey.ShouldEqual, 0)
+	})
+}
+
+func TestServe(t *testing.T) {
+	convey.Convey("Test Serve", t, func() {
+		convey.Convey("01-valid address, should serve until the context is done", func() {
+			ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+			defer cancel()
+			convey.So(Serve(ctx, "127.0.0.1:0"), convey.ShouldBeNil)
+		})
+		convey.Convey("02-invalid address, should return error", func() {
+			convey.So(Serve(context.Background(), "invalid address"), convey.ShouldNotBeNil)
+		})
+	})
+}
@@ -0,0 +1,78 @@
+/* Copyright(C) 2026. Huawei Technologies Co.,Ltd. All rights reserved.
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package metrics
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+
+	"ascend-common/common-utils/hwlog"
+	"ascend-common/common-utils/limiter"
+	"clusterd/pkg/common/constant"
+)
+
+const (
+	// Path the path of the metrics endpoint
+	Path = "/metrics"
+
+	serverTimeout   = 10 * time.Second
+	shutdownTimeout = 5 * time.Second
+	maxHeaderBytes  = 1024
+	maxRequests     = 10
+)
+
+// Serve the metrics endpoint on the address until the context is done
+func Serve(ctx context.Context, address string) error {
+	listen, err := net.Listen("tcp", address)
+	if err != nil {
+		return fmt.Errorf("metrics server listen failed: %v", err)
+	}
+	limitedListener, err := limiter.LimitListener(listen, constant.MaxConcurrentLimit,
+		constant.MaxIPConnectionLimit, constant.CacheSize)
+	if err != nil {
+		return fmt.Errorf("create limit listener of metrics server failed: %v", err)
+	}
+	mux := http.NewServeMux()
+	mux.Handle(Path, promhttp.HandlerFor(registry, promhttp.HandlerOpts{MaxRequestsInFlight: maxRequests}))
+	server := &http.Server{
+		Handler:        mux,
+		ReadTimeout:    serverTimeout,
+		WriteTimeout:   serverTimeout,
+		MaxHeaderBytes: maxHeaderBytes,
+		ErrorLog:       log.New(&hwlog.SelfLogWriter{}, "", log.Lshortfile),
+	}
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+		defer cancel()
+		if err := server.Shutdown(shutdownCtx); err != nil {
+			hwlog.RunLog.Warnf("shutdown metrics server failed: %v", err)
+		}
+	}()
+	go func() {
+		hwlog.RunLog.Infof("metrics server listen on: %s", address)
+		if err := server.Serve(limitedListener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			hwlog.RunLog.Errorf("metrics server crashed, err: %v", err)
+		}
+	}()
+	return nil
+}
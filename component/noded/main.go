@@ -133,6 +133,8 @@ func init() {
 		"Run log file path. if the file size exceeds 20MB, will be rotated")
 	flag.IntVar(&hwLogConfig.MaxBackups, "maxBackups", hwlog.DefaultMaxBackups,
 		"Maximum number of backup operation logs, range is (0, 30]")
+	flag.StringVar(&hwLogConfig.Format, "logFormat", hwlog.FormatText,
+		"Log format, text or json, the json format is for shipping the logs to Loki, Elasticsearch and so on")
 	flag.IntVar(&resultMaxAge, "resultMaxAge", pingmesh.DefaultResultMaxAge,
 		"Maximum number of days for backup run pingmesh result files, range [7, 700] days")
 	flag.IntVar(&deviceResetTimeout, api.DeviceResetTimeout, api.DefaultDeviceResetTimeout,
@@ -22,9 +22,9 @@ import (
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/connectivity"
-	"google.golang.org/grpc/credentials/insecure"
 
 	"ascend-common/common-utils/hwlog"
+	"ascend-common/common-utils/tlsutils"
 	"nodeD/pkg/grpcclient/pubfault"
 )
 
@@ -37,11 +37,11 @@ type Client struct {
 // New get a new grpc client
 func New(serverAddr string) (*Client, error) {
 	c := Client{}
-	var err error
-	c.conn, err = grpc.Dial(
-		serverAddr,
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
-	)
+	opts, err := tlsutils.ClusterdDialOptions()
+	if err != nil {
+		return &Client{}, fmt.Errorf("failed to get grpc dial options: %v", err)
+	}
+	c.conn, err = grpc.Dial(serverAddr, opts...)
 	if err != nil {
 		return &Client{}, fmt.Errorf("failed to connect to grpc server: %v", err)
 	}
@@ -28,6 +28,7 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/influxdata/telegraf/plugins/common/shim"
@@ -38,13 +39,18 @@ import (
 	"ascend-common/api"
 	"ascend-common/common-utils/hwlog"
 	"ascend-common/common-utils/limiter"
+	"ascend-common/common-utils/utils"
 	"ascend-common/devmanager"
 	"ascend-common/devmanager/common"
+	"ascend-common/devmanager/hccn"
 	colcommon "huawei.com/npu-exporter/v6/collector/common"
 	"huawei.com/npu-exporter/v6/collector/config"
 	"huawei.com/npu-exporter/v6/collector/container"
+	"huawei.com/npu-exporter/v6/platforms/history"
 	_ "huawei.com/npu-exporter/v6/platforms/inputs/npu"
+	"huawei.com/npu-exporter/v6/platforms/otlp"
 	"huawei.com/npu-exporter/v6/platforms/prom"
+	"huawei.com/npu-exporter/v6/platforms/remotewrite"
 	"huawei.com/npu-exporter/v6/plugins"
 	"huawei.com/npu-exporter/v6/utils/logger"
 	"huawei.com/npu-exporter/v6/versions"
@@ -69,6 +75,28 @@ var (
 	hccsBWProfilingTime int
 	pollInterval        time.Duration
 	deviceResetTimeout  int
+	otlpEndpoint        = ""
+	otlpProtocol        = ""
+	otlpInterval        int
+	remoteWriteURL      = ""
+	remoteWriteInterval int
+	remoteWriteLabels   = ""
+	remoteWriteAuthFile = ""
+	remoteWriteToken    = ""
+	remoteWriteQueueDir = ""
+	remoteWriteQueueLen int
+	historyTokenFile    = ""
+	historyRetention    int
+	historyMaxSeries    int
+	configFile          = ""
+
+	// flagLimitIPReq and flagConcurrency the command line values, restored when omitted in the config file
+	flagLimitIPReq  = ""
+	flagConcurrency int
+	// rateLimitMu guard limitIPReq and concurrency, they are changed when the config file is reloaded
+	rateLimitMu sync.Mutex
+	// serverHandler the handler of the http server, it is replaced when the rate limit is reloaded
+	serverHandler = &reloadableHandler{}
 )
 
 const (
@@ -95,11 +123,25 @@ const (
 	minHccsBWProfilingTime = 1
 	maxHccsBWProfilingTime = 1000
 	defaultShutDownTimeout = 30 * time.Second
+	defaultOtlpInterval    = 10
+	maxOtlpInterval        = 600
+	defaultRemoteWriteIntv = 15
+	maxRemoteWriteIntv     = 600
+	defaultRemoteWriteLen  = 1000
+	maxRemoteWriteLen      = 10000
+	maxCredentialFileSize  = 4096
+	defaultHistoryRetain   = 600
+	minHistoryRetain       = 60
+	maxHistoryRetain       = 3600
+	defaultHistorySeries   = 20000
+	maxHistorySeries       = 100000
 )
 
 const (
 	prometheusPlatform         = "Prometheus"
 	telegrafPlatform           = "Telegraf"
+	otlpPlatform               = "OTLP"
+	remoteWritePlatform        = "RemoteWrite"
 	pollIntervalStr            = "poll_interval"
 	platformStr                = "platform"
 	updateTimeStr              = "updateTime"
@@ -109,6 +151,12 @@ const (
 	maxAgeStr                  = "maxAge"
 	logFileStr                 = "logFile"
 	maxBackupsStr              = "maxBackups"
+	logFormatStr               = "logFormat"
+	ipStr                      = "ip"
+	portStr                    = "port"
+	historyTokenFileStr        = "historyTokenFile"
+	historyRetentionStr        = "historyRetention"
+	historyMaxSeriesStr        = "historyMaxSeries"
 	defaultProfilingTime       = 200
 	defaultHccsBwProfilingTime = 200
 )
@@ -135,6 +183,7 @@ func main() {
 		return
 	}
 	logger.Infof("npu exporter starting and the version is %s", versions.BuildVersion)
+	initHccnBackend(dmgr.GetDevType())
 	deviceParser := container.MakeDevicesParser(readCntMonitoringFlags())
 	defer deviceParser.Close()
 
@@ -149,15 +198,33 @@ func main() {
 
 	ctx, cancel := context.WithCancel(context.Background())
 	wg := &sync.WaitGroup{}
+	if configFile != "" {
+		if err = watchConfigFile(ctx, wg); err != nil {
+			logger.Errorf("load config file failed: %v", err)
+			cancel()
+			return
+		}
+	}
 	colcommon.InitCardInfo(wg, ctx, colcommon.Collector)
 	colcommon.StartContainerInfoCollect(ctx, cancel, wg, colcommon.Collector)
 
 	colcommon.StartCollect(wg, ctx, colcommon.Collector)
+	if historyTokenFile != "" {
+		if err = startHistory(wg, ctx, cancel); err != nil {
+			logger.Errorf("start history recording failed: %v", err)
+			cancel()
+			return
+		}
+	}
 	switch platform {
 	case prometheusPlatform:
 		prometheusProcss(wg, ctx, cancel)
 	case telegrafPlatform:
 		telegrafProcess()
+	case otlpPlatform:
+		otlpProcess(wg, ctx, cancel)
+	case remoteWritePlatform:
+		remoteWriteProcess(wg, ctx, cancel)
 	default:
 		err = fmt.Errorf("err platform input")
 	}
@@ -176,10 +243,220 @@ func prometheusProcss(wg *sync.WaitGroup, ctx context.Context, cancel context.Ca
 	}()
 }
 
+// startHistory record the metrics from the caches of the collectors whatever the platform is. the history api is
+// served with /metrics by the Prometheus platform, and by a dedicated http server on the other platforms
+func startHistory(wg *sync.WaitGroup, ctx context.Context, cancel context.CancelFunc) error {
+	token, err := readCredential(historyTokenFile)
+	if err != nil {
+		return err
+	}
+	recorder, err := history.NewRecorder(colcommon.Collector, history.Config{
+		Interval:  time.Duration(updateTime) * time.Second,
+		Retention: time.Duration(historyRetention) * time.Second,
+		MaxSeries: historyMaxSeries,
+		Token:     token,
+	})
+	if err != nil {
+		return err
+	}
+	http.Handle(history.APIPath, recorder)
+	logger.Infof("record the metrics of the last %d seconds, query them by %s", historyRetention, history.APIPath)
+	recorder.Start(ctx, wg)
+	if platform != prometheusPlatform {
+		wg.Add(1)
+		go func() {
+			serve(ctx, cancel)
+			wg.Done()
+		}()
+	}
+	return nil
+}
+
+// watchConfigFile load the config file and reload it when changed, the rate limit and the text metrics file
+// path are applied here, the collectors are applied by the config package
+// initHccnBackend parse the hccn_tool output with the parser of the output format of the product
+func initHccnBackend(devType string) {
+	backend, err := hccn.NewExecBackend(devType)
+	if err != nil {
+		logger.Infof("use the default hccn backend for %s: %v", devType, err)
+		return
+	}
+	if err = hccn.SetBackend(backend); err != nil {
+		logger.Warnf("set hccn backend for %s failed: %v", devType, err)
+	}
+}
+
+func watchConfigFile(ctx context.Context, wg *sync.WaitGroup) error {
+	watcher, err := config.NewFileConfigWatcher(configFile, colcommon.Collector, config.ReloadHook{
+		Validate: checkRateLimitConfig,
+		Apply:    applyRateLimitConfig,
+	}, config.ReloadHook{
+		Apply: applyTextMetricsFilePath,
+	})
+	if err != nil {
+		return err
+	}
+	return watcher.Start(ctx, wg)
+}
+
+func checkRateLimitConfig(cfg *config.FileConfig) error {
+	if cfg.RateLimit.LimitIPReq != "" && !regexp.MustCompile(limiter.IPReqLimitReg).MatchString(
+		cfg.RateLimit.LimitIPReq) {
+		return errors.New("limitIPReq of rateLimit format error")
+	}
+	if cfg.RateLimit.Concurrency != 0 && (cfg.RateLimit.Concurrency < 1 ||
+		cfg.RateLimit.Concurrency > maxConcurrency) {
+		return errors.New("concurrency of rateLimit is invalid")
+	}
+	return nil
+}
+
+// applyRateLimitConfig replace the limit handler of the http server, the omitted fields are restored to the
+// command line values. the requests being served are still limited by the old handler
+func applyRateLimitConfig(cfg *config.FileConfig) {
+	rateLimitMu.Lock()
+	limitIPReq, concurrency = flagLimitIPReq, flagConcurrency
+	if cfg.RateLimit.LimitIPReq != "" {
+		limitIPReq = cfg.RateLimit.LimitIPReq
+	}
+	if cfg.RateLimit.Concurrency != 0 {
+		concurrency = cfg.RateLimit.Concurrency
+	}
+	rateLimitMu.Unlock()
+	if !serverHandler.started() {
+		return
+	}
+	conf := initConfig()
+	handler, err := limiter.NewLimitHandlerV2(http.DefaultServeMux, conf)
+	if err != nil {
+		logger.Errorf("reload rate limit failed: %v", err)
+		return
+	}
+	serverHandler.store(handler)
+	logger.Infof("rate limit is reloaded, limitIPReq: %s, concurrency: %d", conf.IPConCurrency,
+		conf.TotalConCurrency)
+}
+
+func applyTextMetricsFilePath(cfg *config.FileConfig) {
+	path := textMetricsFilePath
+	if cfg.TextMetricsFilePath != nil {
+		path = *cfg.TextMetricsFilePath
+	}
+	if textMetricsFilePath == "" && path != "" {
+		logger.Warnf("text metrics collection is not started, restart is required to collect %s", path)
+		return
+	}
+	plugins.ReloadTextMetricsFilePath(path)
+}
+
+// reloadableHandler an http handler which can be replaced when serving
+type reloadableHandler struct {
+	handler atomic.Value
+}
+
+func (h *reloadableHandler) store(handler http.Handler) {
+	h.handler.Store(handler)
+}
+
+func (h *reloadableHandler) started() bool {
+	return h.handler.Load() != nil
+}
+
+// ServeHTTP serve the request with the current handler
+func (h *reloadableHandler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	handler, ok := h.handler.Load().(http.Handler)
+	if !ok {
+		http.Error(w, "server is not ready", http.StatusServiceUnavailable)
+		return
+	}
+	handler.ServeHTTP(w, req)
+}
+
+func otlpProcess(wg *sync.WaitGroup, ctx context.Context, cancel context.CancelFunc) {
+	c, err := otlp.NewOtlpCollector(colcommon.Collector, otlp.Config{
+		Endpoint: otlpEndpoint,
+		Protocol: otlpProtocol,
+		Interval: time.Duration(otlpInterval) * time.Second,
+		Timeout:  timeout * time.Second,
+	})
+	if err != nil {
+		logger.Errorf("create otlp collector failed: %v", err)
+		cancel()
+		return
+	}
+	logger.Warn("enable unsafe otlp exporter, metrics are pushed without tls")
+	logger.Infof("push metrics by otlp %s to %s every %d seconds", otlpProtocol, otlpEndpoint, otlpInterval)
+	c.Start(ctx, wg)
+}
+
+func remoteWriteProcess(wg *sync.WaitGroup, ctx context.Context, cancel context.CancelFunc) {
+	cfg, err := remoteWriteConfig()
+	if err != nil {
+		logger.Errorf("load remote-write config failed: %v", err)
+		cancel()
+		return
+	}
+	c, err := remotewrite.NewRemoteWriteCollector(colcommon.Collector, cfg)
+	if err != nil {
+		logger.Errorf("create remote-write collector failed: %v", err)
+		cancel()
+		return
+	}
+	if strings.HasPrefix(remoteWriteURL, "http://") {
+		logger.Warn("enable unsafe remote-write, metrics and credentials are pushed without tls")
+	}
+	logger.Infof("push metrics by remote-write to %s every %d seconds", remoteWriteURL, remoteWriteInterval)
+	c.Start(ctx, wg)
+}
+
+func remoteWriteConfig() (remotewrite.Config, error) {
+	cfg := remotewrite.Config{
+		Endpoint:  remoteWriteURL,
+		Interval:  time.Duration(remoteWriteInterval) * time.Second,
+		Timeout:   timeout * time.Second,
+		QueueDir:  remoteWriteQueueDir,
+		QueueSize: remoteWriteQueueLen,
+	}
+	labels, err := remotewrite.ParseExternalLabels(remoteWriteLabels)
+	if err != nil {
+		return cfg, err
+	}
+	cfg.ExternalLabels = labels
+	if remoteWriteAuthFile != "" {
+		auth, err := readCredential(remoteWriteAuthFile)
+		if err != nil {
+			return cfg, err
+		}
+		const authPartLen = 2
+		parts := strings.SplitN(auth, ":", authPartLen)
+		if len(parts) != authPartLen || parts[0] == "" {
+			return cfg, errors.New("the basic auth file should contain username:password")
+		}
+		cfg.Username, cfg.Password = parts[0], parts[1]
+	}
+	if remoteWriteToken != "" {
+		token, err := readCredential(remoteWriteToken)
+		if err != nil {
+			return cfg, err
+		}
+		cfg.BearerToken = token
+	}
+	return cfg, nil
+}
+
+func readCredential(path string) (string, error) {
+	data, err := utils.ReadLimitBytes(path, maxCredentialFileSize)
+	if err != nil {
+		return "", fmt.Errorf("read credential file failed: %v", err)
+	}
+	return strings.TrimSpace(string(data)), nil
+}
+
 func initParams() {
 	common.SetHccsBWProfilingTime(hccsBWProfilingTime)
 	common.SetExternalParams(profilingTime)
 	plugins.SetTextMetricsFilePath(textMetricsFilePath)
+	flagLimitIPReq, flagConcurrency = limitIPReq, concurrency
 }
 
 func paramValid(platform string) error {
@@ -189,6 +466,10 @@ func paramValid(platform string) error {
 		err = paramValidInPrometheus()
 	case telegrafPlatform:
 		err = paramValidInTelegraf()
+	case otlpPlatform:
+		err = paramValidInOtlp()
+	case remoteWritePlatform:
+		err = paramValidInRemoteWrite()
 	default:
 		err = fmt.Errorf("err platform input")
 	}
@@ -200,6 +481,8 @@ func paramValid(platform string) error {
 }
 
 func initConfig() *limiter.HandlerConfig {
+	rateLimitMu.Lock()
+	defer rateLimitMu.Unlock()
 	conf := &limiter.HandlerConfig{
 		PrintLog:         true,
 		Method:           http.MethodGet,
@@ -217,9 +500,10 @@ func newServerAndListener(conf *limiter.HandlerConfig) (*http.Server, net.Listen
 		hwlog.RunLog.Error(err)
 		return nil, nil
 	}
+	serverHandler.store(handler)
 	s := &http.Server{
 		Addr:           ip + ":" + strconv.Itoa(port),
-		Handler:        handler,
+		Handler:        serverHandler,
 		ReadTimeout:    timeout * time.Second,
 		WriteTimeout:   timeout * time.Second,
 		MaxHeaderBytes: maxHeaderBytes,
@@ -300,6 +584,49 @@ func paramValidInPrometheus() error {
 		checkHccsBWProfilingTime,
 		checkDeviceResetTimeout,
 		checkPollIntervalInCmdLine,
+		checkHistoryParams,
+	}
+
+	for _, check := range checks {
+		if err := check(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkHistoryServeParams check the history params and the listen address of the history api on the platforms
+// without the http service of /metrics
+func checkHistoryServeParams() error {
+	if historyTokenFile == "" {
+		return nil
+	}
+	if err := checkIPAndPortInPrometheus(); err != nil {
+		return err
+	}
+	return checkHistoryParams()
+}
+
+func checkHistoryParams() error {
+	if historyRetention < minHistoryRetain || historyRetention > maxHistoryRetain {
+		return errors.New("historyRetention range error")
+	}
+	if historyMaxSeries < 1 || historyMaxSeries > maxHistorySeries {
+		return errors.New("historyMaxSeries range error")
+	}
+	return nil
+}
+
+func paramValidInOtlp() error {
+	checks := []func() error{
+		checkOtlpParams,
+		checkUpdateTime,
+		containerSockCheck,
+		checkProfilingTime,
+		checkHccsBWProfilingTime,
+		checkDeviceResetTimeout,
+		checkPollIntervalInCmdLine,
+		checkHistoryServeParams,
 	}
 
 	for _, check := range checks {
@@ -310,6 +637,55 @@ func paramValidInPrometheus() error {
 	return nil
 }
 
+func checkOtlpParams() error {
+	if otlpEndpoint == "" {
+		return errors.New("otlpEndpoint is required when use OTLP platform")
+	}
+	if otlpProtocol != otlp.ProtocolGrpc && otlpProtocol != otlp.ProtocolHTTP {
+		return errors.New("otlpProtocol is invalid, just support grpc and http")
+	}
+	if otlpInterval < 1 || otlpInterval > maxOtlpInterval {
+		return errors.New("otlpInterval range error")
+	}
+	return nil
+}
+
+func paramValidInRemoteWrite() error {
+	checks := []func() error{
+		checkRemoteWriteParams,
+		checkUpdateTime,
+		containerSockCheck,
+		checkProfilingTime,
+		checkHccsBWProfilingTime,
+		checkDeviceResetTimeout,
+		checkPollIntervalInCmdLine,
+		checkHistoryServeParams,
+	}
+
+	for _, check := range checks {
+		if err := check(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func checkRemoteWriteParams() error {
+	if remoteWriteURL == "" {
+		return errors.New("remoteWriteURL is required when use RemoteWrite platform")
+	}
+	if remoteWriteInterval < 1 || remoteWriteInterval > maxRemoteWriteIntv {
+		return errors.New("remoteWriteInterval range error")
+	}
+	if remoteWriteQueueLen < 1 || remoteWriteQueueLen > maxRemoteWriteLen {
+		return errors.New("remoteWriteQueueSize range error")
+	}
+	if remoteWriteAuthFile != "" && remoteWriteToken != "" {
+		return errors.New("remoteWriteBasicAuthFile and remoteWriteBearerTokenFile can not be used together")
+	}
+	return nil
+}
+
 func checkUpdateTime() error {
 	if updateTime > oneMinute || updateTime < 1 {
 		return errors.New("the updateTime is invalid")
@@ -399,9 +775,9 @@ func containerSockCheck() error {
 }
 
 func init() {
-	flag.IntVar(&port, "port", portConst,
+	flag.IntVar(&port, portStr, portConst,
 		"The server port of the http service,range[1025-40000]")
-	flag.StringVar(&ip, "ip", "",
+	flag.StringVar(&ip, ipStr, "",
 		"The listen ip of the service,0.0.0.0 is not recommended when install on Multi-NIC host")
 	flag.IntVar(&updateTime, "updateTime", updateTimeConst,
 		"Interval (seconds) to update the npu metrics cache,range[1-60]")
@@ -424,6 +800,8 @@ func init() {
 		"Log file path. If the file size exceeds 20MB, will be rotated")
 	flag.IntVar(&logger.HwLogConfig.MaxBackups, maxBackupsStr, hwlog.DefaultMaxBackups,
 		"Maximum number of backup log files, range is (0, 30]")
+	flag.StringVar(&logger.HwLogConfig.Format, logFormatStr, hwlog.FormatText,
+		"Log format, text or json, the json format is for shipping the logs to Loki, Elasticsearch and so on")
 	flag.IntVar(&cacheSize, "cacheSize", limiter.DefaultCacheSize, "the cacheSize for ip limit,"+
 		"range  is [1,1024000],keep default normally")
 	flag.IntVar(&limitIPConn, "limitIPConn", defaultConcurrency, "the tcp connection limit for each Ip,"+
@@ -433,12 +811,52 @@ func init() {
 	flag.StringVar(&limitIPReq, "limitIPReq", "20/1",
 		"the http request limit counts for each Ip,20/1 means allow 20 request in 1 seconds")
 	flag.StringVar(&platform, platformStr, "Prometheus", "the data reporting platform, "+
-		"just support Prometheus and Telegraf")
+		"just support Prometheus, Telegraf, OTLP and RemoteWrite")
 	flag.StringVar(&textMetricsFilePath, textMetricsFilePathStr, "",
-		"text indicator collection path, support specified multiple file path")
+		"text indicator collection path, support specified multiple file or directory paths, "+
+			"*.json files use the json schema, *.prom files use the prometheus text exposition format, "+
+			"the files in the directories are scanned in each collection")
 	flag.DurationVar(&pollInterval, pollIntervalStr, 1*time.Second,
 		"how often to send metrics when use Telegraf plugin, "+
 			"needs to be used with -platform=Telegraf, otherwise, it does not take effect")
+	flag.StringVar(&otlpEndpoint, "otlpEndpoint", "",
+		"the otlp receiver address, host:port for grpc or url for http, needs to be used with -platform=OTLP")
+	flag.StringVar(&otlpProtocol, "otlpProtocol", otlp.ProtocolGrpc,
+		"the otlp transport protocol, just support grpc and http, needs to be used with -platform=OTLP")
+	flag.IntVar(&otlpInterval, "otlpInterval", defaultOtlpInterval,
+		"Interval (seconds) to push metrics by otlp, range [1, 600], needs to be used with -platform=OTLP")
+	flag.StringVar(&remoteWriteURL, "remoteWriteURL", "",
+		"the url of the prometheus remote-write receiver, needs to be used with -platform=RemoteWrite")
+	flag.IntVar(&remoteWriteInterval, "remoteWriteInterval", defaultRemoteWriteIntv,
+		"Interval (seconds) to push metrics by remote-write, range [1, 600], "+
+			"needs to be used with -platform=RemoteWrite")
+	flag.StringVar(&remoteWriteLabels, "remoteWriteExternalLabels", "",
+		"external labels added to every series, e.g. cluster=c1,node=n1, node defaults to the node name, "+
+			"needs to be used with -platform=RemoteWrite")
+	flag.StringVar(&remoteWriteAuthFile, "remoteWriteBasicAuthFile", "",
+		"the file containing username:password of the remote-write receiver, "+
+			"needs to be used with -platform=RemoteWrite")
+	flag.StringVar(&remoteWriteToken, "remoteWriteBearerTokenFile", "",
+		"the file containing the bearer token of the remote-write receiver, "+
+			"needs to be used with -platform=RemoteWrite")
+	flag.StringVar(&remoteWriteQueueDir, "remoteWriteQueueDir", "",
+		"the directory buffering the requests failed to push, empty means buffering in memory, "+
+			"needs to be used with -platform=RemoteWrite")
+	flag.IntVar(&remoteWriteQueueLen, "remoteWriteQueueSize", defaultRemoteWriteLen,
+		"the max number of buffered remote-write requests, range [1, 10000], "+
+			"needs to be used with -platform=RemoteWrite")
+	flag.StringVar(&historyTokenFile, historyTokenFileStr, "",
+		"the file containing the bearer token of the "+history.APIPath+" api, the recent metrics of the chips "+
+			"are recorded from the caches every updateTime and served by the api only when it is set, "+
+			"the api listens on -ip and -port, which are required on the platforms other than Prometheus")
+	flag.IntVar(&historyRetention, historyRetentionStr, defaultHistoryRetain,
+		"how long (seconds) the recent metrics are kept in memory, range [60, 3600], "+
+			"needs to be used with -historyTokenFile")
+	flag.IntVar(&historyMaxSeries, historyMaxSeriesStr, defaultHistorySeries,
+		"the max number of series kept in memory, range [1, 100000], needs to be used with -historyTokenFile")
+	flag.StringVar(&configFile, "configFile", "",
+		"the yaml config file hot reloaded when changed, supports the intervals and states of the metrics groups, "+
+			"the label allowlist, the rate limit and the text metrics file path, not support -platform=Telegraf")
 	flag.IntVar(&profilingTime, profilingTimeStr, defaultProfilingTime,
 		"config pcie bandwidth profiling time, range is [1, 2000]")
 	flag.IntVar(&hccsBWProfilingTime, api.HccsBWProfilingTimeStr, defaultHccsBwProfilingTime,
@@ -467,6 +885,11 @@ func indexHandler(w http.ResponseWriter, _ *http.Request) {
 func startServe(ctx context.Context, cancel context.CancelFunc, reg *prometheus.Registry) {
 	http.Handle("/metrics", promhttp.HandlerFor(reg, promhttp.HandlerOpts{ErrorHandling: promhttp.ContinueOnError}))
 	http.Handle("/", http.HandlerFunc(indexHandler))
+	serve(ctx, cancel)
+}
+
+// serve the handlers registered in http.DefaultServeMux until ctx is done
+func serve(ctx context.Context, cancel context.CancelFunc) {
 	conf := initConfig()
 	s, limitLs := newServerAndListener(conf)
 	if s == nil || limitLs == nil {
@@ -509,8 +932,14 @@ func paramValidInTelegraf() error {
 		maxAgeStr:                  true,
 		logFileStr:                 true,
 		maxBackupsStr:              true,
+		logFormatStr:               true,
 		profilingTimeStr:           true,
 		api.DeviceResetTimeout:     true,
+		ipStr:                      true,
+		portStr:                    true,
+		historyTokenFileStr:        true,
+		historyRetentionStr:        true,
+		historyMaxSeriesStr:        true,
 	}
 
 	if len(cmdLine) > len(presetParamsMap) {
@@ -536,6 +965,7 @@ func paramValidInTelegraf() error {
 		checkProfilingTime,
 		checkHccsBWProfilingTime,
 		checkDeviceResetTimeout,
+		checkHistoryServeParams,
 	}
 	for _, check := range checks {
 		if err := check(); err != nil {
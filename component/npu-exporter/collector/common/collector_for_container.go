@@ -107,3 +107,20 @@ func GetContainerNPUInfo(n *NpuCollector) map[int32]container.DevicesInfo {
 	}
 	return res
 }
+
+// GetPodContainers get the pod info of all containers from the container runtime, the key is the container id
+func GetPodContainers(n *NpuCollector) map[string]container.PodContainerInfo {
+	if n == nil || n.devicesParser == nil {
+		return nil
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), podContainersTimeout*time.Second)
+	defer cancel()
+	res, err := n.devicesParser.GetPodContainers(ctx)
+	if err != nil {
+		logger.LogfWithOptions(logger.WarnLevel, logger.LogOptions{Domain: DomainForPodContainers, ID: 0},
+			"get pod info of containers failed: %v", err)
+		return nil
+	}
+	hwlog.ResetErrCnt(DomainForPodContainers, 0)
+	return res
+}
@@ -0,0 +1,177 @@
+/* Copyright(C) 2026. Huawei Technologies Co.,Ltd. All rights reserved.
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+// Package common for general collector
+package common
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"huawei.com/npu-exporter/v6/utils/logger"
+)
+
+const (
+	collectorLabel = "collector"
+	// dueTolerance ticker may fire slightly earlier than the collector interval
+	dueTolerance = 2
+)
+
+var (
+	// collectorOptions cache key of the collector -> CollectorOptions
+	collectorOptions sync.Map
+	// collectorStats cache key of the collector -> *collectStat
+	collectorStats sync.Map
+
+	descCollectDuration = prometheus.NewDesc("npu_exporter_collector_scrape_duration_seconds",
+		"the time cost of each collection of the collector", []string{collectorLabel}, nil)
+	descCollectErrors = prometheus.NewDesc("npu_exporter_collector_scrape_errors_total",
+		"the number of failed collections of the collector, include panic and timeout",
+		[]string{collectorLabel}, nil)
+)
+
+// CollectorOptions collect interval and cache ttl of one collector, zero means following the global setting
+type CollectorOptions struct {
+	Interval  time.Duration
+	CacheTime time.Duration
+}
+
+type collectStat struct {
+	mu          sync.Mutex
+	count       uint64
+	durationSum float64
+	errors      uint64
+}
+
+// SetCollectorOptions set collect interval and cache ttl of the collector. interval is never less than the
+// updateTime of n, and the cache ttl is extended when it is shorter than the interval, avoiding the
+// cache expiring between two collections
+func SetCollectorOptions(n *NpuCollector, c MetricsCollector, opts CollectorOptions) {
+	key := GetCacheKey(c)
+	if opts.Interval < n.updateTime {
+		opts.Interval = n.updateTime
+	}
+	if opts.CacheTime == 0 {
+		opts.CacheTime = n.cacheTime
+	}
+	if opts.CacheTime < opts.Interval {
+		logger.Warnf("cache time %v of %s is less than its interval %v, use %v instead",
+			opts.CacheTime, key, opts.Interval, opts.Interval+n.cacheTime)
+		opts.CacheTime = opts.Interval + n.cacheTime
+	}
+	logger.Infof("collector %s interval: %v, cache time: %v", key, opts.Interval, opts.CacheTime)
+	collectorOptions.Store(key, opts)
+}
+
+func (n *NpuCollector) optionsOf(cacheKey string) CollectorOptions {
+	if value, ok := collectorOptions.Load(cacheKey); ok {
+		if opts, ok := value.(CollectorOptions); ok {
+			return opts
+		}
+	}
+	return CollectorOptions{Interval: n.updateTime, CacheTime: n.cacheTime}
+}
+
+func (n *NpuCollector) cacheTimeOf(cacheKey string) time.Duration {
+	return n.optionsOf(cacheKey).CacheTime
+}
+
+// collectSchedule decides which collectors of a goroutine are due in the current tick, the goroutine
+// ticks at updateTime and collectors with a longer interval skip some of the ticks
+type collectSchedule struct {
+	n           *NpuCollector
+	lastCollect map[string]time.Time
+}
+
+func newCollectSchedule(n *NpuCollector) *collectSchedule {
+	return &collectSchedule{n: n, lastCollect: make(map[string]time.Time)}
+}
+
+func (s *collectSchedule) isDue(c MetricsCollector, now time.Time) bool {
+	if !IsCollectorEnabled(c) {
+		return false
+	}
+	key := GetCacheKey(c)
+	last, ok := s.lastCollect[key]
+	if ok && now.Sub(last) < s.n.optionsOf(key).Interval-s.n.updateTime/dueTolerance {
+		return false
+	}
+	s.lastCollect[key] = now
+	return true
+}
+
+// collectWithStats collect to cache and record the time cost, a panic of the collector is counted as an error
+// instead of crashing the exporter
+func collectWithStats(c MetricsCollector, n *NpuCollector, chipList []HuaWeiAIChip) {
+	key := GetCacheKey(c)
+	begin := time.Now()
+	defer func() {
+		if r := recover(); r != nil {
+			logger.Errorf("collector %s panic: %v", key, r)
+			recordCollectError(key)
+		}
+		recordCollectDuration(key, time.Since(begin))
+	}()
+	c.CollectToCache(n, chipList)
+}
+
+func loadCollectStat(key string) *collectStat {
+	value, _ := collectorStats.LoadOrStore(key, &collectStat{})
+	stat, ok := value.(*collectStat)
+	if !ok {
+		return &collectStat{}
+	}
+	return stat
+}
+
+func recordCollectDuration(key string, cost time.Duration) {
+	stat := loadCollectStat(key)
+	stat.mu.Lock()
+	defer stat.mu.Unlock()
+	stat.count++
+	stat.durationSum += cost.Seconds()
+}
+
+func recordCollectError(key string) {
+	stat := loadCollectStat(key)
+	stat.mu.Lock()
+	defer stat.mu.Unlock()
+	stat.errors++
+}
+
+// DescribeCollectStats report the collector scrape metrics desc to prometheus
+func DescribeCollectStats(ch chan<- *prometheus.Desc) {
+	ch <- descCollectDuration
+	ch <- descCollectErrors
+}
+
+// UpdateCollectStats report the scrape duration and error counters of each collector to prometheus
+func UpdateCollectStats(ch chan<- prometheus.Metric) {
+	collectorStats.Range(func(key, value interface{}) bool {
+		name := fmt.Sprint(key)
+		stat, ok := value.(*collectStat)
+		if !ok {
+			return true
+		}
+		stat.mu.Lock()
+		count, sum, errs := stat.count, stat.durationSum, stat.errors
+		stat.mu.Unlock()
+		ch <- prometheus.MustNewConstSummary(descCollectDuration, count, sum, nil, name)
+		ch <- prometheus.MustNewConstMetric(descCollectErrors, prometheus.CounterValue, float64(errs), name)
+		return true
+	})
+}
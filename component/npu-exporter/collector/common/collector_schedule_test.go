@@ -0,0 +1,113 @@
+/* Copyright(C) 2026. Huawei Technologies Co.,Ltd. All rights reserved.
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+// Package common for general collector
+package common
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/smartystreets/goconvey/convey"
+)
+
+const (
+	scheduleUpdateTime = 5 * time.Second
+	scheduleCacheTime  = 100 * time.Second
+	slowInterval       = 30 * time.Second
+)
+
+type slowCollector struct {
+	MetricsCollectorAdapter
+}
+
+type panicCollector struct {
+	MetricsCollectorAdapter
+}
+
+// CollectToCache always panic
+func (c *panicCollector) CollectToCache(n *NpuCollector, chipList []HuaWeiAIChip) {
+	panic("mock panic")
+}
+
+func TestSetCollectorOptions(t *testing.T) {
+	convey.Convey("test set collector options", t, func() {
+		n := &NpuCollector{updateTime: scheduleUpdateTime, cacheTime: scheduleCacheTime}
+		defer collectorOptions.Delete(GetCacheKey(&slowCollector{}))
+		convey.Convey("collector without options should follow the global setting", func() {
+			opts := n.optionsOf(GetCacheKey(&slowCollector{}))
+			convey.So(opts.Interval, convey.ShouldEqual, scheduleUpdateTime)
+			convey.So(opts.CacheTime, convey.ShouldEqual, scheduleCacheTime)
+		})
+		convey.Convey("interval shorter than updateTime should be raised", func() {
+			SetCollectorOptions(n, &slowCollector{}, CollectorOptions{Interval: time.Second})
+			convey.So(n.optionsOf(GetCacheKey(&slowCollector{})).Interval, convey.ShouldEqual, scheduleUpdateTime)
+		})
+		convey.Convey("cache time shorter than interval should be extended", func() {
+			SetCollectorOptions(n, &slowCollector{}, CollectorOptions{Interval: slowInterval, CacheTime: time.Second})
+			convey.So(n.cacheTimeOf(GetCacheKey(&slowCollector{})), convey.ShouldEqual,
+				slowInterval+scheduleCacheTime)
+		})
+	})
+}
+
+func TestCollectSchedule(t *testing.T) {
+	convey.Convey("test collect schedule", t, func() {
+		n := &NpuCollector{updateTime: scheduleUpdateTime, cacheTime: scheduleCacheTime}
+		SetCollectorOptions(n, &slowCollector{}, CollectorOptions{Interval: slowInterval})
+		defer collectorOptions.Delete(GetCacheKey(&slowCollector{}))
+		schedule := newCollectSchedule(n)
+		begin := time.Now()
+		convey.So(schedule.isDue(&slowCollector{}, begin), convey.ShouldBeTrue)
+		convey.So(schedule.isDue(&panicCollector{}, begin), convey.ShouldBeTrue)
+
+		next := begin.Add(scheduleUpdateTime)
+		convey.So(schedule.isDue(&slowCollector{}, next), convey.ShouldBeFalse)
+		convey.So(schedule.isDue(&panicCollector{}, next), convey.ShouldBeTrue)
+		// the ticker may fire a little earlier than the interval
+		convey.So(schedule.isDue(&slowCollector{}, begin.Add(slowInterval-time.Second)), convey.ShouldBeTrue)
+	})
+}
+
+func TestCollectWithStats(t *testing.T) {
+	convey.Convey("test collect with stats", t, func() {
+		collectorStats = sync.Map{}
+		n := &NpuCollector{updateTime: scheduleUpdateTime, cacheTime: scheduleCacheTime}
+		collectWithStats(&slowCollector{}, n, nil)
+		collectWithStats(&panicCollector{}, n, nil)
+
+		ch := make(chan prometheus.Metric, 4)
+		UpdateCollectStats(ch)
+		close(ch)
+		errs := make(map[string]float64)
+		counts := make(map[string]uint64)
+		for metric := range ch {
+			out := &dto.Metric{}
+			convey.So(metric.Write(out), convey.ShouldBeNil)
+			name := out.GetLabel()[0].GetValue()
+			if out.GetSummary() != nil {
+				counts[name] = out.GetSummary().GetSampleCount()
+				continue
+			}
+			errs[name] = out.GetCounter().GetValue()
+		}
+		convey.So(counts["slowCollector"], convey.ShouldEqual, 1)
+		convey.So(counts["panicCollector"], convey.ShouldEqual, 1)
+		convey.So(errs["slowCollector"], convey.ShouldEqual, 0)
+		convey.So(errs["panicCollector"], convey.ShouldEqual, 1)
+	})
+}
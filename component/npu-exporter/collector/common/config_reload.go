@@ -0,0 +1,166 @@
+/* Copyright(C) 2026. Huawei Technologies Co.,Ltd. All rights reserved.
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+// Package common for general collector
+package common
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	// disabledCollectors cache key of the collectors disabled by the config file
+	disabledCollectors sync.Map
+	// cardLabelDescs the descs whose labels start with CardLabel, only these descs are filtered by the allowlist
+	cardLabelDescs sync.Map
+	// cardLabelAllowed whether each label of CardLabel is reported, nil means all labels are reported
+	cardLabelAllowed atomic.Pointer[[]bool]
+
+	reloadMu        sync.Mutex
+	reloadRecorded  bool
+	reloadSucceeded bool
+	reloadTimestamp time.Time
+
+	descConfigReloadSuccess = prometheus.NewDesc("npu_exporter_config_reload_success",
+		"whether the last reload of the config file succeeded, 1 is succeeded and 0 is failed", nil, nil)
+	descConfigReloadTimestamp = prometheus.NewDesc("npu_exporter_config_reload_timestamp",
+		"the unix time in seconds of the last successful reload of the config file", nil, nil)
+)
+
+// SetCollectorEnabled enable or disable the collector, a disabled collector is neither collected nor reported
+func SetCollectorEnabled(c MetricsCollector, enabled bool) {
+	if enabled {
+		disabledCollectors.Delete(GetCacheKey(c))
+		return
+	}
+	disabledCollectors.Store(GetCacheKey(c), struct{}{})
+}
+
+// IsCollectorEnabled check whether the collector is enabled, all collectors are enabled by default
+func IsCollectorEnabled(c MetricsCollector) bool {
+	_, disabled := disabledCollectors.Load(GetCacheKey(c))
+	return !disabled
+}
+
+// SetCardLabelAllowlist set the labels of CardLabel to be reported, the values of the other labels are reported
+// as empty. the npu id is always reported, and all labels are reported when allowlist is empty
+func SetCardLabelAllowlist(allowlist []string) error {
+	if len(allowlist) == 0 {
+		cardLabelAllowed.Store(nil)
+		return nil
+	}
+	if err := CheckCardLabelAllowlist(allowlist); err != nil {
+		return err
+	}
+	allowed := make([]bool, len(CardLabel))
+	for _, label := range allowlist {
+		allowed[cardLabelIndex(label)] = true
+	}
+	allowed[cardLabelIndex(npuID)] = true
+	cardLabelAllowed.Store(&allowed)
+	return nil
+}
+
+// CheckCardLabelAllowlist check whether all labels of the allowlist are in CardLabel
+func CheckCardLabelAllowlist(allowlist []string) error {
+	for _, label := range allowlist {
+		if cardLabelIndex(label) < 0 {
+			return fmt.Errorf("label %s is not in %v", label, CardLabel)
+		}
+	}
+	return nil
+}
+
+func cardLabelIndex(label string) int {
+	for i, name := range CardLabel {
+		if name == label {
+			return i
+		}
+	}
+	return -1
+}
+
+// FilterCardLabelValues blank the values of the card labels not in the allowlist, the label names are kept
+// unchanged so the descs stay the same after reloading
+func FilterCardLabelValues(desc *prometheus.Desc, values []string) []string {
+	allowed := cardLabelAllowed.Load()
+	if allowed == nil {
+		return values
+	}
+	if _, ok := cardLabelDescs.Load(desc); !ok {
+		return values
+	}
+	res := make([]string, len(values))
+	copy(res, values)
+	for i, ok := range *allowed {
+		if !ok && i < len(res) {
+			res[i] = ""
+		}
+	}
+	return res
+}
+
+func recordCardLabelDesc(desc *prometheus.Desc, labels []string) {
+	if len(labels) < len(CardLabel) {
+		return
+	}
+	for i, name := range CardLabel {
+		if labels[i] != name {
+			return
+		}
+	}
+	cardLabelDescs.Store(desc, struct{}{})
+}
+
+// RecordConfigReload record the result of reloading the config file
+func RecordConfigReload(success bool) {
+	reloadMu.Lock()
+	defer reloadMu.Unlock()
+	reloadRecorded = true
+	reloadSucceeded = success
+	if success {
+		reloadTimestamp = time.Now()
+	}
+}
+
+// DescribeConfigReload report the config reload metrics desc to prometheus
+func DescribeConfigReload(ch chan<- *prometheus.Desc) {
+	ch <- descConfigReloadSuccess
+	ch <- descConfigReloadTimestamp
+}
+
+// UpdateConfigReload report the config reload metrics to prometheus, nothing is reported when the config file
+// is not used
+func UpdateConfigReload(ch chan<- prometheus.Metric) {
+	reloadMu.Lock()
+	recorded, succeeded, timestamp := reloadRecorded, reloadSucceeded, reloadTimestamp
+	reloadMu.Unlock()
+	if !recorded {
+		return
+	}
+	success := 0.0
+	if succeeded {
+		success = 1
+	}
+	ch <- prometheus.MustNewConstMetric(descConfigReloadSuccess, prometheus.GaugeValue, success)
+	if !timestamp.IsZero() {
+		ch <- prometheus.MustNewConstMetric(descConfigReloadTimestamp, prometheus.GaugeValue,
+			float64(timestamp.Unix()))
+	}
+}
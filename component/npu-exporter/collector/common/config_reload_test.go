@@ -0,0 +1,78 @@
+/* Copyright(C) 2026. Huawei Technologies Co.,Ltd. All rights reserved.
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+// Package common for general collector
+package common
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/smartystreets/goconvey/convey"
+)
+
+func TestSetCollectorEnabled(t *testing.T) {
+	convey.Convey("test disabled collector is not due", t, func() {
+		n := &NpuCollector{updateTime: scheduleUpdateTime, cacheTime: scheduleCacheTime}
+		schedule := newCollectSchedule(n)
+		SetCollectorEnabled(&slowCollector{}, false)
+		defer SetCollectorEnabled(&slowCollector{}, true)
+		convey.So(IsCollectorEnabled(&slowCollector{}), convey.ShouldBeFalse)
+		convey.So(IsCollectorEnabled(&panicCollector{}), convey.ShouldBeTrue)
+		convey.So(schedule.isDue(&slowCollector{}, time.Now()), convey.ShouldBeFalse)
+		SetCollectorEnabled(&slowCollector{}, true)
+		convey.So(schedule.isDue(&slowCollector{}, time.Now()), convey.ShouldBeTrue)
+	})
+}
+
+func TestFilterCardLabelValues(t *testing.T) {
+	convey.Convey("test filter card label values by allowlist", t, func() {
+		cardDesc := BuildDesc("test_card_metric", "test")
+		extendedDesc := BuildDescWithLabel("test_extended_metric", "test", append(CardLabel, "extra"))
+		otherDesc := BuildDescWithLabel("test_other_metric", "test", []string{npuID, podName})
+		values := []string{"0", "Ascend910", "uuid", "0000:01:00.0", "ns", "pod", "container"}
+		defer func() {
+			convey.So(SetCardLabelAllowlist(nil), convey.ShouldBeNil)
+		}()
+
+		convey.So(FilterCardLabelValues(cardDesc, values), convey.ShouldResemble, values)
+		convey.So(SetCardLabelAllowlist([]string{modelName, podName}), convey.ShouldBeNil)
+		convey.So(FilterCardLabelValues(cardDesc, values), convey.ShouldResemble,
+			[]string{"0", "Ascend910", "", "", "", "pod", ""})
+		convey.So(FilterCardLabelValues(extendedDesc, append(values, "x")), convey.ShouldResemble,
+			[]string{"0", "Ascend910", "", "", "", "pod", "", "x"})
+		convey.So(FilterCardLabelValues(otherDesc, []string{"0", "pod"}), convey.ShouldResemble,
+			[]string{"0", "pod"})
+		// the values passed in should not be changed
+		convey.So(values[cardLabelIndex(namespace)], convey.ShouldEqual, "ns")
+		convey.So(SetCardLabelAllowlist([]string{"unknown"}), convey.ShouldNotBeNil)
+	})
+}
+
+func TestUpdateConfigReload(t *testing.T) {
+	convey.Convey("test config reload metrics", t, func() {
+		countMetrics := func() int {
+			ch := make(chan prometheus.Metric, len([]string{"success", "timestamp"}))
+			UpdateConfigReload(ch)
+			close(ch)
+			return len(ch)
+		}
+		convey.So(countMetrics(), convey.ShouldEqual, 0)
+		RecordConfigReload(false)
+		convey.So(countMetrics(), convey.ShouldEqual, 1)
+		RecordConfigReload(true)
+		convey.So(countMetrics(), convey.ShouldEqual, len([]string{"success", "timestamp"}))
+	})
+}
@@ -52,6 +52,7 @@ const (
 	// UpdateCachePattern Update cache pattern
 	UpdateCachePattern     = "update Cache,key is %s"
 	connectRefusedMaxRetry = 3
+	podContainersTimeout   = 3
 )
 
 const (
@@ -129,6 +130,12 @@ const (
 	// DomainForProcess domain for process info
 	DomainForProcess = "processInfo"
 
+	// DomainForPodContainers domain for the pod info of containers
+	DomainForPodContainers = "podContainers"
+
+	// DomainForProcessContainer domain for resolving the container of a process
+	DomainForProcessContainer = "processContainer"
+
 	// DomainForHbmUtilization domain for High Bandwidth Memory Utilization
 	DomainForHbmUtilization = "hbmUtilization"
 
@@ -21,6 +21,7 @@ import (
 	"sync"
 
 	"github.com/prometheus/client_golang/prometheus"
+	"go.opentelemetry.io/collector/pdata/pmetric"
 
 	"ascend-common/api"
 	"huawei.com/npu-exporter/v6/collector/container"
@@ -46,12 +47,16 @@ func BuildDescSlice(slice *[]*prometheus.Desc, name string, help string) {
 
 // BuildDesc build desc
 func BuildDesc(name string, help string) *prometheus.Desc {
-	return prometheus.NewDesc(name, help, CardLabel, nil)
+	desc := prometheus.NewDesc(name, help, CardLabel, nil)
+	recordCardLabelDesc(desc, CardLabel)
+	return desc
 }
 
 // BuildDescWithLabel build desc with label
 func BuildDescWithLabel(name string, help string, label []string) *prometheus.Desc {
-	return prometheus.NewDesc(name, help, label, nil)
+	desc := prometheus.NewDesc(name, help, label, nil)
+	recordCardLabelDesc(desc, label)
+	return desc
 }
 
 // MetricsCollector metrics collector
@@ -70,6 +75,11 @@ type MetricsCollector interface {
 	UpdateTelegraf(fieldsMap map[string]map[string]interface{}, n *NpuCollector,
 		containerMap map[int32]container.DevicesInfo, chips []HuaWeiAIChip) map[string]map[string]interface{}
 
+	// UpdateOtlp update otlp metrics, return false when the collector has no native otlp conversion,
+	// then the otlp platform converts the metrics reported by UpdatePrometheus instead
+	UpdateOtlp(metrics pmetric.MetricSlice, n *NpuCollector, containerMap map[int32]container.DevicesInfo,
+		chips []HuaWeiAIChip) bool
+
 	// PreCollect pre handle before collect
 	PreCollect(*NpuCollector, []HuaWeiAIChip)
 
@@ -107,6 +117,13 @@ func (c *MetricsCollectorAdapter) UpdateTelegraf(fieldsMap map[string]map[string
 	return fieldsMap
 }
 
+// UpdateOtlp update otlp metrics, the adapter has no native otlp conversion, so the metrics of UpdatePrometheus
+// are converted instead
+func (c *MetricsCollectorAdapter) UpdateOtlp(metrics pmetric.MetricSlice, n *NpuCollector,
+	containerMap map[int32]container.DevicesInfo, chips []HuaWeiAIChip) bool {
+	return false
+}
+
 // PreCollect pre handle before collect
 func (c *MetricsCollectorAdapter) PreCollect(n *NpuCollector, chipList []HuaWeiAIChip) {
 	if strings.Contains(n.Dmgr.GetDevType(), api.Ascend910A) {
@@ -146,7 +163,7 @@ func UpdateCache[T any](n *NpuCollector, cacheKey string, localCache *sync.Map)
 		return true
 	})
 
-	err = n.cache.Set(cacheKey, cacheInfo, n.cacheTime)
+	err = n.cache.Set(cacheKey, cacheInfo, n.cacheTimeOf(cacheKey))
 	if noNeedToPrintUpdateLog[cacheKey] {
 		return
 	}
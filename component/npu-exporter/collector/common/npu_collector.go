@@ -109,13 +109,14 @@ func startCollectForPluginGoroutine(group *sync.WaitGroup, ctx context.Context,
 }
 
 func runPluginCollect(ctx context.Context, n *NpuCollector, ticker *time.Ticker) {
+	schedule := newCollectSchedule(n)
 	for {
 		select {
 		case <-ctx.Done():
 			logger.Info("received the stop signal,stop plugin collect")
 			return
 		default:
-			collectPluginMetrics(n)
+			collectPluginMetrics(n, schedule)
 			if _, ok := <-ticker.C; !ok {
 				logger.Errorf(tickerFailedPattern, "handling plugin collectors")
 				return
@@ -124,12 +125,16 @@ func runPluginCollect(ctx context.Context, n *NpuCollector, ticker *time.Ticker)
 	}
 }
 
-func collectPluginMetrics(n *NpuCollector) {
+func collectPluginMetrics(n *NpuCollector, schedule *collectSchedule) {
 	chipList := getChipListCache(n)
+	now := time.Now()
 	for _, c := range ChainForCustomPlugin {
+		if !schedule.isDue(c, now) {
+			continue
+		}
 		resultChan := make(chan struct{}, 1)
 		go func(cur MetricsCollector) {
-			cur.CollectToCache(n, chipList)
+			collectWithStats(cur, n, chipList)
 			resultChan <- struct{}{}
 		}(c)
 		select {
@@ -137,6 +142,7 @@ func collectPluginMetrics(n *NpuCollector) {
 			continue
 		case <-time.After(maxCollectTimeout):
 			logger.Errorf("collect timeout for %v", GetCacheKey(c))
+			recordCollectError(GetCacheKey(c))
 			continue
 		}
 
@@ -160,6 +166,7 @@ func runChipCollector(ctx context.Context, n *NpuCollector, chip HuaWeiAIChip) {
 	defer ticker.Stop()
 	goroutinePreCollect(ChainForMultiGoroutine, n)
 	defer goroutinePostCollect(ChainForMultiGoroutine, n)
+	schedule := newCollectSchedule(n)
 	for {
 		select {
 		case <-ctx.Done():
@@ -167,8 +174,11 @@ func runChipCollector(ctx context.Context, n *NpuCollector, chip HuaWeiAIChip) {
 			return
 		default:
 			singleChipSlice := []HuaWeiAIChip{chip}
+			now := time.Now()
 			for _, c := range ChainForMultiGoroutine {
-				c.CollectToCache(n, singleChipSlice)
+				if schedule.isDue(c, now) {
+					collectWithStats(c, n, singleChipSlice)
+				}
 			}
 			if _, ok := <-ticker.C; !ok {
 				logger.Errorf(tickerFailedPattern, "collect for multigroutine ")
@@ -199,6 +209,7 @@ func startCollectSingleGoroutine(group *sync.WaitGroup, ctx context.Context, n *
 		defer ticker.Stop()
 		goroutinePreCollect(ChainForSingleGoroutine, n)
 		defer goroutinePostCollect(ChainForSingleGoroutine, n)
+		schedule := newCollectSchedule(n)
 		for {
 			select {
 			case <-ctx.Done():
@@ -209,7 +220,9 @@ func startCollectSingleGoroutine(group *sync.WaitGroup, ctx context.Context, n *
 				begin := time.Now()
 				chipList := getChipListCache(n)
 				for _, c := range ChainForSingleGoroutine {
-					c.CollectToCache(n, chipList)
+					if schedule.isDue(c, begin) {
+						collectWithStats(c, n, chipList)
+					}
 				}
 				logger.Infof("end to collect npu info by dcmi, time cost :%v", time.Since(begin))
 				if _, ok := <-ticker.C; !ok {
@@ -0,0 +1,276 @@
+/* Copyright(C) 2026. Huawei Technologies Co.,Ltd. All rights reserved.
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+// Package config for general collector
+package config
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"gopkg.in/yaml.v2"
+
+	"ascend-common/common-utils/utils"
+	"huawei.com/npu-exporter/v6/collector/common"
+	"huawei.com/npu-exporter/v6/utils/logger"
+)
+
+const (
+	maxConfigFileSize = 1024 * 1024
+)
+
+// FileConfig the config file of npu-exporter, all fields are hot reloaded when the file changes.
+// the omitted fields take the values of the command line and the metric configuration at startup
+type FileConfig struct {
+	// Collectors config of the metrics groups, key is the metricsGroup in the metric configuration
+	Collectors map[string]GroupConfig `yaml:"collectors"`
+	// LabelAllowlist the card labels reported, the values of the others are reported as empty
+	LabelAllowlist []string `yaml:"labelAllowlist"`
+	// RateLimit the http request limit of the prometheus server
+	RateLimit RateLimitConfig `yaml:"rateLimit"`
+	// TextMetricsFilePath the paths of the text metrics files, see the textMetricsFilePath flag
+	TextMetricsFilePath *string `yaml:"textMetricsFilePath"`
+}
+
+// GroupConfig config of a metrics group
+type GroupConfig struct {
+	// Enabled whether the group is collected and reported, only the groups started at startup can be enabled
+	Enabled *bool `yaml:"enabled"`
+	// Interval collect interval in seconds
+	Interval int `yaml:"interval"`
+	// CacheTime cache ttl in seconds
+	CacheTime int `yaml:"cacheTime"`
+}
+
+// RateLimitConfig the http request limit, see the limitIPReq and concurrency flags
+type RateLimitConfig struct {
+	LimitIPReq  string `yaml:"limitIPReq"`
+	Concurrency int    `yaml:"concurrency"`
+}
+
+// ReloadHook validate and apply the part of the config file not handled by the collectors, such as the http
+// server. Apply is called only when all hooks pass the validation
+type ReloadHook struct {
+	Validate func(cfg *FileConfig) error
+	Apply    func(cfg *FileConfig)
+}
+
+// registeredGroup a metrics group started at startup
+type registeredGroup struct {
+	collector common.MetricsCollector
+	options   common.CollectorOptions
+}
+
+// registeredGroups metricsGroup -> the group started at startup, only these groups can be reloaded
+var registeredGroups = make(map[string]registeredGroup)
+
+// FileConfigWatcher watch the config file and reload it when changed, an invalid config file is rejected and
+// the previous config keeps active
+type FileConfigWatcher struct {
+	path  string
+	n     *common.NpuCollector
+	hooks []ReloadHook
+	// lastContent the content loaded last time, the events not changing the content are ignored
+	lastContent []byte
+}
+
+// NewFileConfigWatcher create the watcher and load the config file, error is returned when the config file is
+// invalid at startup
+func NewFileConfigWatcher(path string, n *common.NpuCollector, hooks ...ReloadHook) (*FileConfigWatcher, error) {
+	absPath, err := filepath.Abs(path)
+	if err != nil {
+		return nil, fmt.Errorf("the config file path is invalid: %v", err)
+	}
+	w := &FileConfigWatcher{path: absPath, n: n, hooks: hooks}
+	if err = w.reload(); err != nil {
+		return nil, err
+	}
+	return w, nil
+}
+
+// Start watch the directory of the config file until ctx is done. the directory is watched instead of the file,
+// because the file of a configmap is replaced by switching the symlink of the directory
+func (w *FileConfigWatcher) Start(ctx context.Context, group *sync.WaitGroup) error {
+	watcher, err := utils.GetFileWatcherChan(filepath.Dir(w.path))
+	if err != nil {
+		return err
+	}
+	group.Add(1)
+	go func() {
+		defer group.Done()
+		defer func() {
+			if err := watcher.Close(); err != nil {
+				logger.Errorf("close config file watcher failed, error: %v", err)
+			}
+		}()
+		w.watch(ctx, watcher)
+	}()
+	return nil
+}
+
+func (w *FileConfigWatcher) watch(ctx context.Context, watcher *utils.FileWatcher) {
+	for {
+		select {
+		case <-ctx.Done():
+			logger.Info("received the stop signal, stop watching the config file")
+			return
+		case event, ok := <-watcher.Events():
+			if !ok {
+				logger.Error("config file event channel is closed")
+				return
+			}
+			if event.Op&(fsnotify.Create|fsnotify.Write|fsnotify.Rename|fsnotify.Remove) == 0 {
+				continue
+			}
+			logger.Debugf("watch config file dir event: %v", event)
+			if err := w.reload(); err != nil {
+				logger.Errorf("reload config file %s failed, keep the previous config: %v", w.path, err)
+			}
+		case watchErr, ok := <-watcher.Errors():
+			if !ok {
+				logger.Error("config file error channel is closed")
+				return
+			}
+			logger.Errorf("watch config file %s failed, error: %v", w.path, watchErr)
+		}
+	}
+}
+
+// reload load and apply the config file when the content changed
+func (w *FileConfigWatcher) reload() error {
+	// the file of a configmap is a symlink to the file in a sub directory, only the symlinks in the directory
+	// of the config file are allowed
+	content, err := utils.ReadLimitBytesWithSymlink(w.path, maxConfigFileSize, func(realPath string) bool {
+		realDir, err := filepath.EvalSymlinks(filepath.Dir(w.path))
+		return err == nil && strings.HasPrefix(realPath, realDir+string(filepath.Separator))
+	})
+	if err != nil {
+		// the file may be being replaced, load it again in the next event even if the content is not changed
+		w.lastContent = nil
+		return w.recordFailure(fmt.Errorf("read config file failed: %v", err))
+	}
+	if w.lastContent != nil && bytes.Equal(content, w.lastContent) {
+		return nil
+	}
+	w.lastContent = content
+	cfg, err := parseFileConfig(content)
+	if err != nil {
+		return w.recordFailure(err)
+	}
+	for _, hook := range w.hooks {
+		if hook.Validate == nil {
+			continue
+		}
+		if err = hook.Validate(cfg); err != nil {
+			return w.recordFailure(err)
+		}
+	}
+	w.apply(cfg)
+	common.RecordConfigReload(true)
+	logger.Infof("config file %s is loaded", w.path)
+	return nil
+}
+
+func (w *FileConfigWatcher) recordFailure(err error) error {
+	common.RecordConfigReload(false)
+	return err
+}
+
+func (w *FileConfigWatcher) apply(cfg *FileConfig) {
+	applyGroupConfigs(w.n, cfg.Collectors)
+	if err := common.SetCardLabelAllowlist(cfg.LabelAllowlist); err != nil {
+		// never happen, the allowlist has been validated
+		logger.Errorf("set label allowlist failed: %v", err)
+	}
+	for _, hook := range w.hooks {
+		if hook.Apply != nil {
+			hook.Apply(cfg)
+		}
+	}
+}
+
+// parseFileConfig parse and validate the config file, unknown fields are rejected to find the typos
+func parseFileConfig(content []byte) (*FileConfig, error) {
+	cfg := &FileConfig{}
+	if err := yaml.UnmarshalStrict(content, cfg); err != nil {
+		return nil, fmt.Errorf("unmarshal config file failed: %v", err)
+	}
+	for name, group := range cfg.Collectors {
+		if !isKnownGroup(name) {
+			return nil, fmt.Errorf("metricsGroup [%s] does not exist", name)
+		}
+		if group.Interval < 0 || group.Interval > maxGroupSeconds ||
+			group.CacheTime < 0 || group.CacheTime > maxGroupSeconds {
+			return nil, fmt.Errorf("interval and cacheTime of metricsGroup [%s] should be in range [0, %d]",
+				name, maxGroupSeconds)
+		}
+	}
+	if err := common.CheckCardLabelAllowlist(cfg.LabelAllowlist); err != nil {
+		return nil, fmt.Errorf("labelAllowlist is invalid: %v", err)
+	}
+	return cfg, nil
+}
+
+func isKnownGroup(name string) bool {
+	for _, groups := range []map[string]common.MetricsCollector{singleGoroutineMap, multiGoroutineMap,
+		pluginCollectorMap} {
+		if _, ok := groups[name]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+func registerGroup(name string, collector common.MetricsCollector, options common.CollectorOptions) {
+	registeredGroups[name] = registeredGroup{collector: collector, options: options}
+}
+
+// applyGroupConfigs apply the group configs to the groups started at startup, the omitted groups and fields
+// are restored to the startup values
+func applyGroupConfigs(n *common.NpuCollector, groups map[string]GroupConfig) {
+	for name := range groups {
+		if _, ok := registeredGroups[name]; !ok {
+			logger.Warnf("metricsGroup [%s] is not started at startup, restart is required to change it", name)
+		}
+	}
+	names := make([]string, 0, len(registeredGroups))
+	for name := range registeredGroups {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		registered := registeredGroups[name]
+		group := groups[name]
+		enabled := group.Enabled == nil || *group.Enabled
+		if enabled != common.IsCollectorEnabled(registered.collector) {
+			logger.Infof("metricsGroup [%s] is changed to enabled: %v", name, enabled)
+		}
+		common.SetCollectorEnabled(registered.collector, enabled)
+		options := registered.options
+		if group.Interval > 0 {
+			options.Interval = time.Duration(group.Interval) * time.Second
+		}
+		if group.CacheTime > 0 {
+			options.CacheTime = time.Duration(group.CacheTime) * time.Second
+		}
+		common.SetCollectorOptions(n, registered.collector, options)
+	}
+}
@@ -0,0 +1,133 @@
+/* Copyright(C) 2026. Huawei Technologies Co.,Ltd. All rights reserved.
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+// Package config for general collector
+package config
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/agiledragon/gomonkey/v2"
+	"github.com/smartystreets/goconvey/convey"
+
+	"huawei.com/npu-exporter/v6/collector/common"
+	"huawei.com/npu-exporter/v6/collector/metrics"
+)
+
+const (
+	testConfigFile  = "config.yaml"
+	testGroupSecond = 30
+	testValidConfig = `
+collectors:
+  hbm:
+    enabled: false
+  network:
+    interval: 30
+labelAllowlist: [id, model_name, pod_name]
+rateLimit:
+  limitIPReq: 10/1
+textMetricsFilePath: /tmp/metrics.json
+`
+)
+
+func TestParseFileConfig(t *testing.T) {
+	convey.Convey("test parse the config file", t, func() {
+		cfg, err := parseFileConfig([]byte(testValidConfig))
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(*cfg.Collectors[groupHbm].Enabled, convey.ShouldBeFalse)
+		convey.So(cfg.Collectors[groupNetwork].Interval, convey.ShouldEqual, testGroupSecond)
+		convey.So(cfg.RateLimit.LimitIPReq, convey.ShouldEqual, "10/1")
+		convey.So(*cfg.TextMetricsFilePath, convey.ShouldEqual, "/tmp/metrics.json")
+
+		invalidConfigs := []string{
+			"collector:\n  hbm:\n    enabled: false\n",
+			"collectors:\n  unknown:\n    enabled: false\n",
+			"collectors:\n  hbm:\n    interval: 3601\n",
+			"collectors:\n  hbm:\n    cacheTime: -1\n",
+			"labelAllowlist: [card_id]\n",
+			"collectors: [hbm]\n",
+		}
+		for _, content := range invalidConfigs {
+			_, err = parseFileConfig([]byte(content))
+			convey.So(err, convey.ShouldNotBeNil)
+		}
+	})
+}
+
+func TestFileConfigWatcherReload(t *testing.T) {
+	convey.Convey("test reload the config file", t, func() {
+		hbm, network := &metrics.HbmCollector{}, &metrics.NetworkCollector{}
+		registeredGroups = map[string]registeredGroup{
+			groupHbm:     {collector: hbm},
+			groupNetwork: {collector: network, options: common.CollectorOptions{CacheTime: time.Minute}},
+		}
+		defer func() {
+			registeredGroups = make(map[string]registeredGroup)
+			common.SetCollectorEnabled(hbm, true)
+			convey.So(common.SetCardLabelAllowlist(nil), convey.ShouldBeNil)
+		}()
+		options := make(map[string]common.CollectorOptions)
+		patches := gomonkey.ApplyFunc(common.SetCollectorOptions,
+			func(_ *common.NpuCollector, c common.MetricsCollector, opts common.CollectorOptions) {
+				options[common.GetCacheKey(c)] = opts
+			})
+		defer patches.Reset()
+		applied := 0
+		hook := ReloadHook{
+			Validate: func(cfg *FileConfig) error {
+				if cfg.RateLimit.Concurrency < 0 {
+					return errors.New("invalid concurrency")
+				}
+				return nil
+			},
+			Apply: func(cfg *FileConfig) { applied++ },
+		}
+		path := filepath.Join(t.TempDir(), testConfigFile)
+		convey.So(os.WriteFile(path, []byte(testValidConfig), 0600), convey.ShouldBeNil)
+
+		w, err := NewFileConfigWatcher(path, &common.NpuCollector{}, hook)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(applied, convey.ShouldEqual, 1)
+		convey.So(common.IsCollectorEnabled(hbm), convey.ShouldBeFalse)
+		convey.So(options[common.GetCacheKey(network)], convey.ShouldResemble, common.CollectorOptions{
+			Interval: testGroupSecond * time.Second, CacheTime: time.Minute})
+
+		convey.Convey("the unchanged content should not be applied again", func() {
+			convey.So(w.reload(), convey.ShouldBeNil)
+			convey.So(applied, convey.ShouldEqual, 1)
+		})
+		convey.Convey("invalid config should be rejected and the previous config keeps active", func() {
+			convey.So(os.WriteFile(path, []byte("rateLimit:\n  concurrency: -1\n"), 0600), convey.ShouldBeNil)
+			convey.So(w.reload(), convey.ShouldNotBeNil)
+			convey.So(applied, convey.ShouldEqual, 1)
+			convey.So(common.IsCollectorEnabled(hbm), convey.ShouldBeFalse)
+		})
+		convey.Convey("the omitted fields should be restored to the startup values", func() {
+			convey.So(os.WriteFile(path, []byte("labelAllowlist: [id]\n"), 0600), convey.ShouldBeNil)
+			convey.So(w.reload(), convey.ShouldBeNil)
+			convey.So(applied, convey.ShouldEqual, len([]string{"startup", "reload"}))
+			convey.So(common.IsCollectorEnabled(hbm), convey.ShouldBeTrue)
+			convey.So(options[common.GetCacheKey(network)], convey.ShouldResemble,
+				common.CollectorOptions{CacheTime: time.Minute})
+		})
+		convey.Convey("the missing config file should fail at startup", func() {
+			_, err = NewFileConfigWatcher(path+".missing", &common.NpuCollector{})
+			convey.So(err, convey.ShouldNotBeNil)
+		})
+	})
+}
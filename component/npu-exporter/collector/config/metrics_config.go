@@ -19,6 +19,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"reflect"
+	"strconv"
+	"time"
 
 	"huawei.com/npu-exporter/v6/collector/common"
 	"huawei.com/npu-exporter/v6/collector/metrics"
@@ -38,6 +40,7 @@ var (
 		groupDDR:     &metrics.DdrCollector{},
 		groupVnpu:    &metrics.VnpuCollector{},
 		groupPcie:    &metrics.PcieCollector{},
+		groupProcess: &metrics.ProcessCollector{},
 	}
 	// multiGoroutineMap metrics in this map will be collected in multi goroutine
 	multiGoroutineMap = map[string]common.MetricsCollector{
@@ -64,6 +67,7 @@ var (
 		{metricsGroup: groupOptical, state: stateOn},
 		{metricsGroup: groupHbm, state: stateOn},
 		{metricsGroup: groupUb, state: stateOn},
+		{metricsGroup: groupProcess, state: stateOFF},
 	}
 	defaultPluginConfigs = []map[string]string{
 		{metricsGroup: groupText, state: stateOn},
@@ -73,6 +77,12 @@ var (
 const (
 	metricsGroup = "metricsGroup"
 	state        = "state"
+	// interval collect interval of the group in seconds, optional, default is the updateTime
+	interval = "interval"
+	// cacheTime cache ttl of the group in seconds, optional, default is the global cache time
+	cacheTime = "cacheTime"
+	// maxGroupSeconds upper limit of interval and cacheTime of a group
+	maxGroupSeconds = 3600
 
 	groupDDR     = "ddr"
 	groupHccs    = "hccs"
@@ -86,8 +96,9 @@ const (
 	groupOptical = "optical"
 	groupHbm     = "hbm"
 	// groupText represents text-based metrics collected by plugin collectors
-	groupText = "text"
-	groupUb   = "ub"
+	groupText    = "text"
+	groupUb      = "ub"
+	groupProcess = "process"
 
 	stateOn  = "ON"
 	stateOFF = "OFF"
@@ -163,11 +174,13 @@ func Register(n *common.NpuCollector) {
 		logger.Infof("metricsGroup [%v] is on", metricsGroupName)
 		collector, exist := singleGoroutineMap[metricsGroupName]
 		if exist && collector.IsSupported(n) {
+			registerCollector(n, metricsGroupName, collector, config)
 			common.ChainForSingleGoroutine = append(common.ChainForSingleGoroutine, collector)
 		}
 
 		collector, exist = multiGoroutineMap[metricsGroupName]
 		if exist && collector.IsSupported(n) {
+			registerCollector(n, metricsGroupName, collector, config)
 			common.ChainForMultiGoroutine = append(common.ChainForMultiGoroutine, collector)
 		}
 	}
@@ -183,6 +196,7 @@ func Register(n *common.NpuCollector) {
 		collector, exist := pluginCollectorMap[metricsGroupName]
 		if exist && collector.IsSupported(n) {
 			logger.Infof("add plugin collector:%v", metricsGroupName)
+			registerCollector(n, metricsGroupName, collector, config)
 			common.ChainForCustomPlugin = append(common.ChainForCustomPlugin, collector)
 		}
 
@@ -193,6 +207,34 @@ func Register(n *common.NpuCollector) {
 	logger.Infof("ChainForCustomPlugin:%#v", common.ChainForCustomPlugin)
 }
 
+func registerCollector(n *common.NpuCollector, name string, collector common.MetricsCollector,
+	config map[string]string) {
+	options := parseCollectorOptions(config)
+	common.SetCollectorOptions(n, collector, options)
+	registerGroup(name, collector, options)
+}
+
+func parseCollectorOptions(config map[string]string) common.CollectorOptions {
+	return common.CollectorOptions{
+		Interval:  parseGroupSeconds(config, interval),
+		CacheTime: parseGroupSeconds(config, cacheTime),
+	}
+}
+
+func parseGroupSeconds(config map[string]string, key string) time.Duration {
+	value, exist := config[key]
+	if !exist || value == "" {
+		return 0
+	}
+	seconds, err := strconv.Atoi(value)
+	if err != nil || seconds <= 0 || seconds > maxGroupSeconds {
+		logger.Warnf("%s of metricsGroup [%v] should be an integer in range [1, %d], ignore it",
+			key, config[metricsGroup], maxGroupSeconds)
+		return 0
+	}
+	return time.Duration(seconds) * time.Second
+}
+
 // UnRegister delete collector from chain
 func UnRegister(worker reflect.Type) {
 	logger.Debugf("unRegister collector:%v", worker)
@@ -19,6 +19,7 @@ import (
 	"ascend-common/common-utils/utils"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/agiledragon/gomonkey/v2"
 	"github.com/smartystreets/goconvey/convey"
@@ -138,6 +139,7 @@ func TestRegister(t *testing.T) {
 		patches.ApplyMethodReturn(&metrics.RoceCollector{}, "IsSupported", true)
 		patches.ApplyMethodReturn(&metrics.OpticalCollector{}, "IsSupported", true)
 		patches.ApplyMethodReturn(&metrics.UbCollector{}, "IsSupported", true)
+		patches.ApplyMethodReturn(&metrics.ProcessCollector{}, "IsSupported", true)
 		patches.ApplyFunc(loadConfiguration, func() {
 			initConfiguration(loadFromFile("../../build/metricConfiguration.json"), &presetConfigs)
 			initConfiguration(loadFromFile("../../build/pluginConfiguration.json"), &pluginConfigs)
@@ -216,3 +218,22 @@ func TestUnRegisterChain(t *testing.T) {
 		})
 	})
 }
+
+func TestParseCollectorOptions(t *testing.T) {
+	convey.Convey("TestParseCollectorOptions", t, func() {
+		convey.Convey("interval and cacheTime should be parsed in seconds", func() {
+			opts := parseCollectorOptions(map[string]string{metricsGroup: groupOptical, state: stateOn,
+				interval: "60", cacheTime: "180"})
+			convey.So(opts.Interval, convey.ShouldEqual, time.Minute)
+			convey.So(opts.CacheTime, convey.ShouldEqual, 3*time.Minute)
+		})
+		convey.Convey("missing or invalid values should follow the global setting", func() {
+			opts := parseCollectorOptions(map[string]string{metricsGroup: groupRoce, state: stateOn,
+				interval: "abc", cacheTime: "-1"})
+			convey.So(opts.Interval, convey.ShouldEqual, 0)
+			convey.So(opts.CacheTime, convey.ShouldEqual, 0)
+			opts = parseCollectorOptions(map[string]string{metricsGroup: groupRoce, state: stateOn})
+			convey.So(opts.Interval, convey.ShouldEqual, 0)
+		})
+	})
+}
@@ -130,6 +130,13 @@ type DevicesInfo struct {
 // DevicesInfos the device information storage map
 type DevicesInfos = map[string]DevicesInfo
 
+// PodContainerInfo the kubernetes info of a container, the fields are empty if the container is not run by k8s
+type PodContainerInfo struct {
+	Namespace     string
+	PodName       string
+	ContainerName string
+}
+
 // DevicesParser the parser which parse device info
 type DevicesParser struct {
 	// instances
@@ -491,6 +498,33 @@ func (dp *DevicesParser) FetchAndParse(resultOut chan<- DevicesInfos) {
 	go dp.doParse(resultOut)
 }
 
+// GetPodContainers queries all containers and returns their pod info, the key is the container id
+func (dp *DevicesParser) GetPodContainers(ctx context.Context) (map[string]PodContainerInfo, error) {
+	if dp.RuntimeOperator == nil {
+		return nil, errors.New("runtime operator is not initialized")
+	}
+	containers, err := dp.RuntimeOperator.GetContainers(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(containers) > maxContainers {
+		return nil, fmt.Errorf("the number of containers %d exceeds the upper limit %d", len(containers),
+			maxContainers)
+	}
+	res := make(map[string]PodContainerInfo, len(containers))
+	for _, c := range containers {
+		if c == nil || c.Id == "" {
+			continue
+		}
+		res[c.Id] = PodContainerInfo{
+			Namespace:     c.Labels[labelK8sPodNamespace],
+			PodName:       c.Labels[labelK8sPodName],
+			ContainerName: c.Labels[labelContainerName],
+		}
+	}
+	return res, nil
+}
+
 func withDefault(v time.Duration, d time.Duration) time.Duration {
 	if v == 0 {
 		return d
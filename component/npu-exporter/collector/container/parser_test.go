@@ -1025,3 +1025,28 @@ func TestWithDefault(t *testing.T) {
 		}
 	})
 }
+
+func TestDevicesParserGetPodContainers(t *testing.T) {
+	convey.Convey("TestDevicesParserGetPodContainers", t, func() {
+		mockOperator := &RuntimeOperatorTool{}
+		dp := &DevicesParser{RuntimeOperator: mockOperator}
+		convey.Convey("should return pod info of containers when get containers success", func() {
+			patches := gomonkey.ApplyMethodReturn(mockOperator, "GetContainers",
+				[]*CommonContainer{createValidContainer(), {Id: testHostContainerID}, nil}, nil)
+			defer patches.Reset()
+			res, err := dp.GetPodContainers(context.Background())
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(res, convey.ShouldResemble, map[string]PodContainerInfo{
+				testContainerID: {Namespace: testPodNamespace, PodName: testPodName,
+					ContainerName: testContainerName},
+				testHostContainerID: {},
+			})
+		})
+		convey.Convey("should return error when get containers failed", func() {
+			patches := gomonkey.ApplyMethodReturn(mockOperator, "GetContainers", nil, errors.New(testOriginalError))
+			defer patches.Reset()
+			_, err := dp.GetPodContainers(context.Background())
+			convey.So(err, convey.ShouldNotBeNil)
+		})
+	})
+}
@@ -21,6 +21,8 @@ import (
 	"fmt"
 	"net"
 	"net/url"
+	"os"
+	"regexp"
 	"strings"
 	"time"
 
@@ -43,8 +45,21 @@ const (
 
 	maxDevicesNum = 100000
 	maxEnvNum     = 10000
+
+	maxCgroupFileLen = 8192
+	procCgroupPath   = "%s/%d/cgroup"
+	// hostProcRoot the /proc of the host mounted in the container, the pids queried from the driver are the pids
+	// in the pid namespace of the host, so the cgroup of them can only be read from the /proc of the host
+	hostProcRoot = "/host/proc"
+	// selfProcRoot the /proc of npu-exporter, which is the /proc of the host when npu-exporter runs on the host
+	// or with hostPID
+	selfProcRoot = "/proc"
 )
 
+// containerIDRe the container id in the cgroup path, such as /docker/<id>, /kubepods/.../<id>
+// and /kubepods.slice/.../cri-containerd-<id>.scope
+var containerIDRe = regexp.MustCompile(`[0-9a-f]{64}`)
+
 // CgroupVersion is the cgroups mode of the host system
 type CgroupVersion int
 
@@ -131,3 +146,41 @@ func makeUpDeviceInfo(c *CommonContainer) (DevicesInfo, error) {
 	deviceInfo.Name = ns + "_" + podName + "_" + containerName
 	return deviceInfo, nil
 }
+
+// GetContainerIDByPid returns the id of the container which the process runs in,
+// empty id is returned if the process runs on the host
+func GetContainerIDByPid(pid int32) (string, error) {
+	return getContainerIDByPid(getProcRoot(), pid)
+}
+
+// getProcRoot returns the /proc of the host mounted in the container, or the /proc of npu-exporter when the
+// host /proc is not mounted
+func getProcRoot() string {
+	if info, err := os.Stat(hostProcRoot); err == nil && info.IsDir() {
+		return hostProcRoot
+	}
+	return selfProcRoot
+}
+
+func getContainerIDByPid(procRoot string, pid int32) (string, error) {
+	if pid <= 0 {
+		return "", fmt.Errorf("invalid pid %d", pid)
+	}
+	data, err := utils.ReadLimitBytes(fmt.Sprintf(procCgroupPath, procRoot, pid), maxCgroupFileLen)
+	if err != nil {
+		return "", err
+	}
+	return parseContainerIDFromCgroup(string(data)), nil
+}
+
+// parseContainerIDFromCgroup returns the container id in the cgroup paths, the innermost one is returned
+// for nested containers such as docker in docker
+func parseContainerIDFromCgroup(content string) string {
+	for _, line := range strings.Split(content, "\n") {
+		ids := containerIDRe.FindAllString(line, -1)
+		if len(ids) != 0 {
+			return ids[len(ids)-1]
+		}
+	}
+	return ""
+}
@@ -19,6 +19,8 @@ import (
 	"context"
 	"errors"
 	"net"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/agiledragon/gomonkey/v2"
@@ -52,6 +54,7 @@ const (
 	testEmptyNamespace       = ""
 	testEmptyPodName         = ""
 	testEmptyContainerName   = ""
+	testHostContainerID      = "host-container"
 )
 
 func init() {
@@ -327,3 +330,51 @@ func validateMakeUpDeviceInfoResult(deviceInfo DevicesInfo, err error, tc struct
 		convey.So(deviceInfo.Name, convey.ShouldEqual, tc.expectedName)
 	}
 }
+
+func TestParseContainerIDFromCgroup(t *testing.T) {
+	const containerID = "0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"
+	testCases := []struct {
+		name     string
+		content  string
+		expected string
+	}{
+		{name: "should return container id when run by docker with cgroup v1",
+			content: "12:memory:/docker/" + containerID + "\n11:cpu:/docker/" + containerID, expected: containerID},
+		{name: "should return container id when run by containerd with systemd cgroup driver",
+			content: "0::/kubepods.slice/kubepods-burstable.slice/kubepods-burstable-pod1b2c_3d4e.slice/" +
+				"cri-containerd-" + containerID + ".scope", expected: containerID},
+		{name: "should return empty id when run on host", content: "0::/user.slice/user-0.slice/session-1.scope",
+			expected: ""},
+	}
+	for _, tc := range testCases {
+		convey.Convey(tc.name, t, func() {
+			convey.So(parseContainerIDFromCgroup(tc.content), convey.ShouldEqual, tc.expected)
+		})
+	}
+	convey.Convey("should return error when pid is invalid", t, func() {
+		_, err := GetContainerIDByPid(0)
+		convey.So(err, convey.ShouldNotBeNil)
+	})
+}
+
+func TestGetContainerIDByPid(t *testing.T) {
+	const (
+		containerID = "0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"
+		pid         = 1234
+		filePerm    = 0600
+		dirPerm     = 0700
+	)
+	convey.Convey("should read the cgroup of the process from the proc root", t, func() {
+		procRoot := t.TempDir()
+		procDir := filepath.Join(procRoot, "1234")
+		convey.So(os.Mkdir(procDir, dirPerm), convey.ShouldBeNil)
+		convey.So(os.WriteFile(filepath.Join(procDir, "cgroup"), []byte("0::/docker/"+containerID), filePerm),
+			convey.ShouldBeNil)
+		id, err := getContainerIDByPid(procRoot, pid)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(id, convey.ShouldEqual, containerID)
+
+		_, err = getContainerIDByPid(procRoot, pid+1)
+		convey.So(err, convey.ShouldNotBeNil)
+	})
+}
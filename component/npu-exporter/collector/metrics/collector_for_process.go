@@ -0,0 +1,204 @@
+/* Copyright(C) 2026. Huawei Technologies Co.,Ltd. All rights reserved.
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+// Package metrics for general collector
+package metrics
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"ascend-common/devmanager/common"
+	colcommon "huawei.com/npu-exporter/v6/collector/common"
+	"huawei.com/npu-exporter/v6/collector/container"
+	"huawei.com/npu-exporter/v6/utils/logger"
+)
+
+const (
+	// shortContainerIDLen the length of the container id shown as the container name when the pod is unknown
+	shortContainerIDLen = 12
+)
+
+var (
+	processLabel = []string{"id", "pid", "namespace", "pod", "container", "container_id"}
+
+	descProcessHbmUsed = colcommon.BuildDescWithLabel("npu_process_hbm_used_bytes",
+		"the hbm used by the process on the npu, unit is 'Byte'. if the process runs on host, "+
+			"the namespace, pod, container and container_id are empty", processLabel)
+)
+
+type processInfo struct {
+	pid         int32
+	memUsage    float64
+	containerID string
+	pod         container.PodContainerInfo
+}
+
+type processCache struct {
+	chip      colcommon.HuaWeiAIChip
+	timestamp time.Time
+	processes []processInfo
+}
+
+// ProcessCollector collects the processes running on the npu and attributes them to the pods
+type ProcessCollector struct {
+	colcommon.MetricsCollectorAdapter
+	// podContainers the pod info of the containers, key is the container id
+	podContainers map[string]container.PodContainerInfo
+	// refreshed whether the podContainers has been refreshed in this round of collection
+	refreshed bool
+}
+
+// Describe description of the metric
+func (c *ProcessCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- descProcessHbmUsed
+}
+
+// CollectToCache collect the processes of each chip to the cache
+func (c *ProcessCollector) CollectToCache(n *colcommon.NpuCollector, chipList []colcommon.HuaWeiAIChip) {
+	c.refreshed = false
+	baseCaches := colcommon.GetInfoFromCache[chipCache](n, colcommon.GetCacheKey(&BaseInfoCollector{}))
+	for _, chip := range chipList {
+		logicID := chip.LogicID
+		cache := processCache{chip: chip, timestamp: time.Now()}
+		info, err := getDevProcessInfo(n, baseCaches, chip)
+		// the processes queried last time are not reported any more when query failed
+		if err == nil && info != nil {
+			cache.processes = c.resolveProcesses(n, logicID, info)
+		}
+		c.LocalCache.Store(chip.PhyId, cache)
+	}
+	colcommon.UpdateCache[processCache](n, colcommon.GetCacheKey(c), &c.LocalCache)
+}
+
+// getDevProcessInfo get the processes of the chip from the cache of the npu group, which has queried them in
+// the same round. the processes are queried only when the npu group is disabled or has not collected the chip yet
+func getDevProcessInfo(n *colcommon.NpuCollector, baseCaches map[int32]chipCache,
+	chip colcommon.HuaWeiAIChip) (*common.DevProcessInfo, error) {
+	if cache, ok := baseCaches[chip.PhyId]; ok && cache.DevProcessInfo != nil {
+		return cache.DevProcessInfo, nil
+	}
+	info, err := n.Dmgr.GetDevProcessInfo(chip.LogicID)
+	handleErr(err, colcommon.DomainForProcess, chip.LogicID)
+	return info, err
+}
+
+func (c *ProcessCollector) resolveProcesses(n *colcommon.NpuCollector, logicID int32,
+	info *common.DevProcessInfo) []processInfo {
+	procNum := int(info.ProcNum)
+	if procNum > len(info.DevProcArray) {
+		procNum = len(info.DevProcArray)
+	}
+	processes := make([]processInfo, 0, procNum)
+	for i := 0; i < procNum; i++ {
+		proc := info.DevProcArray[i]
+		containerID, err := container.GetContainerIDByPid(proc.Pid)
+		if err != nil {
+			// the process may exit after queried, or runs in another pid namespace
+			logger.LogfWithOptions(logger.WarnLevel, logger.LogOptions{Domain: colcommon.DomainForProcessContainer,
+				ID: logicID}, "logicID(%d), get container of process %d failed: %v", logicID, proc.Pid, err)
+		}
+		processes = append(processes, processInfo{
+			pid:         proc.Pid,
+			memUsage:    proc.MemUsage * common.UnitMB,
+			containerID: containerID,
+			pod:         c.getPodContainer(n, containerID),
+		})
+	}
+	return processes
+}
+
+// getPodContainer get the pod info of the container, the pod info of all containers is queried from the
+// container runtime at most once in each round of collection, only when an unknown container is found
+func (c *ProcessCollector) getPodContainer(n *colcommon.NpuCollector, containerID string) container.PodContainerInfo {
+	if containerID == "" {
+		return container.PodContainerInfo{}
+	}
+	if info, ok := c.podContainers[containerID]; ok {
+		return info
+	}
+	if !c.refreshed {
+		c.refreshed = true
+		if podContainers := colcommon.GetPodContainers(n); podContainers != nil {
+			c.podContainers = podContainers
+		}
+	}
+	if c.podContainers == nil {
+		return container.PodContainerInfo{}
+	}
+	info, ok := c.podContainers[containerID]
+	if !ok {
+		// the container is not managed by the container runtime, such as started by docker directly,
+		// record it to avoid querying again until the next refresh
+		c.podContainers[containerID] = info
+	}
+	return info
+}
+
+// UpdatePrometheus update prometheus metrics
+func (c *ProcessCollector) UpdatePrometheus(ch chan<- prometheus.Metric, n *colcommon.NpuCollector,
+	containerMap map[int32]container.DevicesInfo, chips []colcommon.HuaWeiAIChip) {
+	caches := colcommon.GetInfoFromCache[processCache](n, colcommon.GetCacheKey(c))
+	for _, chip := range chips {
+		cache, ok := caches[chip.PhyId]
+		if !ok {
+			continue
+		}
+		phyID := strconv.FormatInt(int64(chip.PhyId), colcommon.Base)
+		for _, proc := range cache.processes {
+			doUpdateMetric(ch, cache.timestamp, proc.memUsage, []string{phyID,
+				strconv.FormatInt(int64(proc.pid), colcommon.Base), proc.pod.Namespace, proc.pod.PodName,
+				getProcessContainerName(proc), proc.containerID}, descProcessHbmUsed)
+		}
+	}
+}
+
+// UpdateTelegraf update telegraf metrics
+func (c *ProcessCollector) UpdateTelegraf(fieldsMap map[string]map[string]interface{}, n *colcommon.NpuCollector,
+	containerMap map[int32]container.DevicesInfo, chips []colcommon.HuaWeiAIChip) map[string]map[string]interface{} {
+	caches := colcommon.GetInfoFromCache[processCache](n, colcommon.GetCacheKey(c))
+	for _, chip := range chips {
+		cache, ok := caches[chip.PhyId]
+		if !ok {
+			continue
+		}
+		fieldMap := getFieldMap(fieldsMap, cache.chip.LogicID)
+		for _, proc := range cache.processes {
+			doUpdateTelegraf(fieldMap, descProcessHbmUsed, proc.memUsage, "_"+strconv.Itoa(int(proc.pid)))
+		}
+	}
+	return fieldsMap
+}
+
+// IsSupported check whether the collector is supported
+func (c *ProcessCollector) IsSupported(n *colcommon.NpuCollector) bool {
+	productTypes := n.Dmgr.GetProductTypeArray()
+	isSupport := !(len(productTypes) == 1 && productTypes[0] == common.Atlas200ISoc)
+	logForUnSupportDevice(isSupport, n.Dmgr.GetDevType(), colcommon.GetCacheKey(c), "")
+	return isSupport
+}
+
+// getProcessContainerName the container name of the pod, or the short container id for the containers
+// not run by k8s, such as the containers started by docker directly
+func getProcessContainerName(proc processInfo) string {
+	if proc.pod.ContainerName != "" {
+		return proc.pod.ContainerName
+	}
+	if len(proc.containerID) > shortContainerIDLen {
+		return proc.containerID[:shortContainerIDLen]
+	}
+	return proc.containerID
+}
@@ -0,0 +1,133 @@
+/* Copyright(C) 2026. Huawei Technologies Co.,Ltd. All rights reserved.
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+// Package metrics for general collector
+package metrics
+
+import (
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/agiledragon/gomonkey/v2"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/smartystreets/goconvey/convey"
+
+	"ascend-common/devmanager/common"
+	colcommon "huawei.com/npu-exporter/v6/collector/common"
+	"huawei.com/npu-exporter/v6/collector/container"
+)
+
+const (
+	mockPodContainerID  = "0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"
+	mockHostContainerID = "fedcba9876543210fedcba9876543210fedcba9876543210fedcba9876543210"
+	mockPodPid          = 100
+	mockHostPid         = 200
+	mockDockerPid       = 300
+	mockMemUsageMB      = 2
+)
+
+func mockMultiProcessInfo() *common.DevProcessInfo {
+	return &common.DevProcessInfo{
+		ProcNum: 3,
+		DevProcArray: []common.DevProcInfo{{Pid: mockPodPid, MemUsage: mockMemUsageMB},
+			{Pid: mockHostPid, MemUsage: mockMemUsageMB}, {Pid: mockDockerPid, MemUsage: mockMemUsageMB}},
+	}
+}
+
+func mockContainerIDByPid(pid int32) (string, error) {
+	switch pid {
+	case mockPodPid:
+		return mockPodContainerID, nil
+	case mockDockerPid:
+		return mockHostContainerID, nil
+	default:
+		return "", nil
+	}
+}
+
+func collectProcessLabels(ch chan prometheus.Metric) map[string]map[string]string {
+	res := make(map[string]map[string]string)
+	for len(ch) > 0 {
+		metric := &dto.Metric{}
+		if err := (<-ch).Write(metric); err != nil {
+			continue
+		}
+		labels := make(map[string]string)
+		for _, label := range metric.GetLabel() {
+			labels[label.GetName()] = label.GetValue()
+		}
+		res[labels["pid"]] = labels
+	}
+	return res
+}
+
+func TestProcessCollector(t *testing.T) {
+	n := mockNewNpuCollector()
+	convey.Convey("TestProcessCollector", t, func() {
+		c := &ProcessCollector{}
+		chips := []colcommon.HuaWeiAIChip{createChip()}
+		queryTimes := 0
+		patches := gomonkey.ApplyMethodReturn(n.Dmgr, "GetDevProcessInfo", mockMultiProcessInfo(), nil).
+			ApplyFunc(container.GetContainerIDByPid, mockContainerIDByPid).
+			ApplyFunc(colcommon.GetPodContainers, func(*colcommon.NpuCollector) map[string]container.PodContainerInfo {
+				queryTimes++
+				return map[string]container.PodContainerInfo{mockPodContainerID: {Namespace: mockNs,
+					PodName: mockPodName, ContainerName: mockContainerName}}
+			})
+		defer patches.Reset()
+		c.CollectToCache(n, chips)
+		convey.So(queryTimes, convey.ShouldEqual, 1)
+
+		ch := make(chan prometheus.Metric, maxMetrics)
+		c.UpdatePrometheus(ch, n, nil, chips)
+		labels := collectProcessLabels(ch)
+		convey.So(len(labels), convey.ShouldEqual, len(mockMultiProcessInfo().DevProcArray))
+		convey.So(labels["100"]["pod"], convey.ShouldEqual, mockPodName)
+		convey.So(labels["100"]["namespace"], convey.ShouldEqual, mockNs)
+		convey.So(labels["100"]["container"], convey.ShouldEqual, mockContainerName)
+		convey.So(labels["200"]["container_id"], convey.ShouldBeEmpty)
+		convey.So(labels["300"]["container"], convey.ShouldEqual, mockHostContainerID[:shortContainerIDLen])
+		convey.So(labels["300"]["pod"], convey.ShouldBeEmpty)
+
+		fieldsMap := c.UpdateTelegraf(make(map[string]map[string]interface{}), n, nil, chips)
+		convey.So(fieldsMap["0"]["npu_process_hbm_used_bytes_100"], convey.ShouldEqual,
+			float64(mockMemUsageMB*common.UnitMB))
+
+		convey.Convey("known containers should not be queried again", func() {
+			c.CollectToCache(n, chips)
+			convey.So(queryTimes, convey.ShouldEqual, 1)
+		})
+		convey.Convey("processes should not be reported when query failed", func() {
+			errPatches := gomonkey.ApplyMethodReturn(n.Dmgr, "GetDevProcessInfo", nil, errors.New("mock error"))
+			defer errPatches.Reset()
+			c.CollectToCache(n, chips)
+			ch = make(chan prometheus.Metric, maxMetrics)
+			c.UpdatePrometheus(ch, n, nil, chips)
+			convey.So(len(ch), convey.ShouldEqual, 0)
+		})
+		convey.Convey("processes should be read from the npu cache without querying again", func() {
+			errPatches := gomonkey.ApplyMethodReturn(n.Dmgr, "GetDevProcessInfo", nil, errors.New("mock error"))
+			defer errPatches.Reset()
+			var baseCache sync.Map
+			baseCache.Store(chips[0].PhyId, chipCache{chip: chips[0], DevProcessInfo: mockMultiProcessInfo()})
+			colcommon.UpdateCache[chipCache](n, colcommon.GetCacheKey(&BaseInfoCollector{}), &baseCache)
+			c.CollectToCache(n, chips)
+			ch = make(chan prometheus.Metric, maxMetrics)
+			c.UpdatePrometheus(ch, n, nil, chips)
+			convey.So(len(ch), convey.ShouldEqual, len(mockMultiProcessInfo().DevProcArray))
+		})
+	})
+}
@@ -530,6 +530,7 @@ func initChain() {
 		&NetworkCollector{},
 		&RoceCollector{},
 		&OpticalCollector{},
+		&ProcessCollector{},
 	}
 }
 
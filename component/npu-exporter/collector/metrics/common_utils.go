@@ -85,8 +85,8 @@ func doUpdateMetric(ch chan<- prometheus.Metric, timestamp time.Time, value inte
 	if finalValue == common.FailedValue {
 		finalValue = common.FailedMetricValue
 	}
-	ch <- prometheus.NewMetricWithTimestamp(timestamp,
-		prometheus.MustNewConstMetric(desc, prometheus.GaugeValue, finalValue, cardLabel...))
+	ch <- prometheus.NewMetricWithTimestamp(timestamp, prometheus.MustNewConstMetric(desc, prometheus.GaugeValue,
+		finalValue, colcommon.FilterCardLabelValues(desc, cardLabel)...))
 }
 
 func getContainerInfoWithDefault(cNameArray []string) (containerName, namespaceValue, podNameValue string) {
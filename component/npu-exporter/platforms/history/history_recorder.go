@@ -0,0 +1,319 @@
+/* Copyright(C) 2026. Huawei Technologies Co.,Ltd. All rights reserved.
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+// Package history for recording the metrics of the collectors and serving the range queries
+package history
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"math"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+
+	"huawei.com/npu-exporter/v6/collector/common"
+	"huawei.com/npu-exporter/v6/collector/container"
+	"huawei.com/npu-exporter/v6/utils"
+	"huawei.com/npu-exporter/v6/utils/logger"
+)
+
+const (
+	// APIPath the path of the history query api
+	APIPath = "/api/v1/history"
+
+	chipLabel     = "id"
+	paramChip     = "chip"
+	paramMetric   = "metric"
+	paramSince    = "since"
+	bearerPrefix  = "Bearer "
+	maxChipID     = math.MaxInt16
+	metricBufSize = 100
+)
+
+var metricNameRegexp = regexp.MustCompile(`^[a-zA-Z_:][a-zA-Z0-9_:]{0,255}$`)
+
+// Config config of the history recorder
+type Config struct {
+	// Interval interval of recording the metrics, usually the same as the collect interval
+	Interval time.Duration
+	// Retention how long the samples are kept
+	Retention time.Duration
+	// MaxSeries the max number of series kept, the new series are dropped when exceeded
+	MaxSeries int
+	// Token the bearer token of the query api, the api rejects all requests when it is empty
+	Token string
+}
+
+// Response the response of the history query api
+type Response struct {
+	Chip   int32    `json:"chip"`
+	Since  int64    `json:"since"`
+	Series []Series `json:"series"`
+}
+
+// Recorder records the samples of the chips from the caches of the collectors periodically and serves the
+// history query api
+type Recorder struct {
+	collector *common.NpuCollector
+	cfg       Config
+	store     *store
+	// reportedDrops the dropped samples already reported in log
+	reportedDrops uint64
+}
+
+// NewRecorder create a history recorder, the samples are read from the caches of the collectors, so they are
+// recorded whatever the platform is and whether the metrics are scraped or pushed
+func NewRecorder(collector *common.NpuCollector, cfg Config) (*Recorder, error) {
+	if collector == nil {
+		return nil, errors.New("collector of history recorder is nil")
+	}
+	if cfg.Interval <= 0 || cfg.Retention < cfg.Interval {
+		return nil, errors.New("history interval must be positive and not greater than the retention")
+	}
+	if cfg.MaxSeries <= 0 {
+		return nil, errors.New("history max series must be positive")
+	}
+	if cfg.Token == "" {
+		return nil, errors.New("history token is required")
+	}
+	// one more sample to cover the whole retention
+	capacity := int(cfg.Retention/cfg.Interval) + 1
+	return &Recorder{
+		collector: collector,
+		cfg:       cfg,
+		store:     newStore(cfg.Retention, capacity, cfg.MaxSeries),
+	}, nil
+}
+
+// Start record the samples every interval until ctx is done
+func (r *Recorder) Start(ctx context.Context, group *sync.WaitGroup) {
+	group.Add(1)
+	go func() {
+		defer group.Done()
+		ticker := time.NewTicker(r.cfg.Interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				logger.Info("received the stop signal,stop history recording")
+				return
+			case now := <-ticker.C:
+				r.record(r.readCaches(), now)
+			}
+		}
+	}()
+}
+
+// readCaches read the metrics of all chains from the caches of the collectors
+func (r *Recorder) readCaches() []*dto.MetricFamily {
+	containerMap := common.GetContainerNPUInfo(r.collector)
+	chips := common.GetChipListWithVNPU(r.collector)
+	families := make(map[string]*dto.MetricFamily)
+	for _, chain := range [][]common.MetricsCollector{common.ChainForSingleGoroutine,
+		common.ChainForMultiGoroutine, common.ChainForCustomPlugin} {
+		r.readChain(families, containerMap, chips, chain)
+	}
+	res := make([]*dto.MetricFamily, 0, len(families))
+	for _, family := range families {
+		res = append(res, family)
+	}
+	return res
+}
+
+func (r *Recorder) readChain(families map[string]*dto.MetricFamily, containerMap map[int32]container.DevicesInfo,
+	chips []common.HuaWeiAIChip, chain []common.MetricsCollector) {
+	for _, collector := range chain {
+		if collector == nil || !common.IsCollectorEnabled(collector) {
+			continue
+		}
+		ch := make(chan prometheus.Metric, metricBufSize)
+		go func(cur common.MetricsCollector) {
+			defer close(ch)
+			cur.UpdatePrometheus(ch, r.collector, containerMap, chips)
+		}(collector)
+		for metric := range ch {
+			addMetric(families, metric)
+		}
+	}
+}
+
+func addMetric(families map[string]*dto.MetricFamily, metric prometheus.Metric) {
+	name := utils.GetDescName(metric.Desc())
+	pb := &dto.Metric{}
+	if name == "" || metric.Write(pb) != nil {
+		return
+	}
+	family, ok := families[name]
+	if !ok {
+		family = &dto.MetricFamily{Name: &name, Type: metricType(pb).Enum()}
+		families[name] = family
+	}
+	family.Metric = append(family.Metric, pb)
+}
+
+func metricType(pb *dto.Metric) dto.MetricType {
+	switch {
+	case pb.GetCounter() != nil:
+		return dto.MetricType_COUNTER
+	case pb.GetGauge() != nil:
+		return dto.MetricType_GAUGE
+	case pb.GetHistogram() != nil:
+		return dto.MetricType_HISTOGRAM
+	case pb.GetSummary() != nil:
+		return dto.MetricType_SUMMARY
+	default:
+		return dto.MetricType_UNTYPED
+	}
+}
+
+func (r *Recorder) record(families []*dto.MetricFamily, now time.Time) {
+	for _, family := range families {
+		if family.GetType() != dto.MetricType_GAUGE && family.GetType() != dto.MetricType_COUNTER &&
+			family.GetType() != dto.MetricType_UNTYPED {
+			continue
+		}
+		for _, metric := range family.GetMetric() {
+			r.recordMetric(family.GetName(), metric, now)
+		}
+	}
+	r.store.expire(now)
+	dropped := r.store.droppedNum()
+	if dropped > r.reportedDrops {
+		logger.Warnf("the number of history series exceeds %d, %d samples are dropped",
+			r.cfg.MaxSeries, dropped-r.reportedDrops)
+		r.reportedDrops = dropped
+	}
+}
+
+func (r *Recorder) recordMetric(name string, metric *dto.Metric, now time.Time) {
+	chip := int64(-1)
+	labels := make(map[string]string, len(metric.GetLabel()))
+	for _, label := range metric.GetLabel() {
+		if label.GetName() != chipLabel {
+			labels[label.GetName()] = label.GetValue()
+			continue
+		}
+		id, err := strconv.ParseInt(label.GetValue(), 10, 32)
+		if err != nil {
+			return
+		}
+		chip = id
+	}
+	// only the metrics of chips are recorded
+	if chip < 0 {
+		return
+	}
+	var value float64
+	switch {
+	case metric.GetGauge() != nil:
+		value = metric.GetGauge().GetValue()
+	case metric.GetCounter() != nil:
+		value = metric.GetCounter().GetValue()
+	case metric.GetUntyped() != nil:
+		value = metric.GetUntyped().GetValue()
+	default:
+		return
+	}
+	// NaN and Inf can not be encoded into json
+	if math.IsNaN(value) || math.IsInf(value, 0) {
+		return
+	}
+	timestamp := metric.GetTimestampMs()
+	if timestamp <= 0 {
+		timestamp = now.UnixMilli()
+	}
+	r.store.add(int32(chip), name, labels, Sample{Timestamp: timestamp, Value: value})
+}
+
+// ServeHTTP serve the history query api, e.g. /api/v1/history?chip=0&metric=npu_chip_info_temperature&since=10m
+// chip is required, all metrics of the chip are returned if metric is empty. since can be a duration before now,
+// a RFC3339 time or unix seconds, the whole retention is returned if since is empty
+func (r *Recorder) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodGet {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	if !r.authorized(req) {
+		w.Header().Set("WWW-Authenticate", "Bearer")
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+	now := time.Now()
+	chip, metric, since, err := r.parseQuery(req, now)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	data, err := json.Marshal(Response{Chip: chip, Since: since, Series: r.store.query(chip, metric, since)})
+	if err != nil {
+		logger.Errorf("marshal history response failed: %v", err)
+		http.Error(w, "internal error", http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if _, err = w.Write(data); err != nil {
+		logger.Errorf("write history response failed: %v", err)
+	}
+}
+
+func (r *Recorder) authorized(req *http.Request) bool {
+	auth := req.Header.Get("Authorization")
+	if r.cfg.Token == "" || !strings.HasPrefix(auth, bearerPrefix) {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(strings.TrimPrefix(auth, bearerPrefix)), []byte(r.cfg.Token)) == 1
+}
+
+func (r *Recorder) parseQuery(req *http.Request, now time.Time) (int32, string, int64, error) {
+	query := req.URL.Query()
+	chip, err := strconv.Atoi(query.Get(paramChip))
+	if err != nil || chip < 0 || chip > maxChipID {
+		return 0, "", 0, errors.New("chip should be a valid chip id")
+	}
+	metric := query.Get(paramMetric)
+	if metric != "" && !metricNameRegexp.MatchString(metric) {
+		return 0, "", 0, errors.New("metric should be a valid metric name")
+	}
+	since, err := parseSince(query.Get(paramSince), now, r.cfg.Retention)
+	if err != nil {
+		return 0, "", 0, err
+	}
+	return int32(chip), metric, since, nil
+}
+
+// parseSince parse since to unix milliseconds
+func parseSince(since string, now time.Time, retention time.Duration) (int64, error) {
+	if since == "" {
+		return now.Add(-retention).UnixMilli(), nil
+	}
+	if d, err := time.ParseDuration(since); err == nil && d >= 0 {
+		return now.Add(-d).UnixMilli(), nil
+	}
+	if t, err := time.Parse(time.RFC3339, since); err == nil {
+		return t.UnixMilli(), nil
+	}
+	if seconds, err := strconv.ParseInt(since, 10, 64); err == nil && seconds >= 0 {
+		return time.Unix(seconds, 0).UnixMilli(), nil
+	}
+	return 0, errors.New("since should be a duration, a RFC3339 time or unix seconds")
+}
@@ -0,0 +1,238 @@
+/* Copyright(C) 2026. Huawei Technologies Co.,Ltd. All rights reserved.
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+// Package history for recording the metrics of the collectors and serving the range queries
+package history
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/agiledragon/gomonkey/v2"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/smartystreets/goconvey/convey"
+
+	"ascend-common/common-utils/hwlog"
+	"huawei.com/npu-exporter/v6/collector/common"
+	"huawei.com/npu-exporter/v6/collector/container"
+	"huawei.com/npu-exporter/v6/utils/logger"
+)
+
+const (
+	testToken      = "test-token"
+	testInterval   = 5 * time.Second
+	testRetention  = 20 * time.Second
+	testMaxSeries  = 4
+	testTempMetric = "npu_chip_info_temperature"
+	testHbmMetric  = "npu_chip_info_hbm_used_memory"
+	testChipNum    = 2
+	testRounds     = 8
+)
+
+func init() {
+	logger.HwLogConfig = &hwlog.LogConfig{
+		OnlyToStdout: true,
+	}
+	logger.InitLogger("Prometheus")
+}
+
+// mockCollector reports the temperature and hbm of the chips from its cache, the value is the round of collecting
+type mockCollector struct {
+	common.MetricsCollectorAdapter
+	round float64
+	extra int
+}
+
+func (m *mockCollector) UpdatePrometheus(ch chan<- prometheus.Metric, n *common.NpuCollector,
+	containerMap map[int32]container.DevicesInfo, chips []common.HuaWeiAIChip) {
+	tempDesc := prometheus.NewDesc(testTempMetric, "", []string{chipLabel, "model_name"}, nil)
+	hbmDesc := prometheus.NewDesc(testHbmMetric, "", []string{chipLabel}, nil)
+	versionDesc := prometheus.NewDesc("npu_exporter_version_info", "", []string{"exporterVersion"}, nil)
+	for chip := 0; chip < testChipNum; chip++ {
+		id := string(rune('0' + chip))
+		ch <- prometheus.MustNewConstMetric(tempDesc, prometheus.GaugeValue, m.round, id, "Ascend910")
+		ch <- prometheus.MustNewConstMetric(hbmDesc, prometheus.GaugeValue, m.round, id)
+	}
+	for i := 0; i < m.extra; i++ {
+		ch <- prometheus.MustNewConstMetric(prometheus.NewDesc("extra_metric", "", []string{chipLabel, "port"},
+			nil), prometheus.GaugeValue, m.round, "0", string(rune('a'+i)))
+	}
+	ch <- prometheus.MustNewConstMetric(versionDesc, prometheus.GaugeValue, 1, "v1")
+}
+
+func newTestRecorder(collector *mockCollector, maxSeries int) *Recorder {
+	common.ChainForSingleGoroutine = []common.MetricsCollector{collector}
+	common.ChainForMultiGoroutine = nil
+	common.ChainForCustomPlugin = nil
+	recorder, err := NewRecorder(&common.NpuCollector{}, Config{Interval: testInterval, Retention: testRetention,
+		MaxSeries: maxSeries, Token: testToken})
+	convey.So(err, convey.ShouldBeNil)
+	return recorder
+}
+
+func patchCaches() *gomonkey.Patches {
+	patches := gomonkey.NewPatches()
+	patches.ApplyFuncReturn(common.GetContainerNPUInfo, map[int32]container.DevicesInfo{})
+	patches.ApplyFuncReturn(common.GetChipListWithVNPU, []common.HuaWeiAIChip{})
+	return patches
+}
+
+func query(recorder *Recorder, url, token string) (*httptest.ResponseRecorder, Response) {
+	req := httptest.NewRequest(http.MethodGet, url, nil)
+	if token != "" {
+		req.Header.Set("Authorization", bearerPrefix+token)
+	}
+	w := httptest.NewRecorder()
+	recorder.ServeHTTP(w, req)
+	var resp Response
+	if w.Code == http.StatusOK {
+		convey.So(json.Unmarshal(w.Body.Bytes(), &resp), convey.ShouldBeNil)
+	}
+	return w, resp
+}
+
+func TestRecorder(t *testing.T) {
+	convey.Convey("test history recorder", t, func() {
+		patches := patchCaches()
+		defer patches.Reset()
+		collector := &mockCollector{}
+		recorder := newTestRecorder(collector, testMaxSeries*testChipNum)
+		start := time.Now()
+		for i := 0; i < testRounds; i++ {
+			collector.round = float64(i)
+			recorder.record(recorder.readCaches(), start.Add(time.Duration(i)*testInterval))
+		}
+		convey.So(recorder.store.seriesNum(), convey.ShouldEqual, testChipNum*len([]string{testTempMetric,
+			testHbmMetric}))
+
+		convey.Convey("the samples out of the buffer should be overwritten", func() {
+			_, resp := query(recorder, APIPath+"?chip=1&metric="+testTempMetric+"&since=1h", testToken)
+			convey.So(resp.Chip, convey.ShouldEqual, 1)
+			convey.So(len(resp.Series), convey.ShouldEqual, 1)
+			series := resp.Series[0]
+			convey.So(series.Labels, convey.ShouldResemble, map[string]string{"model_name": "Ascend910"})
+			capacity := int(testRetention/testInterval) + 1
+			convey.So(len(series.Samples), convey.ShouldEqual, capacity)
+			convey.So(series.Samples[0].Value, convey.ShouldEqual, testRounds-capacity)
+			convey.So(series.Samples[capacity-1].Value, convey.ShouldEqual, testRounds-1)
+		})
+		convey.Convey("all metrics of the chip should be returned when metric is empty", func() {
+			_, resp := query(recorder, APIPath+"?chip=0&since=1h", testToken)
+			convey.So(len(resp.Series), convey.ShouldEqual, len([]string{testTempMetric, testHbmMetric}))
+			convey.So(resp.Series[0].Metric, convey.ShouldEqual, testHbmMetric)
+		})
+		convey.Convey("the series without new samples should be expired", func() {
+			recorder.store.expire(start.Add(time.Hour))
+			convey.So(recorder.store.seriesNum(), convey.ShouldEqual, 0)
+		})
+	})
+}
+
+func TestRecorderMaxSeries(t *testing.T) {
+	convey.Convey("test the new series are dropped when exceeding the max series", t, func() {
+		patches := patchCaches()
+		defer patches.Reset()
+		recorder := newTestRecorder(&mockCollector{extra: testMaxSeries}, testMaxSeries)
+		recorder.record(recorder.readCaches(), time.Now())
+		convey.So(recorder.store.seriesNum(), convey.ShouldEqual, testMaxSeries)
+		convey.So(recorder.reportedDrops, convey.ShouldEqual, testMaxSeries)
+	})
+}
+
+func TestReadCaches(t *testing.T) {
+	convey.Convey("test read the metrics from the caches of the enabled collectors", t, func() {
+		patches := patchCaches()
+		defer patches.Reset()
+		collector := &mockCollector{}
+		recorder := newTestRecorder(collector, testMaxSeries*testChipNum)
+		families := recorder.readCaches()
+		convey.So(len(families), convey.ShouldEqual, len([]string{testTempMetric, testHbmMetric,
+			"npu_exporter_version_info"}))
+		for _, family := range families {
+			if family.GetName() == testTempMetric {
+				convey.So(len(family.GetMetric()), convey.ShouldEqual, testChipNum)
+			}
+		}
+		common.SetCollectorEnabled(collector, false)
+		defer common.SetCollectorEnabled(collector, true)
+		convey.So(len(recorder.readCaches()), convey.ShouldEqual, 0)
+	})
+}
+
+func TestServeHTTP(t *testing.T) {
+	convey.Convey("test the history api", t, func() {
+		recorder := newTestRecorder(&mockCollector{}, testMaxSeries)
+		w, _ := query(recorder, APIPath+"?chip=0", "")
+		convey.So(w.Code, convey.ShouldEqual, http.StatusUnauthorized)
+		w, _ = query(recorder, APIPath+"?chip=0", "wrong-token")
+		convey.So(w.Code, convey.ShouldEqual, http.StatusUnauthorized)
+		w, resp := query(recorder, APIPath+"?chip=0", testToken)
+		convey.So(w.Code, convey.ShouldEqual, http.StatusOK)
+		convey.So(resp.Series, convey.ShouldBeEmpty)
+
+		for _, url := range []string{APIPath, APIPath + "?chip=-1", APIPath + "?chip=0&metric=bad-name",
+			APIPath + "?chip=0&since=yesterday"} {
+			w, _ = query(recorder, url, testToken)
+			convey.So(w.Code, convey.ShouldEqual, http.StatusBadRequest)
+		}
+
+		req := httptest.NewRequest(http.MethodPost, APIPath+"?chip=0", nil)
+		req.Header.Set("Authorization", bearerPrefix+testToken)
+		postW := httptest.NewRecorder()
+		recorder.ServeHTTP(postW, req)
+		convey.So(postW.Code, convey.ShouldEqual, http.StatusMethodNotAllowed)
+	})
+}
+
+func TestParseSince(t *testing.T) {
+	convey.Convey("test parse since", t, func() {
+		now := time.Unix(1700000000, 0)
+		since, err := parseSince("", now, testRetention)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(since, convey.ShouldEqual, now.Add(-testRetention).UnixMilli())
+		since, err = parseSince("10m", now, testRetention)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(since, convey.ShouldEqual, now.Add(-10*time.Minute).UnixMilli())
+		since, err = parseSince("1699999000", now, testRetention)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(since, convey.ShouldEqual, int64(1699999000000))
+		since, err = parseSince(now.Format(time.RFC3339), now, testRetention)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(since, convey.ShouldEqual, now.UnixMilli())
+		_, err = parseSince("-10m", now, testRetention)
+		convey.So(err, convey.ShouldNotBeNil)
+	})
+}
+
+func TestNewRecorder(t *testing.T) {
+	convey.Convey("test invalid config of history recorder", t, func() {
+		invalidConfigs := []Config{
+			{Interval: 0, Retention: testRetention, MaxSeries: testMaxSeries, Token: testToken},
+			{Interval: testRetention * testChipNum, Retention: testRetention, MaxSeries: testMaxSeries,
+				Token: testToken},
+			{Interval: testInterval, Retention: testRetention, MaxSeries: 0, Token: testToken},
+			{Interval: testInterval, Retention: testRetention, MaxSeries: testMaxSeries},
+		}
+		for _, cfg := range invalidConfigs {
+			_, err := NewRecorder(&common.NpuCollector{}, cfg)
+			convey.So(err, convey.ShouldNotBeNil)
+		}
+		_, err := NewRecorder(nil, Config{Interval: testInterval, Retention: testRetention,
+			MaxSeries: testMaxSeries, Token: testToken})
+		convey.So(err, convey.ShouldNotBeNil)
+	})
+}
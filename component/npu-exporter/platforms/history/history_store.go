@@ -0,0 +1,187 @@
+/* Copyright(C) 2026. Huawei Technologies Co.,Ltd. All rights reserved.
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+// Package history for keeping the recent samples of the chips in memory
+package history
+
+import (
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Sample a sample of the series
+type Sample struct {
+	// Timestamp unix milliseconds
+	Timestamp int64   `json:"timestamp"`
+	Value     float64 `json:"value"`
+}
+
+// Series the samples of a metric with the same labels, the chip id label is not included
+type Series struct {
+	Metric  string            `json:"metric"`
+	Labels  map[string]string `json:"labels"`
+	Samples []Sample          `json:"samples"`
+}
+
+// ring fixed size ring buffer of samples, the oldest sample is overwritten when full
+type ring struct {
+	metric  string
+	labels  map[string]string
+	samples []Sample
+	// next index of the next sample
+	next int
+	// size number of samples in the buffer
+	size int
+}
+
+func newRing(metric string, labels map[string]string, capacity int) *ring {
+	return &ring{metric: metric, labels: labels, samples: make([]Sample, capacity)}
+}
+
+func (r *ring) latest() (Sample, bool) {
+	if r.size == 0 {
+		return Sample{}, false
+	}
+	return r.samples[(r.next-1+len(r.samples))%len(r.samples)], true
+}
+
+func (r *ring) add(s Sample) {
+	r.samples[r.next] = s
+	r.next = (r.next + 1) % len(r.samples)
+	if r.size < len(r.samples) {
+		r.size++
+	}
+}
+
+// since the samples not earlier than the timestamp, from the oldest to the newest
+func (r *ring) since(timestamp int64) []Sample {
+	res := make([]Sample, 0, r.size)
+	start := (r.next - r.size + len(r.samples)) % len(r.samples)
+	for i := 0; i < r.size; i++ {
+		s := r.samples[(start+i)%len(r.samples)]
+		if s.Timestamp >= timestamp {
+			res = append(res, s)
+		}
+	}
+	return res
+}
+
+// seriesKey identify the series of a chip
+type seriesKey struct {
+	chip   int32
+	metric string
+	labels string
+}
+
+// store the memory-bounded storage of the recent samples, the number of series and the samples of each series
+// are both limited
+type store struct {
+	mu        sync.RWMutex
+	series    map[seriesKey]*ring
+	retention time.Duration
+	capacity  int
+	maxSeries int
+	// dropped number of the samples dropped because of too many series
+	dropped uint64
+}
+
+func newStore(retention time.Duration, capacity, maxSeries int) *store {
+	return &store{
+		series:    make(map[seriesKey]*ring),
+		retention: retention,
+		capacity:  capacity,
+		maxSeries: maxSeries,
+	}
+}
+
+// add append a sample, the sample is ignored if it is not newer than the latest one, because the caches of
+// the collectors may be not updated since the last recording
+func (s *store) add(chip int32, metric string, labels map[string]string, sample Sample) {
+	key := seriesKey{chip: chip, metric: metric, labels: labelsString(labels)}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	r, ok := s.series[key]
+	if !ok {
+		if len(s.series) >= s.maxSeries {
+			s.dropped++
+			return
+		}
+		r = newRing(metric, labels, s.capacity)
+		s.series[key] = r
+	}
+	if latest, exist := r.latest(); exist && sample.Timestamp <= latest.Timestamp {
+		return
+	}
+	r.add(sample)
+}
+
+// expire remove the series without any samples in the retention, such as the series of removed pods
+func (s *store) expire(now time.Time) {
+	deadline := now.Add(-s.retention).UnixMilli()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for key, r := range s.series {
+		if latest, ok := r.latest(); !ok || latest.Timestamp < deadline {
+			delete(s.series, key)
+		}
+	}
+}
+
+// query the series of the chip since the timestamp, all metrics are returned if metric is empty
+func (s *store) query(chip int32, metric string, since int64) []Series {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	res := make([]Series, 0)
+	for key, r := range s.series {
+		if key.chip != chip || (metric != "" && key.metric != metric) {
+			continue
+		}
+		samples := r.since(since)
+		if len(samples) == 0 {
+			continue
+		}
+		res = append(res, Series{Metric: r.metric, Labels: r.labels, Samples: samples})
+	}
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].Metric != res[j].Metric {
+			return res[i].Metric < res[j].Metric
+		}
+		return labelsString(res[i].Labels) < labelsString(res[j].Labels)
+	})
+	return res
+}
+
+func (s *store) seriesNum() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return len(s.series)
+}
+
+func (s *store) droppedNum() uint64 {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.dropped
+}
+
+func labelsString(labels map[string]string) string {
+	// the separators are not valid utf-8, so they never appear in the label names and values
+	pairs := make([]string, 0, len(labels))
+	for k, v := range labels {
+		pairs = append(pairs, k+"\xff"+v)
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, "\xfe")
+}
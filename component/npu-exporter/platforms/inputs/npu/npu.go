@@ -116,6 +116,9 @@ func (npu *WatchNPU) gatherChain(fieldsMap map[string]map[string]interface{}, ch
 	containerMap map[int32]container.DevicesInfo, chips []common.HuaWeiAIChip) map[string]map[string]interface{} {
 
 	for _, collector := range chain {
+		if !common.IsCollectorEnabled(collector) {
+			continue
+		}
 		fieldsMap = collector.UpdateTelegraf(fieldsMap, npu.collector, containerMap, chips)
 	}
 	return fieldsMap
@@ -0,0 +1,399 @@
+/* Copyright(C) 2026. Huawei Technologies Co.,Ltd. All rights reserved.
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+// Package otlp for pushing metrics with the OpenTelemetry protocol
+package otlp
+
+import (
+	"context"
+	"errors"
+	"math"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/pmetric/pmetricotlp"
+
+	"ascend-common/api"
+	"huawei.com/npu-exporter/v6/collector/common"
+	"huawei.com/npu-exporter/v6/collector/container"
+	"huawei.com/npu-exporter/v6/utils"
+	"huawei.com/npu-exporter/v6/utils/logger"
+	"huawei.com/npu-exporter/v6/versions"
+)
+
+const (
+	// ProtocolGrpc push metrics with otlp over grpc
+	ProtocolGrpc = "grpc"
+	// ProtocolHTTP push metrics with otlp over http/protobuf
+	ProtocolHTTP = "http"
+
+	serviceName   = "npu-exporter"
+	scopeName     = "huawei.com/npu-exporter"
+	metricBufSize = 100
+)
+
+// resourceLabels the card labels which are reported as resource attributes, others stay on data points
+var resourceLabels = map[string]string{
+	"id":             "npu.id",
+	"model_name":     "npu.model_name",
+	"vdie_id":        "npu.vdie_id",
+	"pcie_bus_info":  "npu.pcie_bus_info",
+	"namespace":      "k8s.namespace.name",
+	"pod_name":       "k8s.pod.name",
+	"container_name": "container.name",
+}
+
+// Config config for otlp platform
+type Config struct {
+	// Endpoint host:port of the grpc receiver, or the url of the http receiver
+	Endpoint string
+	// Protocol grpc or http
+	Protocol string
+	// Interval interval of pushing metrics
+	Interval time.Duration
+	// Timeout timeout of each push
+	Timeout time.Duration
+}
+
+// CollectorForOtlp Entry point for collecting, converting and pushing
+type CollectorForOtlp struct {
+	collector *common.NpuCollector
+	exporter  metricsExporter
+	interval  time.Duration
+	timeout   time.Duration
+	resource  map[string]string
+}
+
+// NewOtlpCollector create an instance of otlp collector
+func NewOtlpCollector(collector *common.NpuCollector, cfg Config) (*CollectorForOtlp, error) {
+	if cfg.Interval <= 0 || cfg.Timeout <= 0 {
+		return nil, errors.New("otlp interval and timeout must be positive")
+	}
+	exporter, err := newMetricsExporter(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return &CollectorForOtlp{
+		collector: collector,
+		exporter:  exporter,
+		interval:  cfg.Interval,
+		timeout:   cfg.Timeout,
+		resource:  baseResource(),
+	}, nil
+}
+
+func baseResource() map[string]string {
+	nodeName := os.Getenv(api.NodeNameEnv)
+	if nodeName == "" {
+		if hostName, err := os.Hostname(); err == nil {
+			nodeName = hostName
+		}
+	}
+	return map[string]string{
+		"service.name":    serviceName,
+		"service.version": versions.BuildVersion,
+		"host.name":       nodeName,
+	}
+}
+
+// Start push metrics every interval until ctx is done
+func (o *CollectorForOtlp) Start(ctx context.Context, group *sync.WaitGroup) {
+	group.Add(1)
+	go func() {
+		defer group.Done()
+		defer o.close()
+		ticker := time.NewTicker(o.interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				logger.Info("received the stop signal,stop otlp push")
+				return
+			case <-ticker.C:
+				o.push(ctx)
+			}
+		}
+	}()
+}
+
+func (o *CollectorForOtlp) push(ctx context.Context) {
+	md := o.Gather()
+	if md.DataPointCount() == 0 {
+		logger.Debug("no metrics to push by otlp")
+		return
+	}
+	pushCtx, cancel := context.WithTimeout(ctx, o.timeout)
+	defer cancel()
+	if err := o.exporter.export(pushCtx, pmetricotlp.NewExportRequestFromMetrics(md)); err != nil {
+		logger.Errorf("push metrics by otlp failed: %v", err)
+		return
+	}
+	logger.Debugf("push %d data points by otlp", md.DataPointCount())
+}
+
+func (o *CollectorForOtlp) close() {
+	if err := o.exporter.close(); err != nil {
+		logger.Warnf("close otlp exporter failed: %v", err)
+	}
+}
+
+// Gather collect metrics of all chains from cache in otlp data model
+func (o *CollectorForOtlp) Gather() pmetric.Metrics {
+	md := pmetric.NewMetrics()
+	builder := newMetricsBuilder(md, o.resource)
+	containerMap := common.GetContainerNPUInfo(o.collector)
+	chips := common.GetChipListWithVNPU(o.collector)
+	o.gatherChain(builder, containerMap, chips, common.ChainForSingleGoroutine)
+	o.gatherChain(builder, containerMap, chips, common.ChainForMultiGoroutine)
+	o.gatherChain(builder, containerMap, chips, common.ChainForCustomPlugin)
+	return md
+}
+
+func (o *CollectorForOtlp) gatherChain(builder *metricsBuilder, containerMap map[int32]container.DevicesInfo,
+	chips []common.HuaWeiAIChip, chain []common.MetricsCollector) {
+	for _, collector := range chain {
+		if collector == nil || !common.IsCollectorEnabled(collector) {
+			continue
+		}
+		native := pmetric.NewMetricSlice()
+		if collector.UpdateOtlp(native, o.collector, containerMap, chips) {
+			native.MoveAndAppendTo(builder.nodeMetrics())
+			continue
+		}
+		ch := make(chan prometheus.Metric, metricBufSize)
+		go func(cur common.MetricsCollector) {
+			defer close(ch)
+			cur.UpdatePrometheus(ch, o.collector, containerMap, chips)
+		}(collector)
+		for metric := range ch {
+			builder.add(metric)
+		}
+	}
+}
+
+// metricsBuilder groups the converted metrics by resource, one resource for each chip and container
+type metricsBuilder struct {
+	md        pmetric.Metrics
+	base      map[string]string
+	scopes    map[string]pmetric.MetricSlice
+	metrics   map[string]pmetric.Metric
+	timestamp pcommon.Timestamp
+}
+
+func newMetricsBuilder(md pmetric.Metrics, base map[string]string) *metricsBuilder {
+	return &metricsBuilder{
+		md:        md,
+		base:      base,
+		scopes:    make(map[string]pmetric.MetricSlice),
+		metrics:   make(map[string]pmetric.Metric),
+		timestamp: pcommon.NewTimestampFromTime(time.Now()),
+	}
+}
+
+func (b *metricsBuilder) nodeMetrics() pmetric.MetricSlice {
+	return b.scopeMetrics(nil)
+}
+
+func (b *metricsBuilder) scopeMetrics(resAttrs map[string]string) pmetric.MetricSlice {
+	key := attrsKey(resAttrs)
+	if slice, ok := b.scopes[key]; ok {
+		return slice
+	}
+	rm := b.md.ResourceMetrics().AppendEmpty()
+	attrs := rm.Resource().Attributes()
+	for k, v := range b.base {
+		attrs.PutStr(k, v)
+	}
+	for k, v := range resAttrs {
+		attrs.PutStr(k, v)
+	}
+	sm := rm.ScopeMetrics().AppendEmpty()
+	sm.Scope().SetName(scopeName)
+	sm.Scope().SetVersion(versions.BuildVersion)
+	b.scopes[key] = sm.Metrics()
+	return sm.Metrics()
+}
+
+func (b *metricsBuilder) add(metric prometheus.Metric) {
+	if metric == nil {
+		return
+	}
+	dtoMetric := &dto.Metric{}
+	if err := metric.Write(dtoMetric); err != nil {
+		logger.Warnf("convert metric to otlp failed: %v", err)
+		return
+	}
+	name := utils.GetDescName(metric.Desc())
+	if name == "" {
+		return
+	}
+	resAttrs, pointAttrs := splitLabels(dtoMetric.GetLabel())
+	resKey := attrsKey(resAttrs)
+	otlpMetric, ok := b.metrics[resKey+"/"+name]
+	if !ok {
+		otlpMetric = b.scopeMetrics(resAttrs).AppendEmpty()
+		otlpMetric.SetName(name)
+		b.metrics[resKey+"/"+name] = otlpMetric
+	}
+
+	var attrs pcommon.Map
+	switch {
+	case dtoMetric.Counter != nil, dtoMetric.Gauge != nil, dtoMetric.Untyped != nil:
+		dp, ok := addNumberPoint(otlpMetric, dtoMetric)
+		if !ok {
+			return
+		}
+		dp.SetTimestamp(b.pointTimestamp(dtoMetric))
+		attrs = dp.Attributes()
+	case dtoMetric.Histogram != nil:
+		if !ensureType(otlpMetric, pmetric.MetricTypeHistogram) {
+			return
+		}
+		dp := otlpMetric.Histogram().DataPoints().AppendEmpty()
+		setHistogram(dp, dtoMetric.GetHistogram())
+		dp.SetTimestamp(b.pointTimestamp(dtoMetric))
+		attrs = dp.Attributes()
+	case dtoMetric.Summary != nil:
+		if !ensureType(otlpMetric, pmetric.MetricTypeSummary) {
+			return
+		}
+		dp := otlpMetric.Summary().DataPoints().AppendEmpty()
+		setSummary(dp, dtoMetric.GetSummary())
+		dp.SetTimestamp(b.pointTimestamp(dtoMetric))
+		attrs = dp.Attributes()
+	default:
+		logger.Debugf("metric type of %s is not supported by otlp platform", name)
+		return
+	}
+	for k, v := range pointAttrs {
+		attrs.PutStr(k, v)
+	}
+}
+
+func (b *metricsBuilder) pointTimestamp(dtoMetric *dto.Metric) pcommon.Timestamp {
+	if dtoMetric.TimestampMs != nil {
+		return pcommon.NewTimestampFromTime(time.UnixMilli(dtoMetric.GetTimestampMs()))
+	}
+	return b.timestamp
+}
+
+// ensureType set the type of the new metric, return false when the metric of the same name has another type
+func ensureType(otlpMetric pmetric.Metric, metricType pmetric.MetricType) bool {
+	if otlpMetric.Type() == pmetric.MetricTypeEmpty {
+		switch metricType {
+		case pmetric.MetricTypeSum:
+			otlpMetric.SetEmptySum().SetIsMonotonic(true)
+			otlpMetric.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+		case pmetric.MetricTypeGauge:
+			otlpMetric.SetEmptyGauge()
+		case pmetric.MetricTypeHistogram:
+			otlpMetric.SetEmptyHistogram().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+		case pmetric.MetricTypeSummary:
+			otlpMetric.SetEmptySummary()
+		default:
+			return false
+		}
+	}
+	return otlpMetric.Type() == metricType
+}
+
+func addNumberPoint(otlpMetric pmetric.Metric, dtoMetric *dto.Metric) (pmetric.NumberDataPoint, bool) {
+	if dtoMetric.Counter != nil {
+		if !ensureType(otlpMetric, pmetric.MetricTypeSum) {
+			return pmetric.NumberDataPoint{}, false
+		}
+		dp := otlpMetric.Sum().DataPoints().AppendEmpty()
+		dp.SetDoubleValue(dtoMetric.Counter.GetValue())
+		return dp, true
+	}
+	if !ensureType(otlpMetric, pmetric.MetricTypeGauge) {
+		return pmetric.NumberDataPoint{}, false
+	}
+	dp := otlpMetric.Gauge().DataPoints().AppendEmpty()
+	if dtoMetric.Gauge != nil {
+		dp.SetDoubleValue(dtoMetric.Gauge.GetValue())
+	} else {
+		dp.SetDoubleValue(dtoMetric.Untyped.GetValue())
+	}
+	return dp, true
+}
+
+// setHistogram convert the cumulative buckets of prometheus to the explicit bounds of otlp, the +Inf bucket of
+// prometheus is the last bucket of otlp without bound
+func setHistogram(dp pmetric.HistogramDataPoint, histogram *dto.Histogram) {
+	dp.SetCount(histogram.GetSampleCount())
+	dp.SetSum(histogram.GetSampleSum())
+	var previous uint64
+	for _, bucket := range histogram.GetBucket() {
+		if math.IsInf(bucket.GetUpperBound(), 1) {
+			continue
+		}
+		dp.ExplicitBounds().Append(bucket.GetUpperBound())
+		dp.BucketCounts().Append(subtractCount(bucket.GetCumulativeCount(), previous))
+		previous = bucket.GetCumulativeCount()
+	}
+	dp.BucketCounts().Append(subtractCount(histogram.GetSampleCount(), previous))
+}
+
+func subtractCount(count, previous uint64) uint64 {
+	if count < previous {
+		return 0
+	}
+	return count - previous
+}
+
+func setSummary(dp pmetric.SummaryDataPoint, summary *dto.Summary) {
+	dp.SetCount(summary.GetSampleCount())
+	dp.SetSum(summary.GetSampleSum())
+	for _, quantile := range summary.GetQuantile() {
+		value := dp.QuantileValues().AppendEmpty()
+		value.SetQuantile(quantile.GetQuantile())
+		value.SetValue(quantile.GetValue())
+	}
+}
+
+// splitLabels the card labels become resource attributes, empty card labels are dropped
+func splitLabels(labels []*dto.LabelPair) (map[string]string, map[string]string) {
+	resAttrs := make(map[string]string)
+	pointAttrs := make(map[string]string)
+	for _, label := range labels {
+		if attrName, ok := resourceLabels[label.GetName()]; ok {
+			if label.GetValue() != "" {
+				resAttrs[attrName] = label.GetValue()
+			}
+			continue
+		}
+		pointAttrs[label.GetName()] = label.GetValue()
+	}
+	return resAttrs, pointAttrs
+}
+
+func attrsKey(attrs map[string]string) string {
+	if len(attrs) == 0 {
+		return ""
+	}
+	pairs := make([]string, 0, len(attrs))
+	for k, v := range attrs {
+		pairs = append(pairs, k+"="+v)
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, ",")
+}
@@ -0,0 +1,274 @@
+/* Copyright(C) 2026. Huawei Technologies Co.,Ltd. All rights reserved.
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+// Package otlp for pushing metrics with the OpenTelemetry protocol
+package otlp
+
+import (
+	"context"
+	"io"
+	"math"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/agiledragon/gomonkey/v2"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/smartystreets/goconvey/convey"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/pmetric/pmetricotlp"
+	"google.golang.org/grpc"
+
+	"ascend-common/common-utils/hwlog"
+	"huawei.com/npu-exporter/v6/collector/common"
+	"huawei.com/npu-exporter/v6/collector/container"
+	"huawei.com/npu-exporter/v6/utils/logger"
+)
+
+const (
+	mockPodName   = "mock-pod"
+	mockNamespace = "mock-ns"
+	mockChipNum   = 2
+	num2          = 2
+	pushTimeout   = 5 * time.Second
+)
+
+var (
+	descMockChip = prometheus.NewDesc("npu_chip_mock_value", "mock chip value", common.CardLabel, nil)
+	descMockNode = prometheus.NewDesc("npu_mock_total", "mock node counter", []string{"type"}, nil)
+)
+
+type mockCollector struct {
+	common.MetricsCollectorAdapter
+}
+
+func (c *mockCollector) UpdatePrometheus(ch chan<- prometheus.Metric, n *common.NpuCollector,
+	containerMap map[int32]container.DevicesInfo, chips []common.HuaWeiAIChip) {
+	ch <- prometheus.MustNewConstMetric(descMockChip, prometheus.GaugeValue, 1,
+		"0", "Ascend910", "", "0000:01:00.0", mockNamespace, mockPodName, "mock-container")
+	ch <- prometheus.MustNewConstMetric(descMockChip, prometheus.GaugeValue, 1,
+		"1", "Ascend910", "", "0000:02:00.0", "", "", "")
+	ch <- prometheus.MustNewConstMetric(descMockNode, prometheus.CounterValue, mockChipNum, "mock")
+}
+
+type nativeCollector struct {
+	common.MetricsCollectorAdapter
+}
+
+func (c *nativeCollector) UpdateOtlp(metrics pmetric.MetricSlice, n *common.NpuCollector,
+	containerMap map[int32]container.DevicesInfo, chips []common.HuaWeiAIChip) bool {
+	metric := metrics.AppendEmpty()
+	metric.SetName("npu_native_value")
+	metric.SetEmptyGauge().DataPoints().AppendEmpty().SetIntValue(1)
+	return true
+}
+
+type mockReceiver struct {
+	pmetricotlp.UnimplementedGRPCServer
+	received chan pmetric.Metrics
+}
+
+func (r *mockReceiver) Export(_ context.Context, req pmetricotlp.ExportRequest) (pmetricotlp.ExportResponse, error) {
+	r.received <- req.Metrics()
+	return pmetricotlp.NewExportResponse(), nil
+}
+
+func init() {
+	logger.HwLogConfig = &hwlog.LogConfig{
+		OnlyToStdout: true,
+	}
+	logger.InitLogger(logger.OtlpPlatform)
+}
+
+func patchChain() *gomonkey.Patches {
+	common.ChainForSingleGoroutine = []common.MetricsCollector{&mockCollector{}, &nativeCollector{}}
+	common.ChainForMultiGoroutine = nil
+	common.ChainForCustomPlugin = nil
+	patches := gomonkey.NewPatches()
+	patches.ApplyFuncReturn(common.GetContainerNPUInfo, map[int32]container.DevicesInfo{})
+	patches.ApplyFuncReturn(common.GetChipListWithVNPU, []common.HuaWeiAIChip{})
+	return patches
+}
+
+func findResource(md pmetric.Metrics, key, value string) (pmetric.ResourceMetrics, bool) {
+	for i := 0; i < md.ResourceMetrics().Len(); i++ {
+		rm := md.ResourceMetrics().At(i)
+		if v, ok := rm.Resource().Attributes().Get(key); ok && v.Str() == value {
+			return rm, true
+		}
+	}
+	return pmetric.NewResourceMetrics(), false
+}
+
+func TestGather(t *testing.T) {
+	convey.Convey("test otlp gather", t, func() {
+		patches := patchChain()
+		defer patches.Reset()
+		o := &CollectorForOtlp{resource: baseResource()}
+		md := o.Gather()
+
+		convey.Convey("chip, pod and container labels should be resource attributes", func() {
+			rm, ok := findResource(md, "k8s.pod.name", mockPodName)
+			convey.So(ok, convey.ShouldBeTrue)
+			attrs := rm.Resource().Attributes()
+			ns, _ := attrs.Get("k8s.namespace.name")
+			convey.So(ns.Str(), convey.ShouldEqual, mockNamespace)
+			id, _ := attrs.Get("npu.id")
+			convey.So(id.Str(), convey.ShouldEqual, "0")
+			_, hasVdie := attrs.Get("npu.vdie_id")
+			convey.So(hasVdie, convey.ShouldBeFalse)
+			svc, _ := attrs.Get("service.name")
+			convey.So(svc.Str(), convey.ShouldEqual, serviceName)
+			metric := rm.ScopeMetrics().At(0).Metrics().At(0)
+			convey.So(metric.Name(), convey.ShouldEqual, "npu_chip_mock_value")
+			convey.So(metric.Type(), convey.ShouldEqual, pmetric.MetricTypeGauge)
+		})
+		convey.Convey("node metrics should keep labels as data point attributes", func() {
+			nodeRes, found := findNodeResource(md)
+			convey.So(found, convey.ShouldBeTrue)
+			metrics := nodeRes.ScopeMetrics().At(0).Metrics()
+			convey.So(metrics.Len(), convey.ShouldEqual, num2)
+			sum := metrics.At(0)
+			convey.So(sum.Type(), convey.ShouldEqual, pmetric.MetricTypeSum)
+			convey.So(sum.Sum().IsMonotonic(), convey.ShouldBeTrue)
+			dp := sum.Sum().DataPoints().At(0)
+			typ, _ := dp.Attributes().Get("type")
+			convey.So(typ.Str(), convey.ShouldEqual, "mock")
+			convey.So(dp.DoubleValue(), convey.ShouldEqual, mockChipNum)
+			convey.So(metrics.At(1).Name(), convey.ShouldEqual, "npu_native_value")
+		})
+		convey.Convey("total data points should contain all metrics", func() {
+			const expectedPoints = 4
+			convey.So(md.DataPointCount(), convey.ShouldEqual, expectedPoints)
+		})
+	})
+}
+
+func TestMetricsBuilderHistogramAndSummary(t *testing.T) {
+	convey.Convey("test convert the histogram and the summary to otlp", t, func() {
+		md := pmetric.NewMetrics()
+		builder := newMetricsBuilder(md, nil)
+		const count, sum = 4, 10
+		histDesc := prometheus.NewDesc("npu_mock_latency", "mock histogram", nil, nil)
+		builder.add(prometheus.MustNewConstHistogram(histDesc, count, sum,
+			map[float64]uint64{1: 2, 5: 3, math.Inf(1): count}))
+		summaryDesc := prometheus.NewDesc("npu_mock_duration", "mock summary", nil, nil)
+		builder.add(prometheus.MustNewConstSummary(summaryDesc, count, sum, map[float64]float64{0.5: 2}))
+
+		metrics := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+		convey.So(metrics.Len(), convey.ShouldEqual, num2)
+		histogram := metrics.At(0)
+		convey.So(histogram.Type(), convey.ShouldEqual, pmetric.MetricTypeHistogram)
+		hdp := histogram.Histogram().DataPoints().At(0)
+		convey.So(hdp.Count(), convey.ShouldEqual, count)
+		convey.So(hdp.Sum(), convey.ShouldEqual, sum)
+		convey.So(hdp.ExplicitBounds().AsRaw(), convey.ShouldResemble, []float64{1, 5})
+		convey.So(hdp.BucketCounts().AsRaw(), convey.ShouldResemble, []uint64{2, 1, 1})
+		summary := metrics.At(1)
+		convey.So(summary.Type(), convey.ShouldEqual, pmetric.MetricTypeSummary)
+		sdp := summary.Summary().DataPoints().At(0)
+		convey.So(sdp.Count(), convey.ShouldEqual, count)
+		convey.So(sdp.QuantileValues().At(0).Value(), convey.ShouldEqual, 2)
+	})
+}
+
+func findNodeResource(md pmetric.Metrics) (pmetric.ResourceMetrics, bool) {
+	for i := 0; i < md.ResourceMetrics().Len(); i++ {
+		rm := md.ResourceMetrics().At(i)
+		if _, ok := rm.Resource().Attributes().Get("npu.id"); !ok {
+			return rm, true
+		}
+	}
+	return pmetric.NewResourceMetrics(), false
+}
+
+func TestPushByGrpc(t *testing.T) {
+	convey.Convey("test otlp push by grpc", t, func() {
+		patches := patchChain()
+		defer patches.Reset()
+		ln, err := net.Listen("tcp", "127.0.0.1:0")
+		convey.So(err, convey.ShouldBeNil)
+		server := grpc.NewServer()
+		receiver := &mockReceiver{received: make(chan pmetric.Metrics, 1)}
+		pmetricotlp.RegisterGRPCServer(server, receiver)
+		go server.Serve(ln)
+		defer server.Stop()
+
+		o, err := NewOtlpCollector(nil, Config{Endpoint: ln.Addr().String(), Protocol: ProtocolGrpc,
+			Interval: time.Second, Timeout: pushTimeout})
+		convey.So(err, convey.ShouldBeNil)
+		defer o.close()
+		o.push(context.Background())
+		select {
+		case md := <-receiver.received:
+			convey.So(md.DataPointCount(), convey.ShouldBeGreaterThan, 0)
+		case <-time.After(pushTimeout):
+			t.Error("otlp grpc receiver got nothing")
+		}
+	})
+}
+
+func TestPushByHTTP(t *testing.T) {
+	convey.Convey("test otlp push by http", t, func() {
+		patches := patchChain()
+		defer patches.Reset()
+		received := make(chan pmetric.Metrics, 1)
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, err := io.ReadAll(r.Body)
+			if err != nil || r.URL.Path != defaultHTTPPath || r.Header.Get("Content-Type") != protobufType {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			req := pmetricotlp.NewExportRequest()
+			if err = req.UnmarshalProto(body); err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			received <- req.Metrics()
+		}))
+		defer server.Close()
+
+		o, err := NewOtlpCollector(nil, Config{Endpoint: server.URL, Protocol: ProtocolHTTP,
+			Interval: time.Second, Timeout: pushTimeout})
+		convey.So(err, convey.ShouldBeNil)
+		ctx, cancel := context.WithCancel(context.Background())
+		wg := &sync.WaitGroup{}
+		o.Start(ctx, wg)
+		select {
+		case md := <-received:
+			_, ok := findResource(md, "k8s.pod.name", mockPodName)
+			convey.So(ok, convey.ShouldBeTrue)
+		case <-time.After(pushTimeout):
+			t.Error("otlp http receiver got nothing")
+		}
+		cancel()
+		wg.Wait()
+	})
+}
+
+func TestNewOtlpCollector(t *testing.T) {
+	convey.Convey("test new otlp collector with invalid config", t, func() {
+		_, err := NewOtlpCollector(nil, Config{Endpoint: "127.0.0.1:4317", Protocol: "udp",
+			Interval: time.Second, Timeout: time.Second})
+		convey.So(err, convey.ShouldNotBeNil)
+		_, err = NewOtlpCollector(nil, Config{Endpoint: "127.0.0.1:4318", Protocol: ProtocolHTTP,
+			Interval: time.Second, Timeout: time.Second})
+		convey.So(err, convey.ShouldNotBeNil)
+		_, err = NewOtlpCollector(nil, Config{Endpoint: "127.0.0.1:4317", Protocol: ProtocolGrpc})
+		convey.So(err, convey.ShouldNotBeNil)
+	})
+}
@@ -0,0 +1,134 @@
+/* Copyright(C) 2026. Huawei Technologies Co.,Ltd. All rights reserved.
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+// Package otlp for pushing metrics with the OpenTelemetry protocol
+package otlp
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	"go.opentelemetry.io/collector/pdata/pmetric/pmetricotlp"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+const (
+	defaultHTTPPath    = "/v1/metrics"
+	protobufType       = "application/x-protobuf"
+	maxRespBodySize    = 4096
+	statusSuccessBegin = 200
+	statusSuccessEnd   = 300
+)
+
+type metricsExporter interface {
+	export(ctx context.Context, req pmetricotlp.ExportRequest) error
+	close() error
+}
+
+func newMetricsExporter(cfg Config) (metricsExporter, error) {
+	switch cfg.Protocol {
+	case ProtocolGrpc:
+		return newGrpcExporter(cfg.Endpoint)
+	case ProtocolHTTP:
+		return newHTTPExporter(cfg.Endpoint)
+	default:
+		return nil, fmt.Errorf("otlp protocol %s is not supported", cfg.Protocol)
+	}
+}
+
+type grpcExporter struct {
+	conn   *grpc.ClientConn
+	client pmetricotlp.GRPCClient
+}
+
+func newGrpcExporter(endpoint string) (*grpcExporter, error) {
+	if endpoint == "" {
+		return nil, fmt.Errorf("otlp grpc endpoint is empty")
+	}
+	conn, err := grpc.Dial(endpoint, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, fmt.Errorf("dial otlp grpc endpoint failed: %v", err)
+	}
+	return &grpcExporter{conn: conn, client: pmetricotlp.NewGRPCClient(conn)}, nil
+}
+
+func (g *grpcExporter) export(ctx context.Context, req pmetricotlp.ExportRequest) error {
+	resp, err := g.client.Export(ctx, req)
+	if err != nil {
+		return err
+	}
+	if rejected := resp.PartialSuccess().RejectedDataPoints(); rejected > 0 {
+		return fmt.Errorf("otlp receiver rejected %d data points: %s", rejected,
+			resp.PartialSuccess().ErrorMessage())
+	}
+	return nil
+}
+
+func (g *grpcExporter) close() error {
+	return g.conn.Close()
+}
+
+type httpExporter struct {
+	url    string
+	client *http.Client
+}
+
+func newHTTPExporter(endpoint string) (*httpExporter, error) {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("parse otlp http endpoint failed: %v", err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" || u.Host == "" {
+		return nil, fmt.Errorf("otlp http endpoint must be an absolute http or https url")
+	}
+	if u.Path == "" || u.Path == "/" {
+		u.Path = defaultHTTPPath
+	}
+	return &httpExporter{url: u.String(), client: &http.Client{}}, nil
+}
+
+func (h *httpExporter) export(ctx context.Context, req pmetricotlp.ExportRequest) error {
+	body, err := req.MarshalProto()
+	if err != nil {
+		return fmt.Errorf("marshal otlp request failed: %v", err)
+	}
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, h.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	httpReq.Header.Set("Content-Type", protobufType)
+	resp, err := h.client.Do(httpReq)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(io.LimitReader(resp.Body, maxRespBodySize))
+	if err != nil {
+		return fmt.Errorf("read otlp response failed: %v", err)
+	}
+	if resp.StatusCode < statusSuccessBegin || resp.StatusCode >= statusSuccessEnd {
+		return fmt.Errorf("otlp receiver returned status %d: %s", resp.StatusCode, string(respBody))
+	}
+	return nil
+}
+
+func (h *httpExporter) close() error {
+	h.client.CloseIdleConnections()
+	return nil
+}
@@ -68,6 +68,8 @@ func (*CollectorForPrometheus) Describe(ch chan<- *prometheus.Desc) {
 	describeChain(tempCh, common.ChainForSingleGoroutine)
 	describeChain(tempCh, common.ChainForMultiGoroutine)
 	describeChain(tempCh, common.ChainForCustomPlugin)
+	common.DescribeCollectStats(tempCh)
+	common.DescribeConfigReload(tempCh)
 
 	close(tempCh)
 
@@ -89,6 +91,10 @@ func (n *CollectorForPrometheus) Collect(ch chan<- prometheus.Metric) {
 	collectChain(ch, n, containerMap, chips, common.ChainForSingleGoroutine)
 	collectChain(ch, n, containerMap, chips, common.ChainForMultiGoroutine)
 	collectChain(ch, n, containerMap, chips, common.ChainForCustomPlugin)
+	if ch != nil {
+		common.UpdateCollectStats(ch)
+		common.UpdateConfigReload(ch)
+	}
 }
 
 func collectChain(ch chan<- prometheus.Metric, n *CollectorForPrometheus, containerMap map[int32]container.DevicesInfo,
@@ -98,6 +104,9 @@ func collectChain(ch chan<- prometheus.Metric, n *CollectorForPrometheus, contai
 		return
 	}
 	for _, collector := range chain {
+		if !common.IsCollectorEnabled(collector) {
+			continue
+		}
 		collector.UpdatePrometheus(ch, n.collector, containerMap, chips)
 	}
 }
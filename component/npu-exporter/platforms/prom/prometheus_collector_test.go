@@ -70,6 +70,8 @@ func TestDescribeNilDesc(t *testing.T) {
 		patches.ApplyFunc(describeChain, func(ch chan<- *prometheus.Desc, chain []common.MetricsCollector) {
 			ch <- nil
 		})
+		patches.ApplyFunc(common.DescribeCollectStats, func(ch chan<- *prometheus.Desc) {})
+		patches.ApplyFunc(common.DescribeConfigReload, func(ch chan<- *prometheus.Desc) {})
 
 		collector.Describe(ch)
 		close(ch)
@@ -100,6 +102,8 @@ func TestDescribeDuplicateDesc(t *testing.T) {
 				index++
 			}
 		})
+		patches.ApplyFunc(common.DescribeCollectStats, func(ch chan<- *prometheus.Desc) {})
+		patches.ApplyFunc(common.DescribeConfigReload, func(ch chan<- *prometheus.Desc) {})
 		collector.Describe(ch)
 		close(ch)
 
@@ -0,0 +1,253 @@
+/* Copyright(C) 2026. Huawei Technologies Co.,Ltd. All rights reserved.
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+// Package remotewrite for pushing metrics with the Prometheus remote-write protocol
+package remotewrite
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/golang/snappy"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"huawei.com/npu-exporter/v6/collector/common"
+	"huawei.com/npu-exporter/v6/platforms/prom"
+	"huawei.com/npu-exporter/v6/utils/logger"
+	"huawei.com/npu-exporter/v6/versions"
+)
+
+const (
+	remoteWriteVersion = "0.1.0"
+	protobufType       = "application/x-protobuf"
+	// maxFlushPerPush the max number of queued requests resent in one push, avoid bursting the receiver
+	maxFlushPerPush = 10
+	// maxErrBodyLen the max length of the response body printed in the error
+	maxErrBodyLen = 256
+)
+
+// Config config for remote-write platform
+type Config struct {
+	// Endpoint url of the remote-write receiver
+	Endpoint string
+	// Interval interval of pushing metrics
+	Interval time.Duration
+	// Timeout timeout of each push
+	Timeout time.Duration
+	// Username and Password basic auth of the receiver, optional
+	Username string
+	Password string
+	// BearerToken bearer token of the receiver, optional, can not be used with basic auth
+	BearerToken string
+	// ExternalLabels labels added to every series, such as cluster and node
+	ExternalLabels map[string]string
+	// QueueDir directory buffering the requests failed to push, empty means buffering in memory
+	QueueDir string
+	// QueueSize the max number of buffered requests, the oldest one is dropped when the queue is full
+	QueueSize int
+}
+
+// CollectorForRemoteWrite Entry point for gathering, converting and pushing
+type CollectorForRemoteWrite struct {
+	gatherer prometheus.Gatherer
+	client   *http.Client
+	cfg      Config
+	queue    *retryQueue
+}
+
+// recoverableError the push can be retried later, such as network errors, 5xx and 429
+type recoverableError struct {
+	error
+}
+
+// NewRemoteWriteCollector create an instance of remote-write collector, the metrics are gathered from the
+// prometheus collector, so they are identical to the pull mode
+func NewRemoteWriteCollector(collector *common.NpuCollector, cfg Config) (*CollectorForRemoteWrite, error) {
+	if err := checkConfig(cfg); err != nil {
+		return nil, err
+	}
+	reg := prometheus.NewRegistry()
+	if err := reg.Register(prom.NewPrometheusCollector(collector)); err != nil {
+		return nil, fmt.Errorf("register prometheus collector failed: %v", err)
+	}
+	queue, err := newRetryQueue(cfg.QueueDir, cfg.QueueSize)
+	if err != nil {
+		return nil, err
+	}
+	return &CollectorForRemoteWrite{
+		gatherer: reg,
+		client:   &http.Client{Timeout: cfg.Timeout},
+		cfg:      cfg,
+		queue:    queue,
+	}, nil
+}
+
+func checkConfig(cfg Config) error {
+	if cfg.Interval <= 0 || cfg.Timeout <= 0 {
+		return errors.New("remote-write interval and timeout must be positive")
+	}
+	if cfg.QueueSize <= 0 {
+		return errors.New("remote-write queue size must be positive")
+	}
+	u, err := url.Parse(cfg.Endpoint)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("remote-write endpoint %s is not a valid http or https url", cfg.Endpoint)
+	}
+	if cfg.BearerToken != "" && cfg.Username != "" {
+		return errors.New("basic auth and bearer token can not be used together")
+	}
+	if err = checkExternalLabels(cfg.ExternalLabels); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Start push metrics every interval until ctx is done
+func (r *CollectorForRemoteWrite) Start(ctx context.Context, group *sync.WaitGroup) {
+	group.Add(1)
+	go func() {
+		defer group.Done()
+		ticker := time.NewTicker(r.cfg.Interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				logger.Info("received the stop signal,stop remote-write push")
+				return
+			case <-ticker.C:
+				r.push(ctx)
+			}
+		}
+	}()
+}
+
+// push resend the buffered requests first to keep the order, then send the current metrics. the current
+// metrics are buffered when the receiver is unavailable
+func (r *CollectorForRemoteWrite) push(ctx context.Context) {
+	payload, seriesNum, err := r.buildPayload()
+	if err != nil {
+		logger.Errorf("build remote-write request failed: %v", err)
+		return
+	}
+	if seriesNum == 0 {
+		logger.Debug("no metrics to push by remote-write")
+		r.flushQueue(ctx)
+		return
+	}
+	if !r.flushQueue(ctx) {
+		r.enqueue(payload)
+		return
+	}
+	if err = r.send(ctx, payload); err != nil {
+		r.handleSendErr(err, payload)
+		return
+	}
+	logger.Debugf("push %d series by remote-write", seriesNum)
+}
+
+// flushQueue resend the buffered requests, return false when the receiver is still unavailable
+func (r *CollectorForRemoteWrite) flushQueue(ctx context.Context) bool {
+	for i := 0; i < maxFlushPerPush; i++ {
+		payload, ok, err := r.queue.peek()
+		if err != nil {
+			logger.Errorf("read remote-write queue failed, drop it: %v", err)
+			r.queue.pop()
+			continue
+		}
+		if !ok {
+			return true
+		}
+		if err = r.send(ctx, payload); err != nil {
+			var recoverable *recoverableError
+			if errors.As(err, &recoverable) {
+				logger.Warnf("resend buffered remote-write request failed: %v", err)
+				return false
+			}
+			logger.Errorf("buffered remote-write request is rejected, drop it: %v", err)
+		}
+		r.queue.pop()
+	}
+	// more requests are waiting, keep buffering the current one to preserve the order
+	return r.queue.len() == 0
+}
+
+func (r *CollectorForRemoteWrite) handleSendErr(err error, payload []byte) {
+	var recoverable *recoverableError
+	if errors.As(err, &recoverable) {
+		logger.Warnf("push metrics by remote-write failed, buffer it: %v", err)
+		r.enqueue(payload)
+		return
+	}
+	logger.Errorf("metrics are rejected by the remote-write receiver: %v", err)
+}
+
+func (r *CollectorForRemoteWrite) enqueue(payload []byte) {
+	if err := r.queue.push(payload); err != nil {
+		logger.Errorf("buffer remote-write request failed: %v", err)
+	}
+}
+
+func (r *CollectorForRemoteWrite) buildPayload() ([]byte, int, error) {
+	families, err := r.gatherer.Gather()
+	if err != nil {
+		// metrics gathered successfully are still pushed, the same as ContinueOnError of the pull mode
+		logger.Warnf("gather metrics with error: %v", err)
+	}
+	req := toWriteRequest(families, r.cfg.ExternalLabels, time.Now())
+	data, err := req.Marshal()
+	if err != nil {
+		return nil, 0, fmt.Errorf("marshal write request failed: %v", err)
+	}
+	return snappy.Encode(nil, data), len(req.Timeseries), nil
+}
+
+func (r *CollectorForRemoteWrite) send(ctx context.Context, payload []byte) error {
+	sendCtx, cancel := context.WithTimeout(ctx, r.cfg.Timeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(sendCtx, http.MethodPost, r.cfg.Endpoint, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Encoding", "snappy")
+	req.Header.Set("Content-Type", protobufType)
+	req.Header.Set("User-Agent", "npu-exporter/"+versions.BuildVersion)
+	req.Header.Set("X-Prometheus-Remote-Write-Version", remoteWriteVersion)
+	if r.cfg.Username != "" {
+		req.SetBasicAuth(r.cfg.Username, r.cfg.Password)
+	}
+	if r.cfg.BearerToken != "" {
+		req.Header.Set("Authorization", "Bearer "+r.cfg.BearerToken)
+	}
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return &recoverableError{err}
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= http.StatusOK && resp.StatusCode < http.StatusMultipleChoices {
+		return nil
+	}
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrBodyLen))
+	err = fmt.Errorf("remote-write receiver returns %s: %s", resp.Status, bytes.TrimSpace(body))
+	if resp.StatusCode >= http.StatusInternalServerError || resp.StatusCode == http.StatusTooManyRequests {
+		return &recoverableError{err}
+	}
+	return err
+}
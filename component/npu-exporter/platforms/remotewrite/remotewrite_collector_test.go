@@ -0,0 +1,340 @@
+/* Copyright(C) 2026. Huawei Technologies Co.,Ltd. All rights reserved.
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+// Package remotewrite for pushing metrics with the Prometheus remote-write protocol
+package remotewrite
+
+import (
+	"context"
+	"io"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/agiledragon/gomonkey/v2"
+	"github.com/golang/snappy"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/prometheus/prometheus/prompb"
+	"github.com/smartystreets/goconvey/convey"
+	"google.golang.org/protobuf/proto"
+
+	"ascend-common/api"
+	"ascend-common/common-utils/hwlog"
+	"huawei.com/npu-exporter/v6/collector/common"
+	"huawei.com/npu-exporter/v6/collector/container"
+	"huawei.com/npu-exporter/v6/utils/logger"
+)
+
+const (
+	mockPodName  = "mock-pod"
+	mockCluster  = "c1"
+	mockNode     = "n1"
+	mockUser     = "user"
+	mockPassword = "password"
+	mockToken    = "token"
+	pushTimeout  = 5 * time.Second
+	num2         = 2
+	num3         = 3
+)
+
+var descMockChip = prometheus.NewDesc("npu_chip_mock_value", "mock chip value", common.CardLabel, nil)
+
+type mockCollector struct {
+	common.MetricsCollectorAdapter
+}
+
+func (c *mockCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- descMockChip
+}
+
+func (c *mockCollector) UpdatePrometheus(ch chan<- prometheus.Metric, n *common.NpuCollector,
+	containerMap map[int32]container.DevicesInfo, chips []common.HuaWeiAIChip) {
+	ch <- prometheus.MustNewConstMetric(descMockChip, prometheus.GaugeValue, 1,
+		"0", "Ascend910", "", "0000:01:00.0", "mock-ns", mockPodName, "mock-container")
+}
+
+type mockReceiver struct {
+	server   *httptest.Server
+	status   int32
+	received chan *prompb.WriteRequest
+	headers  chan http.Header
+}
+
+func newMockReceiver() *mockReceiver {
+	r := &mockReceiver{status: http.StatusNoContent, received: make(chan *prompb.WriteRequest, num3),
+		headers: make(chan http.Header, num3)}
+	r.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		status := int(atomic.LoadInt32(&r.status))
+		if status != http.StatusNoContent {
+			w.WriteHeader(status)
+			return
+		}
+		body, err := io.ReadAll(req.Body)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		data, err := snappy.Decode(nil, body)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		wr := &prompb.WriteRequest{}
+		if err = wr.Unmarshal(data); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		r.headers <- req.Header
+		r.received <- wr
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	return r
+}
+
+func (r *mockReceiver) setStatus(status int) {
+	atomic.StoreInt32(&r.status, int32(status))
+}
+
+func init() {
+	logger.HwLogConfig = &hwlog.LogConfig{
+		OnlyToStdout: true,
+	}
+	logger.InitLogger(logger.RemoteWritePlatform)
+}
+
+func patchChain() *gomonkey.Patches {
+	common.ChainForSingleGoroutine = []common.MetricsCollector{&mockCollector{}}
+	common.ChainForMultiGoroutine = nil
+	common.ChainForCustomPlugin = nil
+	patches := gomonkey.NewPatches()
+	patches.ApplyFuncReturn(common.GetContainerNPUInfo, map[int32]container.DevicesInfo{})
+	patches.ApplyFuncReturn(common.GetChipListWithVNPU, []common.HuaWeiAIChip{})
+	return patches
+}
+
+func testConfig(endpoint string) Config {
+	return Config{Endpoint: endpoint, Interval: time.Second, Timeout: pushTimeout, QueueSize: num3,
+		ExternalLabels: map[string]string{"cluster": mockCluster, NodeLabel: mockNode}}
+}
+
+func labelsOf(series prompb.TimeSeries) map[string]string {
+	labels := make(map[string]string, len(series.Labels))
+	for _, label := range series.Labels {
+		labels[label.Name] = label.Value
+	}
+	return labels
+}
+
+func findSeries(wr *prompb.WriteRequest, name string) (prompb.TimeSeries, bool) {
+	for _, series := range wr.Timeseries {
+		if labelsOf(series)[metricNameLabel] == name {
+			return series, true
+		}
+	}
+	return prompb.TimeSeries{}, false
+}
+
+func receive(t *testing.T, r *mockReceiver) *prompb.WriteRequest {
+	select {
+	case wr := <-r.received:
+		return wr
+	case <-time.After(pushTimeout):
+		t.Error("remote-write receiver got nothing")
+		return &prompb.WriteRequest{}
+	}
+}
+
+func TestPush(t *testing.T) {
+	convey.Convey("test remote-write push", t, func() {
+		patches := patchChain()
+		defer patches.Reset()
+		receiver := newMockReceiver()
+		defer receiver.server.Close()
+
+		convey.Convey("payload should contain metrics with external labels and basic auth", func() {
+			cfg := testConfig(receiver.server.URL)
+			cfg.Username, cfg.Password = mockUser, mockPassword
+			r, err := NewRemoteWriteCollector(nil, cfg)
+			convey.So(err, convey.ShouldBeNil)
+			r.push(context.Background())
+			wr := receive(t, receiver)
+			series, ok := findSeries(wr, "npu_chip_mock_value")
+			convey.So(ok, convey.ShouldBeTrue)
+			labels := labelsOf(series)
+			convey.So(labels["pod_name"], convey.ShouldEqual, mockPodName)
+			convey.So(labels["cluster"], convey.ShouldEqual, mockCluster)
+			convey.So(labels[NodeLabel], convey.ShouldEqual, mockNode)
+			_, hasVdie := labels["vdie_id"]
+			convey.So(hasVdie, convey.ShouldBeFalse)
+			convey.So(series.Samples[0].Value, convey.ShouldEqual, 1)
+
+			header := <-receiver.headers
+			convey.So(header.Get("Content-Encoding"), convey.ShouldEqual, "snappy")
+			convey.So(header.Get("Content-Type"), convey.ShouldEqual, protobufType)
+			convey.So(header.Get("X-Prometheus-Remote-Write-Version"), convey.ShouldEqual, remoteWriteVersion)
+			req := &http.Request{Header: header}
+			user, password, ok := req.BasicAuth()
+			convey.So(ok, convey.ShouldBeTrue)
+			convey.So(user, convey.ShouldEqual, mockUser)
+			convey.So(password, convey.ShouldEqual, mockPassword)
+		})
+		convey.Convey("bearer token should be sent by Start", func() {
+			cfg := testConfig(receiver.server.URL)
+			cfg.BearerToken = mockToken
+			r, err := NewRemoteWriteCollector(nil, cfg)
+			convey.So(err, convey.ShouldBeNil)
+			ctx, cancel := context.WithCancel(context.Background())
+			wg := &sync.WaitGroup{}
+			r.Start(ctx, wg)
+			receive(t, receiver)
+			convey.So((<-receiver.headers).Get("Authorization"), convey.ShouldEqual, "Bearer "+mockToken)
+			cancel()
+			wg.Wait()
+		})
+	})
+}
+
+func TestPushRetry(t *testing.T) {
+	convey.Convey("test remote-write retry with disk queue", t, func() {
+		patches := patchChain()
+		defer patches.Reset()
+		receiver := newMockReceiver()
+		defer receiver.server.Close()
+		cfg := testConfig(receiver.server.URL)
+		cfg.QueueDir = t.TempDir()
+		r, err := NewRemoteWriteCollector(nil, cfg)
+		convey.So(err, convey.ShouldBeNil)
+
+		receiver.setStatus(http.StatusServiceUnavailable)
+		r.push(context.Background())
+		r.push(context.Background())
+		convey.So(r.queue.len(), convey.ShouldEqual, num2)
+		entries, err := os.ReadDir(cfg.QueueDir)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(len(entries), convey.ShouldEqual, num2)
+
+		convey.Convey("buffered requests should survive the restart and be flushed in order", func() {
+			restarted, err := NewRemoteWriteCollector(nil, cfg)
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(restarted.queue.len(), convey.ShouldEqual, num2)
+			receiver.setStatus(http.StatusNoContent)
+			restarted.push(context.Background())
+			var lastTs int64
+			for i := 0; i < num3; i++ {
+				wr := receive(t, receiver)
+				series, ok := findSeries(wr, "npu_chip_mock_value")
+				convey.So(ok, convey.ShouldBeTrue)
+				convey.So(series.Samples[0].Timestamp, convey.ShouldBeGreaterThanOrEqualTo, lastTs)
+				lastTs = series.Samples[0].Timestamp
+			}
+			convey.So(restarted.queue.len(), convey.ShouldEqual, 0)
+		})
+		convey.Convey("rejected requests should be dropped", func() {
+			receiver.setStatus(http.StatusBadRequest)
+			r.push(context.Background())
+			convey.So(r.queue.len(), convey.ShouldEqual, 0)
+		})
+	})
+}
+
+func TestRetryQueueFull(t *testing.T) {
+	convey.Convey("test the oldest request is dropped when the queue is full", t, func() {
+		for _, dir := range []string{"", t.TempDir()} {
+			q, err := newRetryQueue(dir, num2)
+			convey.So(err, convey.ShouldBeNil)
+			for _, payload := range []string{"a", "b", "c"} {
+				convey.So(q.push([]byte(payload)), convey.ShouldBeNil)
+			}
+			convey.So(q.len(), convey.ShouldEqual, num2)
+			payload, ok, err := q.peek()
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(ok, convey.ShouldBeTrue)
+			convey.So(string(payload), convey.ShouldEqual, "b")
+		}
+	})
+}
+
+func TestToWriteRequest(t *testing.T) {
+	convey.Convey("test converting summary and histogram", t, func() {
+		name, labelName, labelValue := "latency", "type", "mock"
+		sum, count := 3.0, uint64(num2)
+		upperBound, cumulative, quantile, infBound := 0.5, uint64(1), 0.9, math.Inf(1)
+		families := []*dto.MetricFamily{
+			{Name: proto.String(name), Type: dto.MetricType_SUMMARY.Enum(), Metric: []*dto.Metric{{
+				Label: []*dto.LabelPair{{Name: &labelName, Value: &labelValue}},
+				Summary: &dto.Summary{SampleSum: &sum, SampleCount: &count,
+					Quantile: []*dto.Quantile{{Quantile: &quantile, Value: &sum}}},
+			}}},
+			{Name: proto.String(name + "_h"), Type: dto.MetricType_HISTOGRAM.Enum(), Metric: []*dto.Metric{{
+				Histogram: &dto.Histogram{SampleSum: &sum, SampleCount: &count,
+					Bucket: []*dto.Bucket{{UpperBound: &upperBound, CumulativeCount: &cumulative},
+						{UpperBound: &infBound, CumulativeCount: &count}}},
+			}}},
+		}
+		now := time.Now()
+		wr := toWriteRequest(families, map[string]string{labelName: "external", "cluster": mockCluster}, now)
+		const expectedSeries = 7
+		convey.So(len(wr.Timeseries), convey.ShouldEqual, expectedSeries)
+		labels := labelsOf(wr.Timeseries[0])
+		convey.So(labels[quantileLabel], convey.ShouldEqual, "0.9")
+		convey.So(labels[labelName], convey.ShouldEqual, labelValue)
+		convey.So(labels["cluster"], convey.ShouldEqual, mockCluster)
+		convey.So(wr.Timeseries[0].Samples[0].Timestamp, convey.ShouldEqual, now.UnixMilli())
+		countSeries, ok := findSeries(wr, name+countSuffix)
+		convey.So(ok, convey.ShouldBeTrue)
+		convey.So(countSeries.Samples[0].Value, convey.ShouldEqual, num2)
+		infBucket := wr.Timeseries[num3+1]
+		convey.So(labelsOf(infBucket)[bucketLabel], convey.ShouldEqual, "+Inf")
+		convey.So(infBucket.Samples[0].Value, convey.ShouldEqual, num2)
+	})
+}
+
+func TestParseExternalLabels(t *testing.T) {
+	convey.Convey("test parse external labels", t, func() {
+		t.Setenv(api.NodeNameEnv, mockNode)
+		labels, err := ParseExternalLabels("cluster=c1, zone = z1")
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(labels, convey.ShouldResemble, map[string]string{"cluster": mockCluster, "zone": "z1",
+			NodeLabel: mockNode})
+		labels, err = ParseExternalLabels("node=n2")
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(labels[NodeLabel], convey.ShouldEqual, "n2")
+		_, err = ParseExternalLabels("cluster")
+		convey.So(err, convey.ShouldNotBeNil)
+		_, err = ParseExternalLabels("__name__=x")
+		convey.So(err, convey.ShouldNotBeNil)
+	})
+}
+
+func TestNewRemoteWriteCollector(t *testing.T) {
+	convey.Convey("test new remote-write collector with invalid config", t, func() {
+		cfg := testConfig("127.0.0.1:9090")
+		_, err := NewRemoteWriteCollector(nil, cfg)
+		convey.So(err, convey.ShouldNotBeNil)
+		cfg = testConfig("http://127.0.0.1:9090/api/v1/write")
+		cfg.Username, cfg.BearerToken = mockUser, mockToken
+		_, err = NewRemoteWriteCollector(nil, cfg)
+		convey.So(err, convey.ShouldNotBeNil)
+		cfg = testConfig("http://127.0.0.1:9090/api/v1/write")
+		cfg.QueueSize = 0
+		_, err = NewRemoteWriteCollector(nil, cfg)
+		convey.So(err, convey.ShouldNotBeNil)
+	})
+}
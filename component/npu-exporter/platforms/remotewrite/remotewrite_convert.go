@@ -0,0 +1,181 @@
+/* Copyright(C) 2026. Huawei Technologies Co.,Ltd. All rights reserved.
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+// Package remotewrite for converting prometheus metric families to remote-write time series
+package remotewrite
+
+import (
+	"fmt"
+	"math"
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	dto "github.com/prometheus/client_model/go"
+	"github.com/prometheus/prometheus/prompb"
+
+	"ascend-common/api"
+)
+
+const (
+	metricNameLabel = "__name__"
+	quantileLabel   = "quantile"
+	bucketLabel     = "le"
+	// NodeLabel external label of the node name
+	NodeLabel = "node"
+
+	sumSuffix    = "_sum"
+	countSuffix  = "_count"
+	bucketSuffix = "_bucket"
+
+	labelPartLen = 2
+)
+
+var labelNameRegexp = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+
+// ParseExternalLabels parse external labels like "cluster=c1,node=n1", the node label defaults to the node name
+func ParseExternalLabels(str string) (map[string]string, error) {
+	labels := make(map[string]string)
+	for _, pair := range strings.Split(str, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		parts := strings.SplitN(pair, "=", labelPartLen)
+		if len(parts) != labelPartLen || parts[1] == "" {
+			return nil, fmt.Errorf("external label %s should be in name=value format", pair)
+		}
+		labels[strings.TrimSpace(parts[0])] = strings.TrimSpace(parts[1])
+	}
+	if _, exist := labels[NodeLabel]; !exist {
+		if nodeName := nodeName(); nodeName != "" {
+			labels[NodeLabel] = nodeName
+		}
+	}
+	return labels, checkExternalLabels(labels)
+}
+
+func nodeName() string {
+	if name := os.Getenv(api.NodeNameEnv); name != "" {
+		return name
+	}
+	name, err := os.Hostname()
+	if err != nil {
+		return ""
+	}
+	return name
+}
+
+func checkExternalLabels(labels map[string]string) error {
+	for name := range labels {
+		if !labelNameRegexp.MatchString(name) || strings.HasPrefix(name, "__") {
+			return fmt.Errorf("external label name %s is invalid", name)
+		}
+	}
+	return nil
+}
+
+// toWriteRequest convert metric families to a write request, metrics without timestamp use now
+func toWriteRequest(families []*dto.MetricFamily, externalLabels map[string]string, now time.Time) *prompb.WriteRequest {
+	builder := &seriesBuilder{externalLabels: externalLabels, nowMs: now.UnixMilli()}
+	for _, family := range families {
+		for _, metric := range family.GetMetric() {
+			builder.addMetric(family.GetName(), family.GetType(), metric)
+		}
+	}
+	return &prompb.WriteRequest{Timeseries: builder.series}
+}
+
+type seriesBuilder struct {
+	externalLabels map[string]string
+	nowMs          int64
+	series         []prompb.TimeSeries
+}
+
+func (b *seriesBuilder) addMetric(name string, metricType dto.MetricType, metric *dto.Metric) {
+	ts := b.nowMs
+	if metric.TimestampMs != nil {
+		ts = metric.GetTimestampMs()
+	}
+	labels := metric.GetLabel()
+	switch metricType {
+	case dto.MetricType_COUNTER:
+		b.add(name, labels, metric.GetCounter().GetValue(), ts)
+	case dto.MetricType_GAUGE:
+		b.add(name, labels, metric.GetGauge().GetValue(), ts)
+	case dto.MetricType_SUMMARY:
+		summary := metric.GetSummary()
+		for _, q := range summary.GetQuantile() {
+			b.add(name, labels, q.GetValue(), ts, quantileLabel, formatFloat(q.GetQuantile()))
+		}
+		b.add(name+sumSuffix, labels, summary.GetSampleSum(), ts)
+		b.add(name+countSuffix, labels, float64(summary.GetSampleCount()), ts)
+	case dto.MetricType_HISTOGRAM:
+		histogram := metric.GetHistogram()
+		for _, bucket := range histogram.GetBucket() {
+			// the +Inf bucket is always added by the sample count, skip the explicit one to avoid the duplicate
+			if math.IsInf(bucket.GetUpperBound(), 1) {
+				continue
+			}
+			b.add(name+bucketSuffix, labels, float64(bucket.GetCumulativeCount()), ts,
+				bucketLabel, formatFloat(bucket.GetUpperBound()))
+		}
+		b.add(name+bucketSuffix, labels, float64(histogram.GetSampleCount()), ts, bucketLabel, "+Inf")
+		b.add(name+sumSuffix, labels, histogram.GetSampleSum(), ts)
+		b.add(name+countSuffix, labels, float64(histogram.GetSampleCount()), ts)
+	default:
+		b.add(name, labels, metric.GetUntyped().GetValue(), ts)
+	}
+}
+
+// add append a series, extra is a list of label name and value pairs
+func (b *seriesBuilder) add(name string, pairs []*dto.LabelPair, value float64, ts int64, extra ...string) {
+	labels := make([]prompb.Label, 0, len(pairs)+len(b.externalLabels)+len(extra)/labelPartLen+1)
+	labels = append(labels, prompb.Label{Name: metricNameLabel, Value: name})
+	seen := make(map[string]struct{}, len(pairs))
+	for _, pair := range pairs {
+		if pair.GetValue() == "" {
+			continue
+		}
+		labels = append(labels, prompb.Label{Name: pair.GetName(), Value: pair.GetValue()})
+		seen[pair.GetName()] = struct{}{}
+	}
+	for i := 0; i+1 < len(extra); i += labelPartLen {
+		labels = append(labels, prompb.Label{Name: extra[i], Value: extra[i+1]})
+		seen[extra[i]] = struct{}{}
+	}
+	// labels of the metric take precedence over the external labels, the same as prometheus does
+	for labelName, labelValue := range b.externalLabels {
+		if _, exist := seen[labelName]; !exist {
+			labels = append(labels, prompb.Label{Name: labelName, Value: labelValue})
+		}
+	}
+	sort.Slice(labels, func(i, j int) bool {
+		return labels[i].Name < labels[j].Name
+	})
+	b.series = append(b.series, prompb.TimeSeries{
+		Labels:  labels,
+		Samples: []prompb.Sample{{Value: value, Timestamp: ts}},
+	})
+}
+
+func formatFloat(f float64) string {
+	if math.IsInf(f, 1) {
+		return "+Inf"
+	}
+	return strconv.FormatFloat(f, 'g', -1, 64)
+}
@@ -0,0 +1,157 @@
+/* Copyright(C) 2026. Huawei Technologies Co.,Ltd. All rights reserved.
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+// Package remotewrite for buffering the requests failed to push
+package remotewrite
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"ascend-common/common-utils/utils"
+	"huawei.com/npu-exporter/v6/utils/logger"
+)
+
+const (
+	queueFileSuffix = ".snappy"
+	queueDirMode    = 0700
+	queueFileMode   = 0600
+	// maxSeq the sequence keeps the order of requests buffered in the same nanosecond
+	maxSeq = 1000000
+)
+
+// retryQueue a fifo of compressed write requests, the requests are kept in files when dir is set, so they
+// survive the restart of npu-exporter
+type retryQueue struct {
+	mu      sync.Mutex
+	dir     string
+	maxSize int
+	seq     int64
+	// names file names in dir mode, ordered from the oldest
+	names []string
+	// payloads requests in memory mode, ordered from the oldest
+	payloads [][]byte
+}
+
+func newRetryQueue(dir string, maxSize int) (*retryQueue, error) {
+	q := &retryQueue{dir: dir, maxSize: maxSize}
+	if dir == "" {
+		return q, nil
+	}
+	if err := os.MkdirAll(dir, queueDirMode); err != nil {
+		return nil, fmt.Errorf("create remote-write queue dir failed: %v", err)
+	}
+	if _, err := utils.CheckPath(dir); err != nil {
+		return nil, fmt.Errorf("remote-write queue dir is invalid: %v", err)
+	}
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("read remote-write queue dir failed: %v", err)
+	}
+	for _, entry := range entries {
+		if entry.Type().IsRegular() && strings.HasSuffix(entry.Name(), queueFileSuffix) {
+			q.names = append(q.names, entry.Name())
+		}
+	}
+	// file names start with a fixed width timestamp, so the lexical order is the push order
+	sort.Strings(q.names)
+	if len(q.names) > 0 {
+		logger.Infof("load %d buffered remote-write requests from %s", len(q.names), dir)
+	}
+	for len(q.names) > maxSize {
+		q.dropOldest()
+	}
+	return q, nil
+}
+
+func (q *retryQueue) len() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if q.dir == "" {
+		return len(q.payloads)
+	}
+	return len(q.names)
+}
+
+func (q *retryQueue) push(payload []byte) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if q.dir == "" {
+		if len(q.payloads) >= q.maxSize {
+			logger.Warn("remote-write queue is full, drop the oldest request")
+			q.payloads = q.payloads[1:]
+		}
+		q.payloads = append(q.payloads, payload)
+		return nil
+	}
+	if len(q.names) >= q.maxSize {
+		logger.Warn("remote-write queue is full, drop the oldest request")
+		q.dropOldest()
+	}
+	q.seq++
+	name := fmt.Sprintf("%020d-%06d%s", time.Now().UnixNano(), q.seq%maxSeq, queueFileSuffix)
+	if err := os.WriteFile(filepath.Join(q.dir, name), payload, queueFileMode); err != nil {
+		return err
+	}
+	q.names = append(q.names, name)
+	return nil
+}
+
+// peek return the oldest request, ok is false when the queue is empty
+func (q *retryQueue) peek() ([]byte, bool, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if q.dir == "" {
+		if len(q.payloads) == 0 {
+			return nil, false, nil
+		}
+		return q.payloads[0], true, nil
+	}
+	if len(q.names) == 0 {
+		return nil, false, nil
+	}
+	payload, err := utils.ReadLimitBytes(filepath.Join(q.dir, q.names[0]), utils.Size10M)
+	if err != nil {
+		return nil, true, err
+	}
+	return payload, true, nil
+}
+
+// pop remove the oldest request
+func (q *retryQueue) pop() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if q.dir == "" {
+		if len(q.payloads) > 0 {
+			q.payloads = q.payloads[1:]
+		}
+		return
+	}
+	q.dropOldest()
+}
+
+func (q *retryQueue) dropOldest() {
+	if len(q.names) == 0 {
+		return
+	}
+	if err := os.Remove(filepath.Join(q.dir, q.names[0])); err != nil && !os.IsNotExist(err) {
+		logger.Warnf("remove buffered remote-write request failed: %v", err)
+	}
+	q.names = q.names[1:]
+}
@@ -0,0 +1,549 @@
+/* Copyright(C) 2026. Huawei Technologies Co.,Ltd. All rights reserved.
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+// Package plugins for custom metrics in the prometheus text exposition format
+package plugins
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/prometheus/common/expfmt"
+	"github.com/prometheus/common/model"
+
+	"ascend-common/common-utils/hwlog"
+	"ascend-common/common-utils/utils"
+	"huawei.com/npu-exporter/v6/collector/common"
+	"huawei.com/npu-exporter/v6/utils/logger"
+)
+
+const (
+	promFileSuffix   = ".prom"
+	jsonFileSuffix   = ".json"
+	size1M           = 1024 * 1024
+	maxDirFileNumber = 64
+	maxPromSeries    = 1024
+	fileLabel        = "file"
+	stateKeySuffix   = "-state"
+	textFileMetric   = "npu_exporter_textfile_"
+	quantileLabel    = "quantile"
+	bucketLabel      = "le"
+	labelPairLen     = 2
+)
+
+var (
+	textFileDirs  = make([]string, 0) // directories globbed in each collection
+	promFilePaths = make([]string, 0) // prometheus text files specified directly
+	// dirJSONFiles the json files found in the directories and the modification time when they were checked
+	dirJSONFiles = make(map[string]time.Time)
+
+	descTextFileStale = prometheus.NewDesc(textFileMetric+"stale_seconds",
+		"seconds since the text metrics file was last modified", []string{fileLabel}, nil)
+	descTextFileError = prometheus.NewDesc(textFileMetric+"scrape_error",
+		"1 if reading or parsing the text metrics file failed in the last collection, otherwise 0",
+		[]string{fileLabel}, nil)
+)
+
+// textFileState state of a text metrics file, for the staleness metrics
+type textFileState struct {
+	modTime time.Time
+	failed  bool
+}
+
+// promFileData metric families parsed from a file in the prometheus text exposition format
+type promFileData struct {
+	path     string
+	modTime  time.Time
+	families []*dto.MetricFamily
+}
+
+// textSample a flattened sample of a metric family, for telegraf
+type textSample struct {
+	name   string
+	labels map[string]string
+	value  float64
+}
+
+// expandPaths classify the configured paths, return the json files to be checked at startup.
+// the json and prom files in a directory are found again in each collection
+func expandPaths(paths []string) []string {
+	jsonPaths := make([]string, 0, len(paths))
+	for _, path := range paths {
+		path = strings.TrimSpace(path)
+		switch {
+		case path == "":
+			continue
+		case utils.IsDir(path):
+			if _, err := utils.CheckPath(path); err != nil {
+				logger.Warnf("check dir %s failed: %v, %s", path, err, fileDisabledMsg)
+				continue
+			}
+			textFileDirs = append(textFileDirs, path)
+			for _, jsonPath := range listDirFiles(path, jsonFileSuffix) {
+				dirJSONFiles[jsonPath] = fileModTime(jsonPath)
+				jsonPaths = append(jsonPaths, jsonPath)
+			}
+		case strings.HasSuffix(path, promFileSuffix):
+			promFilePaths = append(promFilePaths, path)
+		default:
+			jsonPaths = append(jsonPaths, path)
+		}
+	}
+	return jsonPaths
+}
+
+// listDirFiles list the regular files with the suffix in dir, sorted by name
+func listDirFiles(dir, suffix string) []string {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		logger.LogfWithOptions(logger.WarnLevel, logger.LogOptions{Domain: logDomain, ID: dir + "readDirErr"},
+			"read dir %s failed: %v", dir, err)
+		return nil
+	}
+	hwlog.ResetErrCnt(logDomain, dir+"readDirErr")
+	files := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		if entry.Type().IsRegular() && strings.HasSuffix(entry.Name(), suffix) {
+			files = append(files, filepath.Join(dir, entry.Name()))
+		}
+	}
+	sort.Strings(files)
+	if len(files) > maxDirFileNumber {
+		logger.LogfWithOptions(logger.WarnLevel, logger.LogOptions{Domain: logDomain, ID: dir + "tooManyFiles"},
+			"the number of %s files in dir %s is more than max allowed number(%d), only the first %d files "+
+				"will be collected", suffix, dir, maxDirFileNumber, maxDirFileNumber)
+		files = files[:maxDirFileNumber]
+	}
+	return files
+}
+
+func fileModTime(path string) time.Time {
+	info, err := os.Stat(path)
+	if err != nil {
+		return time.Time{}
+	}
+	return info.ModTime()
+}
+
+// rescanDirJSONFiles check the json files created in the directories and the rejected ones modified since the
+// last check, and stop collecting the removed ones
+func (c *TextMetricsInfoCollector) rescanDirJSONFiles() {
+	if len(textFileDirs) == 0 {
+		return
+	}
+	paths := make([]string, 0, len(dirJSONFiles))
+	current := make(map[string]struct{}, len(dirJSONFiles))
+	for _, dir := range textFileDirs {
+		for _, path := range listDirFiles(dir, jsonFileSuffix) {
+			paths = append(paths, path)
+			current[path] = struct{}{}
+		}
+	}
+	metricInfosMu.Lock()
+	defer metricInfosMu.Unlock()
+	for path := range dirJSONFiles {
+		if _, exist := current[path]; !exist {
+			logger.Infof("json file %s is removed, stop reporting its metrics", path)
+			c.removeJSONFile(path)
+			delete(dirJSONFiles, path)
+		}
+	}
+	for _, path := range paths {
+		modTime := fileModTime(path)
+		checkedTime, checked := dirJSONFiles[path]
+		if _, valid := metricStructInfosMap[path]; valid || (checked && checkedTime.Equal(modTime)) {
+			continue
+		}
+		// the empty file is checked again in the next collection, like the missing files at startup
+		if checkAndProcessFile(path) {
+			delete(dirJSONFiles, path)
+			continue
+		}
+		dirJSONFiles[path] = modTime
+		if _, valid := metricStructInfosMap[path]; valid {
+			logger.Infof("json file %s is found in the directory, start reporting its metrics", path)
+		}
+	}
+}
+
+// removeJSONFile drop the metric info and the cache of the json file, metricInfosMu should be held
+func (c *TextMetricsInfoCollector) removeJSONFile(path string) {
+	if structInfo, exist := metricStructInfosMap[path]; exist {
+		delete(existMetrics, structInfo.name)
+		delete(metricStructInfosMap, path)
+	}
+	paths := make([]string, 0, len(validPaths))
+	for _, validPath := range validPaths {
+		if validPath != path {
+			paths = append(paths, validPath)
+		}
+	}
+	validPaths = paths
+	c.Cache.Delete(fmt.Sprintf("%s-%s", baseCacheKey, path))
+	c.fileStates.Delete(path)
+}
+
+func promFileCandidates() []string {
+	paths := make([]string, 0, len(promFilePaths))
+	paths = append(paths, promFilePaths...)
+	for _, dir := range textFileDirs {
+		paths = append(paths, listDirFiles(dir, promFileSuffix)...)
+	}
+	return paths
+}
+
+func readPromFile(path string) (*promFileData, error) {
+	if err := checkFilePermission(path); err != nil {
+		return nil, err
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	fileData, err := utils.ReadLimitBytes(path, size1M)
+	if err != nil {
+		return nil, err
+	}
+	families, err := parsePromText(fileData)
+	if err != nil {
+		return nil, fmt.Errorf("file %s: %v", path, err)
+	}
+	return &promFileData{path: path, modTime: info.ModTime(), families: families}, nil
+}
+
+// parsePromText parse the prometheus text exposition format, the families are sorted by name
+func parsePromText(fileData []byte) ([]*dto.MetricFamily, error) {
+	var parser expfmt.TextParser
+	familyMap, err := parser.TextToMetricFamilies(bytes.NewReader(fileData))
+	if err != nil {
+		return nil, fmt.Errorf("parse prometheus text failed: %v", err)
+	}
+	families := make([]*dto.MetricFamily, 0, len(familyMap))
+	seriesNum := 0
+	for _, family := range familyMap {
+		if err = validateFamily(family); err != nil {
+			return nil, err
+		}
+		seriesNum += len(family.GetMetric())
+		families = append(families, family)
+	}
+	if seriesNum > maxPromSeries {
+		return nil, fmt.Errorf("the number of series(%d) is more than max allowed number(%d)", seriesNum, maxPromSeries)
+	}
+	sort.Slice(families, func(i, j int) bool {
+		return families[i].GetName() < families[j].GetName()
+	})
+	return families, nil
+}
+
+func validateFamily(family *dto.MetricFamily) error {
+	name := family.GetName()
+	if len(name) > maxMetricNameSize || !model.IsValidMetricName(model.LabelValue(name)) {
+		return fmt.Errorf("metric name %s is invalid", name)
+	}
+	if strings.HasPrefix(name, textFileMetric) {
+		return fmt.Errorf("metric name %s is reserved", name)
+	}
+	if len(family.GetHelp()) > maxDescSize {
+		return fmt.Errorf("length of help of metric %s should not larger than %d", name, maxDescSize)
+	}
+	var labelKey string
+	for i, metric := range family.GetMetric() {
+		if len(metric.GetLabel()) > maxLabelSize {
+			return fmt.Errorf("size of label(%d) of metric %s is more than max allowed label size(%d)",
+				len(metric.GetLabel()), name, maxLabelSize)
+		}
+		names := make([]string, 0, len(metric.GetLabel()))
+		for _, label := range metric.GetLabel() {
+			if !model.LabelName(label.GetName()).IsValid() || strings.HasPrefix(label.GetName(), "__") {
+				return fmt.Errorf("label name %s of metric %s is invalid", label.GetName(), name)
+			}
+			names = append(names, label.GetName())
+		}
+		sort.Strings(names)
+		// all series of a family should have the same label names, otherwise the family is inconsistent
+		if key := strings.Join(names, ","); i == 0 {
+			labelKey = key
+		} else if key != labelKey {
+			return fmt.Errorf("label names of metric %s are inconsistent", name)
+		}
+	}
+	return nil
+}
+
+// collectPromFiles parse the prom files into the cache, the files removed from the directories are not
+// reported any more
+func (c *TextMetricsInfoCollector) collectPromFiles() {
+	current := make(map[string]struct{})
+	claimed := make(map[string]string, len(existMetrics))
+	for name, path := range existMetrics {
+		claimed[name] = path
+	}
+	for _, path := range promFileCandidates() {
+		current[path] = struct{}{}
+		logId := path + "promFileErr"
+		data, err := readPromFile(path)
+		if err != nil {
+			logger.LogfWithOptions(logger.WarnLevel, logger.LogOptions{Domain: logDomain, ID: logId},
+				"read prom file %s failed: %v, %s", path, err, skipCurrentCollectionMsg)
+			c.storeFileState(path, true)
+			continue
+		}
+		hwlog.ResetErrCnt(logDomain, logId)
+		data.families = filterClaimedFamilies(path, data.families, claimed)
+		c.Cache.Store(fmt.Sprintf("%s-%s", baseCacheKey, path), *data)
+		c.fileStates.Store(path, textFileState{modTime: data.modTime})
+	}
+	c.Cache.Range(func(key, value interface{}) bool {
+		data, ok := value.(promFileData)
+		if !ok {
+			return true
+		}
+		if _, exist := current[data.path]; !exist {
+			logger.Infof("prom file %s is removed, stop reporting its metrics", data.path)
+			c.Cache.Delete(key)
+			c.fileStates.Delete(data.path)
+		}
+		return true
+	})
+}
+
+// filterClaimedFamilies drop the families already reported by other files
+func filterClaimedFamilies(path string, families []*dto.MetricFamily,
+	claimed map[string]string) []*dto.MetricFamily {
+	res := make([]*dto.MetricFamily, 0, len(families))
+	for _, family := range families {
+		if owner, exist := claimed[family.GetName()]; exist && owner != path {
+			logger.LogfWithOptions(logger.WarnLevel, logger.LogOptions{Domain: logDomain,
+				ID: path + family.GetName() + "claimed"},
+				"metric [%s] already described in file [%s], ignore it in file [%s]", family.GetName(), owner, path)
+			continue
+		}
+		claimed[family.GetName()] = path
+		res = append(res, family)
+	}
+	return res
+}
+
+// storeFileState keep the last modification time, so a file failed to parse is still reported as stale
+func (c *TextMetricsInfoCollector) storeFileState(path string, failed bool) {
+	state := textFileState{failed: failed}
+	if info, err := os.Stat(path); err == nil {
+		state.modTime = info.ModTime()
+	} else if old, ok := c.fileStates.Load(path); ok {
+		if oldState, ok := old.(textFileState); ok {
+			state.modTime = oldState.modTime
+		}
+	}
+	c.fileStates.Store(path, state)
+}
+
+func (c *TextMetricsInfoCollector) rangePromFiles(doUpdate func(promFileData)) {
+	c.Cache.Range(func(_, value interface{}) bool {
+		if data, ok := value.(promFileData); ok {
+			doUpdate(data)
+		}
+		return true
+	})
+}
+
+func (c *TextMetricsInfoCollector) rangeFileStates(doUpdate func(string, textFileState)) {
+	c.fileStates.Range(func(key, value interface{}) bool {
+		path, ok := key.(string)
+		state, stateOk := value.(textFileState)
+		if ok && stateOk {
+			doUpdate(path, state)
+		}
+		return true
+	})
+}
+
+func (c *TextMetricsInfoCollector) updatePromFilesToPrometheus(ch chan<- prometheus.Metric) {
+	c.rangePromFiles(func(data promFileData) {
+		for _, family := range data.families {
+			for _, metric := range family.GetMetric() {
+				constMetric, err := toConstMetric(family, metric)
+				if err != nil {
+					logger.LogfWithOptions(logger.WarnLevel, logger.LogOptions{Domain: logDomain,
+						ID: data.path + "convertErr"}, "convert metric of prom file %s failed: %v", data.path, err)
+					continue
+				}
+				ch <- constMetric
+			}
+		}
+	})
+	now := time.Now()
+	c.rangeFileStates(func(path string, state textFileState) {
+		if !state.modTime.IsZero() {
+			ch <- prometheus.MustNewConstMetric(descTextFileStale, prometheus.GaugeValue,
+				now.Sub(state.modTime).Seconds(), path)
+		}
+		ch <- prometheus.MustNewConstMetric(descTextFileError, prometheus.GaugeValue, boolToFloat(state.failed), path)
+	})
+}
+
+func (c *TextMetricsInfoCollector) updatePromFilesToTelegraf(fields map[string]interface{}) {
+	c.rangePromFiles(func(data promFileData) {
+		index := 0
+		for _, family := range data.families {
+			for _, metric := range family.GetMetric() {
+				timestamp := data.modTime
+				if metric.TimestampMs != nil {
+					timestamp = time.UnixMilli(metric.GetTimestampMs())
+				}
+				for _, sample := range flattenMetric(family, metric) {
+					fields[data.path+"-"+strconv.Itoa(index)] = common.TelegrafData{
+						Labels:    sample.labels,
+						Metrics:   map[string]interface{}{sample.name: sample.value},
+						Timestamp: timestamp,
+					}
+					index++
+				}
+			}
+		}
+	})
+	now := time.Now()
+	c.rangeFileStates(func(path string, state textFileState) {
+		metrics := map[string]interface{}{textFileMetric + "scrape_error": boolToFloat(state.failed)}
+		if !state.modTime.IsZero() {
+			metrics[textFileMetric+"stale_seconds"] = now.Sub(state.modTime).Seconds()
+		}
+		fields[path+stateKeySuffix] = common.TelegrafData{
+			Labels:    map[string]string{fileLabel: path},
+			Metrics:   metrics,
+			Timestamp: now,
+		}
+	})
+}
+
+func sortedLabels(pairs []*dto.LabelPair) ([]string, []string) {
+	sorted := make([]*dto.LabelPair, len(pairs))
+	copy(sorted, pairs)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].GetName() < sorted[j].GetName()
+	})
+	names := make([]string, 0, len(sorted))
+	values := make([]string, 0, len(sorted))
+	for _, pair := range sorted {
+		names = append(names, pair.GetName())
+		values = append(values, pair.GetValue())
+	}
+	return names, values
+}
+
+func toConstMetric(family *dto.MetricFamily, metric *dto.Metric) (prometheus.Metric, error) {
+	names, values := sortedLabels(metric.GetLabel())
+	desc := prometheus.NewDesc(family.GetName(), family.GetHelp(), names, nil)
+	var constMetric prometheus.Metric
+	var err error
+	switch family.GetType() {
+	case dto.MetricType_COUNTER:
+		constMetric, err = prometheus.NewConstMetric(desc, prometheus.CounterValue,
+			metric.GetCounter().GetValue(), values...)
+	case dto.MetricType_GAUGE:
+		constMetric, err = prometheus.NewConstMetric(desc, prometheus.GaugeValue,
+			metric.GetGauge().GetValue(), values...)
+	case dto.MetricType_SUMMARY:
+		summary := metric.GetSummary()
+		quantiles := make(map[float64]float64, len(summary.GetQuantile()))
+		for _, q := range summary.GetQuantile() {
+			quantiles[q.GetQuantile()] = q.GetValue()
+		}
+		constMetric, err = prometheus.NewConstSummary(desc, summary.GetSampleCount(), summary.GetSampleSum(),
+			quantiles, values...)
+	case dto.MetricType_HISTOGRAM:
+		histogram := metric.GetHistogram()
+		buckets := make(map[float64]uint64, len(histogram.GetBucket()))
+		for _, b := range histogram.GetBucket() {
+			if !math.IsInf(b.GetUpperBound(), 1) {
+				buckets[b.GetUpperBound()] = b.GetCumulativeCount()
+			}
+		}
+		constMetric, err = prometheus.NewConstHistogram(desc, histogram.GetSampleCount(), histogram.GetSampleSum(),
+			buckets, values...)
+	default:
+		constMetric, err = prometheus.NewConstMetric(desc, prometheus.UntypedValue,
+			metric.GetUntyped().GetValue(), values...)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if metric.TimestampMs != nil {
+		constMetric = prometheus.NewMetricWithTimestamp(time.UnixMilli(metric.GetTimestampMs()), constMetric)
+	}
+	return constMetric, nil
+}
+
+// flattenMetric flatten summary and histogram into samples with the _sum, _count and _bucket suffixes
+func flattenMetric(family *dto.MetricFamily, metric *dto.Metric) []textSample {
+	name := family.GetName()
+	newSample := func(suffix string, value float64, extra ...string) textSample {
+		labels := make(map[string]string, len(metric.GetLabel())+1)
+		for _, pair := range metric.GetLabel() {
+			labels[pair.GetName()] = pair.GetValue()
+		}
+		if len(extra) == labelPairLen {
+			labels[extra[0]] = extra[1]
+		}
+		return textSample{name: name + suffix, labels: labels, value: value}
+	}
+	switch family.GetType() {
+	case dto.MetricType_COUNTER:
+		return []textSample{newSample("", metric.GetCounter().GetValue())}
+	case dto.MetricType_GAUGE:
+		return []textSample{newSample("", metric.GetGauge().GetValue())}
+	case dto.MetricType_SUMMARY:
+		summary := metric.GetSummary()
+		samples := make([]textSample, 0, len(summary.GetQuantile())+labelPairLen)
+		for _, q := range summary.GetQuantile() {
+			samples = append(samples, newSample("", q.GetValue(), quantileLabel, formatBound(q.GetQuantile())))
+		}
+		return append(samples, newSample("_sum", summary.GetSampleSum()),
+			newSample("_count", float64(summary.GetSampleCount())))
+	case dto.MetricType_HISTOGRAM:
+		histogram := metric.GetHistogram()
+		samples := make([]textSample, 0, len(histogram.GetBucket())+labelPairLen)
+		for _, b := range histogram.GetBucket() {
+			samples = append(samples, newSample("_bucket", float64(b.GetCumulativeCount()), bucketLabel,
+				formatBound(b.GetUpperBound())))
+		}
+		return append(samples, newSample("_sum", histogram.GetSampleSum()),
+			newSample("_count", float64(histogram.GetSampleCount())))
+	default:
+		return []textSample{newSample("", metric.GetUntyped().GetValue())}
+	}
+}
+
+func formatBound(f float64) string {
+	if math.IsInf(f, 1) {
+		return "+Inf"
+	}
+	return strconv.FormatFloat(f, 'g', -1, 64)
+}
+
+func boolToFloat(b bool) float64 {
+	if b {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,242 @@
+/* Copyright(C) 2026. Huawei Technologies Co.,Ltd. All rights reserved.
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+// Package plugins for custom metrics in the prometheus text exposition format
+package plugins
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/smartystreets/goconvey/convey"
+
+	"huawei.com/npu-exporter/v6/collector/common"
+)
+
+const (
+	testFileMode = 0600
+	testPromText = `# HELP train_step_total steps of training
+# TYPE train_step_total counter
+train_step_total{job="j1",rank="0"} 100
+train_step_total{job="j1",rank="1"} 98
+# TYPE train_step_seconds summary
+train_step_seconds{quantile="0.5"} 0.2
+train_step_seconds_sum 20
+train_step_seconds_count 100
+# TYPE train_loss_bucket histogram
+train_loss_bucket_bucket{le="0.1"} 3
+train_loss_bucket_bucket{le="+Inf"} 5
+train_loss_bucket_sum 1.5
+train_loss_bucket_count 5
+train_loss 0.01
+`
+	testPromText2 = `train_step_total{job="j2",rank="0"} 1
+train_lr 0.001
+`
+	testJSONText = `{"version":"1.0","desc":"json metric","name":"json_metric","timestamp":1234567890,` +
+		`"data_list":[{"label":{"k":"v"},"value":1}]}`
+	testMetricsCount = 4
+)
+
+func writeTestFile(t *testing.T, dir, name, content string) string {
+	path := filepath.Join(dir, name)
+	if err := os.WriteFile(path, []byte(content), testFileMode); err != nil {
+		t.Fatalf("write file failed: %v", err)
+	}
+	return path
+}
+
+func collectPrometheus(c *TextMetricsInfoCollector) map[string]int {
+	ch := make(chan prometheus.Metric, maxPromSeries)
+	c.UpdatePrometheus(ch, nil, nil, nil)
+	close(ch)
+	counts := make(map[string]int)
+	for metric := range ch {
+		name := metric.Desc().String()
+		name = name[strings.Index(name, `"`)+1:]
+		counts[name[:strings.Index(name, `"`)]]++
+	}
+	return counts
+}
+
+func TestParsePromText(t *testing.T) {
+	convey.Convey("test parse prometheus text", t, func() {
+		families, err := parsePromText([]byte(testPromText))
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(len(families), convey.ShouldEqual, testMetricsCount)
+		convey.So(families[0].GetName(), convey.ShouldEqual, "train_loss")
+		convey.So(families[0].GetType(), convey.ShouldEqual, dto.MetricType_UNTYPED)
+
+		invalidTexts := []string{
+			"train loss 1\n",
+			"npu_exporter_textfile_stale_seconds 1\n",
+			`train_step{__rank="0"} 1` + "\n",
+			"train_step{rank=\"0\"} 1\ntrain_step{job=\"j1\"} 1\n",
+			"train_step{l0=\"0\",l1=\"0\",l2=\"0\",l3=\"0\",l4=\"0\",l5=\"0\",l6=\"0\",l7=\"0\",l8=\"0\"," +
+				"l9=\"0\",l10=\"0\"} 1\n",
+		}
+		for _, text := range invalidTexts {
+			_, err = parsePromText([]byte(text))
+			convey.So(err, convey.ShouldNotBeNil)
+		}
+	})
+}
+
+func TestFlattenMetric(t *testing.T) {
+	convey.Convey("test flatten summary and histogram for telegraf", t, func() {
+		families, err := parsePromText([]byte(testPromText))
+		convey.So(err, convey.ShouldBeNil)
+		var names []string
+		for _, family := range families {
+			for _, metric := range family.GetMetric() {
+				for _, sample := range flattenMetric(family, metric) {
+					names = append(names, sample.name)
+				}
+			}
+		}
+		convey.So(names, convey.ShouldContain, "train_loss_bucket_bucket")
+		convey.So(names, convey.ShouldContain, "train_step_seconds_sum")
+		convey.So(names, convey.ShouldContain, "train_step_seconds_count")
+		const expectedSamples = 10
+		convey.So(len(names), convey.ShouldEqual, expectedSamples)
+	})
+}
+
+func TestCollectTextFileDir(t *testing.T) {
+	convey.Convey("test collect a directory of json and prom files", t, func() {
+		resetGlobalMaps()
+		defer resetGlobalMaps()
+		dir := t.TempDir()
+		promPath := writeTestFile(t, dir, "a.prom", testPromText)
+		promPath2 := writeTestFile(t, dir, "b.prom", testPromText2)
+		writeTestFile(t, dir, "c.json", testJSONText)
+		writeTestFile(t, dir, "ignored.txt", "ignored 1\n")
+		SetTextMetricsFilePath(dir)
+		defer SetTextMetricsFilePath("")
+
+		c := &TextMetricsInfoCollector{}
+		convey.So(c.IsSupported(nil), convey.ShouldBeTrue)
+		convey.So(textFileDirs, convey.ShouldResemble, []string{dir})
+		convey.So(len(validPaths), convey.ShouldEqual, 1)
+		c.CollectToCache(nil, nil)
+
+		counts := collectPrometheus(c)
+		convey.So(counts["train_step_total"], convey.ShouldEqual, num2)
+		convey.So(counts["train_lr"], convey.ShouldEqual, 1)
+		convey.So(counts["train_loss_bucket"], convey.ShouldEqual, 1)
+		convey.So(counts["json_metric"], convey.ShouldEqual, 1)
+		convey.So(counts[textFileMetric+"stale_seconds"], convey.ShouldEqual, len([]string{"a", "b", "c"}))
+		convey.So(counts[textFileMetric+"scrape_error"], convey.ShouldEqual, len([]string{"a", "b", "c"}))
+
+		fieldsMap := c.UpdateTelegraf(make(map[string]map[string]interface{}), nil, nil, nil)
+		textFields := fieldsMap[common.KeyForTextMetrics]
+		state, ok := textFields[promPath+stateKeySuffix].(common.TelegrafData)
+		convey.So(ok, convey.ShouldBeTrue)
+		convey.So(state.Metrics[textFileMetric+"scrape_error"], convey.ShouldEqual, 0)
+		convey.So(state.Labels[fileLabel], convey.ShouldEqual, promPath)
+
+		convey.Convey("removed files should not be reported, broken files should report cached metrics", func() {
+			convey.So(os.Remove(promPath), convey.ShouldBeNil)
+			writeTestFile(t, dir, "b.prom", "broken{ 1\n")
+			c.CollectToCache(nil, nil)
+			counts = collectPrometheus(c)
+			convey.So(counts["train_step_total"], convey.ShouldEqual, 0)
+			convey.So(counts["train_lr"], convey.ShouldEqual, 1)
+			convey.So(counts[textFileMetric+"scrape_error"], convey.ShouldEqual, num2)
+			value, ok := c.fileStates.Load(promPath2)
+			convey.So(ok, convey.ShouldBeTrue)
+			convey.So(value.(textFileState).failed, convey.ShouldBeTrue)
+		})
+	})
+}
+
+func TestRescanDirJSONFiles(t *testing.T) {
+	convey.Convey("test the json files created and removed after startup in a directory", t, func() {
+		resetGlobalMaps()
+		defer resetGlobalMaps()
+		dir := t.TempDir()
+		writeTestFile(t, dir, "a.prom", testPromText2)
+		SetTextMetricsFilePath(dir)
+		defer SetTextMetricsFilePath("")
+
+		c := &TextMetricsInfoCollector{}
+		convey.So(c.IsSupported(nil), convey.ShouldBeTrue)
+		convey.So(len(validPaths), convey.ShouldEqual, 0)
+		jsonPath := writeTestFile(t, dir, "c.json", testJSONText)
+		brokenPath := writeTestFile(t, dir, "d.json", "{")
+		c.CollectToCache(nil, nil)
+		convey.So(validPaths, convey.ShouldResemble, []string{jsonPath})
+		convey.So(collectPrometheus(c)["json_metric"], convey.ShouldEqual, 1)
+
+		convey.Convey("the rejected file should be checked again when it is modified", func() {
+			convey.So(os.Remove(jsonPath), convey.ShouldBeNil)
+			writeTestFile(t, dir, "d.json", testJSONText)
+			convey.So(os.Chtimes(brokenPath, time.Now(), time.Now().Add(time.Minute)), convey.ShouldBeNil)
+			c.CollectToCache(nil, nil)
+			convey.So(validPaths, convey.ShouldResemble, []string{brokenPath})
+			convey.So(existMetrics["json_metric"], convey.ShouldEqual, brokenPath)
+			convey.So(collectPrometheus(c)["json_metric"], convey.ShouldEqual, 1)
+		})
+		convey.Convey("the removed file should not be reported", func() {
+			convey.So(os.Remove(jsonPath), convey.ShouldBeNil)
+			c.CollectToCache(nil, nil)
+			convey.So(len(validPaths), convey.ShouldEqual, 0)
+			convey.So(len(existMetrics), convey.ShouldEqual, 0)
+			convey.So(collectPrometheus(c)["json_metric"], convey.ShouldEqual, 0)
+		})
+	})
+}
+
+func TestFilterClaimedFamilies(t *testing.T) {
+	convey.Convey("test the metrics already reported by other files are ignored", t, func() {
+		families, err := parsePromText([]byte(testPromText2))
+		convey.So(err, convey.ShouldBeNil)
+		claimed := map[string]string{"train_step_total": testFilePath}
+		res := filterClaimedFamilies(testFilePath2, families, claimed)
+		convey.So(len(res), convey.ShouldEqual, 1)
+		convey.So(res[0].GetName(), convey.ShouldEqual, "train_lr")
+		convey.So(claimed["train_lr"], convey.ShouldEqual, testFilePath2)
+	})
+}
+
+func TestReloadTextMetricsFilePath(t *testing.T) {
+	convey.Convey("test reload the text metrics file path", t, func() {
+		resetGlobalMaps()
+		defer resetGlobalMaps()
+		oldDir, newDir := t.TempDir(), t.TempDir()
+		writeTestFile(t, oldDir, "a.prom", testPromText)
+		writeTestFile(t, newDir, "c.json", testJSONText)
+		SetTextMetricsFilePath(oldDir)
+		defer SetTextMetricsFilePath("")
+
+		c := &TextMetricsInfoCollector{}
+		convey.So(c.IsSupported(nil), convey.ShouldBeTrue)
+		c.CollectToCache(nil, nil)
+		convey.So(collectPrometheus(c)["train_step_total"], convey.ShouldEqual, num2)
+
+		ReloadTextMetricsFilePath(newDir + "," + filepath.Join(newDir, "missing.json"))
+		c.CollectToCache(nil, nil)
+		counts := collectPrometheus(c)
+		convey.So(textFileDirs, convey.ShouldResemble, []string{newDir})
+		convey.So(counts["train_step_total"], convey.ShouldEqual, 0)
+		convey.So(counts["json_metric"], convey.ShouldEqual, 1)
+		convey.So(counts[textFileMetric+"stale_seconds"], convey.ShouldEqual, 1)
+		convey.So(reloadedFilePath.Load(), convey.ShouldBeNil)
+	})
+}
@@ -23,6 +23,7 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
@@ -40,6 +41,10 @@ var (
 	existMetrics         = make(map[string]string)
 	metricStructInfosMap = make(map[string]metricStructInfo)
 	baseCacheKey         = ""
+	// metricInfosMu guard the metric infos of the json files, they are rebuilt when the file path is reloaded
+	metricInfosMu sync.RWMutex
+	// reloadedFilePath the file path reloaded from the config file, it is applied in the next collection
+	reloadedFilePath atomic.Pointer[string]
 )
 
 const (
@@ -90,6 +95,58 @@ func SetTextMetricsFilePath(metricsFilePath string) {
 	filePath = metricsFilePath
 }
 
+// ReloadTextMetricsFilePath change the file path at runtime, the new path is applied in the next collection.
+// it takes no effect when the text metrics collector is not started, such as the file path is empty at startup
+func ReloadTextMetricsFilePath(metricsFilePath string) {
+	reloadedFilePath.Store(&metricsFilePath)
+}
+
+// applyReloadedFilePath rebuild the file paths and the metric infos with the reloaded file path. unlike the
+// startup, the missing files are ignored without waiting, to avoid blocking the collection
+func (c *TextMetricsInfoCollector) applyReloadedFilePath() {
+	reloaded := reloadedFilePath.Swap(nil)
+	if reloaded == nil || *reloaded == filePath {
+		return
+	}
+	metricInfosMu.Lock()
+	filePath = *reloaded
+	validPaths = make([]string, 0)
+	textFileDirs = make([]string, 0)
+	promFilePaths = make([]string, 0)
+	dirJSONFiles = make(map[string]time.Time)
+	existMetrics = make(map[string]string)
+	metricStructInfosMap = make(map[string]metricStructInfo)
+	for _, path := range expandPaths(splitFilePath(filePath)) {
+		if checkAndProcessFile(strings.TrimSpace(path)) {
+			logger.Warnf("file %s does not exist or is empty, %s", path, fileDisabledMsg)
+		}
+	}
+	metricInfosMu.Unlock()
+	c.Cache.Range(func(key, _ interface{}) bool {
+		c.Cache.Delete(key)
+		return true
+	})
+	c.fileStates.Range(func(key, _ interface{}) bool {
+		c.fileStates.Delete(key)
+		return true
+	})
+	logger.Infof("text metrics file path is reloaded to %s, %d json text metric file(s), %d prom file(s) and "+
+		"%d dir(s) are collected", filePath, len(validPaths), len(promFilePaths), len(textFileDirs))
+}
+
+func splitFilePath(metricsFilePath string) []string {
+	if metricsFilePath == "" {
+		return nil
+	}
+	paths := strings.Split(metricsFilePath, ",")
+	if len(paths) > maxFileNumber {
+		logger.Warnf("the number of files is more than max allowed number(%d), only the first %d files will be collected",
+			maxFileNumber, maxFileNumber)
+		paths = paths[0:maxFileNumber]
+	}
+	return paths
+}
+
 func isDataOk(metricsData *TextMetricData, filePath string) error {
 	if len(metricsData.DataList) == 0 {
 		return fmt.Errorf("dataList is empty in json file %s", filePath)
@@ -151,55 +208,70 @@ func checkFilePermission(filePath string) error {
 type TextMetricsInfoCollector struct {
 	common.MetricsCollectorAdapter
 	Cache sync.Map
+	// fileStates the state of each file, for the staleness metrics
+	fileStates sync.Map
 }
 
 // Describe description of the metric
 func (c *TextMetricsInfoCollector) Describe(ch chan<- *prometheus.Desc) {
+	metricInfosMu.RLock()
+	defer metricInfosMu.RUnlock()
 	for _, metric := range metricStructInfosMap {
 		if metric.metricDesc != nil {
 			ch <- metric.metricDesc
 		}
 	}
+	ch <- descTextFileStale
+	ch <- descTextFileError
 }
 
 // CollectToCache collect the metric to cache
 func (c *TextMetricsInfoCollector) CollectToCache(n *common.NpuCollector, chipList []common.HuaWeiAIChip) {
 	logger.Debugf("TextMetricsInfoCollector CollectToCache")
+	c.applyReloadedFilePath()
+	c.rescanDirJSONFiles()
 
 	for _, jsonFilePath := range validPaths {
-		fileData, err := utils.ReadLimitBytes(jsonFilePath, size100k)
-		logId := jsonFilePath + "readFileErr"
-		if err != nil {
-			logger.LogfWithOptions(logger.WarnLevel, logger.LogOptions{Domain: logDomain, ID: logId},
-				"read json file %s failed: %v", jsonFilePath, err)
-			continue
-		}
-		hwlog.ResetErrCnt(logDomain, logId)
-
-		var metricsData TextMetricData
-		logId = jsonFilePath + "unmarshalFileErr"
-		if err := json.Unmarshal(fileData, &metricsData); err != nil {
-			logger.LogfWithOptions(logger.WarnLevel, logger.LogOptions{Domain: logDomain, ID: logId},
-				"unmarshal json file %s failed: %v, "+
-					"Possible causes:\n1. The file is not in JSON format\n2. File size is more than 100KB ", jsonFilePath, err)
-			continue
-		}
-		hwlog.ResetErrCnt(logDomain, logId)
+		c.storeFileState(jsonFilePath, !c.collectJSONFile(jsonFilePath))
+	}
+	c.collectPromFiles()
+}
 
-		if isStructInfoChangedForFile(jsonFilePath, metricsData) {
-			continue
-		}
+// collectJSONFile collect the json file to cache, return false when the file can not be collected
+func (c *TextMetricsInfoCollector) collectJSONFile(jsonFilePath string) bool {
+	fileData, err := utils.ReadLimitBytes(jsonFilePath, size100k)
+	logId := jsonFilePath + "readFileErr"
+	if err != nil {
+		logger.LogfWithOptions(logger.WarnLevel, logger.LogOptions{Domain: logDomain, ID: logId},
+			"read json file %s failed: %v", jsonFilePath, err)
+		return false
+	}
+	hwlog.ResetErrCnt(logDomain, logId)
 
-		logId = jsonFilePath + "dataNotOk"
-		if err := isDataOk(&metricsData, jsonFilePath); err != nil {
-			logger.LogfWithOptions(logger.WarnLevel, logger.LogOptions{Domain: logDomain, ID: logId},
-				"%v, %s", err, skipCurrentCollectionMsg)
-			continue
-		}
-		hwlog.ResetErrCnt(logDomain, logId)
+	var metricsData TextMetricData
+	logId = jsonFilePath + "unmarshalFileErr"
+	if err := json.Unmarshal(fileData, &metricsData); err != nil {
+		logger.LogfWithOptions(logger.WarnLevel, logger.LogOptions{Domain: logDomain, ID: logId},
+			"unmarshal json file %s failed: %v, "+
+				"Possible causes:\n1. The file is not in JSON format\n2. File size is more than 100KB ", jsonFilePath, err)
+		return false
+	}
+	hwlog.ResetErrCnt(logDomain, logId)
 
-		c.Cache.Store(fmt.Sprintf("%s-%s", baseCacheKey, jsonFilePath), metricsData)
+	if isStructInfoChangedForFile(jsonFilePath, metricsData) {
+		return false
+	}
+
+	logId = jsonFilePath + "dataNotOk"
+	if err := isDataOk(&metricsData, jsonFilePath); err != nil {
+		logger.LogfWithOptions(logger.WarnLevel, logger.LogOptions{Domain: logDomain, ID: logId},
+			"%v, %s", err, skipCurrentCollectionMsg)
+		return false
 	}
+	hwlog.ResetErrCnt(logDomain, logId)
+
+	c.Cache.Store(fmt.Sprintf("%s-%s", baseCacheKey, jsonFilePath), metricsData)
+	return true
 }
 
 func isStructInfoChangedForFile(jsonFilePath string, data TextMetricData) bool {
@@ -240,6 +312,7 @@ func (c *TextMetricsInfoCollector) UpdatePrometheus(ch chan<- prometheus.Metric,
 		ch <- prometheus.NewMetricWithTimestamp(timestamp,
 			prometheus.MustNewConstMetric(structInfo.metricDesc, prometheus.GaugeValue, item.Value, labelValues...))
 	})
+	c.updatePromFilesToPrometheus(ch)
 }
 
 // UpdateTelegraf update telegraf metric
@@ -265,6 +338,7 @@ func (c *TextMetricsInfoCollector) UpdateTelegraf(fieldsMap map[string]map[strin
 		}
 		fieldsMap[common.KeyForTextMetrics][jsonFilePath+"-"+strconv.Itoa(index)] = tetegrafData
 	})
+	c.updatePromFilesToTelegraf(fieldsMap[common.KeyForTextMetrics])
 
 	return fieldsMap
 }
@@ -309,7 +383,7 @@ func checkAndProcessFile(path string) bool {
 		return false
 	}
 	if utils.IsDir(path) {
-		logger.Errorf("file path %s is a directory, only support specify file path", path)
+		logger.Errorf("file path %s is a directory, the directory should be specified in the file path list", path)
 		return false
 	}
 
@@ -385,24 +459,20 @@ func (c *TextMetricsInfoCollector) IsSupported(n *common.NpuCollector) bool {
 	if filePath == "" {
 		return false
 	}
-	paths := strings.Split(filePath, ",")
-	if len(paths) > maxFileNumber {
-		logger.Warnf("the number of files is more than max allowed number(%d), only the first %d files will be collected",
-			maxFileNumber, maxFileNumber)
-		paths = paths[0:maxFileNumber]
-	}
-
-	preCheckPaths(paths)
+	preCheckPaths(expandPaths(splitFilePath(filePath)))
 
-	if len(validPaths) == 0 {
+	if len(validPaths) == 0 && len(textFileDirs) == 0 && len(promFilePaths) == 0 {
 		logger.Warnf("no valid file paths found in filePath: %s, %s", filePath, fileMetricsDisabledMsg)
 		return false
 	}
-	logger.Infof("successfully initialized %d text metric file(s)", len(validPaths))
+	logger.Infof("successfully initialized %d json text metric file(s), %d prom file(s) and %d dir(s)",
+		len(validPaths), len(promFilePaths), len(textFileDirs))
 	return true
 }
 
 func (c *TextMetricsInfoCollector) update(doUpdate func(string, metricStructInfo, time.Time, DataItem, int)) {
+	metricInfosMu.RLock()
+	defer metricInfosMu.RUnlock()
 	for jsonFilePath, structInfo := range metricStructInfosMap {
 		cacheKey := fmt.Sprintf("%s-%s", baseCacheKey, jsonFilePath)
 		data, ok := c.Cache.Load(cacheKey)
@@ -261,6 +261,9 @@ func resetGlobalMaps() {
 	metricStructInfosMap = make(map[string]metricStructInfo)
 	existMetrics = make(map[string]string)
 	validPaths = make([]string, 0)
+	textFileDirs = make([]string, 0)
+	promFilePaths = make([]string, 0)
+	dirJSONFiles = make(map[string]time.Time)
 }
 
 func setupFileCheckPatches(patches *gomonkey.Patches, isDir bool, checkPathErr error,
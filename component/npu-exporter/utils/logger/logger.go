@@ -45,6 +45,10 @@ const (
 	PrometheusPlatform = "Prometheus"
 	// TelegrafPlatform Telegraf platform
 	TelegrafPlatform = "Telegraf"
+	// OtlpPlatform OpenTelemetry OTLP platform
+	OtlpPlatform = "OTLP"
+	// RemoteWritePlatform Prometheus remote-write platform
+	RemoteWritePlatform = "RemoteWrite"
 )
 
 // HwLogConfig default log file
@@ -76,7 +80,7 @@ func InitLogger(platform string) error {
 		logger = &telegrafLogger{}
 		HwLogConfig.LogFileName = defaultTelegrafLogPath
 		HwLogConfig.OnlyToFile = true
-	} else if platform == PrometheusPlatform {
+	} else if platform == PrometheusPlatform || platform == OtlpPlatform || platform == RemoteWritePlatform {
 		logger = &generalLogger{}
 	} else {
 		return errors.New("platform is not supported:" + platform)
@@ -28,6 +28,8 @@ const (
 const (
 	// LogFilePathEnv for log file path environment
 	LogFilePathEnv = "TASKD_LOG_PATH"
+	// LogFormatEnv for log format environment, text or json, default text
+	LogFormatEnv = "TASKD_LOG_FORMAT"
 	// LogFileName default log file name
 	LogFileName          = "taskd.log"
 	WorkerLogPathPattern = "taskd-worker-%s.log"
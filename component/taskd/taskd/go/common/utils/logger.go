@@ -64,6 +64,7 @@ func GetLoggerConfigWithFileName(logFileName string) (*hwlog.LogConfig, error) {
 		MaxBackups:    constant.DefaultMaxBackups,
 		MaxAge:        constant.DefaultMaxAge,
 		MaxLineLength: constant.DefaultMaxLineLength,
+		Format:        os.Getenv(constant.LogFormatEnv),
 		// do not print to screen to avoid influence training log
 		OnlyToFile: true,
 	}
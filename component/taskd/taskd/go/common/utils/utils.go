@@ -52,6 +52,7 @@ func InitHwLog(logFileName string, ctx context.Context) error {
 		MaxBackups:    constant.DefaultMaxBackups,
 		MaxAge:        constant.DefaultMaxAge,
 		MaxLineLength: constant.DefaultMaxLineLength,
+		Format:        os.Getenv(constant.LogFormatEnv),
 		// do not print to screen to avoid influence training log
 		OnlyToFile: true,
 	}
@@ -26,9 +26,9 @@ import (
 
 	"github.com/google/uuid"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 
 	"ascend-common/common-utils/hwlog"
+	"ascend-common/common-utils/tlsutils"
 	clusterd_constant "clusterd/pkg/common/constant"
 	"clusterd/pkg/interface/grpc/profiling"
 	"clusterd/pkg/interface/grpc/recover"
@@ -175,7 +175,13 @@ func (m *BaseManager) registerClusterD(retryTime time.Duration) {
 		return
 	}
 	hwlog.RunLog.Infof("get clusterd addr %v", addr)
-	conn, err := grpc.Dial(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	dialOpts, err := tlsutils.ClusterdDialOptions()
+	if err != nil {
+		hwlog.RunLog.Errorf("get clusterd dial options err: %v", err)
+		m.registerClusterD(retryTime + 1)
+		return
+	}
+	conn, err := grpc.Dial(addr, dialOpts...)
 	if err != nil {
 		hwlog.RunLog.Errorf("init clusterd connect err: %v", err)
 		m.registerClusterD(retryTime + 1)
@@ -596,7 +602,12 @@ func ReportControllerInfoToClusterd(message *constant.ControllerMessage) bool {
 		hwlog.RunLog.Errorf("get clusterd address err: %v", err)
 		return false
 	}
-	conn, err := grpc.Dial(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	dialOpts, err := tlsutils.ClusterdDialOptions()
+	if err != nil {
+		hwlog.RunLog.Errorf("get clusterd dial options err: %v", err)
+		return false
+	}
+	conn, err := grpc.Dial(addr, dialOpts...)
 	if err != nil {
 		hwlog.RunLog.Errorf("init clusterd connect err: %v", err)
 		return false
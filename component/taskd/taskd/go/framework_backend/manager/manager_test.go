@@ -32,6 +32,7 @@ import (
 	"google.golang.org/grpc/test/bufconn"
 	"k8s.io/apimachinery/pkg/util/uuid"
 
+	"ascend-common/common-utils/tlsutils"
 	clusterd_constant "clusterd/pkg/common/constant"
 	"clusterd/pkg/interface/grpc/profiling"
 	"clusterd/pkg/interface/grpc/recover"
@@ -686,6 +687,23 @@ func TestBaseManager_registerClusterD_GetAddrError(t *testing.T) {
 	})
 }
 
+func TestBaseManager_registerClusterD_DialOptionsError(t *testing.T) {
+	convey.Convey("Test registerClusterD get dial options error, should retry", t, func() {
+		patch := gomonkey.ApplyFuncReturn(utils.InitHwLogger, nil)
+		defer patch.Reset()
+		patch.ApplyFuncReturn(utils.GetClusterdAddr, "127.0.0.1:8899", nil)
+		patch.ApplyFunc(time.Sleep, func(time.Duration) {})
+		callTimes := 0
+		patch.ApplyFunc(tlsutils.ClusterdDialOptions, func() ([]grpc.DialOption, error) {
+			callTimes++
+			return nil, errors.New("dial options error")
+		})
+		manager := &BaseManager{}
+		manager.registerClusterD(0)
+		convey.So(callTimes, convey.ShouldEqual, maxRegRetryTime)
+	})
+}
+
 func fakeTaskDManager(ctx context.Context) *BaseManager {
 	defaultWorkerNum := 8
 	m := &BaseManager{
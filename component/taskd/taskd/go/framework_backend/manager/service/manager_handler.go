@@ -21,9 +21,9 @@ import (
 	"time"
 
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 
 	"ascend-common/common-utils/hwlog"
+	"ascend-common/common-utils/tlsutils"
 	pb "clusterd/pkg/interface/grpc/recover"
 	"taskd/common/constant"
 	"taskd/common/utils"
@@ -70,7 +70,12 @@ func (mpc *MsgProcessor) replyToClusterD(result map[string]string) {
 		hwlog.RunLog.Errorf("get clusterd address err: %v", err)
 		return
 	}
-	conn, err := grpc.Dial(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	dialOpts, err := tlsutils.ClusterdDialOptions()
+	if err != nil {
+		hwlog.RunLog.Errorf("get clusterd dial options err: %v", err)
+		return
+	}
+	conn, err := grpc.Dial(addr, dialOpts...)
 	if err != nil {
 		hwlog.RunLog.Errorf("init clusterd connect err: %v", err)
 		return